@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/caarlos0/env/v11"
@@ -21,13 +22,127 @@ type Config struct {
 	PgConnLifeTime time.Duration `env:"AUTH_PG_CONNECTION_LIFETIME" envDefault:"5m"`
 
 	// JWT
-	AccessSecret  string        `env:"AUTH_ACCESS_SECRET,required"`
 	RefreshSecret string        `env:"AUTH_REFRESH_SECRET,required"`
 	AccessTTL     time.Duration `env:"AUTH_ACCESS_TTL" envDefault:"15m"`
 	RefreshTTL    time.Duration `env:"AUTH_REFRESH_TTL" envDefault:"720h"`
 
-	// Password hasher
-	PasswordCost int `env:"AUTH_PASSWORD_COST" envDefault:"4"`
+	// Lifetime of a token an admin gets to act on behalf of an account,
+	// it is never longer than AccessTTL
+	ImpersonationTTL time.Duration `env:"AUTH_IMPERSONATION_TTL" envDefault:"10m"`
+
+	// How a refreshed session is bound to its client: "strict", "subnet",
+	// "user_agent" or "none". A mismatch is reported, the refresh goes on
+	SessionBinding string `env:"AUTH_SESSION_BINDING" envDefault:"subnet"`
+
+	// Cap on active sessions per role as "role:limit" pairs, an account gets
+	// the largest cap of its roles and a role without one is not capped.
+	// Over the cap the oldest sessions are revoked ("evict_oldest")
+	// or the login is refused ("deny_new")
+	SessionLimits    map[string]int `env:"AUTH_SESSION_LIMITS" envSeparator:"," envKeyValSeparator:":"`
+	SessionLimitMode string         `env:"AUTH_SESSION_LIMIT_MODE" envDefault:"evict_oldest"`
+
+	// Access token signing keys are loaded from the PEM files of the dir.
+	// It is required outside development, there a temporary key
+	// is generated on start when no dir is set
+	JWTKeysDir     string `env:"AUTH_JWT_KEYS_DIR"`
+	JWTActiveKeyID string `env:"AUTH_JWT_ACTIVE_KEY_ID"`
+	JWTAlgorithm   string `env:"AUTH_JWT_ALGORITHM" envDefault:"EdDSA"`
+
+	// Password hasher, new hashes use PasswordAlgorithm (argon2id or bcrypt),
+	// hashes of the other one are still accepted and upgraded on login
+	PasswordAlgorithm string `env:"AUTH_PASSWORD_ALGORITHM" envDefault:"argon2id"`
+	PasswordCost      int    `env:"AUTH_PASSWORD_COST" envDefault:"12"`
+	Argon2Memory      uint32 `env:"AUTH_ARGON2_MEMORY" envDefault:"65536"` // KiB
+	Argon2Iterations  uint32 `env:"AUTH_ARGON2_ITERATIONS" envDefault:"3"`
+	Argon2Parallelism uint8  `env:"AUTH_ARGON2_PARALLELISM" envDefault:"2"`
+
+	// Mailer
+	SMTPHost     string `env:"AUTH_SMTP_HOST"`
+	SMTPPort     int    `env:"AUTH_SMTP_PORT" envDefault:"587"`
+	SMTPUser     string `env:"AUTH_SMTP_USER"`
+	SMTPPassword string `env:"AUTH_SMTP_PASSWORD"`
+	MailFrom     string `env:"AUTH_MAIL_FROM" envDefault:"no-reply@ads-service.local"`
+	MailDir      string `env:"AUTH_MAIL_DIR" envDefault:"./mail"`
+
+	// Email verification, letters per account are limited by a request limit
+	VerificationTokenTTL     time.Duration `env:"AUTH_VERIFICATION_TOKEN_TTL" envDefault:"24h"`
+	VerificationURL          string        `env:"AUTH_VERIFICATION_URL" envDefault:"http://localhost:8080/verify-email"`
+	VerificationWindow       time.Duration `env:"AUTH_VERIFICATION_WINDOW" envDefault:"1h"`
+	VerificationFreeRequests int           `env:"AUTH_VERIFICATION_FREE_REQUESTS" envDefault:"3"`
+	VerificationMaxRequests  int           `env:"AUTH_VERIFICATION_MAX_REQUESTS" envDefault:"5"`
+	VerificationBaseDelay    time.Duration `env:"AUTH_VERIFICATION_BASE_DELAY" envDefault:"1m"`
+
+	// Password reset, links are limited per email and, more loosely, per client IP
+	ResetTokenTTL       time.Duration `env:"AUTH_RESET_TOKEN_TTL" envDefault:"30m"`
+	ResetURL            string        `env:"AUTH_RESET_URL" envDefault:"http://localhost:8080/reset-password"`
+	ResetWindow         time.Duration `env:"AUTH_RESET_WINDOW" envDefault:"1h"`
+	ResetFreeRequests   int           `env:"AUTH_RESET_FREE_REQUESTS" envDefault:"3"`
+	ResetMaxRequests    int           `env:"AUTH_RESET_MAX_REQUESTS" envDefault:"5"`
+	ResetIPFreeRequests int           `env:"AUTH_RESET_IP_FREE_REQUESTS" envDefault:"10"`
+	ResetIPMaxRequests  int           `env:"AUTH_RESET_IP_MAX_REQUESTS" envDefault:"30"`
+	ResetBaseDelay      time.Duration `env:"AUTH_RESET_BASE_DELAY" envDefault:"1m"`
+
+	// Email change
+	EmailChangeTokenTTL time.Duration `env:"AUTH_EMAIL_CHANGE_TOKEN_TTL" envDefault:"1h"`
+	EmailChangeURL      string        `env:"AUTH_EMAIL_CHANGE_URL" envDefault:"http://localhost:8080/confirm-email-change"`
+
+	// Magic link login, requests per email are limited by a request limit
+	MagicLinkTTL          time.Duration `env:"AUTH_MAGIC_LINK_TTL" envDefault:"15m"`
+	MagicLinkURL          string        `env:"AUTH_MAGIC_LINK_URL" envDefault:"http://localhost:8080/magic-link"`
+	MagicLinkWindow       time.Duration `env:"AUTH_MAGIC_LINK_WINDOW" envDefault:"1h"`
+	MagicLinkFreeRequests int           `env:"AUTH_MAGIC_LINK_FREE_REQUESTS" envDefault:"3"`
+	MagicLinkMaxRequests  int           `env:"AUTH_MAGIC_LINK_MAX_REQUESTS" envDefault:"5"`
+	MagicLinkBaseDelay    time.Duration `env:"AUTH_MAGIC_LINK_BASE_DELAY" envDefault:"1m"`
+
+	// New device notifications, a login from an IP and user agent none of the
+	// sessions created within NewDeviceWindow had is reported (zero turns it off),
+	// the letter has a link that ends that session for NewDeviceReportTTL
+	NewDeviceWindow    time.Duration `env:"AUTH_NEW_DEVICE_WINDOW" envDefault:"720h"`
+	NewDeviceReportTTL time.Duration `env:"AUTH_NEW_DEVICE_REPORT_TTL" envDefault:"72h"`
+	NewDeviceReportURL string        `env:"AUTH_NEW_DEVICE_REPORT_URL" envDefault:"http://localhost:8080/report-session"`
+	NewDeviceQueue     string        `env:"AUTH_NEW_DEVICE_QUEUE" envDefault:"auth_new_device_login"`
+
+	// Refresh sessions janitor, revoked sessions are kept at least
+	// for RefreshTTL so that a replayed token is still caught as reuse
+	JanitorInterval         time.Duration `env:"AUTH_JANITOR_INTERVAL" envDefault:"1h"`
+	JanitorRevokedRetention time.Duration `env:"AUTH_JANITOR_REVOKED_RETENTION" envDefault:"720h"`
+	JanitorBatchSize        int           `env:"AUTH_JANITOR_BATCH_SIZE" envDefault:"1000"`
+
+	// Outbox relay, a message that failed to publish is retried after
+	// OutboxBaseDelay doubled on every attempt up to OutboxMaxDelay
+	OutboxInterval  time.Duration `env:"AUTH_OUTBOX_INTERVAL" envDefault:"1s"`
+	OutboxBatchSize int           `env:"AUTH_OUTBOX_BATCH_SIZE" envDefault:"100"`
+	OutboxBaseDelay time.Duration `env:"AUTH_OUTBOX_BASE_DELAY" envDefault:"1s"`
+	OutboxMaxDelay  time.Duration `env:"AUTH_OUTBOX_MAX_DELAY" envDefault:"5m"`
+	OutboxRetention time.Duration `env:"AUTH_OUTBOX_RETENTION" envDefault:"168h"`
+
+	// Clients allowed to introspect tokens, as "id:secret" pairs
+	// separated by commas. Introspection is refused when it is empty
+	IntrospectionClients map[string]string `env:"AUTH_INTROSPECTION_CLIENTS" envSeparator:"," envKeyValSeparator:":"`
+
+	// Revoked access tokens are kept in postgres, positive lookups are cached
+	// until the token expires and negative ones for the cache ttl
+	DenylistCacheTTL time.Duration `env:"AUTH_DENYLIST_CACHE_TTL" envDefault:"5s"`
+
+	// Login brute-force protection, attempts are stored in "postgres" or "memory"
+	LoginAttemptStore string        `env:"AUTH_LOGIN_ATTEMPT_STORE" envDefault:"postgres"`
+	LoginWindow       time.Duration `env:"AUTH_LOGIN_WINDOW" envDefault:"15m"`
+	LoginFreeAttempts int           `env:"AUTH_LOGIN_FREE_ATTEMPTS" envDefault:"3"`
+	LoginMaxFailures  int           `env:"AUTH_LOGIN_MAX_FAILURES" envDefault:"10"`
+	LoginBaseDelay    time.Duration `env:"AUTH_LOGIN_BASE_DELAY" envDefault:"1s"`
+	LoginLockout      time.Duration `env:"AUTH_LOGIN_LOCKOUT" envDefault:"15m"`
+
+	// Two-factor authentication, the encryption key is 32 bytes in base64.
+	// It is required outside development, there a temporary one
+	// is generated on start when it is empty
+	MFATokenTTL      time.Duration `env:"AUTH_MFA_TOKEN_TTL" envDefault:"5m"`
+	MFAEncryptionKey string        `env:"AUTH_MFA_ENCRYPTION_KEY"`
+	TOTPIssuer       string        `env:"AUTH_TOTP_ISSUER" envDefault:"AdsService"`
+
+	// Social login, every listed provider is configured with
+	// AUTH_OIDC_<NAME>_* variables (see OIDCProvider)
+	OIDCProviders []string      `env:"AUTH_OIDC_PROVIDERS" envSeparator:","`
+	OIDCStateTTL  time.Duration `env:"AUTH_OIDC_STATE_TTL" envDefault:"10m"`
 
 	// RabbitMQ
 	RabbitHost     string `env:"RABBIT_HOST,required"`
@@ -47,11 +162,40 @@ type Config struct {
 	Environment string `env:"AUTH_ENVIRONMENT" envDefault:"development"`
 }
 
+type OIDCProvider struct {
+	Issuer       string   `env:"ISSUER,required"`
+	ClientID     string   `env:"CLIENT_ID,required"`
+	ClientSecret string   `env:"CLIENT_SECRET"`
+	RedirectURL  string   `env:"REDIRECT_URL,required"`
+	Scopes       []string `env:"SCOPES" envSeparator:"," envDefault:"openid,email,profile"`
+}
+
+// LoadOIDCProvider reads AUTH_OIDC_GOOGLE_ISSUER and so on for "google"
+func LoadOIDCProvider(name string) (*OIDCProvider, error) {
+	provider := &OIDCProvider{}
+	opts := env.Options{Prefix: "AUTH_OIDC_" + strings.ToUpper(name) + "_"}
+	if err := env.ParseWithOptions(provider, opts); err != nil {
+		return nil, fmt.Errorf("failed to load oidc provider %s: %v", name, err)
+	}
+	return provider, nil
+}
+
+// IsDevelopment allows the insecure fallbacks meant for local runs only
+func (c *Config) IsDevelopment() bool {
+	return c.Environment == "development"
+}
+
 func Load() (*Config, error) {
 	cfg := &Config{}
 	if err := env.Parse(cfg); err != nil {
 		return nil, fmt.Errorf("failed to load config: %v", err)
 	}
+	if cfg.JanitorRevokedRetention < cfg.RefreshTTL {
+		return nil, fmt.Errorf(
+			"failed to load config: AUTH_JANITOR_REVOKED_RETENTION (%s) is shorter than AUTH_REFRESH_TTL (%s)",
+			cfg.JanitorRevokedRetention, cfg.RefreshTTL,
+		)
+	}
 
 	fmt.Printf("Config loaded successfully\n")
 	fmt.Printf("   Environment: %s\n", cfg.Environment)
@@ -3,14 +3,24 @@ package main
 import (
 	"github.com/maket12/ads-service/authservice/cmd/app/config"
 	adaptergrpc "github.com/maket12/ads-service/authservice/internal/adapter/in/grpc"
+	adaptermqin "github.com/maket12/ads-service/authservice/internal/adapter/in/rabbitmq"
+	adapterworker "github.com/maket12/ads-service/authservice/internal/adapter/in/worker"
+	adaptercipher "github.com/maket12/ads-service/authservice/internal/adapter/out/cipher"
 	adapterph "github.com/maket12/ads-service/authservice/internal/adapter/out/hasher"
 	adaptertg "github.com/maket12/ads-service/authservice/internal/adapter/out/jwt"
+	adaptermail "github.com/maket12/ads-service/authservice/internal/adapter/out/mailer"
+	adaptermem "github.com/maket12/ads-service/authservice/internal/adapter/out/memory"
+	adapteroidc "github.com/maket12/ads-service/authservice/internal/adapter/out/oidc"
 	adapterdb "github.com/maket12/ads-service/authservice/internal/adapter/out/postgres"
 	adaptermq "github.com/maket12/ads-service/authservice/internal/adapter/out/rabbitmq"
+	adaptertotp "github.com/maket12/ads-service/authservice/internal/adapter/out/totp"
 	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
 	"github.com/maket12/ads-service/pkg/generated/auth_v1"
 
 	"context"
+	"encoding/base64"
 	"fmt"
 	"log"
 	"log/slog"
@@ -19,9 +29,11 @@ import (
 	"os/signal"
 	"syscall"
 
+	pkgoutbox "github.com/maket12/ads-service/pkg/outbox"
 	pkgpostgres "github.com/maket12/ads-service/pkg/postgres"
 	pkgrabbitmq "github.com/maket12/ads-service/pkg/rabbitmq"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
@@ -135,6 +147,134 @@ func closeAccountPublisher(
 	}
 }
 
+func closeOutboxPublisher(
+	ctx context.Context,
+	logger *slog.Logger,
+	outboxPublisher *pkgrabbitmq.ConfirmPublisher,
+) {
+	logger.InfoContext(ctx, "closing outbox publisher...")
+	if err := outboxPublisher.Close(); err != nil {
+		logger.ErrorContext(ctx, "failed to close outbox publisher",
+			slog.Any("error", err),
+		)
+	}
+}
+
+// Uses SMTP when a host is configured, otherwise drops letters into a directory
+func newMailer(cfg *config.Config) (port.Mailer, error) {
+	if cfg.SMTPHost != "" {
+		smtpConfig := adaptermail.NewSMTPConfig(
+			cfg.SMTPHost, cfg.SMTPPort,
+			cfg.SMTPUser, cfg.SMTPPassword, cfg.MailFrom,
+		)
+		return adaptermail.NewSMTPMailer(smtpConfig), nil
+	}
+
+	return adaptermail.NewFileMailer(cfg.MailDir, cfg.MailFrom)
+}
+
+// Loads the keyring from PEM files, in development a temporary key is generated
+// when no directory is configured (tokens won't survive a restart)
+func newKeyring(cfg *config.Config, logger *slog.Logger) (*adaptertg.Keyring, error) {
+	if cfg.JWTKeysDir != "" {
+		return adaptertg.LoadKeyring(cfg.JWTKeysDir, cfg.JWTActiveKeyID)
+	}
+	if !cfg.IsDevelopment() {
+		return nil, fmt.Errorf("AUTH_JWT_KEYS_DIR is required in %s environment", cfg.Environment)
+	}
+
+	logger.Warn("no signing keys configured, generating a temporary one",
+		slog.String("algorithm", cfg.JWTAlgorithm),
+	)
+	key, err := adaptertg.GenerateSigningKey(
+		uuid.NewString(), model.SigningAlgorithm(cfg.JWTAlgorithm),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return adaptertg.NewKeyring(key)
+}
+
+// Decodes the TOTP secrets key, in development a temporary key is generated
+// when none is configured (enrolled factors won't survive a restart)
+func newSecretCipher(cfg *config.Config, logger *slog.Logger) (*adaptercipher.AESGCM, error) {
+	var (
+		key []byte
+		err error
+	)
+	switch {
+	case cfg.MFAEncryptionKey != "":
+		key, err = base64.StdEncoding.DecodeString(cfg.MFAEncryptionKey)
+	case !cfg.IsDevelopment():
+		return nil, fmt.Errorf("AUTH_MFA_ENCRYPTION_KEY is required in %s environment", cfg.Environment)
+	default:
+		logger.Warn("no mfa encryption key configured, generating a temporary one")
+		key, err = adaptercipher.GenerateKey()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return adaptercipher.NewAESGCM(key)
+}
+
+// Discovers every configured OIDC provider
+func newOIDCProviders(ctx context.Context, cfg *config.Config) (map[string]port.OIDCProvider, error) {
+	providers := make(map[string]port.OIDCProvider, len(cfg.OIDCProviders))
+	for _, name := range cfg.OIDCProviders {
+		providerCfg, err := config.LoadOIDCProvider(name)
+		if err != nil {
+			return nil, err
+		}
+
+		provider, err := adapteroidc.NewProvider(
+			ctx, providerCfg.Issuer, providerCfg.ClientID,
+			providerCfg.ClientSecret, providerCfg.RedirectURL,
+			providerCfg.Scopes,
+		)
+		if err != nil {
+			return nil, err
+		}
+		providers[name] = provider
+	}
+	return providers, nil
+}
+
+// In-memory counters are per replica, so they only fit a single instance
+func newLoginAttemptRepository(
+	cfg *config.Config, pgClient *pkgpostgres.Client,
+) (port.LoginAttemptRepository, error) {
+	switch cfg.LoginAttemptStore {
+	case "postgres":
+		return adapterdb.NewLoginAttemptsRepository(pgClient), nil
+	case "memory":
+		return adaptermem.NewLoginAttemptsRepository(), nil
+	default:
+		return nil, fmt.Errorf("unknown login attempt store: %s", cfg.LoginAttemptStore)
+	}
+}
+
+func newPasswordHasher(cfg *config.Config) (*adapterph.PasswordHasher, error) {
+	bcryptHasher := adapterph.NewBcryptHasher(cfg.PasswordCost)
+	argon2idHasher := adapterph.NewArgon2idHasher(adapterph.Argon2idParams{
+		Memory:      cfg.Argon2Memory,
+		Iterations:  cfg.Argon2Iterations,
+		Parallelism: cfg.Argon2Parallelism,
+		SaltLength:  16,
+		KeyLength:   32,
+	})
+
+	switch cfg.PasswordAlgorithm {
+	case "argon2id":
+		return adapterph.NewPasswordHasher(argon2idHasher, bcryptHasher), nil
+	case "bcrypt":
+		return adapterph.NewPasswordHasher(bcryptHasher, argon2idHasher), nil
+	default:
+		return nil, fmt.Errorf("unknown password algorithm: %s", cfg.PasswordAlgorithm)
+	}
+}
+
 func runServer(ctx context.Context, cfg *config.Config, logger *slog.Logger) error {
 	// Postgres client
 	pgClient, err := newPostgresClient(cfg)
@@ -158,12 +298,109 @@ func runServer(ctx context.Context, cfg *config.Config, logger *slog.Logger) err
 	accountRepo := adapterdb.NewAccountsRepository(pgClient)
 	accountRoleRepo := adapterdb.NewAccountRolesRepository(pgClient)
 	refreshSessionRepo := adapterdb.NewRefreshSessionsRepository(pgClient)
-	passwordHasher := adapterph.NewBcryptHasher(cfg.PasswordCost)
+	verificationTokenRepo := adapterdb.NewEmailVerificationTokensRepository(pgClient)
+	resetTokenRepo := adapterdb.NewPasswordResetTokensRepository(pgClient)
+	emailChangeTokenRepo := adapterdb.NewEmailChangeTokensRepository(pgClient)
+	magicLinkTokenRepo := adapterdb.NewMagicLinkTokensRepository(pgClient)
+	authEventRepo := adapterdb.NewAuthEventsRepository(pgClient)
+	totpFactorRepo := adapterdb.NewTOTPFactorsRepository(pgClient)
+	recoveryCodeRepo := adapterdb.NewRecoveryCodesRepository(pgClient)
+	externalIdentityRepo := adapterdb.NewExternalIdentitiesRepository(pgClient)
+	oidcStateRepo := adapterdb.NewOIDCStatesRepository(pgClient)
+	apiKeyRepo := adapterdb.NewAPIKeysRepository(pgClient)
+	sessionReportTokenRepo := adapterdb.NewSessionReportTokensRepository(pgClient)
+	locker := adapterdb.NewAdvisoryLocker(pgClient)
+	accessDenylist := adaptermem.NewCachedAccessTokenDenylist(
+		adapterdb.NewAccessTokenDenylist(pgClient), cfg.DenylistCacheTTL,
+	)
+	requestCounter := adapterdb.NewRequestCounter(pgClient)
+	loginAttemptRepo, err := newLoginAttemptRepository(cfg, pgClient)
+	if err != nil {
+		return fmt.Errorf("failed to init login attempt store: %w", err)
+	}
+	passwordHasher, err := newPasswordHasher(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to init password hasher: %w", err)
+	}
+
+	// Token signing
+	keyring, err := newKeyring(cfg, logger)
+	if err != nil {
+		return fmt.Errorf("failed to init signing keys: %w", err)
+	}
 	tokenGenerator := adaptertg.NewTokenGenerator(
-		cfg.AccessSecret, cfg.RefreshSecret,
-		cfg.AccessTTL, cfg.RefreshTTL,
+		keyring, cfg.RefreshSecret,
+		cfg.AccessTTL, cfg.RefreshTTL, cfg.MFATokenTTL,
 	)
 
+	// Two-factor authentication
+	totpProvider := adaptertotp.NewGenerator(cfg.TOTPIssuer)
+	secretCipher, err := newSecretCipher(cfg, logger)
+	if err != nil {
+		return fmt.Errorf("failed to init mfa encryption: %w", err)
+	}
+
+	// Login throttling
+	loginThrottle, err := model.NewLoginThrottle(
+		cfg.LoginWindow, cfg.LoginFreeAttempts, cfg.LoginMaxFailures,
+		cfg.LoginBaseDelay, cfg.LoginLockout,
+	)
+	if err != nil {
+		return fmt.Errorf("invalid login throttle config: %w", err)
+	}
+
+	// Request limits
+	magicLinkLimit, err := model.NewRequestLimit(
+		cfg.MagicLinkWindow, cfg.MagicLinkFreeRequests, cfg.MagicLinkMaxRequests,
+		cfg.MagicLinkBaseDelay,
+	)
+	if err != nil {
+		return fmt.Errorf("invalid magic link limit config: %w", err)
+	}
+	verificationLimit, err := model.NewRequestLimit(
+		cfg.VerificationWindow, cfg.VerificationFreeRequests, cfg.VerificationMaxRequests,
+		cfg.VerificationBaseDelay,
+	)
+	if err != nil {
+		return fmt.Errorf("invalid verification limit config: %w", err)
+	}
+	resetEmailLimit, err := model.NewRequestLimit(
+		cfg.ResetWindow, cfg.ResetFreeRequests, cfg.ResetMaxRequests, cfg.ResetBaseDelay,
+	)
+	if err != nil {
+		return fmt.Errorf("invalid password reset limit config: %w", err)
+	}
+	resetIPLimit, err := model.NewRequestLimit(
+		cfg.ResetWindow, cfg.ResetIPFreeRequests, cfg.ResetIPMaxRequests, cfg.ResetBaseDelay,
+	)
+	if err != nil {
+		return fmt.Errorf("invalid password reset ip limit config: %w", err)
+	}
+
+	// Refresh session binding
+	sessionBinding, err := model.NewSessionBindingPolicy(cfg.SessionBinding)
+	if err != nil {
+		return fmt.Errorf("invalid session binding config: %w", err)
+	}
+
+	// Active sessions cap
+	sessionLimit, err := model.NewSessionLimitPolicy(cfg.SessionLimitMode, cfg.SessionLimits)
+	if err != nil {
+		return fmt.Errorf("invalid session limit config: %w", err)
+	}
+
+	// Social login
+	oidcProviders, err := newOIDCProviders(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to init oidc providers: %w", err)
+	}
+
+	// Mailer
+	mailer, err := newMailer(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to init mailer: %w", err)
+	}
+
 	// RabbitMQ Publisher
 	accountPublisher, err := newAccountPublisher(cfg, rabbitClient)
 	if err != nil {
@@ -171,23 +408,171 @@ func runServer(ctx context.Context, cfg *config.Config, logger *slog.Logger) err
 	}
 	defer closeAccountPublisher(ctx, logger, accountPublisher)
 
+	// Transactional outbox, events are published with confirms by the relay
+	txManager := pkgpostgres.NewTransactionManager(pgClient)
+	outboxStore := pkgoutbox.NewPostgresStore(pgClient)
+	accountOutbox := adaptermq.NewAccountOutbox(
+		adaptermq.NewPublisherConfig(cfg.ExchangeName, cfg.RoutingKey), outboxStore,
+	)
+	outboxPublisher, err := pkgrabbitmq.NewConfirmPublisher(rabbitClient, cfg.ExchangeName)
+	if err != nil {
+		return fmt.Errorf("failed to init outbox publisher: %w", err)
+	}
+	defer closeOutboxPublisher(ctx, logger, outboxPublisher)
+
 	// Use-cases
 	registerUC := usecase.NewRegisterUC(
-		accountRepo, accountRoleRepo, passwordHasher, accountPublisher,
+		accountRepo, accountRoleRepo, passwordHasher, txManager, accountOutbox,
 	)
 	loginUC := usecase.NewLoginUC(
-		accountRepo, accountRoleRepo, refreshSessionRepo,
-		passwordHasher, tokenGenerator, cfg.RefreshTTL,
+		accountRepo, accountRoleRepo, refreshSessionRepo, totpFactorRepo,
+		passwordHasher, tokenGenerator, loginAttemptRepo, authEventRepo,
+		txManager, accountOutbox, loginThrottle, sessionLimit,
+		cfg.NewDeviceWindow, cfg.RefreshTTL,
+	)
+	logoutUC := usecase.NewLogoutUC(
+		refreshSessionRepo, tokenGenerator, authEventRepo,
+		accessDenylist, cfg.AccessTTL,
 	)
-	logoutUC := usecase.NewLogoutUC(refreshSessionRepo, tokenGenerator)
 	refreshSessionUC := usecase.NewRefreshSessionUC(
-		accountRoleRepo, refreshSessionRepo,
-		tokenGenerator, cfg.RefreshTTL,
+		accountRoleRepo, refreshSessionRepo, tokenGenerator,
+		authEventRepo, txManager, accountOutbox, accessDenylist,
+		sessionBinding, cfg.RefreshTTL, cfg.AccessTTL,
 	)
 	validateAccessUC := usecase.NewValidateAccessTokenUC(
-		accountRepo, tokenGenerator,
+		accountRepo, tokenGenerator, accessDenylist,
+	)
+	introspectTokenUC := usecase.NewIntrospectTokenUC(
+		accountRepo, accountRoleRepo, refreshSessionRepo, tokenGenerator,
+		accessDenylist, cfg.IntrospectionClients,
+	)
+	assignRoleUC := usecase.NewAssignRoleUC(accountRoleRepo, authEventRepo)
+	revokeRoleUC := usecase.NewRevokeRoleUC(accountRoleRepo, authEventRepo)
+	getUserRolesUC := usecase.NewGetUserRolesUC(accountRoleRepo)
+	listAccountsUC := usecase.NewListAccountsUC(accountRepo)
+	listAuthEventsUC := usecase.NewListAuthEventsUC(authEventRepo)
+	requestEmailChangeUC := usecase.NewRequestEmailChangeUC(
+		accountRepo, emailChangeTokenRepo, passwordHasher, mailer,
+		cfg.EmailChangeTokenTTL, cfg.EmailChangeURL,
+	)
+	confirmEmailChangeUC := usecase.NewConfirmEmailChangeUC(
+		accountRepo, emailChangeTokenRepo, authEventRepo, mailer, accountPublisher,
+	)
+	requestMagicLinkUC := usecase.NewRequestMagicLinkUC(
+		accountRepo, magicLinkTokenRepo, requestCounter, mailer,
+		magicLinkLimit, cfg.MagicLinkTTL, cfg.MagicLinkURL,
+	)
+	consumeMagicLinkUC := usecase.NewConsumeMagicLinkUC(
+		accountRepo, accountRoleRepo, refreshSessionRepo, totpFactorRepo,
+		magicLinkTokenRepo, tokenGenerator, authEventRepo, txManager,
+		accountOutbox, sessionLimit, cfg.NewDeviceWindow, cfg.RefreshTTL,
+	)
+	impersonateUC := usecase.NewImpersonateUC(
+		accountRepo, accountRoleRepo, tokenGenerator, authEventRepo,
+		min(cfg.ImpersonationTTL, cfg.AccessTTL),
+	)
+	sendVerificationUC := usecase.NewSendVerificationEmailUC(
+		accountRepo, verificationTokenRepo, requestCounter, txManager, mailer,
+		verificationLimit, cfg.VerificationTokenTTL, cfg.VerificationURL,
+	)
+	verifyEmailUC := usecase.NewVerifyEmailUC(accountRepo, verificationTokenRepo)
+	requestResetUC := usecase.NewRequestPasswordResetUC(
+		logger, accountRepo, resetTokenRepo, requestCounter, mailer,
+		resetEmailLimit, resetIPLimit, cfg.ResetTokenTTL, cfg.ResetURL,
+	)
+	resetPasswordUC := usecase.NewResetPasswordUC(
+		accountRepo, resetTokenRepo, refreshSessionRepo, passwordHasher,
+		authEventRepo, accessDenylist, cfg.AccessTTL,
+	)
+	changePasswordUC := usecase.NewChangePasswordUC(
+		accountRepo, refreshSessionRepo, passwordHasher, tokenGenerator,
+		authEventRepo, accessDenylist, cfg.AccessTTL,
+	)
+	listSessionsUC := usecase.NewListSessionsUC(refreshSessionRepo, tokenGenerator)
+	revokeSessionUC := usecase.NewRevokeSessionUC(
+		refreshSessionRepo, authEventRepo, accessDenylist, cfg.AccessTTL,
+	)
+	revokeOtherUC := usecase.NewRevokeAllOtherSessionsUC(
+		refreshSessionRepo, tokenGenerator, authEventRepo,
+		accessDenylist, cfg.AccessTTL,
+	)
+	getJWKSUC := usecase.NewGetJWKSUC(keyring)
+	blockAccountUC := usecase.NewBlockAccountUC(
+		accountRepo, accountRoleRepo, refreshSessionRepo, authEventRepo,
+		txManager, accountOutbox, accessDenylist, cfg.AccessTTL,
 	)
-	assignRoleUC := usecase.NewAssignRoleUC(accountRoleRepo)
+	unblockAccountUC := usecase.NewUnblockAccountUC(
+		accountRepo, accountRoleRepo, authEventRepo, txManager, accountOutbox,
+	)
+	deleteAccountUC := usecase.NewDeleteAccountUC(
+		accountRepo, accountRoleRepo, refreshSessionRepo, authEventRepo,
+		txManager, accountOutbox,
+	)
+	enrollTOTPUC := usecase.NewEnrollTOTPUC(
+		accountRepo, totpFactorRepo, totpProvider, secretCipher,
+	)
+	confirmTOTPUC := usecase.NewConfirmTOTPUC(
+		totpFactorRepo, recoveryCodeRepo, totpProvider, secretCipher,
+	)
+	verifyMFAUC := usecase.NewVerifyMFAUC(
+		accountRepo, accountRoleRepo, refreshSessionRepo, totpFactorRepo,
+		recoveryCodeRepo, loginAttemptRepo, totpProvider, secretCipher,
+		tokenGenerator, authEventRepo, txManager, accountOutbox,
+		loginThrottle, sessionLimit, cfg.NewDeviceWindow, cfg.RefreshTTL,
+	)
+	startOIDCLoginUC := usecase.NewStartOIDCLoginUC(
+		oidcStateRepo, oidcProviders, cfg.OIDCStateTTL,
+	)
+	completeOIDCLoginUC := usecase.NewCompleteOIDCLoginUC(
+		accountRepo, accountRoleRepo, refreshSessionRepo, totpFactorRepo,
+		externalIdentityRepo, oidcStateRepo, passwordHasher,
+		tokenGenerator, authEventRepo, txManager, accountOutbox,
+		oidcProviders, sessionLimit, cfg.NewDeviceWindow, cfg.RefreshTTL,
+	)
+	createAPIKeyUC := usecase.NewCreateAPIKeyUC(accountRepo, accountRoleRepo, apiKeyRepo)
+	listAPIKeysUC := usecase.NewListAPIKeysUC(apiKeyRepo)
+	revokeAPIKeyUC := usecase.NewRevokeAPIKeyUC(apiKeyRepo)
+	validateAPIKeyUC := usecase.NewValidateAPIKeyUC(accountRepo, accountRoleRepo, apiKeyRepo)
+	notifyNewDeviceUC := usecase.NewNotifyNewDeviceLoginUC(
+		accountRepo, refreshSessionRepo, sessionReportTokenRepo, mailer,
+		cfg.NewDeviceReportTTL, cfg.NewDeviceReportURL,
+	)
+	reportSessionUC := usecase.NewReportSessionUC(
+		refreshSessionRepo, sessionReportTokenRepo, authEventRepo,
+		accessDenylist, cfg.AccessTTL,
+	)
+	// No throttle or request limit reads entries older than its own window
+	limitRetention := max(
+		cfg.LoginWindow, cfg.MagicLinkWindow, cfg.VerificationWindow, cfg.ResetWindow,
+	)
+	cleanupSessionsUC := usecase.NewCleanupRefreshSessionsUC(
+		refreshSessionRepo, accessDenylist, loginAttemptRepo, requestCounter, locker,
+		cfg.JanitorRevokedRetention, limitRetention, cfg.JanitorBatchSize,
+	)
+
+	// Background workers
+	sessionJanitor := adapterworker.NewSessionJanitor(
+		logger, cfg.JanitorInterval, cleanupSessionsUC,
+	)
+	sessionJanitor.Start(ctx)
+	outboxRelay := pkgoutbox.NewRelay(logger, outboxStore, outboxPublisher, pkgoutbox.RelayConfig{
+		Interval:  cfg.OutboxInterval,
+		BatchSize: cfg.OutboxBatchSize,
+		Backoff:   pkgoutbox.Backoff{Base: cfg.OutboxBaseDelay, Max: cfg.OutboxMaxDelay},
+		Retention: cfg.OutboxRetention,
+	})
+	outboxRelay.Start(ctx)
+
+	// RabbitMQ Subscriber, mails owners about logins from new devices
+	newDeviceSubscriber := adaptermqin.NewNewDeviceSubscriber(
+		adaptermqin.NewSubscriberConfig(
+			cfg.ExchangeName, cfg.NewDeviceQueue, pkgrabbitmq.NewDeviceLoginRoutingKey,
+		),
+		logger, rabbitClient, notifyNewDeviceUC,
+	)
+	if err := newDeviceSubscriber.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start new device subscriber: %w", err)
+	}
 
 	// Handler
 	authHandler := adaptergrpc.NewAuthHandler(
@@ -198,6 +583,38 @@ func runServer(ctx context.Context, cfg *config.Config, logger *slog.Logger) err
 		refreshSessionUC,
 		validateAccessUC,
 		assignRoleUC,
+		sendVerificationUC,
+		verifyEmailUC,
+		requestResetUC,
+		resetPasswordUC,
+		changePasswordUC,
+		listSessionsUC,
+		revokeSessionUC,
+		revokeOtherUC,
+		getJWKSUC,
+		blockAccountUC,
+		unblockAccountUC,
+		deleteAccountUC,
+		enrollTOTPUC,
+		confirmTOTPUC,
+		verifyMFAUC,
+		startOIDCLoginUC,
+		completeOIDCLoginUC,
+		revokeRoleUC,
+		getUserRolesUC,
+		createAPIKeyUC,
+		listAPIKeysUC,
+		revokeAPIKeyUC,
+		validateAPIKeyUC,
+		listAuthEventsUC,
+		requestEmailChangeUC,
+		confirmEmailChangeUC,
+		requestMagicLinkUC,
+		consumeMagicLinkUC,
+		impersonateUC,
+		introspectTokenUC,
+		listAccountsUC,
+		reportSessionUC,
 	)
 
 	// gRPC server
@@ -3,10 +3,16 @@ package grpc
 import (
 	"context"
 	"log/slog"
+	"slices"
 
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
 	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
 	"github.com/maket12/ads-service/pkg/generated/auth_v1"
+	"github.com/maket12/ads-service/pkg/utils"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc/status"
 )
 
@@ -19,6 +25,38 @@ type AuthHandler struct {
 	refreshSessionUC      usecase.RefreshSessionUseCase
 	validateAccessTokenUC usecase.ValidateAccessTokenUseCase
 	assignRoleUC          usecase.AssignRoleUseCase
+	sendVerificationUC    usecase.SendVerificationEmailUseCase
+	verifyEmailUC         usecase.VerifyEmailUseCase
+	requestResetUC        usecase.RequestPasswordResetUseCase
+	resetPasswordUC       usecase.ResetPasswordUseCase
+	changePasswordUC      usecase.ChangePasswordUseCase
+	listSessionsUC        usecase.ListSessionsUseCase
+	revokeSessionUC       usecase.RevokeSessionUseCase
+	revokeOtherUC         usecase.RevokeAllOtherSessionsUseCase
+	getJWKSUC             usecase.GetJWKSUseCase
+	blockAccountUC        usecase.BlockAccountUseCase
+	unblockAccountUC      usecase.UnblockAccountUseCase
+	deleteAccountUC       usecase.DeleteAccountUseCase
+	enrollTOTPUC          usecase.EnrollTOTPUseCase
+	confirmTOTPUC         usecase.ConfirmTOTPUseCase
+	verifyMFAUC           usecase.VerifyMFAUseCase
+	startOIDCLoginUC      usecase.StartOIDCLoginUseCase
+	completeOIDCLoginUC   usecase.CompleteOIDCLoginUseCase
+	revokeRoleUC          usecase.RevokeRoleUseCase
+	getUserRolesUC        usecase.GetUserRolesUseCase
+	createAPIKeyUC        usecase.CreateAPIKeyUseCase
+	listAPIKeysUC         usecase.ListAPIKeysUseCase
+	revokeAPIKeyUC        usecase.RevokeAPIKeyUseCase
+	validateAPIKeyUC      usecase.ValidateAPIKeyUseCase
+	listAuthEventsUC      usecase.ListAuthEventsUseCase
+	requestEmailChangeUC  usecase.RequestEmailChangeUseCase
+	confirmEmailChangeUC  usecase.ConfirmEmailChangeUseCase
+	requestMagicLinkUC    usecase.RequestMagicLinkUseCase
+	consumeMagicLinkUC    usecase.ConsumeMagicLinkUseCase
+	impersonateUC         usecase.ImpersonateUseCase
+	introspectTokenUC     usecase.IntrospectTokenUseCase
+	listAccountsUC        usecase.ListAccountsUseCase
+	reportSessionUC       usecase.ReportSessionUseCase
 }
 
 func NewAuthHandler(
@@ -29,6 +67,38 @@ func NewAuthHandler(
 	refreshSessionUC usecase.RefreshSessionUseCase,
 	validateAccessTokenUC usecase.ValidateAccessTokenUseCase,
 	assignRoleUC usecase.AssignRoleUseCase,
+	sendVerificationUC usecase.SendVerificationEmailUseCase,
+	verifyEmailUC usecase.VerifyEmailUseCase,
+	requestResetUC usecase.RequestPasswordResetUseCase,
+	resetPasswordUC usecase.ResetPasswordUseCase,
+	changePasswordUC usecase.ChangePasswordUseCase,
+	listSessionsUC usecase.ListSessionsUseCase,
+	revokeSessionUC usecase.RevokeSessionUseCase,
+	revokeOtherUC usecase.RevokeAllOtherSessionsUseCase,
+	getJWKSUC usecase.GetJWKSUseCase,
+	blockAccountUC usecase.BlockAccountUseCase,
+	unblockAccountUC usecase.UnblockAccountUseCase,
+	deleteAccountUC usecase.DeleteAccountUseCase,
+	enrollTOTPUC usecase.EnrollTOTPUseCase,
+	confirmTOTPUC usecase.ConfirmTOTPUseCase,
+	verifyMFAUC usecase.VerifyMFAUseCase,
+	startOIDCLoginUC usecase.StartOIDCLoginUseCase,
+	completeOIDCLoginUC usecase.CompleteOIDCLoginUseCase,
+	revokeRoleUC usecase.RevokeRoleUseCase,
+	getUserRolesUC usecase.GetUserRolesUseCase,
+	createAPIKeyUC usecase.CreateAPIKeyUseCase,
+	listAPIKeysUC usecase.ListAPIKeysUseCase,
+	revokeAPIKeyUC usecase.RevokeAPIKeyUseCase,
+	validateAPIKeyUC usecase.ValidateAPIKeyUseCase,
+	listAuthEventsUC usecase.ListAuthEventsUseCase,
+	requestEmailChangeUC usecase.RequestEmailChangeUseCase,
+	confirmEmailChangeUC usecase.ConfirmEmailChangeUseCase,
+	requestMagicLinkUC usecase.RequestMagicLinkUseCase,
+	consumeMagicLinkUC usecase.ConsumeMagicLinkUseCase,
+	impersonateUC usecase.ImpersonateUseCase,
+	introspectTokenUC usecase.IntrospectTokenUseCase,
+	listAccountsUC usecase.ListAccountsUseCase,
+	reportSessionUC usecase.ReportSessionUseCase,
 ) *AuthHandler {
 	return &AuthHandler{
 		log:                   log,
@@ -38,9 +108,124 @@ func NewAuthHandler(
 		refreshSessionUC:      refreshSessionUC,
 		validateAccessTokenUC: validateAccessTokenUC,
 		assignRoleUC:          assignRoleUC,
+		sendVerificationUC:    sendVerificationUC,
+		verifyEmailUC:         verifyEmailUC,
+		requestResetUC:        requestResetUC,
+		resetPasswordUC:       resetPasswordUC,
+		changePasswordUC:      changePasswordUC,
+		listSessionsUC:        listSessionsUC,
+		revokeSessionUC:       revokeSessionUC,
+		revokeOtherUC:         revokeOtherUC,
+		getJWKSUC:             getJWKSUC,
+		blockAccountUC:        blockAccountUC,
+		unblockAccountUC:      unblockAccountUC,
+		deleteAccountUC:       deleteAccountUC,
+		enrollTOTPUC:          enrollTOTPUC,
+		confirmTOTPUC:         confirmTOTPUC,
+		verifyMFAUC:           verifyMFAUC,
+		startOIDCLoginUC:      startOIDCLoginUC,
+		completeOIDCLoginUC:   completeOIDCLoginUC,
+		revokeRoleUC:          revokeRoleUC,
+		getUserRolesUC:        getUserRolesUC,
+		createAPIKeyUC:        createAPIKeyUC,
+		listAPIKeysUC:         listAPIKeysUC,
+		revokeAPIKeyUC:        revokeAPIKeyUC,
+		validateAPIKeyUC:      validateAPIKeyUC,
+		listAuthEventsUC:      listAuthEventsUC,
+		requestEmailChangeUC:  requestEmailChangeUC,
+		confirmEmailChangeUC:  confirmEmailChangeUC,
+		requestMagicLinkUC:    requestMagicLinkUC,
+		consumeMagicLinkUC:    consumeMagicLinkUC,
+		impersonateUC:         impersonateUC,
+		introspectTokenUC:     introspectTokenUC,
+		listAccountsUC:        listAccountsUC,
+		reportSessionUC:       reportSessionUC,
 	}
 }
 
+// Extracts account id from context and returns gRPC error if fails
+func (h *AuthHandler) extractID(ctx context.Context) (uuid.UUID, error) {
+	accountID, err := utils.ExtractAccountID(ctx)
+	if err != nil {
+		outErr := gRPCError(err)
+		return uuid.Nil, status.Error(outErr.Code, outErr.Message)
+	}
+	return accountID, nil
+}
+
+// Extracts account id like extractID, but refuses requests an admin makes
+// on behalf of the account
+func (h *AuthHandler) extractActingID(ctx context.Context) (uuid.UUID, error) {
+	accountID, err := h.extractID(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	actorID, err := utils.ExtractActorID(ctx)
+	if err != nil {
+		outErr := gRPCError(err)
+		return uuid.Nil, status.Error(outErr.Code, outErr.Message)
+	}
+	if actorID != nil {
+		outErr := gRPCError(ucerrs.ErrImpersonationForbidden)
+		h.log.WarnContext(ctx, "refused impersonated request",
+			slog.String("account_id", accountID.String()),
+			slog.String("actor_id", actorID.String()),
+		)
+		return uuid.Nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return accountID, nil
+}
+
+// Extracts account id like extractActingID and refuses api keys as well,
+// only the owner in person may change its credentials and keys
+func (h *AuthHandler) extractOwnerID(ctx context.Context) (uuid.UUID, error) {
+	accountID, err := h.extractActingID(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	keyID, err := utils.ExtractAPIKeyID(ctx)
+	if err != nil {
+		outErr := gRPCError(err)
+		return uuid.Nil, status.Error(outErr.Code, outErr.Message)
+	}
+	if keyID != nil {
+		outErr := gRPCError(ucerrs.ErrAPIKeyForbidden)
+		h.log.WarnContext(ctx, "refused api key request",
+			slog.String("account_id", accountID.String()),
+			slog.String("api_key_id", keyID.String()),
+		)
+		return uuid.Nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return accountID, nil
+}
+
+// Extracts caller's account id from context and returns gRPC error
+// if the caller is not authenticated or lacks the permission.
+// Admin actions are never taken under impersonation, a scoped
+// api key may take them
+func (h *AuthHandler) extractAuthorizedID(ctx context.Context, permission model.Permission) (uuid.UUID, error) {
+	accountID, err := h.extractActingID(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	permissions, err := utils.ExtractAccountPermissions(ctx)
+	if err != nil {
+		outErr := gRPCError(err)
+		return uuid.Nil, status.Error(outErr.Code, outErr.Message)
+	}
+	if !slices.Contains(permissions, permission.String()) {
+		outErr := gRPCError(ucerrs.ErrPermissionDenied)
+		return uuid.Nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return accountID, nil
+}
+
 func (h *AuthHandler) Register(ctx context.Context, req *auth_v1.RegisterRequest) (*auth_v1.RegisterResponse, error) {
 	ucResp, err := h.registerUC.Execute(ctx, MapRegisterPbToDTO(req))
 
@@ -67,7 +252,7 @@ func (h *AuthHandler) Login(ctx context.Context, req *auth_v1.LoginRequest) (*au
 			slog.String("public_msg", outErr.Message),
 			slog.Any("reason", outErr.Reason),
 		)
-		return nil, status.Error(outErr.Code, outErr.Message)
+		return nil, statusError(outErr, err)
 	}
 
 	return MapLoginDTOToPb(ucResp), nil
@@ -122,7 +307,12 @@ func (h *AuthHandler) ValidateAccessToken(ctx context.Context, req *auth_v1.Vali
 }
 
 func (h *AuthHandler) AssignRole(ctx context.Context, req *auth_v1.AssignRoleRequest) (*auth_v1.AssignRoleResponse, error) {
-	ucResp, err := h.assignRoleUC.Execute(ctx, MapAssignRolePbToDTO(req))
+	adminID, gRPCErr := h.extractAuthorizedID(ctx, model.PermissionRolesAssign)
+	if gRPCErr != nil {
+		return nil, gRPCErr
+	}
+
+	ucResp, err := h.assignRoleUC.Execute(ctx, MapAssignRolePbToDTO(adminID, req))
 
 	if err != nil {
 		outErr := gRPCError(err)
@@ -136,3 +326,651 @@ func (h *AuthHandler) AssignRole(ctx context.Context, req *auth_v1.AssignRoleReq
 
 	return MapAssignRoleDTOToPb(ucResp), nil
 }
+
+func (h *AuthHandler) RevokeRole(ctx context.Context, req *auth_v1.RevokeRoleRequest) (*auth_v1.RevokeRoleResponse, error) {
+	adminID, gRPCErr := h.extractAuthorizedID(ctx, model.PermissionRolesAssign)
+	if gRPCErr != nil {
+		return nil, gRPCErr
+	}
+
+	ucResp, err := h.revokeRoleUC.Execute(ctx, MapRevokeRolePbToDTO(adminID, req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to revoke account role",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapRevokeRoleDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) GetUserRoles(ctx context.Context, req *auth_v1.GetUserRolesRequest) (*auth_v1.GetUserRolesResponse, error) {
+	callerID, gRPCErr := h.extractID(ctx)
+	if gRPCErr != nil {
+		return nil, gRPCErr
+	}
+
+	// Roles of another account are visible to the staff only
+	accountID := callerID
+	if req.GetAccountId() != "" && req.GetAccountId() != callerID.String() {
+		if _, gRPCErr := h.extractAuthorizedID(ctx, model.PermissionUsersRead); gRPCErr != nil {
+			return nil, gRPCErr
+		}
+		parsedID, err := uuid.Parse(req.GetAccountId())
+		if err != nil {
+			outErr := gRPCError(ucerrs.ErrInvalidAccountID)
+			return nil, status.Error(outErr.Code, outErr.Message)
+		}
+		accountID = parsedID
+	}
+
+	ucResp, err := h.getUserRolesUC.Execute(ctx, MapGetUserRolesPbToDTO(accountID))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to get account roles",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapGetUserRolesDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) SendVerificationEmail(ctx context.Context, _ *auth_v1.SendVerificationEmailRequest) (*auth_v1.SendVerificationEmailResponse, error) {
+	accountID, gRPCErr := h.extractID(ctx)
+	if gRPCErr != nil {
+		return nil, gRPCErr
+	}
+
+	ucResp, err := h.sendVerificationUC.Execute(ctx, MapSendVerificationEmailPbToDTO(accountID))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to send verification email",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, statusError(outErr, err)
+	}
+
+	return MapSendVerificationEmailDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) VerifyEmail(ctx context.Context, req *auth_v1.VerifyEmailRequest) (*auth_v1.VerifyEmailResponse, error) {
+	ucResp, err := h.verifyEmailUC.Execute(ctx, MapVerifyEmailPbToDTO(req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to verify email",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapVerifyEmailDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) RequestPasswordReset(ctx context.Context, req *auth_v1.RequestPasswordResetRequest) (*auth_v1.RequestPasswordResetResponse, error) {
+	ucResp, err := h.requestResetUC.Execute(ctx, MapRequestPasswordResetPbToDTO(req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to request password reset",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, statusError(outErr, err)
+	}
+
+	return MapRequestPasswordResetDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) ResetPassword(ctx context.Context, req *auth_v1.ResetPasswordRequest) (*auth_v1.ResetPasswordResponse, error) {
+	ucResp, err := h.resetPasswordUC.Execute(ctx, MapResetPasswordPbToDTO(req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to reset password",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapResetPasswordDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) ChangePassword(ctx context.Context, req *auth_v1.ChangePasswordRequest) (*auth_v1.ChangePasswordResponse, error) {
+	accountID, gRPCErr := h.extractOwnerID(ctx)
+	if gRPCErr != nil {
+		return nil, gRPCErr
+	}
+
+	ucResp, err := h.changePasswordUC.Execute(ctx, MapChangePasswordPbToDTO(accountID, req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to change password",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapChangePasswordDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) ListSessions(ctx context.Context, req *auth_v1.ListSessionsRequest) (*auth_v1.ListSessionsResponse, error) {
+	accountID, gRPCErr := h.extractID(ctx)
+	if gRPCErr != nil {
+		return nil, gRPCErr
+	}
+
+	ucResp, err := h.listSessionsUC.Execute(ctx, MapListSessionsPbToDTO(accountID, req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to list sessions",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapListSessionsDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) RevokeSession(ctx context.Context, req *auth_v1.RevokeSessionRequest) (*auth_v1.RevokeSessionResponse, error) {
+	accountID, gRPCErr := h.extractOwnerID(ctx)
+	if gRPCErr != nil {
+		return nil, gRPCErr
+	}
+
+	ucResp, err := h.revokeSessionUC.Execute(ctx, MapRevokeSessionPbToDTO(accountID, req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to revoke session",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapRevokeSessionDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) RevokeAllOtherSessions(ctx context.Context, req *auth_v1.RevokeAllOtherSessionsRequest) (*auth_v1.RevokeAllOtherSessionsResponse, error) {
+	accountID, gRPCErr := h.extractOwnerID(ctx)
+	if gRPCErr != nil {
+		return nil, gRPCErr
+	}
+
+	ucResp, err := h.revokeOtherUC.Execute(ctx, MapRevokeAllOtherSessionsPbToDTO(accountID, req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to revoke other sessions",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapRevokeAllOtherSessionsDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) GetJWKS(ctx context.Context, _ *auth_v1.GetJWKSRequest) (*auth_v1.GetJWKSResponse, error) {
+	ucResp, err := h.getJWKSUC.Execute(ctx, dto.GetJWKSInput{})
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to get jwks",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapGetJWKSDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) BlockAccount(ctx context.Context, req *auth_v1.BlockAccountRequest) (*auth_v1.BlockAccountResponse, error) {
+	adminID, gRPCErr := h.extractAuthorizedID(ctx, model.PermissionUsersBlock)
+	if gRPCErr != nil {
+		return nil, gRPCErr
+	}
+
+	ucResp, err := h.blockAccountUC.Execute(ctx, MapBlockAccountPbToDTO(adminID, req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to block account",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapBlockAccountDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) UnblockAccount(ctx context.Context, req *auth_v1.UnblockAccountRequest) (*auth_v1.UnblockAccountResponse, error) {
+	adminID, gRPCErr := h.extractAuthorizedID(ctx, model.PermissionUsersBlock)
+	if gRPCErr != nil {
+		return nil, gRPCErr
+	}
+
+	ucResp, err := h.unblockAccountUC.Execute(ctx, MapUnblockAccountPbToDTO(adminID, req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to unblock account",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapUnblockAccountDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) DeleteAccount(ctx context.Context, req *auth_v1.DeleteAccountRequest) (*auth_v1.DeleteAccountResponse, error) {
+	adminID, gRPCErr := h.extractAuthorizedID(ctx, model.PermissionUsersDelete)
+	if gRPCErr != nil {
+		return nil, gRPCErr
+	}
+
+	ucResp, err := h.deleteAccountUC.Execute(ctx, MapDeleteAccountPbToDTO(adminID, req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to delete account",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapDeleteAccountDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) EnrollTOTP(ctx context.Context, req *auth_v1.EnrollTOTPRequest) (*auth_v1.EnrollTOTPResponse, error) {
+	accountID, gRPCErr := h.extractOwnerID(ctx)
+	if gRPCErr != nil {
+		return nil, gRPCErr
+	}
+
+	ucResp, err := h.enrollTOTPUC.Execute(ctx, MapEnrollTOTPPbToDTO(accountID, req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to enroll totp",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapEnrollTOTPDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) ConfirmTOTP(ctx context.Context, req *auth_v1.ConfirmTOTPRequest) (*auth_v1.ConfirmTOTPResponse, error) {
+	accountID, gRPCErr := h.extractOwnerID(ctx)
+	if gRPCErr != nil {
+		return nil, gRPCErr
+	}
+
+	ucResp, err := h.confirmTOTPUC.Execute(ctx, MapConfirmTOTPPbToDTO(accountID, req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to confirm totp",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapConfirmTOTPDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) VerifyMFA(ctx context.Context, req *auth_v1.VerifyMFARequest) (*auth_v1.VerifyMFAResponse, error) {
+	ucResp, err := h.verifyMFAUC.Execute(ctx, MapVerifyMFAPbToDTO(req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to verify mfa",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, statusError(outErr, err)
+	}
+
+	return MapVerifyMFADTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) StartOIDCLogin(ctx context.Context, req *auth_v1.StartOIDCLoginRequest) (*auth_v1.StartOIDCLoginResponse, error) {
+	ucResp, err := h.startOIDCLoginUC.Execute(ctx, MapStartOIDCLoginPbToDTO(req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to start oidc login",
+			slog.String("provider", req.GetProvider()),
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapStartOIDCLoginDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) CompleteOIDCLogin(ctx context.Context, req *auth_v1.CompleteOIDCLoginRequest) (*auth_v1.CompleteOIDCLoginResponse, error) {
+	ucResp, err := h.completeOIDCLoginUC.Execute(ctx, MapCompleteOIDCLoginPbToDTO(req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to complete oidc login",
+			slog.String("provider", req.GetProvider()),
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapCompleteOIDCLoginDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) CreateAPIKey(ctx context.Context, req *auth_v1.CreateAPIKeyRequest) (*auth_v1.CreateAPIKeyResponse, error) {
+	accountID, gRPCErr := h.extractOwnerID(ctx)
+	if gRPCErr != nil {
+		return nil, gRPCErr
+	}
+
+	permissions, err := utils.ExtractAccountPermissions(ctx)
+	if err != nil {
+		outErr := gRPCError(err)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	ucResp, err := h.createAPIKeyUC.Execute(ctx, MapCreateAPIKeyPbToDTO(accountID, permissions, req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to create api key",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapCreateAPIKeyDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) ListAPIKeys(ctx context.Context, req *auth_v1.ListAPIKeysRequest) (*auth_v1.ListAPIKeysResponse, error) {
+	accountID, gRPCErr := h.extractID(ctx)
+	if gRPCErr != nil {
+		return nil, gRPCErr
+	}
+
+	ucResp, err := h.listAPIKeysUC.Execute(ctx, MapListAPIKeysPbToDTO(accountID, req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to list api keys",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapListAPIKeysDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) RevokeAPIKey(ctx context.Context, req *auth_v1.RevokeAPIKeyRequest) (*auth_v1.RevokeAPIKeyResponse, error) {
+	accountID, gRPCErr := h.extractOwnerID(ctx)
+	if gRPCErr != nil {
+		return nil, gRPCErr
+	}
+
+	ucResp, err := h.revokeAPIKeyUC.Execute(ctx, MapRevokeAPIKeyPbToDTO(accountID, req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to revoke api key",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapRevokeAPIKeyDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) ValidateAPIKey(ctx context.Context, req *auth_v1.ValidateAPIKeyRequest) (*auth_v1.ValidateAPIKeyResponse, error) {
+	ucResp, err := h.validateAPIKeyUC.Execute(ctx, MapValidateAPIKeyPbToDTO(req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to validate api key",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapValidateAPIKeyDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) ListAuthEvents(ctx context.Context, req *auth_v1.ListAuthEventsRequest) (*auth_v1.ListAuthEventsResponse, error) {
+	if _, gRPCErr := h.extractAuthorizedID(ctx, model.PermissionAuditRead); gRPCErr != nil {
+		return nil, gRPCErr
+	}
+
+	ucResp, err := h.listAuthEventsUC.Execute(ctx, MapListAuthEventsPbToDTO(req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to list auth events",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapListAuthEventsDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) ListMyAuthEvents(ctx context.Context, req *auth_v1.ListMyAuthEventsRequest) (*auth_v1.ListAuthEventsResponse, error) {
+	accountID, gRPCErr := h.extractID(ctx)
+	if gRPCErr != nil {
+		return nil, gRPCErr
+	}
+
+	ucResp, err := h.listAuthEventsUC.Execute(ctx, MapListMyAuthEventsPbToDTO(accountID, req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to list own auth events",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapListAuthEventsDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) RequestEmailChange(ctx context.Context, req *auth_v1.RequestEmailChangeRequest) (*auth_v1.RequestEmailChangeResponse, error) {
+	accountID, gRPCErr := h.extractOwnerID(ctx)
+	if gRPCErr != nil {
+		return nil, gRPCErr
+	}
+
+	ucResp, err := h.requestEmailChangeUC.Execute(ctx, MapRequestEmailChangePbToDTO(accountID, req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to request email change",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapRequestEmailChangeDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) ConfirmEmailChange(ctx context.Context, req *auth_v1.ConfirmEmailChangeRequest) (*auth_v1.ConfirmEmailChangeResponse, error) {
+	ucResp, err := h.confirmEmailChangeUC.Execute(ctx, MapConfirmEmailChangePbToDTO(req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to confirm email change",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapConfirmEmailChangeDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) RequestMagicLink(ctx context.Context, req *auth_v1.RequestMagicLinkRequest) (*auth_v1.RequestMagicLinkResponse, error) {
+	ucResp, err := h.requestMagicLinkUC.Execute(ctx, MapRequestMagicLinkPbToDTO(req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to request magic link",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, statusError(outErr, err)
+	}
+
+	return MapRequestMagicLinkDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) ConsumeMagicLink(ctx context.Context, req *auth_v1.ConsumeMagicLinkRequest) (*auth_v1.ConsumeMagicLinkResponse, error) {
+	ucResp, err := h.consumeMagicLinkUC.Execute(ctx, MapConsumeMagicLinkPbToDTO(req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to consume magic link",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapConsumeMagicLinkDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) Impersonate(ctx context.Context, req *auth_v1.ImpersonateRequest) (*auth_v1.ImpersonateResponse, error) {
+	adminID, gRPCErr := h.extractAuthorizedID(ctx, model.PermissionAccountsImpersonate)
+	if gRPCErr != nil {
+		return nil, gRPCErr
+	}
+
+	ucResp, err := h.impersonateUC.Execute(ctx, MapImpersonatePbToDTO(adminID, req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to impersonate account",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapImpersonateDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) IntrospectToken(ctx context.Context, req *auth_v1.IntrospectTokenRequest) (*auth_v1.IntrospectTokenResponse, error) {
+	ucResp, err := h.introspectTokenUC.Execute(ctx, MapIntrospectTokenPbToDTO(req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to introspect token",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapIntrospectTokenDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) ListAccounts(ctx context.Context, req *auth_v1.ListAccountsRequest) (*auth_v1.ListAccountsResponse, error) {
+	if _, gRPCErr := h.extractAuthorizedID(ctx, model.PermissionUsersRead); gRPCErr != nil {
+		return nil, gRPCErr
+	}
+
+	ucResp, err := h.listAccountsUC.Execute(ctx, MapListAccountsPbToDTO(req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to list accounts",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapListAccountsDTOToPb(ucResp), nil
+}
+
+func (h *AuthHandler) ReportSession(ctx context.Context, req *auth_v1.ReportSessionRequest) (*auth_v1.ReportSessionResponse, error) {
+	ucResp, err := h.reportSessionUC.Execute(ctx, MapReportSessionPbToDTO(req))
+
+	if err != nil {
+		outErr := gRPCError(err)
+		h.log.ErrorContext(ctx, "failed to report session",
+			slog.Int("code", int(outErr.Code)),
+			slog.String("public_msg", outErr.Message),
+			slog.Any("reason", outErr.Reason),
+		)
+		return nil, status.Error(outErr.Code, outErr.Message)
+	}
+
+	return MapReportSessionDTOToPb(ucResp), nil
+}
@@ -2,8 +2,10 @@ package grpc_test
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/maket12/ads-service/authservice/internal/adapter/in/grpc"
@@ -13,7 +15,12 @@ import (
 	"github.com/maket12/ads-service/pkg/generated/auth_v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestAH_Register(t *testing.T) {
@@ -64,7 +71,9 @@ func TestAH_Register(t *testing.T) {
 			}
 
 			handler := grpc.NewAuthHandler(slog.Default(), mockReg, nil,
-				nil, nil, nil, nil,
+				nil, nil, nil, nil, nil, nil,
+				nil, nil, nil, nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 			)
 
 			resp, err := handler.Register(context.Background(), tt.request)
@@ -136,7 +145,10 @@ func TestAH_Login(t *testing.T) {
 			handler := grpc.NewAuthHandler(
 				slog.Default(), nil, mockLogin,
 				nil, nil, nil,
-				nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 			)
 
 			resp, err := handler.Login(context.Background(), tt.request)
@@ -149,6 +161,37 @@ func TestAH_Login(t *testing.T) {
 	}
 }
 
+func TestAH_Login_Throttled(t *testing.T) {
+	mockLogin := mocks.NewLoginUseCase(t)
+	mockLogin.On("Execute", mock.Anything, mock.Anything).
+		Return(dto.LoginOutput{}, ucerrs.RetryAfter(
+			ucerrs.ErrTooManyLoginAttempts, time.Millisecond*1500,
+		))
+
+	handler := grpc.NewAuthHandler(
+		slog.Default(), nil, mockLogin,
+		nil, nil, nil,
+		nil, nil, nil,
+		nil, nil, nil,
+		nil, nil, nil,
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+	)
+
+	_, err := handler.Login(context.Background(), &auth_v1.LoginRequest{
+		Email:    "zaizai@yummy.com",
+		Password: "i bother ShiShi",
+	})
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+
+	require.Len(t, st.Details(), 1)
+	retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	assert.Equal(t, time.Second*2, retryInfo.GetRetryDelay().AsDuration())
+}
+
 func TestAH_Logout(t *testing.T) {
 	type testCase struct {
 		name      string
@@ -202,7 +245,10 @@ func TestAH_Logout(t *testing.T) {
 			handler := grpc.NewAuthHandler(
 				slog.Default(), nil, nil,
 				mockLogout, nil, nil,
-				nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 			)
 
 			resp, err := handler.Logout(context.Background(), tt.request)
@@ -257,6 +303,30 @@ func TestAH_RefreshSession(t *testing.T) {
 				RefreshToken: "",
 			},
 		},
+		{
+			name: "Failure - reused token",
+			request: &auth_v1.RefreshSessionRequest{
+				OldRefreshToken: "refresh-token",
+			},
+			setupMock: func(m *mocks.RefreshSessionUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.RefreshSessionOutput{}, ucerrs.ErrRefreshTokenReused)
+			},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "Failure - reused token with a reason",
+			request: &auth_v1.RefreshSessionRequest{
+				OldRefreshToken: "refresh-token",
+			},
+			setupMock: func(m *mocks.RefreshSessionUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.RefreshSessionOutput{}, ucerrs.Wrap(
+						ucerrs.ErrRefreshTokenReused, errors.New("notification failed"),
+					))
+			},
+			wantCode: codes.Unauthenticated,
+		},
 	}
 
 	for _, tt := range testCases {
@@ -269,7 +339,10 @@ func TestAH_RefreshSession(t *testing.T) {
 			handler := grpc.NewAuthHandler(
 				slog.Default(), nil, nil,
 				nil, mockRefresh, nil,
-				nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 			)
 
 			resp, err := handler.RefreshSession(context.Background(), tt.request)
@@ -277,6 +350,8 @@ func TestAH_RefreshSession(t *testing.T) {
 			if tt.wantCode == codes.OK {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.wantResp, resp)
+			} else {
+				assert.Equal(t, tt.wantCode, status.Code(err))
 			}
 		})
 	}
@@ -301,14 +376,16 @@ func TestAH_ValidateAccessToken(t *testing.T) {
 			setupMock: func(m *mocks.ValidateAccessTokenUseCase) {
 				m.On("Execute", mock.Anything, mock.Anything).
 					Return(dto.ValidateAccessTokenOutput{
-						AccountID: testUID,
-						Role:      "user",
+						AccountID:   testUID,
+						Roles:       []string{"user"},
+						Permissions: []string{"ads:read", "ads:write"},
 					}, nil)
 			},
 			wantCode: codes.OK,
 			wantResp: &auth_v1.ValidateAccessTokenResponse{
-				AccountId: testUID.String(),
-				Role:      "user",
+				AccountId:   testUID.String(),
+				Roles:       []string{"user"},
+				Permissions: []string{"ads:read", "ads:write"},
 			},
 		},
 		{
@@ -323,7 +400,6 @@ func TestAH_ValidateAccessToken(t *testing.T) {
 			wantCode: codes.Unauthenticated,
 			wantResp: &auth_v1.ValidateAccessTokenResponse{
 				AccountId: "",
-				Role:      "",
 			},
 		},
 	}
@@ -338,7 +414,10 @@ func TestAH_ValidateAccessToken(t *testing.T) {
 			handler := grpc.NewAuthHandler(
 				slog.Default(), nil, nil,
 				nil, nil, mockValidate,
-				nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 			)
 
 			resp, err := handler.ValidateAccessToken(context.Background(), tt.request)
@@ -352,8 +431,16 @@ func TestAH_ValidateAccessToken(t *testing.T) {
 }
 
 func TestAH_AssignRole(t *testing.T) {
+	adminCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", uuid.NewString(), "x-account-permissions", "roles:assign"),
+	)
+	userCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", uuid.NewString(), "x-account-permissions", "ads:write"),
+	)
+
 	type testCase struct {
 		name      string
+		ctx       context.Context
 		request   *auth_v1.AssignRoleRequest
 		setupMock func(m *mocks.AssignRoleUseCase)
 		wantCode  codes.Code
@@ -361,9 +448,11 @@ func TestAH_AssignRole(t *testing.T) {
 	}
 	testCases := []testCase{
 		{
-			name: "Success logout",
+			name: "Success assign",
+			ctx:  adminCtx,
 			request: &auth_v1.AssignRoleRequest{
 				AccountId: uuid.New().String(),
+				Role:      "moderator",
 			},
 			setupMock: func(m *mocks.AssignRoleUseCase) {
 				m.On("Execute", mock.Anything, mock.Anything).
@@ -378,8 +467,10 @@ func TestAH_AssignRole(t *testing.T) {
 		},
 		{
 			name: "Failure - precondition",
+			ctx:  adminCtx,
 			request: &auth_v1.AssignRoleRequest{
 				AccountId: uuid.New().String(),
+				Role:      "root",
 			},
 			setupMock: func(m *mocks.AssignRoleUseCase) {
 				m.On("Execute", mock.Anything, mock.Anything).
@@ -388,9 +479,24 @@ func TestAH_AssignRole(t *testing.T) {
 					}, ucerrs.ErrCannotAssign)
 			},
 			wantCode: codes.FailedPrecondition,
-			wantResp: &auth_v1.AssignRoleResponse{
-				Assign: false,
+		},
+		{
+			name: "Failure - no roles:assign permission",
+			ctx:  userCtx,
+			request: &auth_v1.AssignRoleRequest{
+				AccountId: uuid.New().String(),
+				Role:      "admin",
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "Failure - not authenticated",
+			ctx:  context.Background(),
+			request: &auth_v1.AssignRoleRequest{
+				AccountId: uuid.New().String(),
+				Role:      "admin",
 			},
+			wantCode: codes.Unauthenticated,
 		},
 	}
 
@@ -404,15 +510,2471 @@ func TestAH_AssignRole(t *testing.T) {
 			handler := grpc.NewAuthHandler(
 				slog.Default(), nil, nil,
 				nil, nil, nil,
-				mockAssign,
+				mockAssign, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.AssignRole(tt.ctx, tt.request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_SendVerificationEmail(t *testing.T) {
+	testUID := uuid.New()
+
+	type testCase struct {
+		name      string
+		ctx       context.Context
+		setupMock func(m *mocks.SendVerificationEmailUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.SendVerificationEmailResponse
+		wantRetry time.Duration
+	}
+
+	testCases := []testCase{
+		{
+			name: "Success sending",
+			ctx: metadata.NewIncomingContext(context.Background(),
+				metadata.Pairs("x-account-id", testUID.String()),
+			),
+			setupMock: func(m *mocks.SendVerificationEmailUseCase) {
+				m.On("Execute", mock.Anything, dto.SendVerificationEmailInput{
+					AccountID: testUID,
+				}).Return(dto.SendVerificationEmailOutput{Sent: true}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.SendVerificationEmailResponse{Sent: true},
+		},
+		{
+			name:     "Failure - missing account id",
+			ctx:      context.Background(),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "Failure - already verified",
+			ctx: metadata.NewIncomingContext(context.Background(),
+				metadata.Pairs("x-account-id", testUID.String()),
+			),
+			setupMock: func(m *mocks.SendVerificationEmailUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.SendVerificationEmailOutput{Sent: false},
+						ucerrs.ErrEmailAlreadyVerified)
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "Failure - too many requests",
+			ctx: metadata.NewIncomingContext(context.Background(),
+				metadata.Pairs("x-account-id", testUID.String()),
+			),
+			setupMock: func(m *mocks.SendVerificationEmailUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.SendVerificationEmailOutput{}, ucerrs.RetryAfter(
+						ucerrs.ErrTooManyVerifications, time.Minute,
+					))
+			},
+			wantCode:  codes.ResourceExhausted,
+			wantRetry: time.Minute,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSend := mocks.NewSendVerificationEmailUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockSend)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, mockSend, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.SendVerificationEmail(
+				tt.ctx, &auth_v1.SendVerificationEmailRequest{},
+			)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, tt.wantCode, st.Code())
+			if tt.wantRetry > 0 {
+				require.Len(t, st.Details(), 1)
+				retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+				require.True(t, ok)
+				assert.Equal(t, tt.wantRetry, retryInfo.GetRetryDelay().AsDuration())
+			}
+		})
+	}
+}
+
+func TestAH_VerifyEmail(t *testing.T) {
+	type testCase struct {
+		name      string
+		request   *auth_v1.VerifyEmailRequest
+		setupMock func(m *mocks.VerifyEmailUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.VerifyEmailResponse
+	}
+
+	testCases := []testCase{
+		{
+			name:    "Success verification",
+			request: &auth_v1.VerifyEmailRequest{Token: "valid-token"},
+			setupMock: func(m *mocks.VerifyEmailUseCase) {
+				m.On("Execute", mock.Anything, dto.VerifyEmailInput{
+					Token: "valid-token",
+				}).Return(dto.VerifyEmailOutput{Verified: true}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.VerifyEmailResponse{Verified: true},
+		},
+		{
+			name:    "Failure - invalid token",
+			request: &auth_v1.VerifyEmailRequest{Token: "bad-token"},
+			setupMock: func(m *mocks.VerifyEmailUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.VerifyEmailOutput{Verified: false},
+						ucerrs.ErrInvalidVerificationToken)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockVerify := mocks.NewVerifyEmailUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockVerify)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, mockVerify,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.VerifyEmail(context.Background(), tt.request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_RequestPasswordReset(t *testing.T) {
+	type testCase struct {
+		name      string
+		request   *auth_v1.RequestPasswordResetRequest
+		setupMock func(m *mocks.RequestPasswordResetUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.RequestPasswordResetResponse
+		wantRetry time.Duration
+	}
+
+	ip := "127.0.0.1"
+
+	testCases := []testCase{
+		{
+			name:    "Success request",
+			request: &auth_v1.RequestPasswordResetRequest{Email: "user@test.com", Ip: &ip},
+			setupMock: func(m *mocks.RequestPasswordResetUseCase) {
+				m.On("Execute", mock.Anything, dto.RequestPasswordResetInput{
+					Email: "user@test.com",
+					IP:    &ip,
+				}).Return(dto.RequestPasswordResetOutput{Sent: true}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.RequestPasswordResetResponse{Sent: true},
+		},
+		{
+			name:    "Failure - mailer is down",
+			request: &auth_v1.RequestPasswordResetRequest{Email: "user@test.com"},
+			setupMock: func(m *mocks.RequestPasswordResetUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.RequestPasswordResetOutput{},
+						ucerrs.Wrap(ucerrs.ErrSendEmail, assert.AnError))
+			},
+			wantCode: codes.Internal,
+		},
+		{
+			name:    "Failure - too many requests",
+			request: &auth_v1.RequestPasswordResetRequest{Email: "user@test.com"},
+			setupMock: func(m *mocks.RequestPasswordResetUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.RequestPasswordResetOutput{}, ucerrs.RetryAfter(
+						ucerrs.ErrTooManyResetRequests, time.Minute,
+					))
+			},
+			wantCode:  codes.ResourceExhausted,
+			wantRetry: time.Minute,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRequest := mocks.NewRequestPasswordResetUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRequest)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				mockRequest, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.RequestPasswordReset(context.Background(), tt.request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, tt.wantCode, st.Code())
+			if tt.wantRetry > 0 {
+				require.Len(t, st.Details(), 1)
+				retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+				require.True(t, ok)
+				assert.Equal(t, tt.wantRetry, retryInfo.GetRetryDelay().AsDuration())
+			}
+		})
+	}
+}
+
+func TestAH_ResetPassword(t *testing.T) {
+	type testCase struct {
+		name      string
+		request   *auth_v1.ResetPasswordRequest
+		setupMock func(m *mocks.ResetPasswordUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.ResetPasswordResponse
+	}
+
+	testCases := []testCase{
+		{
+			name: "Success reset",
+			request: &auth_v1.ResetPasswordRequest{
+				Token:       "valid-token",
+				NewPassword: "brandNewPassword42",
+			},
+			setupMock: func(m *mocks.ResetPasswordUseCase) {
+				m.On("Execute", mock.Anything, dto.ResetPasswordInput{
+					Token:       "valid-token",
+					NewPassword: "brandNewPassword42",
+				}).Return(dto.ResetPasswordOutput{Reset: true}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.ResetPasswordResponse{Success: true},
+		},
+		{
+			name: "Failure - weak password",
+			request: &auth_v1.ResetPasswordRequest{
+				Token:       "valid-token",
+				NewPassword: "123",
+			},
+			setupMock: func(m *mocks.ResetPasswordUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.ResetPasswordOutput{}, ucerrs.ErrWeakPassword)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "Failure - invalid token",
+			request: &auth_v1.ResetPasswordRequest{
+				Token:       "bad-token",
+				NewPassword: "brandNewPassword42",
+			},
+			setupMock: func(m *mocks.ResetPasswordUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.ResetPasswordOutput{}, ucerrs.ErrInvalidResetToken)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReset := mocks.NewResetPasswordUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockReset)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, mockReset, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.ResetPassword(context.Background(), tt.request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_ChangePassword(t *testing.T) {
+	testUID := uuid.New()
+
+	type testCase struct {
+		name      string
+		ctx       context.Context
+		request   *auth_v1.ChangePasswordRequest
+		setupMock func(m *mocks.ChangePasswordUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.ChangePasswordResponse
+	}
+
+	authCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", testUID.String()),
+	)
+	request := &auth_v1.ChangePasswordRequest{
+		OldPassword:  "oldPassword1",
+		NewPassword:  "brandNewPassword42",
+		RefreshToken: "refresh-token",
+	}
+
+	testCases := []testCase{
+		{
+			name:    "Success change",
+			ctx:     authCtx,
+			request: request,
+			setupMock: func(m *mocks.ChangePasswordUseCase) {
+				m.On("Execute", mock.Anything, dto.ChangePasswordInput{
+					AccountID:    testUID,
+					RefreshToken: "refresh-token",
+					OldPassword:  "oldPassword1",
+					NewPassword:  "brandNewPassword42",
+				}).Return(dto.ChangePasswordOutput{Changed: true}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.ChangePasswordResponse{Changed: true},
+		},
+		{
+			name:     "Failure - missing account id",
+			ctx:      context.Background(),
+			request:  request,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "Failure - impersonated",
+			ctx: metadata.NewIncomingContext(context.Background(),
+				metadata.Pairs("x-account-id", testUID.String(), "x-actor-id", uuid.NewString()),
+			),
+			request:  request,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "Failure - api key",
+			ctx: metadata.NewIncomingContext(context.Background(),
+				metadata.Pairs("x-account-id", testUID.String(), "x-api-key-id", uuid.NewString()),
+			),
+			request:  request,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:    "Failure - wrong password",
+			ctx:     authCtx,
+			request: request,
+			setupMock: func(m *mocks.ChangePasswordUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.ChangePasswordOutput{}, ucerrs.ErrWrongPassword)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockChange := mocks.NewChangePasswordUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockChange)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, mockChange,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.ChangePassword(tt.ctx, tt.request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_ListSessions(t *testing.T) {
+	testUID := uuid.New()
+	sessionID := uuid.New()
+	createdAt := time.Now()
+	expiresAt := createdAt.Add(time.Hour)
+	ip := "127.0.0.1"
+
+	type testCase struct {
+		name      string
+		ctx       context.Context
+		setupMock func(m *mocks.ListSessionsUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.ListSessionsResponse
+	}
+
+	testCases := []testCase{
+		{
+			name: "Success listing",
+			ctx: metadata.NewIncomingContext(context.Background(),
+				metadata.Pairs("x-account-id", testUID.String()),
+			),
+			setupMock: func(m *mocks.ListSessionsUseCase) {
+				m.On("Execute", mock.Anything, dto.ListSessionsInput{
+					AccountID: testUID,
+				}).Return(dto.ListSessionsOutput{Sessions: []dto.SessionInfo{{
+					SessionID: sessionID,
+					IP:        &ip,
+					CreatedAt: createdAt,
+					ExpiresAt: expiresAt,
+					Current:   true,
+				}}}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.ListSessionsResponse{Sessions: []*auth_v1.Session{{
+				SessionId: sessionID.String(),
+				Ip:        &ip,
+				CreatedAt: timestamppb.New(createdAt),
+				ExpiresAt: timestamppb.New(expiresAt),
+				Current:   true,
+			}}},
+		},
+		{
+			name:     "Failure - missing account id",
+			ctx:      context.Background(),
+			wantCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockList := mocks.NewListSessionsUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockList)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				mockList, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.ListSessions(tt.ctx, &auth_v1.ListSessionsRequest{})
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_RevokeSession(t *testing.T) {
+	testUID := uuid.New()
+	sessionID := uuid.New()
+
+	authCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", testUID.String()),
+	)
+
+	type testCase struct {
+		name      string
+		request   *auth_v1.RevokeSessionRequest
+		setupMock func(m *mocks.RevokeSessionUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.RevokeSessionResponse
+	}
+
+	testCases := []testCase{
+		{
+			name:    "Success revoke",
+			request: &auth_v1.RevokeSessionRequest{SessionId: sessionID.String()},
+			setupMock: func(m *mocks.RevokeSessionUseCase) {
+				m.On("Execute", mock.Anything, dto.RevokeSessionInput{
+					AccountID: testUID,
+					SessionID: sessionID,
+				}).Return(dto.RevokeSessionOutput{Revoked: true}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.RevokeSessionResponse{Revoked: true},
+		},
+		{
+			name:    "Failure - foreign session",
+			request: &auth_v1.RevokeSessionRequest{SessionId: sessionID.String()},
+			setupMock: func(m *mocks.RevokeSessionUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.RevokeSessionOutput{}, ucerrs.ErrInvalidSessionID)
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRevoke := mocks.NewRevokeSessionUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRevoke)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, mockRevoke, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.RevokeSession(authCtx, tt.request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_RevokeAllOtherSessions(t *testing.T) {
+	testUID := uuid.New()
+
+	authCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", testUID.String()),
+	)
+
+	type testCase struct {
+		name      string
+		setupMock func(m *mocks.RevokeAllOtherSessionsUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.RevokeAllOtherSessionsResponse
+	}
+
+	testCases := []testCase{
+		{
+			name: "Success revoke",
+			setupMock: func(m *mocks.RevokeAllOtherSessionsUseCase) {
+				m.On("Execute", mock.Anything, dto.RevokeAllOtherSessionsInput{
+					AccountID:    testUID,
+					RefreshToken: "refresh-token",
+				}).Return(dto.RevokeAllOtherSessionsOutput{Revoked: true}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.RevokeAllOtherSessionsResponse{Revoked: true},
+		},
+		{
+			name: "Failure - invalid refresh token",
+			setupMock: func(m *mocks.RevokeAllOtherSessionsUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.RevokeAllOtherSessionsOutput{}, ucerrs.ErrInvalidRefreshToken)
+			},
+			wantCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRevokeOther := mocks.NewRevokeAllOtherSessionsUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRevokeOther)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, mockRevokeOther,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.RevokeAllOtherSessions(authCtx,
+				&auth_v1.RevokeAllOtherSessionsRequest{RefreshToken: "refresh-token"},
+			)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_GetJWKS(t *testing.T) {
+	type testCase struct {
+		name      string
+		setupMock func(m *mocks.GetJWKSUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.GetJWKSResponse
+	}
+
+	testCases := []testCase{
+		{
+			name: "Success get jwks",
+			setupMock: func(m *mocks.GetJWKSUseCase) {
+				m.On("Execute", mock.Anything, dto.GetJWKSInput{}).
+					Return(dto.GetJWKSOutput{Keys: []dto.JWK{{
+						KeyID:     "key-1",
+						KeyType:   "OKP",
+						Algorithm: "EdDSA",
+						Use:       "sig",
+						Curve:     "Ed25519",
+						X:         "public-part",
+					}}}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.GetJWKSResponse{Keys: []*auth_v1.JWK{{
+				Kid: "key-1",
+				Kty: "OKP",
+				Alg: "EdDSA",
+				Use: "sig",
+				Crv: "Ed25519",
+				X:   "public-part",
+			}}},
+		},
+		{
+			name: "Failure - key set error",
+			setupMock: func(m *mocks.GetJWKSUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.GetJWKSOutput{}, ucerrs.Wrap(ucerrs.ErrGetPublicKeys, assert.AnError))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGetJWKS := mocks.NewGetJWKSUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockGetJWKS)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				mockGetJWKS, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.GetJWKS(context.Background(), &auth_v1.GetJWKSRequest{})
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_BlockAccount(t *testing.T) {
+	adminID := uuid.New()
+	targetID := uuid.New()
+
+	adminCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", adminID.String(), "x-account-permissions", "users:block"),
+	)
+	userCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", adminID.String(), "x-account-permissions", "ads:write"),
+	)
+	noPermissionsCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", adminID.String()),
+	)
+
+	request := &auth_v1.BlockAccountRequest{AccountId: targetID.String(), Reason: "spam"}
+
+	type testCase struct {
+		name      string
+		ctx       context.Context
+		setupMock func(m *mocks.BlockAccountUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.BlockAccountResponse
+	}
+
+	testCases := []testCase{
+		{
+			name: "Success block",
+			ctx:  adminCtx,
+			setupMock: func(m *mocks.BlockAccountUseCase) {
+				m.On("Execute", mock.Anything, dto.BlockAccountInput{
+					ActorID:   adminID,
+					AccountID: targetID,
+					Reason:    "spam",
+				}).Return(dto.BlockAccountOutput{Blocked: true}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.BlockAccountResponse{Blocked: true},
+		},
+		{
+			name:     "Failure - no users:block permission",
+			ctx:      userCtx,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Failure - permissions are missing",
+			ctx:      noPermissionsCtx,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Failure - not authenticated",
+			ctx:      context.Background(),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "Failure - already blocked",
+			ctx:  adminCtx,
+			setupMock: func(m *mocks.BlockAccountUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.BlockAccountOutput{}, ucerrs.ErrInvalidStatusTransition)
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "Failure - empty reason",
+			ctx:  adminCtx,
+			setupMock: func(m *mocks.BlockAccountUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.BlockAccountOutput{}, ucerrs.ErrReasonRequired)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockBlock := mocks.NewBlockAccountUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockBlock)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, mockBlock, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.BlockAccount(tt.ctx, request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_UnblockAccount(t *testing.T) {
+	adminID := uuid.New()
+	targetID := uuid.New()
+
+	adminCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", adminID.String(), "x-account-permissions", "users:block"),
+	)
+
+	type testCase struct {
+		name      string
+		setupMock func(m *mocks.UnblockAccountUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.UnblockAccountResponse
+	}
+
+	testCases := []testCase{
+		{
+			name: "Success unblock",
+			setupMock: func(m *mocks.UnblockAccountUseCase) {
+				m.On("Execute", mock.Anything, dto.UnblockAccountInput{
+					ActorID:   adminID,
+					AccountID: targetID,
+					Reason:    "appeal",
+				}).Return(dto.UnblockAccountOutput{Unblocked: true}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.UnblockAccountResponse{Unblocked: true},
+		},
+		{
+			name: "Failure - account not found",
+			setupMock: func(m *mocks.UnblockAccountUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.UnblockAccountOutput{}, ucerrs.ErrInvalidAccountID)
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUnblock := mocks.NewUnblockAccountUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockUnblock)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, mockUnblock,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.UnblockAccount(adminCtx,
+				&auth_v1.UnblockAccountRequest{AccountId: targetID.String(), Reason: "appeal"},
+			)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_DeleteAccount(t *testing.T) {
+	adminID := uuid.New()
+	targetID := uuid.New()
+
+	adminCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", adminID.String(), "x-account-permissions", "users:delete"),
+	)
+
+	type testCase struct {
+		name      string
+		setupMock func(m *mocks.DeleteAccountUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.DeleteAccountResponse
+	}
+
+	testCases := []testCase{
+		{
+			name: "Success delete",
+			setupMock: func(m *mocks.DeleteAccountUseCase) {
+				m.On("Execute", mock.Anything, dto.DeleteAccountInput{
+					ActorID:   adminID,
+					AccountID: targetID,
+					Reason:    "fraud",
+				}).Return(dto.DeleteAccountOutput{Deleted: true}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.DeleteAccountResponse{Deleted: true},
+		},
+		{
+			name: "Failure - own account",
+			setupMock: func(m *mocks.DeleteAccountUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.DeleteAccountOutput{}, ucerrs.ErrCannotModerateSelf)
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDelete := mocks.NewDeleteAccountUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockDelete)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				mockDelete, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.DeleteAccount(adminCtx,
+				&auth_v1.DeleteAccountRequest{AccountId: targetID.String(), Reason: "fraud"},
+			)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_EnrollTOTP(t *testing.T) {
+	testUID := uuid.New()
+
+	type testCase struct {
+		name      string
+		ctx       context.Context
+		setupMock func(m *mocks.EnrollTOTPUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.EnrollTOTPResponse
+	}
+
+	authCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", testUID.String()),
+	)
+
+	testCases := []testCase{
+		{
+			name: "Success enroll",
+			ctx:  authCtx,
+			setupMock: func(m *mocks.EnrollTOTPUseCase) {
+				m.On("Execute", mock.Anything, dto.EnrollTOTPInput{AccountID: testUID}).
+					Return(dto.EnrollTOTPOutput{Secret: "SECRET", URI: "otpauth://totp/x"}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.EnrollTOTPResponse{Secret: "SECRET", OtpauthUri: "otpauth://totp/x"},
+		},
+		{
+			name:     "Failure - missing account id",
+			ctx:      context.Background(),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "Failure - already enabled",
+			ctx:  authCtx,
+			setupMock: func(m *mocks.EnrollTOTPUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.EnrollTOTPOutput{}, ucerrs.ErrMFAAlreadyEnabled)
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockEnroll := mocks.NewEnrollTOTPUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockEnroll)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, mockEnroll, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.EnrollTOTP(tt.ctx, &auth_v1.EnrollTOTPRequest{})
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+			} else {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestAH_ConfirmTOTP(t *testing.T) {
+	testUID := uuid.New()
+
+	type testCase struct {
+		name      string
+		ctx       context.Context
+		setupMock func(m *mocks.ConfirmTOTPUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.ConfirmTOTPResponse
+	}
+
+	authCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", testUID.String()),
+	)
+	request := &auth_v1.ConfirmTOTPRequest{Code: "123456"}
+
+	testCases := []testCase{
+		{
+			name: "Success confirm",
+			ctx:  authCtx,
+			setupMock: func(m *mocks.ConfirmTOTPUseCase) {
+				m.On("Execute", mock.Anything, dto.ConfirmTOTPInput{AccountID: testUID, Code: "123456"}).
+					Return(dto.ConfirmTOTPOutput{RecoveryCodes: []string{"abcde-fghij"}}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.ConfirmTOTPResponse{RecoveryCodes: []string{"abcde-fghij"}},
+		},
+		{
+			name:     "Failure - missing account id",
+			ctx:      context.Background(),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "Failure - wrong code",
+			ctx:  authCtx,
+			setupMock: func(m *mocks.ConfirmTOTPUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.ConfirmTOTPOutput{}, ucerrs.ErrInvalidMFACode)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockConfirm := mocks.NewConfirmTOTPUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockConfirm)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, mockConfirm, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.ConfirmTOTP(tt.ctx, request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+			} else {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestAH_VerifyMFA(t *testing.T) {
+	type testCase struct {
+		name      string
+		setupMock func(m *mocks.VerifyMFAUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.VerifyMFAResponse
+	}
+
+	request := &auth_v1.VerifyMFARequest{MfaToken: "mfa-token", Code: "123456"}
+
+	testCases := []testCase{
+		{
+			name: "Success verify",
+			setupMock: func(m *mocks.VerifyMFAUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.VerifyMFAOutput{AccessToken: "access", RefreshToken: "refresh"}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.VerifyMFAResponse{AccessToken: "access", RefreshToken: "refresh"},
+		},
+		{
+			name: "Failure - expired challenge",
+			setupMock: func(m *mocks.VerifyMFAUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.VerifyMFAOutput{}, ucerrs.ErrInvalidMFAToken)
+			},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "Failure - too many attempts",
+			setupMock: func(m *mocks.VerifyMFAUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.VerifyMFAOutput{}, ucerrs.RetryAfter(ucerrs.ErrTooManyLoginAttempts, time.Minute))
+			},
+			wantCode: codes.ResourceExhausted,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockVerify := mocks.NewVerifyMFAUseCase(t)
+			tt.setupMock(mockVerify)
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, mockVerify, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.VerifyMFA(context.Background(), request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+			} else {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestAH_StartOIDCLogin(t *testing.T) {
+	type testCase struct {
+		name      string
+		setupMock func(m *mocks.StartOIDCLoginUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.StartOIDCLoginResponse
+	}
+
+	request := &auth_v1.StartOIDCLoginRequest{Provider: "google"}
+
+	testCases := []testCase{
+		{
+			name: "Success start",
+			setupMock: func(m *mocks.StartOIDCLoginUseCase) {
+				m.On("Execute", mock.Anything, dto.StartOIDCLoginInput{Provider: "google"}).
+					Return(dto.StartOIDCLoginOutput{AuthURL: "https://idp/auth", State: "state"}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.StartOIDCLoginResponse{AuthorizationUrl: "https://idp/auth", State: "state"},
+		},
+		{
+			name: "Failure - unknown provider",
+			setupMock: func(m *mocks.StartOIDCLoginUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.StartOIDCLoginOutput{}, ucerrs.ErrUnknownOIDCProvider)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStart := mocks.NewStartOIDCLoginUseCase(t)
+			tt.setupMock(mockStart)
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, mockStart, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.StartOIDCLogin(context.Background(), request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+			} else {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestAH_CompleteOIDCLogin(t *testing.T) {
+	type testCase struct {
+		name      string
+		setupMock func(m *mocks.CompleteOIDCLoginUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.CompleteOIDCLoginResponse
+	}
+
+	request := &auth_v1.CompleteOIDCLoginRequest{
+		Provider: "google", State: "state", Code: "code",
+	}
+
+	testCases := []testCase{
+		{
+			name: "Success complete",
+			setupMock: func(m *mocks.CompleteOIDCLoginUseCase) {
+				m.On("Execute", mock.Anything, mock.MatchedBy(func(in dto.CompleteOIDCLoginInput) bool {
+					return in.Provider == "google" && in.State == "state" && in.Code == "code"
+				})).Return(dto.CompleteOIDCLoginOutput{
+					AccessToken: "access", RefreshToken: "refresh", AccountCreated: true,
+				}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.CompleteOIDCLoginResponse{
+				AccessToken: "access", RefreshToken: "refresh", AccountCreated: true,
+			},
+		},
+		{
+			name: "Failure - replayed state",
+			setupMock: func(m *mocks.CompleteOIDCLoginUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.CompleteOIDCLoginOutput{}, ucerrs.ErrInvalidOIDCState)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "Failure - provider rejected code",
+			setupMock: func(m *mocks.CompleteOIDCLoginUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.CompleteOIDCLoginOutput{}, ucerrs.Wrap(ucerrs.ErrOIDCAuthFailed, assert.AnError))
+			},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "Failure - email not verified",
+			setupMock: func(m *mocks.CompleteOIDCLoginUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.CompleteOIDCLoginOutput{}, ucerrs.ErrCannotLinkIdentity)
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockComplete := mocks.NewCompleteOIDCLoginUseCase(t)
+			tt.setupMock(mockComplete)
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, mockComplete, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.CompleteOIDCLogin(context.Background(), request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+			} else {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestAH_RevokeRole(t *testing.T) {
+	adminID := uuid.New()
+	targetID := uuid.New()
+
+	adminCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", adminID.String(), "x-account-permissions", "roles:assign"),
+	)
+	supportCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", adminID.String(), "x-account-permissions", "users:block"),
+	)
+
+	request := &auth_v1.RevokeRoleRequest{AccountId: targetID.String(), Role: "moderator"}
+
+	type testCase struct {
+		name      string
+		ctx       context.Context
+		setupMock func(m *mocks.RevokeRoleUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.RevokeRoleResponse
+	}
+
+	testCases := []testCase{
+		{
+			name: "Success revoke",
+			ctx:  adminCtx,
+			setupMock: func(m *mocks.RevokeRoleUseCase) {
+				m.On("Execute", mock.Anything, dto.RevokeRoleInput{
+					AdminID:   adminID,
+					AccountID: targetID,
+					Role:      "moderator",
+				}).Return(dto.RevokeRoleOutput{Revoked: true}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.RevokeRoleResponse{Revoked: true},
+		},
+		{
+			name: "Failure - last role",
+			ctx:  adminCtx,
+			setupMock: func(m *mocks.RevokeRoleUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.RevokeRoleOutput{}, ucerrs.ErrCannotRevokeRole)
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "Failure - no roles:assign permission",
+			ctx:      supportCtx,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Failure - not authenticated",
+			ctx:      context.Background(),
+			wantCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRevoke := mocks.NewRevokeRoleUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRevoke)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, mockRevoke, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.RevokeRole(tt.ctx, request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_GetUserRoles(t *testing.T) {
+	callerID := uuid.New()
+	otherID := uuid.New()
+
+	userCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", callerID.String(), "x-account-permissions", "ads:write"),
+	)
+	supportCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", callerID.String(), "x-account-permissions", "users:read"),
+	)
+
+	type testCase struct {
+		name      string
+		ctx       context.Context
+		request   *auth_v1.GetUserRolesRequest
+		setupMock func(m *mocks.GetUserRolesUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.GetUserRolesResponse
+	}
+
+	testCases := []testCase{
+		{
+			name:    "Success - own roles",
+			ctx:     userCtx,
+			request: &auth_v1.GetUserRolesRequest{},
+			setupMock: func(m *mocks.GetUserRolesUseCase) {
+				m.On("Execute", mock.Anything, dto.GetUserRolesInput{AccountID: callerID}).
+					Return(dto.GetUserRolesOutput{
+						Roles:       []string{"user"},
+						Permissions: []string{"ads:read", "ads:write"},
+					}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.GetUserRolesResponse{
+				Roles:       []string{"user"},
+				Permissions: []string{"ads:read", "ads:write"},
+			},
+		},
+		{
+			name:    "Success - roles of another account",
+			ctx:     supportCtx,
+			request: &auth_v1.GetUserRolesRequest{AccountId: otherID.String()},
+			setupMock: func(m *mocks.GetUserRolesUseCase) {
+				m.On("Execute", mock.Anything, dto.GetUserRolesInput{AccountID: otherID}).
+					Return(dto.GetUserRolesOutput{Roles: []string{"admin"}}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.GetUserRolesResponse{Roles: []string{"admin"}},
+		},
+		{
+			name:     "Failure - another account without users:read",
+			ctx:      userCtx,
+			request:  &auth_v1.GetUserRolesRequest{AccountId: otherID.String()},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Failure - invalid account id",
+			ctx:      supportCtx,
+			request:  &auth_v1.GetUserRolesRequest{AccountId: "not-a-uuid"},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:    "Failure - account not found",
+			ctx:     supportCtx,
+			request: &auth_v1.GetUserRolesRequest{AccountId: otherID.String()},
+			setupMock: func(m *mocks.GetUserRolesUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.GetUserRolesOutput{}, ucerrs.ErrInvalidAccountID)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "Failure - not authenticated",
+			ctx:      context.Background(),
+			request:  &auth_v1.GetUserRolesRequest{},
+			wantCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGet := mocks.NewGetUserRolesUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockGet)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockGet, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.GetUserRoles(tt.ctx, tt.request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_CreateAPIKey(t *testing.T) {
+	testUID := uuid.New()
+	keyID := uuid.New()
+
+	authCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", testUID.String(), "x-account-permissions", "ads:write"),
+	)
+	apiKeyCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(
+			"x-account-id", testUID.String(), "x-api-key-id", uuid.NewString(),
+			"x-account-permissions", "ads:write",
+		),
+	)
+
+	type testCase struct {
+		name      string
+		ctx       context.Context
+		request   *auth_v1.CreateAPIKeyRequest
+		setupMock func(m *mocks.CreateAPIKeyUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.CreateAPIKeyResponse
+	}
+
+	testCases := []testCase{
+		{
+			name:    "Success create",
+			ctx:     authCtx,
+			request: &auth_v1.CreateAPIKeyRequest{Name: "ci", Scopes: []string{"ads:write"}},
+			setupMock: func(m *mocks.CreateAPIKeyUseCase) {
+				m.On("Execute", mock.Anything, dto.CreateAPIKeyInput{
+					AccountID: testUID,
+					Name:      "ci",
+					Scopes:    []string{"ads:write"},
+
+					CallerPermissions: []string{"ads:write"},
+				}).Return(dto.CreateAPIKeyOutput{
+					KeyID:  keyID,
+					Key:    "ak_aaaaaaaa_secret",
+					Prefix: "ak_aaaaaaaa",
+				}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.CreateAPIKeyResponse{
+				KeyId:  keyID.String(),
+				Key:    "ak_aaaaaaaa_secret",
+				Prefix: "ak_aaaaaaaa",
+			},
+		},
+		{
+			name:     "Failure - unauthenticated",
+			ctx:      context.Background(),
+			request:  &auth_v1.CreateAPIKeyRequest{Name: "ci", Scopes: []string{"ads:write"}},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "Failure - api key can not mint keys",
+			ctx:      apiKeyCtx,
+			request:  &auth_v1.CreateAPIKeyRequest{Name: "ci", Scopes: []string{"ads:write"}},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:    "Failure - scope not granted",
+			ctx:     authCtx,
+			request: &auth_v1.CreateAPIKeyRequest{Name: "ci", Scopes: []string{"users:block"}},
+			setupMock: func(m *mocks.CreateAPIKeyUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.CreateAPIKeyOutput{}, ucerrs.ErrInvalidScope)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCreate := mocks.NewCreateAPIKeyUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockCreate)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockCreate, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.CreateAPIKey(tt.ctx, tt.request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_ValidateAPIKey(t *testing.T) {
+	testUID := uuid.New()
+	keyID := uuid.New()
+
+	type testCase struct {
+		name      string
+		request   *auth_v1.ValidateAPIKeyRequest
+		setupMock func(m *mocks.ValidateAPIKeyUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.ValidateAPIKeyResponse
+	}
+
+	testCases := []testCase{
+		{
+			name:    "Success validation",
+			request: &auth_v1.ValidateAPIKeyRequest{Key: "ak_aaaaaaaa_secret"},
+			setupMock: func(m *mocks.ValidateAPIKeyUseCase) {
+				m.On("Execute", mock.Anything, dto.ValidateAPIKeyInput{Key: "ak_aaaaaaaa_secret"}).
+					Return(dto.ValidateAPIKeyOutput{
+						AccountID:   testUID,
+						KeyID:       keyID,
+						Roles:       []string{"user"},
+						Permissions: []string{"ads:write"},
+					}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.ValidateAPIKeyResponse{
+				AccountId:   testUID.String(),
+				KeyId:       keyID.String(),
+				Roles:       []string{"user"},
+				Permissions: []string{"ads:write"},
+			},
+		},
+		{
+			name:    "Failure - revoked key",
+			request: &auth_v1.ValidateAPIKeyRequest{Key: "ak_aaaaaaaa_secret"},
+			setupMock: func(m *mocks.ValidateAPIKeyUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.ValidateAPIKeyOutput{}, ucerrs.ErrInvalidAPIKey)
+			},
+			wantCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockValidate := mocks.NewValidateAPIKeyUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockValidate)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockValidate, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.ValidateAPIKey(context.Background(), tt.request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_ListAuthEvents(t *testing.T) {
+	adminID := uuid.New()
+	targetID := uuid.New()
+	eventID := uuid.New()
+	createdAt := time.Now().UTC()
+
+	adminCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", adminID.String(), "x-account-permissions", "audit:read"),
+	)
+	userCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", adminID.String(), "x-account-permissions", "ads:write"),
+	)
+
+	accountID := targetID.String()
+	eventType := "login"
+	cursor := "next-page"
+	request := &auth_v1.ListAuthEventsRequest{
+		AccountId: &accountID,
+		EventType: &eventType,
+		Limit:     10,
+	}
+
+	type testCase struct {
+		name      string
+		ctx       context.Context
+		setupMock func(m *mocks.ListAuthEventsUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.ListAuthEventsResponse
+	}
+
+	testCases := []testCase{
+		{
+			name: "Success list",
+			ctx:  adminCtx,
+			setupMock: func(m *mocks.ListAuthEventsUseCase) {
+				m.On("Execute", mock.Anything, dto.ListAuthEventsInput{
+					AccountID: &targetID,
+					EventType: &eventType,
+					Limit:     10,
+				}).Return(dto.ListAuthEventsOutput{
+					Events: []dto.AuthEventInfo{{
+						EventID:   eventID,
+						AccountID: targetID,
+						EventType: "login",
+						Outcome:   "failure",
+						Metadata:  map[string]string{"method": "password"},
+						CreatedAt: createdAt,
+					}},
+					NextCursor: &cursor,
+				}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.ListAuthEventsResponse{
+				Events: []*auth_v1.AuthEvent{{
+					EventId:   eventID.String(),
+					AccountId: targetID.String(),
+					EventType: "login",
+					Outcome:   "failure",
+					Metadata:  map[string]string{"method": "password"},
+					CreatedAt: timestamppb.New(createdAt),
+				}},
+				NextCursor: &cursor,
+			},
+		},
+		{
+			name: "Failure - invalid cursor",
+			ctx:  adminCtx,
+			setupMock: func(m *mocks.ListAuthEventsUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.ListAuthEventsOutput{}, ucerrs.ErrInvalidCursor)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Failure - no audit:read permission",
+			ctx:      userCtx,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Failure - not authenticated",
+			ctx:      context.Background(),
+			wantCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockList := mocks.NewListAuthEventsUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockList)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockList, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.ListAuthEvents(tt.ctx, request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_ListMyAuthEvents(t *testing.T) {
+	callerID := uuid.New()
+
+	userCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", callerID.String()),
+	)
+
+	type testCase struct {
+		name      string
+		ctx       context.Context
+		setupMock func(m *mocks.ListAuthEventsUseCase)
+		wantCode  codes.Code
+	}
+
+	testCases := []testCase{
+		{
+			name: "Success - only own events",
+			ctx:  userCtx,
+			setupMock: func(m *mocks.ListAuthEventsUseCase) {
+				m.On("Execute", mock.Anything, dto.ListAuthEventsInput{
+					AccountID: &callerID,
+					Limit:     20,
+				}).Return(dto.ListAuthEventsOutput{}, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "Failure - not authenticated",
+			ctx:      context.Background(),
+			wantCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockList := mocks.NewListAuthEventsUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockList)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockList, nil, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.ListMyAuthEvents(tt.ctx, &auth_v1.ListMyAuthEventsRequest{Limit: 20})
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Empty(t, resp.GetEvents())
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_RequestEmailChange(t *testing.T) {
+	testUID := uuid.New()
+
+	type testCase struct {
+		name      string
+		ctx       context.Context
+		setupMock func(m *mocks.RequestEmailChangeUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.RequestEmailChangeResponse
+	}
+
+	authCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", testUID.String()),
+	)
+	request := &auth_v1.RequestEmailChangeRequest{
+		Password: "password123",
+		NewEmail: "new@test.com",
+	}
+
+	testCases := []testCase{
+		{
+			name: "Success request",
+			ctx:  authCtx,
+			setupMock: func(m *mocks.RequestEmailChangeUseCase) {
+				m.On("Execute", mock.Anything, dto.RequestEmailChangeInput{
+					AccountID: testUID,
+					Password:  "password123",
+					NewEmail:  "new@test.com",
+				}).Return(dto.RequestEmailChangeOutput{Sent: true}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.RequestEmailChangeResponse{Sent: true},
+		},
+		{
+			name:     "Failure - missing account id",
+			ctx:      context.Background(),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "Failure - email taken",
+			ctx:  authCtx,
+			setupMock: func(m *mocks.RequestEmailChangeUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.RequestEmailChangeOutput{}, ucerrs.ErrAccountAlreadyExists)
+			},
+			wantCode: codes.AlreadyExists,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRequest := mocks.NewRequestEmailChangeUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRequest)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockRequest, nil, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.RequestEmailChange(tt.ctx, request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_ConfirmEmailChange(t *testing.T) {
+	type testCase struct {
+		name      string
+		setupMock func(m *mocks.ConfirmEmailChangeUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.ConfirmEmailChangeResponse
+	}
+
+	request := &auth_v1.ConfirmEmailChangeRequest{Token: "raw-token"}
+
+	testCases := []testCase{
+		{
+			name: "Success confirm",
+			setupMock: func(m *mocks.ConfirmEmailChangeUseCase) {
+				m.On("Execute", mock.Anything, dto.ConfirmEmailChangeInput{Token: "raw-token"}).
+					Return(dto.ConfirmEmailChangeOutput{Changed: true}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.ConfirmEmailChangeResponse{Changed: true},
+		},
+		{
+			name: "Failure - invalid token",
+			setupMock: func(m *mocks.ConfirmEmailChangeUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.ConfirmEmailChangeOutput{}, ucerrs.ErrInvalidEmailChangeToken)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockConfirm := mocks.NewConfirmEmailChangeUseCase(t)
+			tt.setupMock(mockConfirm)
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockConfirm, nil, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.ConfirmEmailChange(context.Background(), request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_RequestMagicLink(t *testing.T) {
+	type testCase struct {
+		name      string
+		setupMock func(m *mocks.RequestMagicLinkUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.RequestMagicLinkResponse
+	}
+
+	request := &auth_v1.RequestMagicLinkRequest{Email: "user@test.com"}
+
+	testCases := []testCase{
+		{
+			name: "Success request",
+			setupMock: func(m *mocks.RequestMagicLinkUseCase) {
+				m.On("Execute", mock.Anything, dto.RequestMagicLinkInput{Email: "user@test.com"}).
+					Return(dto.RequestMagicLinkOutput{Sent: true}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.RequestMagicLinkResponse{Sent: true},
+		},
+		{
+			name: "Failure - too many requests",
+			setupMock: func(m *mocks.RequestMagicLinkUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.RequestMagicLinkOutput{}, ucerrs.RetryAfter(ucerrs.ErrTooManyMagicLinks, time.Minute))
+			},
+			wantCode: codes.ResourceExhausted,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRequest := mocks.NewRequestMagicLinkUseCase(t)
+			tt.setupMock(mockRequest)
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+				mockRequest, nil, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.RequestMagicLink(context.Background(), request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_ConsumeMagicLink(t *testing.T) {
+	type testCase struct {
+		name      string
+		setupMock func(m *mocks.ConsumeMagicLinkUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.ConsumeMagicLinkResponse
+	}
+
+	ip, ua := "127.0.0.1", "Mozilla/5.0"
+	request := &auth_v1.ConsumeMagicLinkRequest{Token: "raw-token", Ip: &ip, UserAgent: &ua}
+
+	testCases := []testCase{
+		{
+			name: "Success consume",
+			setupMock: func(m *mocks.ConsumeMagicLinkUseCase) {
+				m.On("Execute", mock.Anything, dto.ConsumeMagicLinkInput{
+					Token:     "raw-token",
+					IP:        &ip,
+					UserAgent: &ua,
+				}).Return(dto.ConsumeMagicLinkOutput{AccessToken: "access", RefreshToken: "refresh"}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.ConsumeMagicLinkResponse{AccessToken: "access", RefreshToken: "refresh"},
+		},
+		{
+			name: "Failure - invalid link",
+			setupMock: func(m *mocks.ConsumeMagicLinkUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.ConsumeMagicLinkOutput{}, ucerrs.ErrInvalidMagicLink)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockConsume := mocks.NewConsumeMagicLinkUseCase(t)
+			tt.setupMock(mockConsume)
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+				nil, mockConsume, nil, nil, nil, nil,
+			)
+
+			resp, err := handler.ConsumeMagicLink(context.Background(), request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_Impersonate(t *testing.T) {
+	adminID := uuid.New()
+	targetID := uuid.New()
+	expiresAt := time.Now().Add(time.Minute * 10)
+
+	adminCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", adminID.String(), "x-account-permissions", "accounts:impersonate"),
+	)
+	userCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", adminID.String(), "x-account-permissions", "users:block"),
+	)
+	impersonatedCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(
+			"x-account-id", adminID.String(), "x-actor-id", uuid.NewString(),
+			"x-account-permissions", "accounts:impersonate",
+		),
+	)
+
+	request := &auth_v1.ImpersonateRequest{AccountId: targetID.String(), Reason: "ticket #42"}
+
+	type testCase struct {
+		name      string
+		ctx       context.Context
+		setupMock func(m *mocks.ImpersonateUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.ImpersonateResponse
+	}
+
+	testCases := []testCase{
+		{
+			name: "Success impersonate",
+			ctx:  adminCtx,
+			setupMock: func(m *mocks.ImpersonateUseCase) {
+				m.On("Execute", mock.Anything, dto.ImpersonateInput{
+					ActorID:   adminID,
+					AccountID: targetID,
+					Reason:    "ticket #42",
+				}).Return(dto.ImpersonateOutput{AccessToken: "access", ExpiresAt: expiresAt}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.ImpersonateResponse{
+				AccessToken: "access",
+				ExpiresAt:   timestamppb.New(expiresAt),
+			},
+		},
+		{
+			name:     "Failure - no accounts:impersonate permission",
+			ctx:      userCtx,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Failure - already impersonating",
+			ctx:      impersonatedCtx,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Failure - not authenticated",
+			ctx:      context.Background(),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "Failure - own account",
+			ctx:  adminCtx,
+			setupMock: func(m *mocks.ImpersonateUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.ImpersonateOutput{}, ucerrs.ErrCannotImpersonateSelf)
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockImpersonate := mocks.NewImpersonateUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockImpersonate)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+				nil, nil, mockImpersonate, nil, nil, nil,
+			)
+
+			resp, err := handler.Impersonate(tt.ctx, request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_IntrospectToken(t *testing.T) {
+	accountID := uuid.New()
+	sessionID := uuid.New()
+	issuedAt := time.Now()
+	expiresAt := issuedAt.Add(time.Minute * 15)
+	tokenType := "access_token"
+	accountIDStr, sessionIDStr := accountID.String(), sessionID.String()
+
+	request := &auth_v1.IntrospectTokenRequest{
+		ClientId:     "billing",
+		ClientSecret: "billing-secret",
+		Token:        "access",
+	}
+
+	type testCase struct {
+		name      string
+		setupMock func(m *mocks.IntrospectTokenUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.IntrospectTokenResponse
+	}
+
+	testCases := []testCase{
+		{
+			name: "Success active token",
+			setupMock: func(m *mocks.IntrospectTokenUseCase) {
+				m.On("Execute", mock.Anything, dto.IntrospectTokenInput{
+					ClientID:     "billing",
+					ClientSecret: "billing-secret",
+					Token:        "access",
+				}).Return(dto.IntrospectTokenOutput{
+					Active:    true,
+					TokenType: tokenType,
+					AccountID: accountID,
+					Roles:     []string{"user"},
+					SessionID: &sessionID,
+					IssuedAt:  issuedAt,
+					ExpiresAt: expiresAt,
+				}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.IntrospectTokenResponse{
+				Active:    true,
+				TokenType: &tokenType,
+				AccountId: &accountIDStr,
+				Roles:     []string{"user"},
+				SessionId: &sessionIDStr,
+				IssuedAt:  timestamppb.New(issuedAt),
+				ExpiresAt: timestamppb.New(expiresAt),
+			},
+		},
+		{
+			name: "Success inactive token reveals nothing",
+			setupMock: func(m *mocks.IntrospectTokenUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.IntrospectTokenOutput{Active: false}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.IntrospectTokenResponse{Active: false},
+		},
+		{
+			name: "Failure - invalid client",
+			setupMock: func(m *mocks.IntrospectTokenUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.IntrospectTokenOutput{}, ucerrs.ErrInvalidClient)
+			},
+			wantCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockIntrospect := mocks.NewIntrospectTokenUseCase(t)
+			tt.setupMock(mockIntrospect)
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+				nil, nil, nil, mockIntrospect, nil, nil,
+			)
+
+			resp, err := handler.IntrospectToken(context.Background(), request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_ListAccounts(t *testing.T) {
+	supportID := uuid.New()
+	accountID := uuid.New()
+	createdAt := time.Now().UTC()
+
+	supportCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", supportID.String(), "x-account-permissions", "users:read"),
+	)
+	userCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-account-id", supportID.String(), "x-account-permissions", "ads:write"),
+	)
+
+	role := "support"
+	sortBy := "email"
+	cursor := "next-page"
+	request := &auth_v1.ListAccountsRequest{
+		Role:     &role,
+		SortBy:   &sortBy,
+		SortDesc: true,
+		Limit:    10,
+	}
+
+	type testCase struct {
+		name      string
+		ctx       context.Context
+		setupMock func(m *mocks.ListAccountsUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.ListAccountsResponse
+	}
+
+	testCases := []testCase{
+		{
+			name: "Success list",
+			ctx:  supportCtx,
+			setupMock: func(m *mocks.ListAccountsUseCase) {
+				m.On("Execute", mock.Anything, dto.ListAccountsInput{
+					Role:     &role,
+					SortBy:   &sortBy,
+					SortDesc: true,
+					Limit:    10,
+				}).Return(dto.ListAccountsOutput{
+					Accounts: []dto.AccountInfo{{
+						AccountID:      accountID,
+						Email:          "user@test.com",
+						Status:         "active",
+						EmailVerified:  true,
+						Roles:          []string{"support", "user"},
+						ActiveSessions: 3,
+						CreatedAt:      createdAt,
+					}},
+					NextCursor: &cursor,
+				}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.ListAccountsResponse{
+				Accounts: []*auth_v1.AccountSummary{{
+					AccountId:      accountID.String(),
+					Email:          "user@test.com",
+					Status:         "active",
+					EmailVerified:  true,
+					Roles:          []string{"support", "user"},
+					ActiveSessions: 3,
+					CreatedAt:      timestamppb.New(createdAt),
+				}},
+				NextCursor: &cursor,
+			},
+		},
+		{
+			name: "Failure - invalid filter",
+			ctx:  supportCtx,
+			setupMock: func(m *mocks.ListAccountsUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.ListAccountsOutput{}, ucerrs.ErrInvalidAccountFilter)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Failure - no users:read permission",
+			ctx:      userCtx,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Failure - not authenticated",
+			ctx:      context.Background(),
+			wantCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockList := mocks.NewListAccountsUseCase(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockList)
+			}
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+				nil, nil, nil, nil, mockList, nil,
+			)
+
+			resp, err := handler.ListAccounts(tt.ctx, request)
+
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+				return
+			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAH_ReportSession(t *testing.T) {
+	type testCase struct {
+		name      string
+		setupMock func(m *mocks.ReportSessionUseCase)
+		wantCode  codes.Code
+		wantResp  *auth_v1.ReportSessionResponse
+	}
+
+	ip, ua := "127.0.0.1", "Mozilla/5.0"
+	request := &auth_v1.ReportSessionRequest{Token: "raw-token", Ip: &ip, UserAgent: &ua}
+
+	testCases := []testCase{
+		{
+			name: "Success report",
+			setupMock: func(m *mocks.ReportSessionUseCase) {
+				m.On("Execute", mock.Anything, dto.ReportSessionInput{
+					Token:     "raw-token",
+					IP:        &ip,
+					UserAgent: &ua,
+				}).Return(dto.ReportSessionOutput{Revoked: true}, nil)
+			},
+			wantCode: codes.OK,
+			wantResp: &auth_v1.ReportSessionResponse{Revoked: true},
+		},
+		{
+			name: "Failure - invalid link",
+			setupMock: func(m *mocks.ReportSessionUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.ReportSessionOutput{}, ucerrs.ErrInvalidSessionReport)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "Failure - internal error",
+			setupMock: func(m *mocks.ReportSessionUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.ReportSessionOutput{}, ucerrs.ErrUseSessionReportTokenDB)
+			},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReport := mocks.NewReportSessionUseCase(t)
+			tt.setupMock(mockReport)
+
+			handler := grpc.NewAuthHandler(
+				slog.Default(), nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+				nil, nil, nil, nil, nil, mockReport,
 			)
 
-			resp, err := handler.AssignRole(context.Background(), tt.request)
+			resp, err := handler.ReportSession(context.Background(), request)
 
 			if tt.wantCode == codes.OK {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.wantResp, resp)
+				return
 			}
+			assert.Equal(t, tt.wantCode, status.Code(err))
 		})
 	}
 }
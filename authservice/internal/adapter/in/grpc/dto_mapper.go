@@ -1,10 +1,13 @@
 package grpc
 
 import (
+	"time"
+
 	"github.com/maket12/ads-service/authservice/internal/app/dto"
 	"github.com/maket12/ads-service/pkg/generated/auth_v1"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func MapRegisterPbToDTO(req *auth_v1.RegisterRequest) dto.RegisterInput {
@@ -32,11 +35,16 @@ func MapLoginDTOToPb(out dto.LoginOutput) *auth_v1.LoginResponse {
 	return &auth_v1.LoginResponse{
 		AccessToken:  out.AccessToken,
 		RefreshToken: out.RefreshToken,
+		MfaRequired:  out.MFARequired,
+		MfaToken:     out.MFAToken,
 	}
 }
 
 func MapLogoutPbToDTO(req *auth_v1.LogoutRequest) dto.LogoutInput {
-	return dto.LogoutInput{RefreshToken: req.GetRefreshToken()}
+	return dto.LogoutInput{
+		RefreshToken: req.GetRefreshToken(),
+		AccessToken:  req.AccessToken,
+	}
 }
 
 func MapLogoutDTOToPb(out dto.LogoutOutput) *auth_v1.LogoutResponse {
@@ -65,14 +73,17 @@ func MapValidateAccessTokenPbToDTO(req *auth_v1.ValidateAccessTokenRequest) dto.
 
 func MapValidateAccessTokenDTOToPb(out dto.ValidateAccessTokenOutput) *auth_v1.ValidateAccessTokenResponse {
 	return &auth_v1.ValidateAccessTokenResponse{
-		AccountId: out.AccountID.String(),
-		Role:      out.Role,
+		AccountId:   out.AccountID.String(),
+		Roles:       out.Roles,
+		Permissions: out.Permissions,
+		ActorId:     optionalUUID(out.ActorID),
 	}
 }
 
-func MapAssignRolePbToDTO(req *auth_v1.AssignRoleRequest) dto.AssignRoleInput {
+func MapAssignRolePbToDTO(adminID uuid.UUID, req *auth_v1.AssignRoleRequest) dto.AssignRoleInput {
 	accID, _ := uuid.Parse(req.GetAccountId())
 	return dto.AssignRoleInput{
+		AdminID:   adminID,
 		AccountID: accID,
 		Role:      req.GetRole(),
 	}
@@ -81,3 +92,534 @@ func MapAssignRolePbToDTO(req *auth_v1.AssignRoleRequest) dto.AssignRoleInput {
 func MapAssignRoleDTOToPb(out dto.AssignRoleOutput) *auth_v1.AssignRoleResponse {
 	return &auth_v1.AssignRoleResponse{Assign: out.Assign}
 }
+
+func MapRevokeRolePbToDTO(adminID uuid.UUID, req *auth_v1.RevokeRoleRequest) dto.RevokeRoleInput {
+	accID, _ := uuid.Parse(req.GetAccountId())
+	return dto.RevokeRoleInput{
+		AdminID:   adminID,
+		AccountID: accID,
+		Role:      req.GetRole(),
+	}
+}
+
+func MapRevokeRoleDTOToPb(out dto.RevokeRoleOutput) *auth_v1.RevokeRoleResponse {
+	return &auth_v1.RevokeRoleResponse{Revoked: out.Revoked}
+}
+
+func MapGetUserRolesPbToDTO(accountID uuid.UUID) dto.GetUserRolesInput {
+	return dto.GetUserRolesInput{AccountID: accountID}
+}
+
+func MapGetUserRolesDTOToPb(out dto.GetUserRolesOutput) *auth_v1.GetUserRolesResponse {
+	return &auth_v1.GetUserRolesResponse{
+		Roles:       out.Roles,
+		Permissions: out.Permissions,
+	}
+}
+
+func MapSendVerificationEmailPbToDTO(accountID uuid.UUID) dto.SendVerificationEmailInput {
+	return dto.SendVerificationEmailInput{AccountID: accountID}
+}
+
+func MapSendVerificationEmailDTOToPb(out dto.SendVerificationEmailOutput) *auth_v1.SendVerificationEmailResponse {
+	return &auth_v1.SendVerificationEmailResponse{Sent: out.Sent}
+}
+
+func MapVerifyEmailPbToDTO(req *auth_v1.VerifyEmailRequest) dto.VerifyEmailInput {
+	return dto.VerifyEmailInput{Token: req.GetToken()}
+}
+
+func MapVerifyEmailDTOToPb(out dto.VerifyEmailOutput) *auth_v1.VerifyEmailResponse {
+	return &auth_v1.VerifyEmailResponse{Verified: out.Verified}
+}
+
+func MapRequestPasswordResetPbToDTO(req *auth_v1.RequestPasswordResetRequest) dto.RequestPasswordResetInput {
+	var ip = req.GetIp()
+	return dto.RequestPasswordResetInput{
+		Email: req.GetEmail(),
+		IP:    &ip,
+	}
+}
+
+func MapRequestPasswordResetDTOToPb(out dto.RequestPasswordResetOutput) *auth_v1.RequestPasswordResetResponse {
+	return &auth_v1.RequestPasswordResetResponse{Sent: out.Sent}
+}
+
+func MapResetPasswordPbToDTO(req *auth_v1.ResetPasswordRequest) dto.ResetPasswordInput {
+	return dto.ResetPasswordInput{
+		Token:       req.GetToken(),
+		NewPassword: req.GetNewPassword(),
+	}
+}
+
+func MapResetPasswordDTOToPb(out dto.ResetPasswordOutput) *auth_v1.ResetPasswordResponse {
+	return &auth_v1.ResetPasswordResponse{Success: out.Reset}
+}
+
+func MapChangePasswordPbToDTO(accountID uuid.UUID, req *auth_v1.ChangePasswordRequest) dto.ChangePasswordInput {
+	return dto.ChangePasswordInput{
+		AccountID:    accountID,
+		RefreshToken: req.GetRefreshToken(),
+		OldPassword:  req.GetOldPassword(),
+		NewPassword:  req.GetNewPassword(),
+	}
+}
+
+func MapChangePasswordDTOToPb(out dto.ChangePasswordOutput) *auth_v1.ChangePasswordResponse {
+	return &auth_v1.ChangePasswordResponse{Changed: out.Changed}
+}
+
+func MapListSessionsPbToDTO(accountID uuid.UUID, req *auth_v1.ListSessionsRequest) dto.ListSessionsInput {
+	return dto.ListSessionsInput{
+		AccountID:    accountID,
+		RefreshToken: req.RefreshToken,
+	}
+}
+
+func MapListSessionsDTOToPb(out dto.ListSessionsOutput) *auth_v1.ListSessionsResponse {
+	sessions := make([]*auth_v1.Session, 0, len(out.Sessions))
+	for _, s := range out.Sessions {
+		sessions = append(sessions, &auth_v1.Session{
+			SessionId: s.SessionID.String(),
+			Ip:        s.IP,
+			UserAgent: s.UserAgent,
+			CreatedAt: timestamppb.New(s.CreatedAt),
+			ExpiresAt: timestamppb.New(s.ExpiresAt),
+			Current:   s.Current,
+		})
+	}
+	return &auth_v1.ListSessionsResponse{Sessions: sessions}
+}
+
+func MapRevokeSessionPbToDTO(accountID uuid.UUID, req *auth_v1.RevokeSessionRequest) dto.RevokeSessionInput {
+	sessionID, _ := uuid.Parse(req.GetSessionId())
+	return dto.RevokeSessionInput{
+		AccountID: accountID,
+		SessionID: sessionID,
+	}
+}
+
+func MapRevokeSessionDTOToPb(out dto.RevokeSessionOutput) *auth_v1.RevokeSessionResponse {
+	return &auth_v1.RevokeSessionResponse{Revoked: out.Revoked}
+}
+
+func MapRevokeAllOtherSessionsPbToDTO(accountID uuid.UUID, req *auth_v1.RevokeAllOtherSessionsRequest) dto.RevokeAllOtherSessionsInput {
+	return dto.RevokeAllOtherSessionsInput{
+		AccountID:    accountID,
+		RefreshToken: req.GetRefreshToken(),
+	}
+}
+
+func MapRevokeAllOtherSessionsDTOToPb(out dto.RevokeAllOtherSessionsOutput) *auth_v1.RevokeAllOtherSessionsResponse {
+	return &auth_v1.RevokeAllOtherSessionsResponse{Revoked: out.Revoked}
+}
+
+func MapGetJWKSDTOToPb(out dto.GetJWKSOutput) *auth_v1.GetJWKSResponse {
+	keys := make([]*auth_v1.JWK, 0, len(out.Keys))
+	for _, k := range out.Keys {
+		keys = append(keys, &auth_v1.JWK{
+			Kid: k.KeyID,
+			Kty: k.KeyType,
+			Alg: k.Algorithm,
+			Use: k.Use,
+			N:   k.N,
+			E:   k.E,
+			Crv: k.Curve,
+			X:   k.X,
+		})
+	}
+	return &auth_v1.GetJWKSResponse{Keys: keys}
+}
+
+func MapBlockAccountPbToDTO(actorID uuid.UUID, req *auth_v1.BlockAccountRequest) dto.BlockAccountInput {
+	accountID, _ := uuid.Parse(req.GetAccountId())
+	return dto.BlockAccountInput{
+		ActorID:   actorID,
+		AccountID: accountID,
+		Reason:    req.GetReason(),
+	}
+}
+
+func MapBlockAccountDTOToPb(out dto.BlockAccountOutput) *auth_v1.BlockAccountResponse {
+	return &auth_v1.BlockAccountResponse{Blocked: out.Blocked}
+}
+
+func MapUnblockAccountPbToDTO(actorID uuid.UUID, req *auth_v1.UnblockAccountRequest) dto.UnblockAccountInput {
+	accountID, _ := uuid.Parse(req.GetAccountId())
+	return dto.UnblockAccountInput{
+		ActorID:   actorID,
+		AccountID: accountID,
+		Reason:    req.GetReason(),
+	}
+}
+
+func MapUnblockAccountDTOToPb(out dto.UnblockAccountOutput) *auth_v1.UnblockAccountResponse {
+	return &auth_v1.UnblockAccountResponse{Unblocked: out.Unblocked}
+}
+
+func MapDeleteAccountPbToDTO(actorID uuid.UUID, req *auth_v1.DeleteAccountRequest) dto.DeleteAccountInput {
+	accountID, _ := uuid.Parse(req.GetAccountId())
+	return dto.DeleteAccountInput{
+		ActorID:   actorID,
+		AccountID: accountID,
+		Reason:    req.GetReason(),
+	}
+}
+
+func MapDeleteAccountDTOToPb(out dto.DeleteAccountOutput) *auth_v1.DeleteAccountResponse {
+	return &auth_v1.DeleteAccountResponse{Deleted: out.Deleted}
+}
+
+func MapEnrollTOTPPbToDTO(accountID uuid.UUID, _ *auth_v1.EnrollTOTPRequest) dto.EnrollTOTPInput {
+	return dto.EnrollTOTPInput{AccountID: accountID}
+}
+
+func MapEnrollTOTPDTOToPb(out dto.EnrollTOTPOutput) *auth_v1.EnrollTOTPResponse {
+	return &auth_v1.EnrollTOTPResponse{
+		Secret:     out.Secret,
+		OtpauthUri: out.URI,
+	}
+}
+
+func MapConfirmTOTPPbToDTO(accountID uuid.UUID, req *auth_v1.ConfirmTOTPRequest) dto.ConfirmTOTPInput {
+	return dto.ConfirmTOTPInput{
+		AccountID: accountID,
+		Code:      req.GetCode(),
+	}
+}
+
+func MapConfirmTOTPDTOToPb(out dto.ConfirmTOTPOutput) *auth_v1.ConfirmTOTPResponse {
+	return &auth_v1.ConfirmTOTPResponse{RecoveryCodes: out.RecoveryCodes}
+}
+
+func MapVerifyMFAPbToDTO(req *auth_v1.VerifyMFARequest) dto.VerifyMFAInput {
+	var ip, userAgent = req.GetIp(), req.GetUserAgent()
+	return dto.VerifyMFAInput{
+		MFAToken:  req.GetMfaToken(),
+		Code:      req.GetCode(),
+		IP:        &ip,
+		UserAgent: &userAgent,
+	}
+}
+
+func MapVerifyMFADTOToPb(out dto.VerifyMFAOutput) *auth_v1.VerifyMFAResponse {
+	return &auth_v1.VerifyMFAResponse{
+		AccessToken:  out.AccessToken,
+		RefreshToken: out.RefreshToken,
+	}
+}
+
+func MapStartOIDCLoginPbToDTO(req *auth_v1.StartOIDCLoginRequest) dto.StartOIDCLoginInput {
+	return dto.StartOIDCLoginInput{Provider: req.GetProvider()}
+}
+
+func MapStartOIDCLoginDTOToPb(out dto.StartOIDCLoginOutput) *auth_v1.StartOIDCLoginResponse {
+	return &auth_v1.StartOIDCLoginResponse{
+		AuthorizationUrl: out.AuthURL,
+		State:            out.State,
+	}
+}
+
+func MapCompleteOIDCLoginPbToDTO(req *auth_v1.CompleteOIDCLoginRequest) dto.CompleteOIDCLoginInput {
+	var ip, userAgent = req.GetIp(), req.GetUserAgent()
+	return dto.CompleteOIDCLoginInput{
+		Provider:  req.GetProvider(),
+		State:     req.GetState(),
+		Code:      req.GetCode(),
+		IP:        &ip,
+		UserAgent: &userAgent,
+	}
+}
+
+func MapCompleteOIDCLoginDTOToPb(out dto.CompleteOIDCLoginOutput) *auth_v1.CompleteOIDCLoginResponse {
+	return &auth_v1.CompleteOIDCLoginResponse{
+		AccessToken:    out.AccessToken,
+		RefreshToken:   out.RefreshToken,
+		MfaRequired:    out.MFARequired,
+		MfaToken:       out.MFAToken,
+		AccountCreated: out.AccountCreated,
+	}
+}
+
+func MapCreateAPIKeyPbToDTO(accountID uuid.UUID, permissions []string, req *auth_v1.CreateAPIKeyRequest) dto.CreateAPIKeyInput {
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t := req.GetExpiresAt().AsTime()
+		expiresAt = &t
+	}
+	return dto.CreateAPIKeyInput{
+		AccountID: accountID,
+		Name:      req.GetName(),
+		Scopes:    req.GetScopes(),
+		ExpiresAt: expiresAt,
+
+		CallerPermissions: permissions,
+	}
+}
+
+func MapCreateAPIKeyDTOToPb(out dto.CreateAPIKeyOutput) *auth_v1.CreateAPIKeyResponse {
+	return &auth_v1.CreateAPIKeyResponse{
+		KeyId:  out.KeyID.String(),
+		Key:    out.Key,
+		Prefix: out.Prefix,
+	}
+}
+
+func MapListAPIKeysPbToDTO(accountID uuid.UUID, _ *auth_v1.ListAPIKeysRequest) dto.ListAPIKeysInput {
+	return dto.ListAPIKeysInput{AccountID: accountID}
+}
+
+func MapListAPIKeysDTOToPb(out dto.ListAPIKeysOutput) *auth_v1.ListAPIKeysResponse {
+	keys := make([]*auth_v1.APIKey, 0, len(out.Keys))
+	for _, k := range out.Keys {
+		keys = append(keys, &auth_v1.APIKey{
+			KeyId:      k.KeyID.String(),
+			Name:       k.Name,
+			Prefix:     k.Prefix,
+			Scopes:     k.Scopes,
+			CreatedAt:  timestamppb.New(k.CreatedAt),
+			ExpiresAt:  optionalTimestamp(k.ExpiresAt),
+			LastUsedAt: optionalTimestamp(k.LastUsedAt),
+			RevokedAt:  optionalTimestamp(k.RevokedAt),
+		})
+	}
+	return &auth_v1.ListAPIKeysResponse{Keys: keys}
+}
+
+func MapRevokeAPIKeyPbToDTO(accountID uuid.UUID, req *auth_v1.RevokeAPIKeyRequest) dto.RevokeAPIKeyInput {
+	keyID, _ := uuid.Parse(req.GetKeyId())
+	return dto.RevokeAPIKeyInput{
+		AccountID: accountID,
+		KeyID:     keyID,
+	}
+}
+
+func MapRevokeAPIKeyDTOToPb(out dto.RevokeAPIKeyOutput) *auth_v1.RevokeAPIKeyResponse {
+	return &auth_v1.RevokeAPIKeyResponse{Revoked: out.Revoked}
+}
+
+func MapValidateAPIKeyPbToDTO(req *auth_v1.ValidateAPIKeyRequest) dto.ValidateAPIKeyInput {
+	return dto.ValidateAPIKeyInput{Key: req.GetKey()}
+}
+
+func MapValidateAPIKeyDTOToPb(out dto.ValidateAPIKeyOutput) *auth_v1.ValidateAPIKeyResponse {
+	return &auth_v1.ValidateAPIKeyResponse{
+		AccountId:   out.AccountID.String(),
+		KeyId:       out.KeyID.String(),
+		Roles:       out.Roles,
+		Permissions: out.Permissions,
+	}
+}
+
+func MapListAuthEventsPbToDTO(req *auth_v1.ListAuthEventsRequest) dto.ListAuthEventsInput {
+	var in = dto.ListAuthEventsInput{
+		EventType: req.EventType,
+		Outcome:   req.Outcome,
+		Cursor:    req.Cursor,
+		Limit:     int(req.GetLimit()),
+	}
+	if req.AccountId != nil {
+		accID, _ := uuid.Parse(req.GetAccountId())
+		in.AccountID = &accID
+	}
+	if req.From != nil {
+		from := req.GetFrom().AsTime()
+		in.From = &from
+	}
+	if req.To != nil {
+		to := req.GetTo().AsTime()
+		in.To = &to
+	}
+	return in
+}
+
+func MapListMyAuthEventsPbToDTO(accountID uuid.UUID, req *auth_v1.ListMyAuthEventsRequest) dto.ListAuthEventsInput {
+	return dto.ListAuthEventsInput{
+		AccountID: &accountID,
+		Cursor:    req.Cursor,
+		Limit:     int(req.GetLimit()),
+	}
+}
+
+func MapListAuthEventsDTOToPb(out dto.ListAuthEventsOutput) *auth_v1.ListAuthEventsResponse {
+	events := make([]*auth_v1.AuthEvent, 0, len(out.Events))
+	for _, e := range out.Events {
+		events = append(events, &auth_v1.AuthEvent{
+			EventId:   e.EventID.String(),
+			AccountId: e.AccountID.String(),
+			EventType: e.EventType,
+			Outcome:   e.Outcome,
+			SessionId: optionalUUID(e.SessionID),
+			Ip:        e.IP,
+			UserAgent: e.UserAgent,
+			ActorId:   optionalUUID(e.ActorID),
+			Reason:    e.Reason,
+			Metadata:  e.Metadata,
+			CreatedAt: timestamppb.New(e.CreatedAt),
+		})
+	}
+	return &auth_v1.ListAuthEventsResponse{
+		Events:     events,
+		NextCursor: out.NextCursor,
+	}
+}
+
+func MapRequestEmailChangePbToDTO(accountID uuid.UUID, req *auth_v1.RequestEmailChangeRequest) dto.RequestEmailChangeInput {
+	return dto.RequestEmailChangeInput{
+		AccountID: accountID,
+		Password:  req.GetPassword(),
+		NewEmail:  req.GetNewEmail(),
+	}
+}
+
+func MapRequestEmailChangeDTOToPb(out dto.RequestEmailChangeOutput) *auth_v1.RequestEmailChangeResponse {
+	return &auth_v1.RequestEmailChangeResponse{Sent: out.Sent}
+}
+
+func MapConfirmEmailChangePbToDTO(req *auth_v1.ConfirmEmailChangeRequest) dto.ConfirmEmailChangeInput {
+	return dto.ConfirmEmailChangeInput{Token: req.GetToken()}
+}
+
+func MapConfirmEmailChangeDTOToPb(out dto.ConfirmEmailChangeOutput) *auth_v1.ConfirmEmailChangeResponse {
+	return &auth_v1.ConfirmEmailChangeResponse{Changed: out.Changed}
+}
+
+func MapRequestMagicLinkPbToDTO(req *auth_v1.RequestMagicLinkRequest) dto.RequestMagicLinkInput {
+	return dto.RequestMagicLinkInput{Email: req.GetEmail()}
+}
+
+func MapRequestMagicLinkDTOToPb(out dto.RequestMagicLinkOutput) *auth_v1.RequestMagicLinkResponse {
+	return &auth_v1.RequestMagicLinkResponse{Sent: out.Sent}
+}
+
+func MapConsumeMagicLinkPbToDTO(req *auth_v1.ConsumeMagicLinkRequest) dto.ConsumeMagicLinkInput {
+	var ip, userAgent = req.GetIp(), req.GetUserAgent()
+	return dto.ConsumeMagicLinkInput{
+		Token:     req.GetToken(),
+		IP:        &ip,
+		UserAgent: &userAgent,
+	}
+}
+
+func MapConsumeMagicLinkDTOToPb(out dto.ConsumeMagicLinkOutput) *auth_v1.ConsumeMagicLinkResponse {
+	return &auth_v1.ConsumeMagicLinkResponse{
+		AccessToken:  out.AccessToken,
+		RefreshToken: out.RefreshToken,
+		MfaRequired:  out.MFARequired,
+		MfaToken:     out.MFAToken,
+	}
+}
+
+func MapReportSessionPbToDTO(req *auth_v1.ReportSessionRequest) dto.ReportSessionInput {
+	var ip, userAgent = req.GetIp(), req.GetUserAgent()
+	return dto.ReportSessionInput{
+		Token:     req.GetToken(),
+		IP:        &ip,
+		UserAgent: &userAgent,
+	}
+}
+
+func MapReportSessionDTOToPb(out dto.ReportSessionOutput) *auth_v1.ReportSessionResponse {
+	return &auth_v1.ReportSessionResponse{Revoked: out.Revoked}
+}
+
+func MapImpersonatePbToDTO(actorID uuid.UUID, req *auth_v1.ImpersonateRequest) dto.ImpersonateInput {
+	accountID, _ := uuid.Parse(req.GetAccountId())
+	return dto.ImpersonateInput{
+		ActorID:   actorID,
+		AccountID: accountID,
+		Reason:    req.GetReason(),
+	}
+}
+
+func MapImpersonateDTOToPb(out dto.ImpersonateOutput) *auth_v1.ImpersonateResponse {
+	return &auth_v1.ImpersonateResponse{
+		AccessToken: out.AccessToken,
+		ExpiresAt:   timestamppb.New(out.ExpiresAt),
+	}
+}
+
+func MapIntrospectTokenPbToDTO(req *auth_v1.IntrospectTokenRequest) dto.IntrospectTokenInput {
+	return dto.IntrospectTokenInput{
+		ClientID:      req.GetClientId(),
+		ClientSecret:  req.GetClientSecret(),
+		Token:         req.GetToken(),
+		TokenTypeHint: req.GetTokenTypeHint(),
+	}
+}
+
+func MapIntrospectTokenDTOToPb(out dto.IntrospectTokenOutput) *auth_v1.IntrospectTokenResponse {
+	if !out.Active {
+		return &auth_v1.IntrospectTokenResponse{Active: false}
+	}
+	return &auth_v1.IntrospectTokenResponse{
+		Active:    true,
+		TokenType: &out.TokenType,
+		AccountId: optionalUUID(&out.AccountID),
+		Roles:     out.Roles,
+		SessionId: optionalUUID(out.SessionID),
+		ActorId:   optionalUUID(out.ActorID),
+		IssuedAt:  timestamppb.New(out.IssuedAt),
+		ExpiresAt: timestamppb.New(out.ExpiresAt),
+	}
+}
+
+func MapListAccountsPbToDTO(req *auth_v1.ListAccountsRequest) dto.ListAccountsInput {
+	return dto.ListAccountsInput{
+		Status:        req.Status,
+		Role:          req.Role,
+		EmailVerified: req.EmailVerified,
+		EmailPrefix:   req.EmailPrefix,
+		CreatedFrom:   optionalTime(req.CreatedFrom),
+		CreatedTo:     optionalTime(req.CreatedTo),
+		LastLoginFrom: optionalTime(req.LastLoginFrom),
+		LastLoginTo:   optionalTime(req.LastLoginTo),
+		SortBy:        req.SortBy,
+		SortDesc:      req.GetSortDesc(),
+		Cursor:        req.Cursor,
+		Limit:         int(req.GetLimit()),
+	}
+}
+
+func MapListAccountsDTOToPb(out dto.ListAccountsOutput) *auth_v1.ListAccountsResponse {
+	accounts := make([]*auth_v1.AccountSummary, 0, len(out.Accounts))
+	for _, a := range out.Accounts {
+		accounts = append(accounts, &auth_v1.AccountSummary{
+			AccountId:      a.AccountID.String(),
+			Email:          a.Email,
+			Status:         a.Status,
+			EmailVerified:  a.EmailVerified,
+			Roles:          a.Roles,
+			ActiveSessions: int32(a.ActiveSessions),
+			CreatedAt:      timestamppb.New(a.CreatedAt),
+			LastLoginAt:    optionalTimestamp(a.LastLoginAt),
+		})
+	}
+	return &auth_v1.ListAccountsResponse{
+		Accounts:   accounts,
+		NextCursor: out.NextCursor,
+	}
+}
+
+func optionalUUID(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
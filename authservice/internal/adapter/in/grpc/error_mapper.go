@@ -2,11 +2,15 @@ package grpc
 
 import (
 	"errors"
+	"time"
 
 	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
 	pkgerrs "github.com/maket12/ads-service/pkg/errs"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func gRPCError(err error) *pkgerrs.OutErr {
@@ -23,15 +27,76 @@ func gRPCError(err error) *pkgerrs.OutErr {
 			errors.Is(w.Public, ucerrs.ErrCreateRefreshSessionDB),
 			errors.Is(w.Public, ucerrs.ErrGetRefreshSessionByIDDB),
 			errors.Is(w.Public, ucerrs.ErrRevokeRefreshSessionDB),
+			errors.Is(w.Public, ucerrs.ErrListRefreshSessionsDB),
 			errors.Is(w.Public, ucerrs.ErrCreateAccountRoleDB),
 			errors.Is(w.Public, ucerrs.ErrGenerateAccessToken),
 			errors.Is(w.Public, ucerrs.ErrGenerateRefreshToken),
-			errors.Is(w.Public, ucerrs.ErrPublishEvent):
+			errors.Is(w.Public, ucerrs.ErrPublishEvent),
+			errors.Is(w.Public, ucerrs.ErrGenerateOneTimeToken),
+			errors.Is(w.Public, ucerrs.ErrSendEmail),
+			errors.Is(w.Public, ucerrs.ErrGetPublicKeys),
+			errors.Is(w.Public, ucerrs.ErrAcquireLock),
+			errors.Is(w.Public, ucerrs.ErrDeleteRefreshSessionsDB),
+			errors.Is(w.Public, ucerrs.ErrCreateVerificationTokenDB),
+			errors.Is(w.Public, ucerrs.ErrDeleteVerificationTokenDB),
+			errors.Is(w.Public, ucerrs.ErrGetVerificationTokenDB),
+			errors.Is(w.Public, ucerrs.ErrUseVerificationTokenDB),
+			errors.Is(w.Public, ucerrs.ErrCreateResetTokenDB),
+			errors.Is(w.Public, ucerrs.ErrGetResetTokenDB),
+			errors.Is(w.Public, ucerrs.ErrUseResetTokenDB),
+			errors.Is(w.Public, ucerrs.ErrCreateAuthEventDB),
+			errors.Is(w.Public, ucerrs.ErrGetLoginAttemptsDB),
+			errors.Is(w.Public, ucerrs.ErrRecordLoginAttemptDB),
+			errors.Is(w.Public, ucerrs.ErrClearLoginAttemptsDB),
+			errors.Is(w.Public, ucerrs.ErrCleanupLoginAttemptsDB),
+			errors.Is(w.Public, ucerrs.ErrCountRequestsDB),
+			errors.Is(w.Public, ucerrs.ErrAddRequestDB),
+			errors.Is(w.Public, ucerrs.ErrCleanupRequestsDB),
+			errors.Is(w.Public, ucerrs.ErrGenerateMFAToken),
+			errors.Is(w.Public, ucerrs.ErrGenerateTOTPSecret),
+			errors.Is(w.Public, ucerrs.ErrEncryptSecret),
+			errors.Is(w.Public, ucerrs.ErrDecryptSecret),
+			errors.Is(w.Public, ucerrs.ErrGetTOTPFactorDB),
+			errors.Is(w.Public, ucerrs.ErrSaveTOTPFactorDB),
+			errors.Is(w.Public, ucerrs.ErrConfirmTOTPFactorDB),
+			errors.Is(w.Public, ucerrs.ErrUseTOTPStepDB),
+			errors.Is(w.Public, ucerrs.ErrSaveRecoveryCodesDB),
+			errors.Is(w.Public, ucerrs.ErrUseRecoveryCodeDB),
+			errors.Is(w.Public, ucerrs.ErrCreateOIDCStateDB),
+			errors.Is(w.Public, ucerrs.ErrConsumeOIDCStateDB),
+			errors.Is(w.Public, ucerrs.ErrGetExternalIdentityDB),
+			errors.Is(w.Public, ucerrs.ErrCreateExternalIdentityDB),
+			errors.Is(w.Public, ucerrs.ErrGenerateAPIKey),
+			errors.Is(w.Public, ucerrs.ErrCreateAPIKeyDB),
+			errors.Is(w.Public, ucerrs.ErrGetAPIKeyDB),
+			errors.Is(w.Public, ucerrs.ErrListAPIKeysDB),
+			errors.Is(w.Public, ucerrs.ErrRevokeAPIKeyDB),
+			errors.Is(w.Public, ucerrs.ErrMarkAPIKeyUsedDB),
+			errors.Is(w.Public, ucerrs.ErrDenyAccessTokenDB),
+			errors.Is(w.Public, ucerrs.ErrCheckDenylistDB),
+			errors.Is(w.Public, ucerrs.ErrCleanupDenylistDB),
+			errors.Is(w.Public, ucerrs.ErrListAuthEventsDB),
+			errors.Is(w.Public, ucerrs.ErrCreateEmailChangeTokenDB),
+			errors.Is(w.Public, ucerrs.ErrGetEmailChangeTokenDB),
+			errors.Is(w.Public, ucerrs.ErrConfirmEmailChangeDB),
+			errors.Is(w.Public, ucerrs.ErrCreateMagicLinkTokenDB),
+			errors.Is(w.Public, ucerrs.ErrGetMagicLinkTokenDB),
+			errors.Is(w.Public, ucerrs.ErrUseMagicLinkTokenDB),
+			errors.Is(w.Public, ucerrs.ErrCreateSessionReportTokenDB),
+			errors.Is(w.Public, ucerrs.ErrGetSessionReportTokenDB),
+			errors.Is(w.Public, ucerrs.ErrUseSessionReportTokenDB),
+			errors.Is(w.Public, ucerrs.ErrTransactionDB),
+			errors.Is(w.Public, ucerrs.ErrAddOutboxEventDB),
+			errors.Is(w.Public, ucerrs.ErrListAccountsDB):
 			return pkgerrs.NewOutError(codes.Internal, w.Public.Error(), w.Reason)
 
 		case errors.Is(w.Public, ucerrs.ErrInvalidInput):
 			return pkgerrs.NewOutError(codes.InvalidArgument, w.Public.Error(), w.Reason)
 
+		case errors.Is(w.Public, ucerrs.ErrOIDCAuthFailed),
+			errors.Is(w.Public, ucerrs.ErrRefreshTokenReused):
+			return pkgerrs.NewOutError(codes.Unauthenticated, w.Public.Error(), w.Reason)
+
 		default:
 			return pkgerrs.NewOutError(codes.Internal, "internal error", w.Reason)
 		}
@@ -39,7 +104,9 @@ func gRPCError(err error) *pkgerrs.OutErr {
 
 	switch {
 	case errors.Is(err, ucerrs.ErrInvalidCredentials),
-		errors.Is(err, ucerrs.ErrInvalidAccountID):
+		errors.Is(err, ucerrs.ErrInvalidAccountID),
+		errors.Is(err, ucerrs.ErrInvalidSessionID),
+		errors.Is(err, ucerrs.ErrInvalidAPIKeyID):
 		return pkgerrs.NewOutError(codes.NotFound, err.Error(), nil)
 
 	case errors.Is(err, ucerrs.ErrAccountAlreadyExists):
@@ -47,13 +114,83 @@ func gRPCError(err error) *pkgerrs.OutErr {
 
 	case errors.Is(err, ucerrs.ErrCannotLogin),
 		errors.Is(err, ucerrs.ErrCannotAssign),
-		errors.Is(err, ucerrs.ErrCannotRevoke):
+		errors.Is(err, ucerrs.ErrCannotRevokeRole),
+		errors.Is(err, ucerrs.ErrCannotRevoke),
+		errors.Is(err, ucerrs.ErrEmailAlreadyVerified),
+		errors.Is(err, ucerrs.ErrCannotModerateSelf),
+		errors.Is(err, ucerrs.ErrCannotImpersonateSelf),
+		errors.Is(err, ucerrs.ErrInvalidStatusTransition),
+		errors.Is(err, ucerrs.ErrMFAAlreadyEnabled),
+		errors.Is(err, ucerrs.ErrMFANotEnrolled),
+		errors.Is(err, ucerrs.ErrOIDCEmailNotVerified),
+		errors.Is(err, ucerrs.ErrCannotLinkIdentity),
+		errors.Is(err, ucerrs.ErrAPIKeyAlreadyRevoked):
 		return pkgerrs.NewOutError(codes.FailedPrecondition, err.Error(), nil)
 
+	case errors.Is(err, ucerrs.ErrPermissionDenied),
+		errors.Is(err, ucerrs.ErrCannotModeratePeer),
+		errors.Is(err, ucerrs.ErrImpersonationForbidden),
+		errors.Is(err, ucerrs.ErrAPIKeyForbidden):
+		return pkgerrs.NewOutError(codes.PermissionDenied, err.Error(), nil)
+
+	case errors.Is(err, ucerrs.ErrInvalidVerificationToken),
+		errors.Is(err, ucerrs.ErrInvalidResetToken),
+		errors.Is(err, ucerrs.ErrWeakPassword),
+		errors.Is(err, ucerrs.ErrWrongPassword),
+		errors.Is(err, ucerrs.ErrReasonRequired),
+		errors.Is(err, ucerrs.ErrInvalidMFACode),
+		errors.Is(err, ucerrs.ErrUnknownOIDCProvider),
+		errors.Is(err, ucerrs.ErrInvalidOIDCState),
+		errors.Is(err, ucerrs.ErrInvalidScope),
+		errors.Is(err, ucerrs.ErrInvalidCursor),
+		errors.Is(err, ucerrs.ErrInvalidEventFilter),
+		errors.Is(err, ucerrs.ErrInvalidAccountFilter),
+		errors.Is(err, ucerrs.ErrSameEmail),
+		errors.Is(err, ucerrs.ErrInvalidEmailChangeToken),
+		errors.Is(err, ucerrs.ErrInvalidMagicLink),
+		errors.Is(err, ucerrs.ErrInvalidSessionReport):
+		return pkgerrs.NewOutError(codes.InvalidArgument, err.Error(), nil)
+
+	case errors.Is(err, ucerrs.ErrTooManyLoginAttempts),
+		errors.Is(err, ucerrs.ErrTooManyMagicLinks),
+		errors.Is(err, ucerrs.ErrTooManyVerifications),
+		errors.Is(err, ucerrs.ErrTooManyResetRequests),
+		errors.Is(err, ucerrs.ErrTooManySessions):
+		return pkgerrs.NewOutError(codes.ResourceExhausted, err.Error(), nil)
+
 	case errors.Is(err, ucerrs.ErrInvalidAccessToken),
-		errors.Is(err, ucerrs.ErrInvalidRefreshToken):
+		errors.Is(err, ucerrs.ErrInvalidRefreshToken),
+		errors.Is(err, ucerrs.ErrRefreshTokenReused),
+		errors.Is(err, ucerrs.ErrInvalidMFAToken),
+		errors.Is(err, ucerrs.ErrInvalidAPIKey),
+		errors.Is(err, ucerrs.ErrInvalidClient),
+		errors.Is(err, pkgerrs.ErrNotAuthenticated):
 		return pkgerrs.NewOutError(codes.Unauthenticated, err.Error(), nil)
 	}
 
 	return pkgerrs.NewOutError(codes.Internal, "internal error", nil)
 }
+
+// statusError builds the gRPC status, throttled calls get a RetryInfo
+// detail so clients know when the next attempt is allowed
+func statusError(outErr *pkgerrs.OutErr, err error) error {
+	st := status.New(outErr.Code, outErr.Message)
+
+	var retryErr *ucerrs.RetryAfterError
+	if errors.As(err, &retryErr) {
+		// Round up, a client retrying on the dot should not be rejected again
+		delay := retryErr.RetryAfter.Round(time.Second)
+		if delay < retryErr.RetryAfter {
+			delay += time.Second
+		}
+
+		withDetails, detailsErr := st.WithDetails(&errdetails.RetryInfo{
+			RetryDelay: durationpb.New(delay),
+		})
+		if detailsErr == nil {
+			st = withDetails
+		}
+	}
+
+	return st.Err()
+}
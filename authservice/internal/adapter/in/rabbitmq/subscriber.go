@@ -0,0 +1,158 @@
+package rabbitmq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/pkg/rabbitmq"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+type SubscriberConfig struct {
+	Exchange   string
+	Queue      string
+	RoutingKey string
+}
+
+func NewSubscriberConfig(exchange, queue, routingKey string) *SubscriberConfig {
+	return &SubscriberConfig{
+		Exchange:   exchange,
+		Queue:      queue,
+		RoutingKey: routingKey,
+	}
+}
+
+// NewDeviceSubscriber emails the owner about logins from new devices
+type NewDeviceSubscriber struct {
+	cfg      *SubscriberConfig
+	log      *slog.Logger
+	client   *rabbitmq.RabbitClient
+	notifyUC usecase.NotifyNewDeviceLoginUseCase
+}
+
+func NewNewDeviceSubscriber(
+	cfg *SubscriberConfig,
+	log *slog.Logger,
+	client *rabbitmq.RabbitClient,
+	notifyUC usecase.NotifyNewDeviceLoginUseCase,
+) *NewDeviceSubscriber {
+	return &NewDeviceSubscriber{
+		cfg:      cfg,
+		log:      log,
+		client:   client,
+		notifyUC: notifyUC,
+	}
+}
+
+func (s *NewDeviceSubscriber) Start(ctx context.Context) error {
+	ch, err := s.client.Conn.Channel()
+	if err != nil {
+		return fmt.Errorf("failed to open channel: %w", err)
+	}
+
+	// Exchange
+	if err = ch.ExchangeDeclare(
+		s.cfg.Exchange,
+		"topic",
+		true,
+		false,
+		false,
+		false,
+		nil,
+	); err != nil {
+		return fmt.Errorf("failed to declare exchange: %w", err)
+	}
+
+	// Queue
+	q, err := ch.QueueDeclare(
+		s.cfg.Queue,
+		true,
+		false,
+		false,
+		false,
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to declare queue: %w", err)
+	}
+
+	// Bind queue
+	if err = ch.QueueBind(
+		q.Name,
+		s.cfg.RoutingKey,
+		s.cfg.Exchange,
+		false,
+		nil,
+	); err != nil {
+		return fmt.Errorf("failed to bind queue: %w", err)
+	}
+
+	// Define consumer
+	msgs, err := ch.ConsumeWithContext(
+		ctx,
+		q.Name,
+		"",
+		false,
+		false,
+		false,
+		false,
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to define consumer: %w", err)
+	}
+
+	// Listening to queue
+	go func() {
+		for d := range msgs {
+			s.handleMessage(ctx, &d)
+		}
+	}()
+
+	return nil
+}
+
+func (s *NewDeviceSubscriber) handleMessage(ctx context.Context, d *amqp.Delivery) {
+	// Deserialisation json to DTO
+	var event rabbitmq.NewDeviceLoginEvent
+	if err := json.Unmarshal(d.Body, &event); err != nil {
+		s.log.ErrorContext(ctx, "failed to unmarshal new device event",
+			slog.String("body", string(d.Body)),
+			slog.Any("reason", err),
+		)
+		_ = d.Nack(false, false)
+		return
+	}
+
+	// Calling UC
+	out, err := s.notifyUC.Execute(ctx, dto.NotifyNewDeviceLoginInput{
+		AccountID:  event.AccountID,
+		SessionID:  event.SessionID,
+		IP:         event.IP,
+		Browser:    event.Browser,
+		OS:         event.OS,
+		LoggedInAt: event.LoggedInAt,
+	})
+	if err != nil {
+		s.log.ErrorContext(ctx, "failed to notify about new device",
+			slog.String("account_id", event.AccountID.String()),
+			slog.String("session_id", event.SessionID.String()),
+			slog.Any("reason", err),
+		)
+		_ = d.Nack(false, true)
+		return
+	}
+
+	// Notify queue about success
+	s.log.InfoContext(ctx, "handled new device event",
+		slog.String("account_id", event.AccountID.String()),
+		slog.String("session_id", event.SessionID.String()),
+		slog.Bool("sent", out.Sent),
+	)
+	_ = d.Ack(false)
+}
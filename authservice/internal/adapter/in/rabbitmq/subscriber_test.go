@@ -0,0 +1,94 @@
+package rabbitmq
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase/mocks"
+	"github.com/maket12/ads-service/pkg/rabbitmq"
+
+	"github.com/google/uuid"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// acknowledger remembers how the delivery was settled
+type acknowledger struct {
+	acked, nacked, requeued bool
+}
+
+func (a *acknowledger) Ack(uint64, bool) error { a.acked = true; return nil }
+func (a *acknowledger) Nack(_ uint64, _ bool, requeue bool) error {
+	a.nacked, a.requeued = true, requeue
+	return nil
+}
+func (a *acknowledger) Reject(_ uint64, requeue bool) error {
+	a.nacked, a.requeued = true, requeue
+	return nil
+}
+
+func TestNewDeviceSubscriber_HandleMessage(t *testing.T) {
+	type testCase struct {
+		name         string
+		body         []byte
+		prepare      func(m *mocks.NotifyNewDeviceLoginUseCase)
+		wantAck      bool
+		wantRequeued bool
+	}
+
+	event := rabbitmq.NewDeviceLoginEvent{
+		AccountID: uuid.New(),
+		SessionID: uuid.New(),
+		Browser:   "Chrome",
+		OS:        "Android",
+	}
+	body, _ := json.Marshal(event)
+
+	var tests = []testCase{
+		{
+			name: "Success",
+			body: body,
+			prepare: func(m *mocks.NotifyNewDeviceLoginUseCase) {
+				m.On("Execute", mock.Anything, mock.MatchedBy(func(in dto.NotifyNewDeviceLoginInput) bool {
+					return in.AccountID == event.AccountID && in.SessionID == event.SessionID &&
+						in.Browser == "Chrome" && in.OS == "Android"
+				})).Return(dto.NotifyNewDeviceLoginOutput{Sent: true}, nil)
+			},
+			wantAck: true,
+		},
+		{
+			name:    "Malformed Event Is Dropped",
+			body:    []byte("{"),
+			prepare: func(m *mocks.NotifyNewDeviceLoginUseCase) {},
+		},
+		{
+			name: "Failure Is Retried",
+			body: body,
+			prepare: func(m *mocks.NotifyNewDeviceLoginUseCase) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.NotifyNewDeviceLoginOutput{}, assert.AnError)
+			},
+			wantRequeued: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifyUC := mocks.NewNotifyNewDeviceLoginUseCase(t)
+			tt.prepare(notifyUC)
+
+			s := NewNewDeviceSubscriber(nil, slog.Default(), nil, notifyUC)
+
+			ack := &acknowledger{}
+			s.handleMessage(context.Background(), &amqp.Delivery{Acknowledger: ack, Body: tt.body})
+
+			assert.Equal(t, tt.wantAck, ack.acked)
+			assert.Equal(t, !tt.wantAck, ack.nacked)
+			assert.Equal(t, tt.wantRequeued, ack.requeued)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+)
+
+// SessionJanitor periodically removes expired and long-revoked refresh sessions
+type SessionJanitor struct {
+	log       *slog.Logger
+	interval  time.Duration
+	cleanupUC usecase.CleanupRefreshSessionsUseCase
+}
+
+func NewSessionJanitor(
+	log *slog.Logger,
+	interval time.Duration,
+	cleanupUC usecase.CleanupRefreshSessionsUseCase,
+) *SessionJanitor {
+	return &SessionJanitor{
+		log:       log,
+		interval:  interval,
+		cleanupUC: cleanupUC,
+	}
+}
+
+// Start runs the janitor in background until ctx is cancelled
+func (j *SessionJanitor) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			j.runOnce(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (j *SessionJanitor) runOnce(ctx context.Context) {
+	startedAt := time.Now()
+	out, err := j.cleanupUC.Execute(ctx, dto.CleanupRefreshSessionsInput{Now: startedAt})
+	if err != nil {
+		var reason error
+		var w *ucerrs.WrappedError
+		if errors.As(err, &w) {
+			reason = w.Reason
+		}
+		j.log.ErrorContext(ctx, "failed to clean up refresh sessions",
+			slog.Int64("deleted", out.Deleted),
+			slog.Int64("denied_deleted", out.DeniedDeleted),
+			slog.Int64("failures_deleted", out.FailuresDeleted),
+			slog.Int64("requests_deleted", out.RequestsDeleted),
+			slog.String("public_msg", err.Error()),
+			slog.Any("reason", reason),
+		)
+		return
+	}
+
+	if out.Skipped {
+		j.log.DebugContext(ctx, "refresh sessions cleanup is running on another replica")
+		return
+	}
+
+	j.log.InfoContext(ctx, "refresh sessions cleaned up",
+		slog.Int64("deleted", out.Deleted),
+		slog.Int64("denied_deleted", out.DeniedDeleted),
+		slog.Int64("failures_deleted", out.FailuresDeleted),
+		slog.Int64("requests_deleted", out.RequestsDeleted),
+		slog.Duration("took", time.Since(startedAt)),
+	)
+}
@@ -0,0 +1,76 @@
+package worker_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/in/worker"
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Non-blocking signal, the janitor keeps ticking after the test has read enough
+func notify(called chan struct{}) {
+	select {
+	case called <- struct{}{}:
+	default:
+	}
+}
+
+func TestSessionJanitor_Start(t *testing.T) {
+	type testCase struct {
+		name      string
+		setupMock func(m *mocks.CleanupRefreshSessionsUseCase, called chan struct{})
+	}
+
+	testCases := []testCase{
+		{
+			name: "Runs cleanup on every tick",
+			setupMock: func(m *mocks.CleanupRefreshSessionsUseCase, called chan struct{}) {
+				m.On("Execute", mock.Anything, mock.AnythingOfType("dto.CleanupRefreshSessionsInput")).
+					Return(dto.CleanupRefreshSessionsOutput{Deleted: 10}, nil).
+					Run(func(mock.Arguments) { notify(called) })
+			},
+		},
+		{
+			name: "Keeps running after a failure",
+			setupMock: func(m *mocks.CleanupRefreshSessionsUseCase, called chan struct{}) {
+				m.On("Execute", mock.Anything, mock.Anything).
+					Return(dto.CleanupRefreshSessionsOutput{},
+						ucerrs.Wrap(ucerrs.ErrDeleteRefreshSessionsDB, assert.AnError)).
+					Run(func(mock.Arguments) { notify(called) })
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				called      = make(chan struct{}, 10)
+				mockCleanup = mocks.NewCleanupRefreshSessionsUseCase(t)
+			)
+			tt.setupMock(mockCleanup, called)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			janitor := worker.NewSessionJanitor(slog.Default(), time.Millisecond, mockCleanup)
+			janitor.Start(ctx)
+
+			// Immediate run and at least one tick
+			for range 2 {
+				select {
+				case <-called:
+				case <-time.After(time.Second):
+					t.Fatal("cleanup was not called")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package cipher_test
+
+import (
+	"testing"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/cipher"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAESGCM(t *testing.T) {
+	t.Parallel()
+
+	key, err := cipher.GenerateKey()
+	require.NoError(t, err)
+
+	c, err := cipher.NewAESGCM(key)
+	require.NoError(t, err)
+
+	sealed, err := c.Encrypt("JBSWY3DPEHPK3PXP")
+	require.NoError(t, err)
+	assert.NotContains(t, sealed, "JBSWY3DPEHPK3PXP")
+
+	// Every encryption uses a fresh nonce
+	sealedAgain, err := c.Encrypt("JBSWY3DPEHPK3PXP")
+	require.NoError(t, err)
+	assert.NotEqual(t, sealed, sealedAgain)
+
+	plain, err := c.Decrypt(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", plain)
+
+	// Another key can not open it
+	otherKey, _ := cipher.GenerateKey()
+	other, _ := cipher.NewAESGCM(otherKey)
+	_, err = other.Decrypt(sealed)
+	assert.Error(t, err)
+
+	_, err = c.Decrypt("%%%")
+	assert.ErrorIs(t, err, cipher.ErrMalformedCiphertext)
+}
+
+func TestNewAESGCM_InvalidKey(t *testing.T) {
+	t.Parallel()
+
+	_, err := cipher.NewAESGCM([]byte("short"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,73 @@
+package hasher_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/hasher"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Cheap parameters, the real ones would slow the tests down
+var testArgon2idParams = hasher.Argon2idParams{
+	Memory:      64,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+func TestArgon2idHasher_HashCompare(t *testing.T) {
+	t.Parallel()
+
+	var passwordHasher = hasher.NewArgon2idHasher(testArgon2idParams)
+
+	hash, err := passwordHasher.Hash("password-12345")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=64,t=1,p=1$"))
+	assert.True(t, passwordHasher.Matches(hash))
+
+	t.Run("success", func(t *testing.T) {
+		assert.True(t, passwordHasher.Compare(hash, "password-12345"))
+	})
+
+	t.Run("fail - not equal", func(t *testing.T) {
+		assert.False(t, passwordHasher.Compare(hash, "password-54321"))
+	})
+
+	t.Run("fail - malformed hash", func(t *testing.T) {
+		assert.False(t, passwordHasher.Compare("$argon2id$v=19$broken", "password-12345"))
+	})
+
+	t.Run("salt is random", func(t *testing.T) {
+		other, err := passwordHasher.Hash("password-12345")
+		require.NoError(t, err)
+		assert.NotEqual(t, hash, other)
+	})
+}
+
+func TestArgon2idHasher_NeedsRehash(t *testing.T) {
+	t.Parallel()
+
+	var (
+		oldHasher     = hasher.NewArgon2idHasher(testArgon2idParams)
+		strongParams  = testArgon2idParams
+		currentHasher *hasher.Argon2idHasher
+	)
+	strongParams.Iterations = 2
+	currentHasher = hasher.NewArgon2idHasher(strongParams)
+
+	oldHash, err := oldHasher.Hash("password-12345")
+	require.NoError(t, err)
+	currentHash, err := currentHasher.Hash("password-12345")
+	require.NoError(t, err)
+
+	assert.True(t, currentHasher.NeedsRehash(oldHash))
+	assert.False(t, currentHasher.NeedsRehash(currentHash))
+	assert.True(t, currentHasher.NeedsRehash("garbage"))
+
+	// Hashes made with older parameters are still verified
+	assert.True(t, currentHasher.Compare(oldHash, "password-12345"))
+}
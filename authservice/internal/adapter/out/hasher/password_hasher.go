@@ -1,6 +1,58 @@
 package hasher
 
-import "golang.org/x/crypto/bcrypt"
+import (
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Algorithm is a single hashing scheme, it recognises its own hashes
+// so that several algorithms can be verified side by side
+type Algorithm interface {
+	Hash(password string) (string, error)
+	Compare(hash, password string) bool
+	NeedsRehash(hash string) bool
+	Matches(hash string) bool
+}
+
+// ================ Hasher with algorithm detection ================
+
+// PasswordHasher hashes new passwords with the current algorithm and
+// verifies stored hashes with whichever known algorithm produced them
+type PasswordHasher struct {
+	current Algorithm
+	known   []Algorithm
+}
+
+func NewPasswordHasher(current Algorithm, legacy ...Algorithm) *PasswordHasher {
+	return &PasswordHasher{
+		current: current,
+		known:   append([]Algorithm{current}, legacy...),
+	}
+}
+
+func (h *PasswordHasher) Hash(password string) (string, error) {
+	return h.current.Hash(password)
+}
+
+func (h *PasswordHasher) Compare(hash, password string) bool {
+	for _, alg := range h.known {
+		if alg.Matches(hash) {
+			return alg.Compare(hash, password)
+		}
+	}
+	return false
+}
+
+// NeedsRehash reports hashes of another algorithm or with outdated parameters
+func (h *PasswordHasher) NeedsRehash(hash string) bool {
+	if !h.current.Matches(hash) {
+		return true
+	}
+	return h.current.NeedsRehash(hash)
+}
+
+// ================ Bcrypt ================
 
 type BcryptHasher struct {
 	cost int
@@ -24,3 +76,17 @@ func (h *BcryptHasher) Compare(hash, password string) bool {
 	}
 	return true
 }
+
+func (h *BcryptHasher) NeedsRehash(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	if err != nil {
+		return true
+	}
+	return cost < h.cost
+}
+
+func (h *BcryptHasher) Matches(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") ||
+		strings.HasPrefix(hash, "$2b$") ||
+		strings.HasPrefix(hash, "$2y$")
+}
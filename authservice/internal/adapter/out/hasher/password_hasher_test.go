@@ -72,3 +72,82 @@ func TestPasswordHasher_Compare(t *testing.T) {
 		assert.False(t, result)
 	})
 }
+
+func TestPasswordHasher_Detection(t *testing.T) {
+	t.Parallel()
+
+	const hashCost = 4
+	var (
+		bcryptHasher   = hasher.NewBcryptHasher(hashCost)
+		argon2idHasher = hasher.NewArgon2idHasher(testArgon2idParams)
+		passwordHasher = hasher.NewPasswordHasher(argon2idHasher, bcryptHasher)
+		password       = "password-12345"
+	)
+
+	bcryptHash, err := bcryptHasher.Hash(password)
+	require.NoError(t, err)
+	argon2idHash, err := passwordHasher.Hash(password)
+	require.NoError(t, err)
+
+	type testCase struct {
+		name       string
+		hash       string
+		password   string
+		wantMatch  bool
+		wantRehash bool
+	}
+
+	var tests = []testCase{
+		{
+			name:       "current algorithm",
+			hash:       argon2idHash,
+			password:   password,
+			wantMatch:  true,
+			wantRehash: false,
+		},
+		{
+			name:       "legacy algorithm",
+			hash:       bcryptHash,
+			password:   password,
+			wantMatch:  true,
+			wantRehash: true,
+		},
+		{
+			name:       "legacy algorithm - wrong password",
+			hash:       bcryptHash,
+			password:   "password-54321",
+			wantMatch:  false,
+			wantRehash: true,
+		},
+		{
+			name:       "unknown algorithm",
+			hash:       "$scrypt$ln=16,r=8,p=1$c2FsdA$aGFzaA",
+			password:   password,
+			wantMatch:  false,
+			wantRehash: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantMatch, passwordHasher.Compare(tt.hash, tt.password))
+			assert.Equal(t, tt.wantRehash, passwordHasher.NeedsRehash(tt.hash))
+		})
+	}
+}
+
+func TestBcryptHasher_NeedsRehash(t *testing.T) {
+	t.Parallel()
+
+	var (
+		weakHasher   = hasher.NewBcryptHasher(4)
+		strongHasher = hasher.NewBcryptHasher(5)
+	)
+
+	weakHash, err := weakHasher.Hash("password-12345")
+	require.NoError(t, err)
+
+	assert.True(t, strongHasher.Matches(weakHash))
+	assert.True(t, strongHasher.NeedsRehash(weakHash))
+	assert.False(t, weakHasher.NeedsRehash(weakHash))
+}
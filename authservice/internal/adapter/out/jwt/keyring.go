@@ -0,0 +1,200 @@
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const rsaKeyBits = 2048
+
+var ErrUnknownKeyID = errors.New("unknown signing key id")
+
+// SigningKey is one key of the keyring, private part is nil for retired
+// keys that were loaded from a public PEM and can only verify tokens
+type SigningKey struct {
+	id        string
+	algorithm model.SigningAlgorithm
+	private   crypto.Signer
+	public    crypto.PublicKey
+}
+
+func newSigningKey(id string, key any) (*SigningKey, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return &SigningKey{id: id, algorithm: model.SigningAlgRS256, private: k, public: &k.PublicKey}, nil
+	case ed25519.PrivateKey:
+		return &SigningKey{id: id, algorithm: model.SigningAlgEdDSA, private: k, public: k.Public()}, nil
+	case *rsa.PublicKey:
+		return &SigningKey{id: id, algorithm: model.SigningAlgRS256, public: k}, nil
+	case ed25519.PublicKey:
+		return &SigningKey{id: id, algorithm: model.SigningAlgEdDSA, public: k}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", key)
+	}
+}
+
+// GenerateSigningKey creates a new in-memory key for the given algorithm
+func GenerateSigningKey(id string, algorithm model.SigningAlgorithm) (*SigningKey, error) {
+	switch algorithm {
+	case model.SigningAlgRS256:
+		key, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate rsa key: %w", err)
+		}
+		return newSigningKey(id, key)
+	case model.SigningAlgEdDSA:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate ed25519 key: %w", err)
+		}
+		return newSigningKey(id, key)
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm: %s", algorithm)
+	}
+}
+
+// ParseSigningKey reads a PEM encoded PKCS#8/PKCS#1 private key
+// or a PKIX public key, the algorithm is taken from the key type
+func ParseSigningKey(id string, data []byte) (*SigningKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("key %s: no PEM block found", id)
+	}
+
+	var (
+		key any
+		err error
+	)
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("key %s: unsupported PEM block %q", id, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("key %s: %w", id, err)
+	}
+
+	return newSigningKey(id, key)
+}
+
+func (k *SigningKey) ID() string                        { return k.id }
+func (k *SigningKey) Algorithm() model.SigningAlgorithm { return k.algorithm }
+func (k *SigningKey) CanSign() bool                     { return k.private != nil }
+
+func (k *SigningKey) method() jwt.SigningMethod {
+	if k.algorithm == model.SigningAlgRS256 {
+		return jwt.SigningMethodRS256
+	}
+	return jwt.SigningMethodEdDSA
+}
+
+// Keyring signs with the active key and verifies with any known key,
+// so tokens issued before a rotation stay valid until they expire
+type Keyring struct {
+	active *SigningKey
+	keys   map[string]*SigningKey
+	order  []string
+}
+
+func NewKeyring(active *SigningKey, retired ...*SigningKey) (*Keyring, error) {
+	if active == nil || !active.CanSign() {
+		return nil, errors.New("active key must have a private part")
+	}
+
+	kr := &Keyring{
+		active: active,
+		keys:   map[string]*SigningKey{active.id: active},
+		order:  []string{active.id},
+	}
+	for _, key := range retired {
+		if _, ok := kr.keys[key.id]; ok {
+			return nil, fmt.Errorf("duplicate key id: %s", key.id)
+		}
+		kr.keys[key.id] = key
+		kr.order = append(kr.order, key.id)
+	}
+
+	return kr, nil
+}
+
+// LoadKeyring reads every *.pem file of dir, the file name without
+// extension is used as a key id. The key with activeID signs new tokens
+func LoadKeyring(dir, activeID string) (*Keyring, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list keys: %w", err)
+	}
+	sort.Strings(paths)
+
+	var (
+		active  *SigningKey
+		retired []*SigningKey
+	)
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read key: %w", err)
+		}
+
+		id := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		key, err := ParseSigningKey(id, data)
+		if err != nil {
+			return nil, err
+		}
+
+		if id == activeID {
+			active = key
+		} else {
+			retired = append(retired, key)
+		}
+	}
+
+	if active == nil {
+		return nil, fmt.Errorf("active key %q not found in %s", activeID, dir)
+	}
+
+	return NewKeyring(active, retired...)
+}
+
+func (kr *Keyring) Active() *SigningKey { return kr.active }
+
+func (kr *Keyring) Lookup(id string) (*SigningKey, error) {
+	key, ok := kr.keys[id]
+	if !ok {
+		return nil, ErrUnknownKeyID
+	}
+	return key, nil
+}
+
+func (kr *Keyring) PublicKeys(_ context.Context) ([]*model.PublicKey, error) {
+	keys := make([]*model.PublicKey, 0, len(kr.order))
+	for _, id := range kr.order {
+		key := kr.keys[id]
+		pub, err := model.NewPublicKey(key.id, key.algorithm, key.public, key == kr.active)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, pub)
+	}
+	return keys, nil
+}
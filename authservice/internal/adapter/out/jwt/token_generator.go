@@ -5,47 +5,84 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 )
 
 type CustomClaims struct {
 	jwt.RegisteredClaims
-	Role string `json:"role,omitempty"`
-	Type string `json:"type"`
+	Roles       []string `json:"roles,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+	SessionID   string   `json:"sid,omitempty"`
+	Actor       *Actor   `json:"act,omitempty"`
+	Type        string   `json:"type"`
+}
+
+// Actor is the party acting on behalf of the subject (RFC 8693)
+type Actor struct {
+	Subject string `json:"sub"`
 }
 
 type TokenGenerator struct {
-	accessSecret  []byte
+	keyring       *Keyring
 	refreshSecret []byte
 	accessTTL     time.Duration
 	refreshTTL    time.Duration
+	mfaTTL        time.Duration
 }
 
 func NewTokenGenerator(
-	accessSecret, refreshSecret string,
-	accessTTL, refreshTTL time.Duration) *TokenGenerator {
+	keyring *Keyring, refreshSecret string,
+	accessTTL, refreshTTL, mfaTTL time.Duration) *TokenGenerator {
 	return &TokenGenerator{
-		accessSecret:  []byte(accessSecret),
+		keyring:       keyring,
 		refreshSecret: []byte(refreshSecret),
 		accessTTL:     accessTTL,
 		refreshTTL:    refreshTTL,
+		mfaTTL:        mfaTTL,
 	}
 }
 
-func (gen *TokenGenerator) GenerateAccessToken(_ context.Context, accountID uuid.UUID, role string) (string, error) {
+func (gen *TokenGenerator) GenerateAccessToken(_ context.Context, claims *model.AccessTokenClaims) (string, error) {
+	roles := make([]string, 0, len(claims.Roles))
+	for _, role := range claims.Roles {
+		roles = append(roles, role.String())
+	}
+	permissions := make([]string, 0, len(claims.Permissions))
+	for _, permission := range claims.Permissions {
+		permissions = append(permissions, permission.String())
+	}
+
+	expiresAt := time.Now().Add(gen.accessTTL)
+	if !claims.ExpiresAt.IsZero() && claims.ExpiresAt.Before(expiresAt) {
+		expiresAt = claims.ExpiresAt
+	}
+
+	// jti lets a single token be revoked before it expires
 	accessClaims := CustomClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
-			Subject:   accountID.String(),
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(gen.accessTTL)),
+			Subject:   claims.AccountID.String(),
+			ID:        uuid.NewString(),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
-		Role: role,
-		Type: "access",
+		Roles:       roles,
+		Permissions: permissions,
+		Type:        "access",
+	}
+	if claims.SessionID != uuid.Nil {
+		accessClaims.SessionID = claims.SessionID.String()
+	}
+	if claims.ActorID != nil {
+		accessClaims.Actor = &Actor{Subject: claims.ActorID.String()}
 	}
 
-	accessToken := jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims)
-	accessStr, err := accessToken.SignedString(gen.accessSecret)
+	signingKey := gen.keyring.Active()
+	accessToken := jwt.NewWithClaims(signingKey.method(), accessClaims)
+	accessToken.Header["kid"] = signingKey.ID()
+	accessStr, err := accessToken.SignedString(signingKey.private)
 
 	if err != nil {
 		return "", err
@@ -75,16 +112,46 @@ func (gen *TokenGenerator) GenerateRefreshToken(_ context.Context, accountID, se
 	return refreshStr, nil
 }
 
+// GenerateMFAToken issues a challenge proving the password step has passed,
+// it is signed like a refresh token and only differs by type
+func (gen *TokenGenerator) GenerateMFAToken(_ context.Context, accountID uuid.UUID) (string, error) {
+	mfaClaims := CustomClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   accountID.String(),
+			ID:        uuid.NewString(),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(gen.mfaTTL)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+		Type: "mfa",
+	}
+
+	mfaToken := jwt.NewWithClaims(jwt.SigningMethodHS256, mfaClaims)
+	mfaStr, err := mfaToken.SignedString(gen.refreshSecret)
+
+	if err != nil {
+		return "", err
+	}
+
+	return mfaStr, nil
+}
+
 func (gen *TokenGenerator) parseAccessToken(_ context.Context, token string) (*CustomClaims, error) {
 	accessClaims := &CustomClaims{}
 
 	parsedToken, err := jwt.ParseWithClaims(token, accessClaims, func(token *jwt.Token) (interface{}, error) {
-		// Check the signing method
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		// Find the key by its id and check the signing method
+		kid, _ := token.Header["kid"].(string)
+		key, err := gen.keyring.Lookup(kid)
+		if err != nil {
+			return nil, err
+		}
+		if token.Method.Alg() != key.method().Alg() {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return gen.accessSecret, nil
-	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}), jwt.WithLeeway(30*time.Second))
+		return key.public, nil
+	}, jwt.WithValidMethods([]string{
+		jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg(),
+	}), jwt.WithLeeway(30*time.Second))
 
 	if err != nil || !parsedToken.Valid {
 		return nil, fmt.Errorf("failed to parse access token: %w", err)
@@ -111,26 +178,64 @@ func (gen *TokenGenerator) parseRefreshToken(_ context.Context, token string) (*
 	return refreshClaims, nil
 }
 
-func (gen *TokenGenerator) ValidateAccessToken(ctx context.Context, token string) (uuid.UUID, string, error) {
+func (gen *TokenGenerator) ValidateAccessToken(ctx context.Context, token string) (*model.AccessTokenClaims, error) {
 	claims, err := gen.parseAccessToken(ctx, token)
 	if err != nil {
-		return uuid.Nil, "", err
+		return nil, err
 	}
 
 	if claims.Type != "access" {
-		return uuid.Nil, "", fmt.Errorf("invalid token type")
+		return nil, fmt.Errorf("invalid token type")
 	}
 
 	sub, err := uuid.Parse(claims.Subject)
 	if err != nil {
-		return uuid.Nil, "", fmt.Errorf("failed to get account_id: %w", err)
+		return nil, fmt.Errorf("failed to get account_id: %w", err)
+	}
+	if len(claims.Roles) == 0 {
+		return nil, fmt.Errorf("failed to get account roles")
+	}
+
+	// Tokens issued before sid was added have none
+	var sessionID uuid.UUID
+	if claims.SessionID != "" {
+		sessionID, err = uuid.Parse(claims.SessionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get session id: %w", err)
+		}
+	}
+
+	var actorID *uuid.UUID
+	if claims.Actor != nil {
+		actor, err := uuid.Parse(claims.Actor.Subject)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get actor id: %w", err)
+		}
+		actorID = &actor
+	}
+
+	accessClaims := &model.AccessTokenClaims{
+		AccountID:   sub,
+		SessionID:   sessionID,
+		Roles:       make([]model.Role, 0, len(claims.Roles)),
+		Permissions: make([]model.Permission, 0, len(claims.Permissions)),
+		ActorID:     actorID,
+		TokenID:     claims.ID,
+	}
+	if claims.IssuedAt != nil {
+		accessClaims.IssuedAt = claims.IssuedAt.Time
+	}
+	if claims.ExpiresAt != nil {
+		accessClaims.ExpiresAt = claims.ExpiresAt.Time
+	}
+	for _, role := range claims.Roles {
+		accessClaims.Roles = append(accessClaims.Roles, model.Role(role))
 	}
-	role := claims.Role
-	if role == "" {
-		return uuid.Nil, "", fmt.Errorf("failed to get account role")
+	for _, permission := range claims.Permissions {
+		accessClaims.Permissions = append(accessClaims.Permissions, model.Permission(permission))
 	}
 
-	return sub, role, nil
+	return accessClaims, nil
 }
 
 func (gen *TokenGenerator) ValidateRefreshToken(ctx context.Context, token string) (uuid.UUID, uuid.UUID, error) {
@@ -154,3 +259,22 @@ func (gen *TokenGenerator) ValidateRefreshToken(ctx context.Context, token strin
 
 	return sub, id, nil
 }
+
+func (gen *TokenGenerator) ValidateMFAToken(ctx context.Context, token string) (uuid.UUID, error) {
+	// Same signature as a refresh token
+	claims, err := gen.parseRefreshToken(ctx, token)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if claims.Type != "mfa" {
+		return uuid.Nil, fmt.Errorf("invalid token type")
+	}
+
+	sub, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get account_id: %w", err)
+	}
+
+	return sub, nil
+}
@@ -0,0 +1,217 @@
+package jwt_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	adaptertg "github.com/maket12/ads-service/authservice/internal/adapter/out/jwt"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newGenerator(t *testing.T, active *adaptertg.SigningKey, retired ...*adaptertg.SigningKey) *adaptertg.TokenGenerator {
+	keyring, err := adaptertg.NewKeyring(active, retired...)
+	require.NoError(t, err)
+	return adaptertg.NewTokenGenerator(keyring, "refresh-secret", time.Minute, time.Hour, time.Minute*5)
+}
+
+func TestTokenGenerator_AccessToken(t *testing.T) {
+	t.Parallel()
+
+	for _, alg := range []model.SigningAlgorithm{model.SigningAlgRS256, model.SigningAlgEdDSA} {
+		t.Run(alg.String(), func(t *testing.T) {
+			key, err := adaptertg.GenerateSigningKey("key-1", alg)
+			require.NoError(t, err)
+			gen := newGenerator(t, key)
+
+			claims := &model.AccessTokenClaims{
+				AccountID:   uuid.New(),
+				SessionID:   uuid.New(),
+				Roles:       []model.Role{model.RoleUser, model.RoleModerator},
+				Permissions: []model.Permission{model.PermissionAdsModerate},
+			}
+			token, err := gen.GenerateAccessToken(context.Background(), claims)
+			require.NoError(t, err)
+
+			// Header carries the key id and the algorithm
+			parsed, _, err := jwt.NewParser().ParseUnverified(token, jwt.MapClaims{})
+			require.NoError(t, err)
+			assert.Equal(t, "key-1", parsed.Header["kid"])
+			assert.Equal(t, alg.String(), parsed.Header["alg"])
+
+			got, err := gen.ValidateAccessToken(context.Background(), token)
+			require.NoError(t, err)
+
+			// Every token gets its own id, expiry comes from the TTL
+			assert.NotEmpty(t, got.TokenID)
+			assert.WithinDuration(t, time.Now().Add(time.Minute), got.ExpiresAt, time.Second*2)
+			assert.WithinDuration(t, time.Now(), got.IssuedAt, time.Second*2)
+			claims.TokenID, claims.IssuedAt, claims.ExpiresAt = got.TokenID, got.IssuedAt, got.ExpiresAt
+			assert.Equal(t, claims, got)
+
+			other, err := gen.GenerateAccessToken(context.Background(), claims)
+			require.NoError(t, err)
+			gotOther, err := gen.ValidateAccessToken(context.Background(), other)
+			require.NoError(t, err)
+			assert.NotEqual(t, got.TokenID, gotOther.TokenID)
+		})
+	}
+}
+
+func TestTokenGenerator_ImpersonationToken(t *testing.T) {
+	t.Parallel()
+
+	key, err := adaptertg.GenerateSigningKey("key-1", model.SigningAlgEdDSA)
+	require.NoError(t, err)
+	gen := newGenerator(t, key)
+
+	actorID := uuid.New()
+	accountRole := model.RestoreAccountRole(uuid.New(), []model.Role{model.RoleUser}, nil)
+	claims := model.NewImpersonationClaims(accountRole, actorID, time.Second*30)
+
+	token, err := gen.GenerateAccessToken(context.Background(), claims)
+	require.NoError(t, err)
+
+	// The admin is named in the act claim
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, jwt.MapClaims{})
+	require.NoError(t, err)
+	act, ok := parsed.Claims.(jwt.MapClaims)["act"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, actorID.String(), act["sub"])
+
+	got, err := gen.ValidateAccessToken(context.Background(), token)
+	require.NoError(t, err)
+	assert.True(t, got.IsImpersonated())
+	assert.Equal(t, actorID, *got.ActorID)
+	assert.Equal(t, uuid.Nil, got.SessionID)
+	assert.WithinDuration(t, time.Now().Add(time.Second*30), got.ExpiresAt, time.Second*2)
+
+	// A longer lifetime than the access TTL is cut down
+	claims = model.NewImpersonationClaims(accountRole, actorID, time.Hour)
+	token, err = gen.GenerateAccessToken(context.Background(), claims)
+	require.NoError(t, err)
+	got, err = gen.ValidateAccessToken(context.Background(), token)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), got.ExpiresAt, time.Second*2)
+}
+
+func TestTokenGenerator_Rotation(t *testing.T) {
+	t.Parallel()
+
+	oldKey, err := adaptertg.GenerateSigningKey("old", model.SigningAlgRS256)
+	require.NoError(t, err)
+	newKey, err := adaptertg.GenerateSigningKey("new", model.SigningAlgEdDSA)
+	require.NoError(t, err)
+
+	// Token issued before the rotation
+	token, err := newGenerator(t, oldKey).
+		GenerateAccessToken(context.Background(), &model.AccessTokenClaims{
+			AccountID: uuid.New(),
+			Roles:     []model.Role{model.RoleUser},
+		})
+	require.NoError(t, err)
+
+	t.Run("retired key still verifies", func(t *testing.T) {
+		_, err := newGenerator(t, newKey, oldKey).
+			ValidateAccessToken(context.Background(), token)
+		assert.NoError(t, err)
+	})
+	t.Run("removed key is rejected", func(t *testing.T) {
+		_, err := newGenerator(t, newKey).
+			ValidateAccessToken(context.Background(), token)
+		assert.ErrorIs(t, err, adaptertg.ErrUnknownKeyID)
+	})
+}
+
+func TestTokenGenerator_RefreshTokenIsNotAccessToken(t *testing.T) {
+	t.Parallel()
+
+	key, err := adaptertg.GenerateSigningKey("key-1", model.SigningAlgEdDSA)
+	require.NoError(t, err)
+	gen := newGenerator(t, key)
+
+	refresh, err := gen.GenerateRefreshToken(context.Background(), uuid.New(), uuid.New())
+	require.NoError(t, err)
+
+	_, err = gen.ValidateAccessToken(context.Background(), refresh)
+	assert.Error(t, err)
+}
+
+func TestTokenGenerator_MFAToken(t *testing.T) {
+	t.Parallel()
+
+	key, err := adaptertg.GenerateSigningKey("key-1", model.SigningAlgEdDSA)
+	require.NoError(t, err)
+	gen := newGenerator(t, key)
+
+	accountID := uuid.New()
+	mfaToken, err := gen.GenerateMFAToken(context.Background(), accountID)
+	require.NoError(t, err)
+
+	gotID, err := gen.ValidateMFAToken(context.Background(), mfaToken)
+	require.NoError(t, err)
+	assert.Equal(t, accountID, gotID)
+
+	// A challenge is neither a refresh nor an access token and vice versa
+	_, _, err = gen.ValidateRefreshToken(context.Background(), mfaToken)
+	assert.Error(t, err)
+	_, err = gen.ValidateAccessToken(context.Background(), mfaToken)
+	assert.Error(t, err)
+
+	refresh, err := gen.GenerateRefreshToken(context.Background(), accountID, uuid.New())
+	require.NoError(t, err)
+	_, err = gen.ValidateMFAToken(context.Background(), refresh)
+	assert.Error(t, err)
+}
+
+func TestLoadKeyring(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	privDER, err := x509.MarshalPKCS8PrivateKey(priv)
+	require.NoError(t, err)
+	pubDER, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+
+	writePEM := func(name, blockType string, der []byte) {
+		data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), data, 0o600))
+	}
+	writePEM("current.pem", "PRIVATE KEY", privDER)
+	writePEM("previous.pem", "PUBLIC KEY", pubDER)
+
+	t.Run("success", func(t *testing.T) {
+		keyring, err := adaptertg.LoadKeyring(dir, "current")
+		require.NoError(t, err)
+		assert.Equal(t, "current", keyring.Active().ID())
+		assert.Equal(t, model.SigningAlgEdDSA, keyring.Active().Algorithm())
+
+		keys, err := keyring.PublicKeys(context.Background())
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+		assert.True(t, keys[0].IsActive())
+		assert.False(t, keys[1].IsActive())
+	})
+	t.Run("active key is public only", func(t *testing.T) {
+		_, err := adaptertg.LoadKeyring(dir, "previous")
+		assert.Error(t, err)
+	})
+	t.Run("active key is missing", func(t *testing.T) {
+		_, err := adaptertg.LoadKeyring(dir, "unknown")
+		assert.Error(t, err)
+	})
+}
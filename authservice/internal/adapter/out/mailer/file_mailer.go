@@ -0,0 +1,44 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// FileMailer writes every message into a separate .eml file
+// instead of sending it. Useful for local development.
+type FileMailer struct {
+	dir  string
+	from string
+}
+
+func NewFileMailer(dir, from string) (*FileMailer, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create mail directory: %w", err)
+	}
+	return &FileMailer{dir: dir, from: from}, nil
+}
+
+func (m *FileMailer) Send(_ context.Context, to, subject, body string) error {
+	msg := Message{
+		From:    m.from,
+		To:      to,
+		Subject: subject,
+		Body:    body,
+		SentAt:  time.Now(),
+	}
+
+	name := fmt.Sprintf("%d-%s.eml",
+		msg.SentAt.UnixNano(), strings.NewReplacer("@", "_at_", "/", "_").Replace(to),
+	)
+
+	if err := os.WriteFile(filepath.Join(m.dir, name), msg.bytes(), 0o640); err != nil {
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+
+	return nil
+}
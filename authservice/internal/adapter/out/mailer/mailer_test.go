@@ -0,0 +1,62 @@
+package mailer_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/mailer"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryMailer_Send(t *testing.T) {
+	t.Parallel()
+
+	m := mailer.NewMemoryMailer("noreply@ads.local")
+
+	_, ok := m.Last()
+	assert.False(t, ok)
+
+	err := m.Send(context.Background(), "first@test.com", "Hello", "body 1")
+	require.NoError(t, err)
+	err = m.Send(context.Background(), "second@test.com", "Hello", "body 2")
+	require.NoError(t, err)
+
+	messages := m.Messages()
+	require.Len(t, messages, 2)
+	assert.Equal(t, "first@test.com", messages[0].To)
+
+	last, ok := m.Last()
+	require.True(t, ok)
+	assert.Equal(t, "second@test.com", last.To)
+	assert.Equal(t, "noreply@ads.local", last.From)
+	assert.Equal(t, "body 2", last.Body)
+}
+
+func TestFileMailer_Send(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "mail")
+
+	m, err := mailer.NewFileMailer(dir, "noreply@ads.local")
+	require.NoError(t, err)
+
+	err = m.Send(context.Background(), "user@test.com", "Confirm your email", "follow the link")
+	require.NoError(t, err)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	raw, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+	require.NoError(t, err)
+
+	content := string(raw)
+	assert.Contains(t, content, "To: user@test.com")
+	assert.Contains(t, content, "From: noreply@ads.local")
+	assert.Contains(t, content, "Subject: Confirm your email")
+	assert.Contains(t, content, "follow the link")
+}
@@ -0,0 +1,96 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+)
+
+type denylistEntry struct {
+	denied    bool
+	expiresAt time.Time // when a denied entry can be forgotten
+	checkedAt time.Time // when a miss was confirmed by the backend
+}
+
+// CachedAccessTokenDenylist keeps denylist lookups in the process memory
+// in front of a shared store. Denied keys are cached until they expire,
+// misses only for missTTL, so revocations made by other replicas are
+// picked up with at most that delay
+type CachedAccessTokenDenylist struct {
+	backend port.AccessTokenDenylist
+	missTTL time.Duration
+
+	mu      sync.Mutex
+	entries map[string]denylistEntry
+}
+
+func NewCachedAccessTokenDenylist(backend port.AccessTokenDenylist, missTTL time.Duration) *CachedAccessTokenDenylist {
+	return &CachedAccessTokenDenylist{
+		backend: backend,
+		missTTL: missTTL,
+		entries: make(map[string]denylistEntry),
+	}
+}
+
+func (d *CachedAccessTokenDenylist) Add(ctx context.Context, key string, expiresAt time.Time) error {
+	if err := d.backend.Add(ctx, key, expiresAt); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if entry, ok := d.entries[key]; ok && entry.denied && entry.expiresAt.After(expiresAt) {
+		return nil
+	}
+	d.entries[key] = denylistEntry{denied: true, expiresAt: expiresAt}
+	return nil
+}
+
+func (d *CachedAccessTokenDenylist) Contains(ctx context.Context, key string) (bool, error) {
+	now := time.Now()
+
+	d.mu.Lock()
+	entry, ok := d.entries[key]
+	d.mu.Unlock()
+
+	if ok {
+		if entry.denied && now.Before(entry.expiresAt) {
+			return true, nil
+		}
+		if !entry.denied && now.Sub(entry.checkedAt) < d.missTTL {
+			return false, nil
+		}
+	}
+
+	denied, err := d.backend.Contains(ctx, key)
+	if err != nil {
+		return false, err
+	}
+
+	// The backend does not tell the expiry, a denied key is
+	// rechecked after missTTL like a miss
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if denied {
+		d.entries[key] = denylistEntry{denied: true, expiresAt: now.Add(d.missTTL)}
+	} else {
+		d.entries[key] = denylistEntry{checkedAt: now}
+	}
+	return denied, nil
+}
+
+func (d *CachedAccessTokenDenylist) DeleteExpired(ctx context.Context, expiredBefore time.Time, limit int) (int64, error) {
+	d.mu.Lock()
+	for key, entry := range d.entries {
+		if (entry.denied && !entry.expiresAt.After(expiredBefore)) ||
+			(!entry.denied && expiredBefore.Sub(entry.checkedAt) >= d.missTTL) {
+			delete(d.entries, key)
+		}
+	}
+	d.mu.Unlock()
+
+	return d.backend.DeleteExpired(ctx, expiredBefore, limit)
+}
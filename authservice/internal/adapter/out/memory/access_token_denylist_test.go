@@ -0,0 +1,58 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/memory"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedAccessTokenDenylist(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		backend = mocks.NewAccessTokenDenylist(t)
+		cache   = memory.NewCachedAccessTokenDenylist(backend, time.Hour)
+		denied  = "jti:denied"
+		allowed = "jti:allowed"
+	)
+
+	// Added keys are answered from memory
+	backend.On("Add", mock.Anything, denied, mock.Anything).Return(nil).Once()
+	require.NoError(t, cache.Add(ctx, denied, time.Now().Add(time.Minute)))
+
+	ok, err := cache.Contains(ctx, denied)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// Misses are asked once and then cached for the miss ttl
+	backend.On("Contains", mock.Anything, allowed).Return(false, nil).Once()
+	for range 2 {
+		ok, err = cache.Contains(ctx, allowed)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	}
+
+	// Backend errors are not cached
+	backend.On("Contains", mock.Anything, "sid:broken").Return(false, assert.AnError).Once()
+	_, err = cache.Contains(ctx, "sid:broken")
+	assert.ErrorIs(t, err, assert.AnError)
+
+	// Cleanup forgets expired entries, the denied key is asked again
+	backend.On("DeleteExpired", mock.Anything, mock.Anything, 100).Return(int64(1), nil).Once()
+	deleted, err := cache.DeleteExpired(ctx, time.Now().Add(time.Hour*2), 100)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	backend.On("Contains", mock.Anything, denied).Return(false, nil).Once()
+	ok, err = cache.Contains(ctx, denied)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
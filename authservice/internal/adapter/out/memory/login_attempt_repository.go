@@ -0,0 +1,89 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// LoginAttemptRepository keeps failures in the process memory, it suits
+// a single replica deployment and tests, counters are lost on restart
+type LoginAttemptRepository struct {
+	mu       sync.Mutex
+	failures map[string][]time.Time
+}
+
+func NewLoginAttemptsRepository() *LoginAttemptRepository {
+	return &LoginAttemptRepository{failures: make(map[string][]time.Time)}
+}
+
+func (r *LoginAttemptRepository) RecordFailure(_ context.Context, key string, at time.Time, window time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var (
+		expiredBefore = at.Add(-window)
+		kept          = r.failures[key][:0]
+	)
+	for _, failedAt := range r.failures[key] {
+		if !failedAt.Before(expiredBefore) {
+			kept = append(kept, failedAt)
+		}
+	}
+	r.failures[key] = append(kept, at)
+
+	return nil
+}
+
+func (r *LoginAttemptRepository) GetFailures(_ context.Context, key string, since time.Time) (int, time.Time, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var (
+		count int
+		last  time.Time
+	)
+	for _, failedAt := range r.failures[key] {
+		if failedAt.Before(since) {
+			continue
+		}
+		count++
+		if failedAt.After(last) {
+			last = failedAt
+		}
+	}
+
+	return count, last, nil
+}
+
+func (r *LoginAttemptRepository) Clear(_ context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.failures, key)
+	return nil
+}
+
+func (r *LoginAttemptRepository) DeleteExpired(_ context.Context, expiredBefore time.Time, limit int) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int64
+	for key, failures := range r.failures {
+		kept := failures[:0]
+		for _, failedAt := range failures {
+			if failedAt.Before(expiredBefore) && deleted < int64(limit) {
+				deleted++
+				continue
+			}
+			kept = append(kept, failedAt)
+		}
+		if len(kept) == 0 {
+			delete(r.failures, key)
+		} else {
+			r.failures[key] = kept
+		}
+	}
+
+	return deleted, nil
+}
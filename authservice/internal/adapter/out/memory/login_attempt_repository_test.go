@@ -0,0 +1,67 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/memory"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginAttemptRepository(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		repo   = memory.NewLoginAttemptsRepository()
+		now    = time.Now()
+		window = time.Minute * 15
+		key    = "account:user@email.com"
+	)
+
+	count, last, err := repo.GetFailures(ctx, key, now.Add(-window))
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	assert.True(t, last.IsZero())
+
+	// The first failure falls out of the window once the third one is recorded
+	require.NoError(t, repo.RecordFailure(ctx, key, now.Add(-window*2), window))
+	require.NoError(t, repo.RecordFailure(ctx, key, now.Add(-time.Minute), window))
+	require.NoError(t, repo.RecordFailure(ctx, key, now, window))
+	require.NoError(t, repo.RecordFailure(ctx, "ip:127.0.0.1", now, window))
+
+	count, last, err = repo.GetFailures(ctx, key, now.Add(-window))
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, now, last)
+
+	require.NoError(t, repo.Clear(ctx, key))
+
+	count, _, err = repo.GetFailures(ctx, key, now.Add(-window))
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	// Other keys are untouched
+	count, _, err = repo.GetFailures(ctx, "ip:127.0.0.1", now.Add(-window))
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	// Only failures older than the cutoff are removed, up to the limit
+	require.NoError(t, repo.RecordFailure(ctx, "account:a@email.com", now.Add(-window*2), window))
+	require.NoError(t, repo.RecordFailure(ctx, "account:b@email.com", now.Add(-window*3), window))
+
+	deleted, err := repo.DeleteExpired(ctx, now.Add(-window), 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	deleted, err = repo.DeleteExpired(ctx, now.Add(-window), 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	count, _, err = repo.GetFailures(ctx, "ip:127.0.0.1", now.Add(-window))
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
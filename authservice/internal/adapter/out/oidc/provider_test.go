@@ -0,0 +1,276 @@
+package oidc_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/oidc"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testClientID    = "ads-service"
+	testKeyID       = "mock-key"
+	testRedirectURL = "http://localhost:8080/oidc/callback"
+)
+
+// mockIssuer is a minimal OpenID provider: discovery, JWKS and a token
+// endpoint that accepts a single code bound to a PKCE challenge
+type mockIssuer struct {
+	*httptest.Server
+	key *rsa.PrivateKey
+
+	code      string
+	challenge string
+	claims    jwt.MapClaims
+}
+
+func newMockIssuer(t *testing.T) *mockIssuer {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	m := &mockIssuer{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", m.discovery)
+	mux.HandleFunc("/jwks", m.jwks)
+	mux.HandleFunc("/token", m.token)
+
+	m.Server = httptest.NewServer(mux)
+	t.Cleanup(m.Close)
+
+	return m
+}
+
+func (m *mockIssuer) discovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, map[string]any{
+		"issuer":                                m.URL,
+		"authorization_endpoint":                m.URL + "/authorize",
+		"token_endpoint":                        m.URL + "/token",
+		"jwks_uri":                              m.URL + "/jwks",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (m *mockIssuer) jwks(w http.ResponseWriter, _ *http.Request) {
+	pub := m.key.PublicKey
+	writeJSON(w, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": testKeyID,
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}},
+	})
+}
+
+func (m *mockIssuer) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	verifier := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if r.PostForm.Get("code") != m.code ||
+		base64.RawURLEncoding.EncodeToString(verifier[:]) != m.challenge {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+		return
+	}
+
+	idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, m.claims)
+	idToken.Header["kid"] = testKeyID
+	signed, err := idToken.SignedString(m.key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, map[string]any{
+		"access_token": "mock-access-token",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     signed,
+	})
+}
+
+func (m *mockIssuer) idClaims(nonce string) jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":            m.URL,
+		"aud":            testClientID,
+		"sub":            "mock-subject",
+		"email":          "user@email.com",
+		"email_verified": true,
+		"nonce":          nonce,
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Minute).Unix(),
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func s256(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func newProvider(t *testing.T, issuer *mockIssuer) *oidc.Provider {
+	t.Helper()
+
+	provider, err := oidc.NewProvider(
+		context.Background(), issuer.URL, testClientID, "secret",
+		testRedirectURL, []string{"openid", "email"},
+	)
+	require.NoError(t, err)
+	return provider
+}
+
+func TestProvider_AuthCodeURL(t *testing.T) {
+	issuer := newMockIssuer(t)
+	provider := newProvider(t, issuer)
+
+	raw := provider.AuthCodeURL("state-value", "nonce-value", "challenge-value")
+
+	u, err := url.Parse(raw)
+	require.NoError(t, err)
+	assert.Equal(t, issuer.URL+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+
+	q := u.Query()
+	assert.Equal(t, "code", q.Get("response_type"))
+	assert.Equal(t, testClientID, q.Get("client_id"))
+	assert.Equal(t, testRedirectURL, q.Get("redirect_uri"))
+	assert.Equal(t, "openid email", q.Get("scope"))
+	assert.Equal(t, "state-value", q.Get("state"))
+	assert.Equal(t, "nonce-value", q.Get("nonce"))
+	assert.Equal(t, "challenge-value", q.Get("code_challenge"))
+	assert.Equal(t, "S256", q.Get("code_challenge_method"))
+}
+
+func TestProvider_Exchange(t *testing.T) {
+	const (
+		code     = "auth-code"
+		verifier = "pkce-verifier-pkce-verifier-pkce-verifier-123"
+		nonce    = "nonce-value"
+	)
+
+	type testCase struct {
+		name     string
+		code     string
+		verifier string
+		nonce    string
+		claims   func(c jwt.MapClaims)
+		wantErr  bool
+	}
+
+	var tests = []testCase{
+		{
+			name:     "Success",
+			code:     code,
+			verifier: verifier,
+			nonce:    nonce,
+		},
+		{
+			name:     "Fail - Wrong Code",
+			code:     "other-code",
+			verifier: verifier,
+			nonce:    nonce,
+			wantErr:  true,
+		},
+		{
+			name:     "Fail - Wrong PKCE Verifier",
+			code:     code,
+			verifier: "another-verifier-another-verifier-another-12",
+			nonce:    nonce,
+			wantErr:  true,
+		},
+		{
+			name:     "Fail - Nonce Mismatch",
+			code:     code,
+			verifier: verifier,
+			nonce:    "other-nonce",
+			wantErr:  true,
+		},
+		{
+			name:     "Fail - Foreign Audience",
+			code:     code,
+			verifier: verifier,
+			nonce:    nonce,
+			claims:   func(c jwt.MapClaims) { c["aud"] = "another-client" },
+			wantErr:  true,
+		},
+		{
+			name:     "Fail - Expired ID Token",
+			code:     code,
+			verifier: verifier,
+			nonce:    nonce,
+			claims:   func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() },
+			wantErr:  true,
+		},
+	}
+
+	issuer := newMockIssuer(t)
+	provider := newProvider(t, issuer)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issuer.code = code
+			issuer.challenge = s256(verifier)
+			issuer.claims = issuer.idClaims(nonce)
+			if tt.claims != nil {
+				tt.claims(issuer.claims)
+			}
+
+			claims, err := provider.Exchange(
+				context.Background(), tt.code, tt.verifier, tt.nonce,
+			)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, claims)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "mock-subject", claims.Subject)
+				assert.Equal(t, "user@email.com", claims.Email)
+				assert.True(t, claims.EmailVerified)
+			}
+		})
+	}
+}
+
+func TestProvider_Exchange_EmailVerifiedAsString(t *testing.T) {
+	const verifier = "pkce-verifier-pkce-verifier-pkce-verifier-123"
+
+	issuer := newMockIssuer(t)
+	provider := newProvider(t, issuer)
+
+	issuer.code = "auth-code"
+	issuer.challenge = s256(verifier)
+	issuer.claims = issuer.idClaims("nonce")
+	issuer.claims["email_verified"] = "true"
+
+	claims, err := provider.Exchange(context.Background(), "auth-code", verifier, "nonce")
+	require.NoError(t, err)
+	assert.True(t, claims.EmailVerified)
+}
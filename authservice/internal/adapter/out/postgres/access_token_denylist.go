@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	pkgpostgres "github.com/maket12/ads-service/pkg/postgres"
+)
+
+type AccessTokenDenylist struct {
+	q *sqlc.Queries
+}
+
+func NewAccessTokenDenylist(pgClient *pkgpostgres.Client) *AccessTokenDenylist {
+	queries := sqlc.New(pgClient.DB)
+	return &AccessTokenDenylist{q: queries}
+}
+
+func (d *AccessTokenDenylist) Add(ctx context.Context, key string, expiresAt time.Time) error {
+	return d.q.AddToAccessTokenDenylist(ctx, sqlc.AddToAccessTokenDenylistParams{
+		DenylistKey: key,
+		ExpiresAt:   expiresAt,
+	})
+}
+
+func (d *AccessTokenDenylist) Contains(ctx context.Context, key string) (bool, error) {
+	return d.q.IsInAccessTokenDenylist(ctx, key)
+}
+
+func (d *AccessTokenDenylist) DeleteExpired(ctx context.Context, expiredBefore time.Time, limit int) (int64, error) {
+	return d.q.DeleteExpiredFromAccessTokenDenylist(ctx, sqlc.DeleteExpiredFromAccessTokenDenylistParams{
+		ExpiredBefore: expiredBefore,
+		BatchSize:     int32(limit),
+	})
+}
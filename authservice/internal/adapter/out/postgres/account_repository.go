@@ -9,6 +9,7 @@ import (
 	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/mapper"
 	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
 	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
 	pkgerrs "github.com/maket12/ads-service/pkg/errs"
 	pkgpostgres "github.com/maket12/ads-service/pkg/postgres"
 
@@ -27,7 +28,7 @@ func NewAccountsRepository(pgClient *pkgpostgres.Client) *AccountRepository {
 
 func (r *AccountRepository) Create(ctx context.Context, account *model.Account) error {
 	params := mapper.MapAccountToSQLCCreate(account)
-	err := r.q.CreateAccount(ctx, params)
+	err := queriesFor(ctx, r.q).CreateAccount(ctx, params)
 
 	if err != nil {
 		var pgErr *pgconn.PgError
@@ -45,7 +46,7 @@ func (r *AccountRepository) Create(ctx context.Context, account *model.Account)
 }
 
 func (r *AccountRepository) GetByEmail(ctx context.Context, email string) (*model.Account, error) {
-	rawAcc, err := r.q.GetAccountByEmail(ctx, email)
+	rawAcc, err := queriesFor(ctx, r.q).GetAccountByEmail(ctx, email)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, pkgerrs.NewObjectNotFoundError("account", email)
@@ -59,7 +60,7 @@ func (r *AccountRepository) GetByEmail(ctx context.Context, email string) (*mode
 }
 
 func (r *AccountRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Account, error) {
-	rawAcc, err := r.q.GetAccountByID(ctx, id)
+	rawAcc, err := queriesFor(ctx, r.q).GetAccountByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, pkgerrs.NewObjectNotFoundError("account", id)
@@ -72,6 +73,23 @@ func (r *AccountRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.A
 	return account, nil
 }
 
+func (r *AccountRepository) List(
+	ctx context.Context, filter port.AccountFilter, sort port.AccountSort,
+	after *port.AccountCursor, limit int,
+) ([]*port.AccountSummary, error) {
+	params := mapper.MapAccountFilterToSQLCList(filter, sort, after, limit)
+	rawAccounts, err := queriesFor(ctx, r.q).ListAccounts(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts = make([]*port.AccountSummary, 0, len(rawAccounts))
+	for _, rawAccount := range rawAccounts {
+		accounts = append(accounts, mapper.MapSQLCToAccountSummary(rawAccount))
+	}
+	return accounts, nil
+}
+
 func (r *AccountRepository) MarkLogin(ctx context.Context, account *model.Account) error {
 	var lastLoginTime time.Time
 	if account.LastLoginAt() != nil {
@@ -87,7 +105,7 @@ func (r *AccountRepository) MarkLogin(ctx context.Context, account *model.Accoun
 		UpdatedAt: account.UpdatedAt(),
 	}
 
-	if err := r.q.MarkAccountLogin(ctx, params); err != nil {
+	if err := queriesFor(ctx, r.q).MarkAccountLogin(ctx, params); err != nil {
 		return err
 	}
 
@@ -99,5 +117,23 @@ func (r *AccountRepository) VerifyEmail(ctx context.Context, account *model.Acco
 		ID:        account.ID(),
 		UpdatedAt: account.UpdatedAt(),
 	}
-	return r.q.VerifyAccountEmail(ctx, params)
+	return queriesFor(ctx, r.q).VerifyAccountEmail(ctx, params)
+}
+
+func (r *AccountRepository) UpdatePassword(ctx context.Context, account *model.Account) error {
+	params := sqlc.UpdateAccountPasswordParams{
+		ID:           account.ID(),
+		PasswordHash: account.PasswordHash(),
+		UpdatedAt:    account.UpdatedAt(),
+	}
+	return queriesFor(ctx, r.q).UpdateAccountPassword(ctx, params)
+}
+
+func (r *AccountRepository) UpdateStatus(ctx context.Context, account *model.Account) error {
+	params := sqlc.UpdateAccountStatusParams{
+		ID:        account.ID(),
+		Status:    sqlc.AccountStatus(account.Status()),
+		UpdatedAt: account.UpdatedAt(),
+	}
+	return queriesFor(ctx, r.q).UpdateAccountStatus(ctx, params)
 }
@@ -9,6 +9,7 @@ import (
 
 	adapterpostgres "github.com/maket12/ads-service/authservice/internal/adapter/out/postgres"
 	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
 	"github.com/maket12/ads-service/authservice/migrations"
 	pkgerrs "github.com/maket12/ads-service/pkg/errs"
 	pkgpostgres "github.com/maket12/ads-service/pkg/postgres"
@@ -36,7 +37,7 @@ func TestAccountsRepoSuite(t *testing.T) {
 }
 
 func (s *AccountsRepoSuite) setupDatabase() {
-	const targetVersion = 1
+	const targetVersion = 13
 
 	dbConfig := pkgpostgres.NewConfig(
 		"localhost", 5432,
@@ -197,3 +198,109 @@ func (s *AccountsRepoSuite) TestVerifyEmail() {
 	s.Require().NotEqual(s.testAccount.UpdatedAt(), acc.UpdatedAt(),
 		"expected new update time")
 }
+
+func (s *AccountsRepoSuite) TestUpdateStatus() {
+	// Create account at first
+	acc, _ := model.NewAccount("blocked@email.com", "hashed-secret-pass")
+	_ = s.repo.Create(s.ctx, acc)
+
+	// Block it
+	s.Require().NoError(acc.Block())
+	err := s.repo.UpdateStatus(s.ctx, acc)
+	s.Require().NoError(err)
+
+	// Check the stored status
+	stored, _ := s.repo.GetByID(s.ctx, acc.ID())
+	s.Require().Equal(model.AccountBlocked, stored.Status())
+}
+
+func (s *AccountsRepoSuite) createListed(email string, roles ...string) *model.Account {
+	acc, _ := model.NewAccount(email, "hashed-secret-pass")
+	s.Require().NoError(s.repo.Create(s.ctx, acc))
+	for _, role := range roles {
+		_, err := s.dbClient.DB.Exec(
+			"INSERT INTO account_roles (account_id, role) VALUES ($1, $2)", acc.ID(), role,
+		)
+		s.Require().NoError(err)
+	}
+	return acc
+}
+
+func (s *AccountsRepoSuite) TestList_FiltersAndSummary() {
+	alice := s.createListed("alice@email.com", "user", "support")
+	_ = s.createListed("bob@email.com", "user")
+	carol := s.createListed("Carol@email.com", "user")
+	s.Require().NoError(carol.Block())
+	s.Require().NoError(s.repo.UpdateStatus(s.ctx, carol))
+
+	// One active and one revoked session
+	_, err := s.dbClient.DB.Exec(`
+		INSERT INTO refresh_sessions (id, account_id, refresh_token_hash, expires_at, revoked_at)
+		VALUES (gen_random_uuid(), $1, 'h1', now() + interval '1 hour', NULL),
+		       (gen_random_uuid(), $1, 'h2', now() + interval '1 hour', now())`,
+		alice.ID(),
+	)
+	s.Require().NoError(err)
+
+	sort := port.AccountSort{Field: port.AccountSortEmail}
+
+	// Role
+	support := model.Role("support")
+	got, err := s.repo.List(s.ctx, port.AccountFilter{Role: &support}, sort, nil, 10)
+	s.Require().NoError(err)
+	s.Require().Len(got, 1)
+	s.Require().Equal(alice.ID(), got[0].Account.ID())
+	s.Require().ElementsMatch([]model.Role{"support", "user"}, got[0].Roles)
+	s.Require().Equal(1, got[0].ActiveSessions)
+
+	// Status
+	blocked := model.AccountBlocked
+	got, err = s.repo.List(s.ctx, port.AccountFilter{Status: &blocked}, sort, nil, 10)
+	s.Require().NoError(err)
+	s.Require().Len(got, 1)
+	s.Require().Equal(carol.ID(), got[0].Account.ID())
+
+	// Email prefix is case-insensitive
+	prefix := "CA"
+	got, err = s.repo.List(s.ctx, port.AccountFilter{EmailPrefix: &prefix}, sort, nil, 10)
+	s.Require().NoError(err)
+	s.Require().Len(got, 1)
+	s.Require().Equal(carol.ID(), got[0].Account.ID())
+
+	// Nobody has logged in yet
+	from := time.Now().Add(-time.Hour)
+	got, err = s.repo.List(s.ctx, port.AccountFilter{LastLoginFrom: &from}, sort, nil, 10)
+	s.Require().NoError(err)
+	s.Require().Empty(got)
+}
+
+func (s *AccountsRepoSuite) TestList_KeysetPagination() {
+	for _, email := range []string{"d@email.com", "a@email.com", "C@email.com", "b@email.com"} {
+		_ = s.createListed(email, "user")
+	}
+
+	for _, desc := range []bool{false, true} {
+		sort := port.AccountSort{Field: port.AccountSortEmail, Desc: desc}
+
+		var emails []string
+		var after *port.AccountCursor
+		for {
+			page, err := s.repo.List(s.ctx, port.AccountFilter{}, sort, after, 3)
+			s.Require().NoError(err)
+			for _, row := range page {
+				emails = append(emails, strings.ToLower(row.Account.Email()))
+			}
+			if len(page) < 3 {
+				break
+			}
+			last := page[len(page)-1].Account
+			after = &port.AccountCursor{Email: last.Email(), ID: last.ID()}
+		}
+
+		want := []string{"a@email.com", "b@email.com", "c@email.com", "d@email.com"}
+		if desc {
+			want = []string{"d@email.com", "c@email.com", "b@email.com", "a@email.com"}
+		}
+		s.Require().Equal(want, emails)
+	}
+}
@@ -2,8 +2,6 @@ package postgres
 
 import (
 	"context"
-	"database/sql"
-	"errors"
 
 	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/mapper"
 	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
@@ -24,37 +22,51 @@ func NewAccountRolesRepository(pgClient *pkgpostgres.Client) *AccountRoleReposit
 }
 
 func (r *AccountRoleRepository) Create(ctx context.Context, accountRole *model.AccountRole) error {
-	params := mapper.MapAccountRoleToSQLCCreate(accountRole)
-	return r.q.CreateAccountRole(ctx, params)
+	for _, role := range accountRole.Roles() {
+		params := mapper.MapAccountRoleToSQLCCreate(accountRole.AccountID(), role)
+		if err := queriesFor(ctx, r.q).CreateAccountRole(ctx, params); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (r *AccountRoleRepository) Get(ctx context.Context, accountID uuid.UUID) (*model.AccountRole, error) {
-	rawAccRole, err := r.q.GetAccountRole(ctx, accountID)
+	rows, err := queriesFor(ctx, r.q).GetAccountRolePermissions(ctx, accountID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, pkgerrs.NewObjectNotFoundError("account_role", accountID)
-		}
 		return nil, err
 	}
+	if len(rows) == 0 {
+		return nil, pkgerrs.NewObjectNotFoundError("account_role", accountID)
+	}
 
-	accountRole := mapper.MapSQLCToAccountRole(rawAccRole)
+	accountRole := mapper.MapSQLCToAccountRole(accountID, rows)
 
 	return accountRole, nil
 }
 
-func (r *AccountRoleRepository) Update(ctx context.Context, accountRole *model.AccountRole) error {
-	var params = sqlc.UpdateAccountRoleParams{
-		AccountID: accountRole.AccountID(),
-		Role:      sqlc.RoleType(accountRole.Role()),
+func (r *AccountRoleRepository) AddRole(ctx context.Context, accountID uuid.UUID, role model.Role) error {
+	params := mapper.MapAccountRoleToSQLCCreate(accountID, role)
+	return queriesFor(ctx, r.q).CreateAccountRole(ctx, params)
+}
+
+func (r *AccountRoleRepository) RemoveRole(ctx context.Context, accountID uuid.UUID, role model.Role) error {
+	var params = sqlc.DeleteAccountRoleParams{
+		AccountID: accountID,
+		Role:      role.String(),
 	}
 
-	if err := r.q.UpdateAccountRole(ctx, params); err != nil {
+	rows, err := queriesFor(ctx, r.q).DeleteAccountRole(ctx, params)
+	if err != nil {
 		return err
 	}
+	if rows == 0 {
+		return pkgerrs.NewObjectNotFoundError("account_role", accountID)
+	}
 
 	return nil
 }
 
 func (r *AccountRoleRepository) Delete(ctx context.Context, accountID uuid.UUID) error {
-	return r.q.DeleteAccountRole(ctx, accountID)
+	return queriesFor(ctx, r.q).DeleteAccountRoles(ctx, accountID)
 }
@@ -121,7 +121,11 @@ func (s *AccountRolesRepoSuite) TestCreateGet() {
 	// Get by account id
 	role, err := s.repo.Get(s.ctx, s.testRole.AccountID())
 	s.Require().NoError(err)
-	s.Require().Equal(s.testRole.Role(), role.Role())
+	s.Require().Equal(s.testRole.Roles(), role.Roles())
+
+	// Permissions come from the seeded role_permissions
+	s.Require().True(role.HasPermission(model.PermissionAdsWrite))
+	s.Require().False(role.HasPermission(model.PermissionUsersBlock))
 }
 
 func (s *AccountRolesRepoSuite) TestCreate_NonExistingAccount() {
@@ -138,20 +142,40 @@ func (s *AccountRolesRepoSuite) TestGet_NotFound() {
 	s.Require().ErrorIs(err, pkgerrs.ErrObjectNotFound)
 }
 
-func (s *AccountRolesRepoSuite) TestUpdate() {
+func (s *AccountRolesRepoSuite) TestAddRole() {
 	// Create at first
 	_ = s.repo.Create(s.ctx, s.testRole)
 
-	// Copy value and assigned to not change test data
-	assignedRole := *s.testRole
-	_ = assignedRole.Assign("admin")
+	err := s.repo.AddRole(s.ctx, s.testRole.AccountID(), model.RoleModerator)
+	s.Require().NoError(err)
 
-	err := s.repo.Update(s.ctx, &assignedRole)
+	// Adding the same role twice is a no-op
+	err = s.repo.AddRole(s.ctx, s.testRole.AccountID(), model.RoleModerator)
 	s.Require().NoError(err)
 
-	// Ensure update was correct
+	// Ensure both roles and their permissions are there
 	acc, _ := s.repo.Get(s.ctx, s.testRole.AccountID())
-	s.Require().Equal(model.RoleAdmin, acc.Role())
+	s.Require().ElementsMatch(
+		[]model.Role{model.RoleUser, model.RoleModerator}, acc.Roles(),
+	)
+	s.Require().True(acc.HasPermission(model.PermissionAdsModerate))
+	s.Require().True(acc.HasPermission(model.PermissionAdsWrite))
+}
+
+func (s *AccountRolesRepoSuite) TestRemoveRole() {
+	// Create at first
+	_ = s.repo.Create(s.ctx, s.testRole)
+	_ = s.repo.AddRole(s.ctx, s.testRole.AccountID(), model.RoleAdmin)
+
+	err := s.repo.RemoveRole(s.ctx, s.testRole.AccountID(), model.RoleAdmin)
+	s.Require().NoError(err)
+
+	acc, _ := s.repo.Get(s.ctx, s.testRole.AccountID())
+	s.Require().Equal([]model.Role{model.RoleUser}, acc.Roles())
+
+	// The last role stays
+	err = s.repo.RemoveRole(s.ctx, s.testRole.AccountID(), model.RoleUser)
+	s.Require().ErrorIs(err, pkgerrs.ErrObjectNotFound)
 }
 
 func (s *AccountRolesRepoSuite) TestDelete() {
@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	pkgpostgres "github.com/maket12/ads-service/pkg/postgres"
+)
+
+// AdvisoryLocker uses session-level advisory locks, so the lock lives
+// on a dedicated connection which is held until unlock is called
+type AdvisoryLocker struct {
+	pgClient *pkgpostgres.Client
+}
+
+func NewAdvisoryLocker(pgClient *pkgpostgres.Client) *AdvisoryLocker {
+	return &AdvisoryLocker{pgClient: pgClient}
+}
+
+func (l *AdvisoryLocker) TryLock(ctx context.Context, name string) (func(ctx context.Context) error, bool, error) {
+	conn, err := l.pgClient.DB.Conn(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get connection: %w", err)
+	}
+
+	q := sqlc.New(conn)
+	acquired, err := q.TryAdvisoryLock(ctx, name)
+	if err != nil || !acquired {
+		_ = conn.Close()
+		return nil, false, err
+	}
+
+	unlock := func(ctx context.Context) error {
+		defer func() { _ = conn.Close() }()
+		if _, err := q.AdvisoryUnlock(ctx, name); err != nil {
+			return fmt.Errorf("failed to release lock %s: %w", name, err)
+		}
+		return nil
+	}
+
+	return unlock, true, nil
+}
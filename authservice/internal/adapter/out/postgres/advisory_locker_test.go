@@ -0,0 +1,67 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	adapterpostgres "github.com/maket12/ads-service/authservice/internal/adapter/out/postgres"
+	pkgpostgres "github.com/maket12/ads-service/pkg/postgres"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AdvisoryLockerSuite struct {
+	suite.Suite
+	dbClient *pkgpostgres.Client
+	locker   *adapterpostgres.AdvisoryLocker
+	ctx      context.Context
+}
+
+func TestAdvisoryLockerSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	suite.Run(t, new(AdvisoryLockerSuite))
+}
+
+func (s *AdvisoryLockerSuite) SetupSuite() {
+	dbConfig := pkgpostgres.NewConfig(
+		"localhost", 5432,
+		"test", "test", "testdb",
+		"disable", 25, 25, time.Minute*5,
+	)
+
+	dbClient, err := pkgpostgres.NewClient(dbConfig)
+	s.Require().NoError(err)
+	s.dbClient = dbClient
+
+	s.locker = adapterpostgres.NewAdvisoryLocker(s.dbClient)
+	s.ctx = context.Background()
+}
+
+func (s *AdvisoryLockerSuite) TearDownSuite() {
+	err := s.dbClient.Close()
+	s.Require().NoError(err, "failed to close db connection")
+}
+
+func (s *AdvisoryLockerSuite) TestTryLock() {
+	const name = "test-lock"
+
+	unlock, acquired, err := s.locker.TryLock(s.ctx, name)
+	s.Require().NoError(err)
+	s.Require().True(acquired)
+
+	// Another holder can not take the same lock
+	_, acquired, err = s.locker.TryLock(s.ctx, name)
+	s.Require().NoError(err)
+	s.Require().False(acquired)
+
+	// After the release the lock is free again
+	s.Require().NoError(unlock(s.ctx))
+
+	unlock, acquired, err = s.locker.TryLock(s.ctx, name)
+	s.Require().NoError(err)
+	s.Require().True(acquired)
+	s.Require().NoError(unlock(s.ctx))
+}
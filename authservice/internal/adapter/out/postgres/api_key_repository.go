@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/mapper"
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+	pkgpostgres "github.com/maket12/ads-service/pkg/postgres"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type APIKeyRepository struct {
+	q *sqlc.Queries
+}
+
+func NewAPIKeysRepository(pgClient *pkgpostgres.Client) *APIKeyRepository {
+	queries := sqlc.New(pgClient.DB)
+	return &APIKeyRepository{q: queries}
+}
+
+func (r *APIKeyRepository) Create(ctx context.Context, key *model.APIKey) error {
+	params := mapper.MapAPIKeyToSQLCCreate(key)
+	err := r.q.CreateAPIKey(ctx, params)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" {
+				return pkgerrs.NewObjectAlreadyExistsErrorWithReason(
+					"api_key", pgErr,
+				)
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *APIKeyRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.APIKey, error) {
+	rawKey, err := r.q.GetAPIKeyByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, pkgerrs.NewObjectNotFoundError("api_key", id)
+		}
+		return nil, err
+	}
+	return mapper.MapSQLCToAPIKey(rawKey), nil
+}
+
+func (r *APIKeyRepository) GetByPrefix(ctx context.Context, prefix string) (*model.APIKey, error) {
+	rawKey, err := r.q.GetAPIKeyByPrefix(ctx, prefix)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, pkgerrs.NewObjectNotFoundError("api_key", prefix)
+		}
+		return nil, err
+	}
+	return mapper.MapSQLCToAPIKey(rawKey), nil
+}
+
+func (r *APIKeyRepository) ListByAccount(ctx context.Context, accountID uuid.UUID) ([]*model.APIKey, error) {
+	rawKeys, err := r.q.ListAPIKeysByAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*model.APIKey, 0, len(rawKeys))
+	for _, rawKey := range rawKeys {
+		keys = append(keys, mapper.MapSQLCToAPIKey(rawKey))
+	}
+	return keys, nil
+}
+
+func (r *APIKeyRepository) Revoke(ctx context.Context, key *model.APIKey) error {
+	var revokedAt sql.NullTime
+	if key.RevokedAt() != nil {
+		revokedAt = sql.NullTime{
+			Time:  *key.RevokedAt(),
+			Valid: true,
+		}
+	}
+
+	var params = sqlc.RevokeAPIKeyParams{
+		ID:        key.ID(),
+		RevokedAt: revokedAt,
+	}
+	return r.q.RevokeAPIKey(ctx, params)
+}
+
+func (r *APIKeyRepository) MarkUsed(ctx context.Context, key *model.APIKey) error {
+	var lastUsedAt sql.NullTime
+	if key.LastUsedAt() != nil {
+		lastUsedAt = sql.NullTime{
+			Time:  *key.LastUsedAt(),
+			Valid: true,
+		}
+	}
+
+	var params = sqlc.MarkAPIKeyUsedParams{
+		ID:         key.ID(),
+		LastUsedAt: lastUsedAt,
+	}
+	return r.q.MarkAPIKeyUsed(ctx, params)
+}
@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/mapper"
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgpostgres "github.com/maket12/ads-service/pkg/postgres"
+)
+
+type AuthEventRepository struct {
+	q *sqlc.Queries
+}
+
+func NewAuthEventsRepository(pgClient *pkgpostgres.Client) *AuthEventRepository {
+	queries := sqlc.New(pgClient.DB)
+	return &AuthEventRepository{q: queries}
+}
+
+func (r *AuthEventRepository) Create(ctx context.Context, event *model.AuthEvent) error {
+	params := mapper.MapAuthEventToSQLCCreate(event)
+	return queriesFor(ctx, r.q).CreateAuthEvent(ctx, params)
+}
+
+func (r *AuthEventRepository) List(
+	ctx context.Context, filter port.AuthEventFilter,
+	after *port.AuthEventCursor, limit int,
+) ([]*model.AuthEvent, error) {
+	params := mapper.MapAuthEventFilterToSQLCList(filter, after, limit)
+	rawEvents, err := queriesFor(ctx, r.q).ListAuthEvents(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var events = make([]*model.AuthEvent, 0, len(rawEvents))
+	for _, rawEvent := range rawEvents {
+		event, err := mapper.MapSQLCToAuthEvent(rawEvent)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/mapper"
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+	pkgpostgres "github.com/maket12/ads-service/pkg/postgres"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type EmailChangeTokenRepository struct {
+	q *sqlc.Queries
+}
+
+func NewEmailChangeTokensRepository(pgClient *pkgpostgres.Client) *EmailChangeTokenRepository {
+	queries := sqlc.New(pgClient.DB)
+	return &EmailChangeTokenRepository{q: queries}
+}
+
+func (r *EmailChangeTokenRepository) Create(ctx context.Context, token *model.EmailChangeToken) error {
+	params := mapper.MapEmailChangeTokenToSQLCCreate(token)
+	return r.q.CreateEmailChangeToken(ctx, params)
+}
+
+func (r *EmailChangeTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*model.EmailChangeToken, error) {
+	rawToken, err := r.q.GetEmailChangeTokenByHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, pkgerrs.NewObjectNotFoundError("email_change_token", tokenHash)
+		}
+		return nil, err
+	}
+	return mapper.MapSQLCToEmailChangeToken(rawToken), nil
+}
+
+func (r *EmailChangeTokenRepository) Confirm(ctx context.Context, token *model.EmailChangeToken) error {
+	if token.UsedAt() == nil {
+		return pkgerrs.NewValueRequiredError("used_at")
+	}
+
+	params := sqlc.ConfirmEmailChangeParams{
+		ID:     token.ID(),
+		UsedAt: *token.UsedAt(),
+	}
+
+	// Token and email are changed by a single statement
+	affected, err := r.q.ConfirmEmailChange(ctx, params)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return pkgerrs.NewObjectAlreadyExistsErrorWithReason(
+				"account", pgErr,
+			)
+		}
+		return err
+	}
+
+	// Somebody has already consumed this token
+	if affected == 0 {
+		return model.ErrTokenAlreadyUsed
+	}
+
+	return nil
+}
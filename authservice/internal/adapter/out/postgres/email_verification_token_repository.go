@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/mapper"
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+	pkgpostgres "github.com/maket12/ads-service/pkg/postgres"
+
+	"github.com/google/uuid"
+)
+
+type EmailVerificationTokenRepository struct {
+	q *sqlc.Queries
+}
+
+func NewEmailVerificationTokensRepository(pgClient *pkgpostgres.Client) *EmailVerificationTokenRepository {
+	queries := sqlc.New(pgClient.DB)
+	return &EmailVerificationTokenRepository{q: queries}
+}
+
+func (r *EmailVerificationTokenRepository) Create(ctx context.Context, token *model.EmailVerificationToken) error {
+	params := mapper.MapEmailVerificationTokenToSQLCCreate(token)
+	return queriesFor(ctx, r.q).CreateEmailVerificationToken(ctx, params)
+}
+
+func (r *EmailVerificationTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*model.EmailVerificationToken, error) {
+	rawToken, err := queriesFor(ctx, r.q).GetEmailVerificationTokenByHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, pkgerrs.NewObjectNotFoundError("email_verification_token", tokenHash)
+		}
+		return nil, err
+	}
+	return mapper.MapSQLCToEmailVerificationToken(rawToken), nil
+}
+
+func (r *EmailVerificationTokenRepository) MarkUsed(ctx context.Context, token *model.EmailVerificationToken) error {
+	var usedAt sql.NullTime
+	if token.UsedAt() != nil {
+		usedAt = sql.NullTime{Time: *token.UsedAt(), Valid: true}
+	}
+
+	params := sqlc.MarkEmailVerificationTokenUsedParams{
+		ID:     token.ID(),
+		UsedAt: usedAt,
+	}
+
+	affected, err := queriesFor(ctx, r.q).MarkEmailVerificationTokenUsed(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	// Somebody has already consumed this token
+	if affected == 0 {
+		return model.ErrTokenAlreadyUsed
+	}
+
+	return nil
+}
+
+// DeleteUnused removes the links of the account that were not followed yet
+func (r *EmailVerificationTokenRepository) DeleteUnused(ctx context.Context, accountID uuid.UUID) error {
+	return queriesFor(ctx, r.q).DeleteUnusedEmailVerificationTokens(ctx, accountID)
+}
@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/mapper"
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+	pkgpostgres "github.com/maket12/ads-service/pkg/postgres"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type ExternalIdentityRepository struct {
+	q *sqlc.Queries
+}
+
+func NewExternalIdentitiesRepository(pgClient *pkgpostgres.Client) *ExternalIdentityRepository {
+	queries := sqlc.New(pgClient.DB)
+	return &ExternalIdentityRepository{q: queries}
+}
+
+func (r *ExternalIdentityRepository) Create(ctx context.Context, identity *model.ExternalIdentity) error {
+	params := mapper.MapExternalIdentityToSQLCCreate(identity)
+	err := r.q.CreateExternalIdentity(ctx, params)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" {
+				return pkgerrs.NewObjectAlreadyExistsErrorWithReason(
+					"external_identity", pgErr,
+				)
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *ExternalIdentityRepository) GetByProviderSubject(
+	ctx context.Context, provider, subject string,
+) (*model.ExternalIdentity, error) {
+	rawIdentity, err := r.q.GetExternalIdentityByProviderSubject(
+		ctx, sqlc.GetExternalIdentityByProviderSubjectParams{
+			Provider: provider,
+			Subject:  subject,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, pkgerrs.NewObjectNotFoundError("external_identity", subject)
+		}
+		return nil, err
+	}
+	return mapper.MapSQLCToExternalIdentity(rawIdentity), nil
+}
@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	pkgpostgres "github.com/maket12/ads-service/pkg/postgres"
+)
+
+type LoginAttemptRepository struct {
+	q *sqlc.Queries
+}
+
+func NewLoginAttemptsRepository(pgClient *pkgpostgres.Client) *LoginAttemptRepository {
+	queries := sqlc.New(pgClient.DB)
+	return &LoginAttemptRepository{q: queries}
+}
+
+func (r *LoginAttemptRepository) RecordFailure(ctx context.Context, key string, at time.Time, window time.Duration) error {
+	// Keep the table small, only failures within the window matter
+	if err := r.q.DeleteExpiredLoginFailures(ctx, sqlc.DeleteExpiredLoginFailuresParams{
+		ThrottleKey:   key,
+		ExpiredBefore: at.Add(-window),
+	}); err != nil {
+		return err
+	}
+
+	return r.q.CreateLoginFailure(ctx, sqlc.CreateLoginFailureParams{
+		ThrottleKey: key,
+		FailedAt:    at,
+	})
+}
+
+func (r *LoginAttemptRepository) GetFailures(ctx context.Context, key string, since time.Time) (int, time.Time, error) {
+	row, err := r.q.GetLoginFailures(ctx, sqlc.GetLoginFailuresParams{
+		ThrottleKey: key,
+		Since:       since,
+	})
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	if row.Failures == 0 {
+		return 0, time.Time{}, nil
+	}
+	return int(row.Failures), row.LastFailedAt, nil
+}
+
+func (r *LoginAttemptRepository) Clear(ctx context.Context, key string) error {
+	return r.q.DeleteLoginFailures(ctx, key)
+}
+
+func (r *LoginAttemptRepository) DeleteExpired(ctx context.Context, expiredBefore time.Time, limit int) (int64, error) {
+	return r.q.DeleteStaleLoginFailures(ctx, sqlc.DeleteStaleLoginFailuresParams{
+		ExpiredBefore: expiredBefore,
+		BatchSize:     int32(limit),
+	})
+}
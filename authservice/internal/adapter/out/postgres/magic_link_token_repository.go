@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/mapper"
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+	pkgpostgres "github.com/maket12/ads-service/pkg/postgres"
+)
+
+type MagicLinkTokenRepository struct {
+	q *sqlc.Queries
+}
+
+func NewMagicLinkTokensRepository(pgClient *pkgpostgres.Client) *MagicLinkTokenRepository {
+	queries := sqlc.New(pgClient.DB)
+	return &MagicLinkTokenRepository{q: queries}
+}
+
+func (r *MagicLinkTokenRepository) Create(ctx context.Context, token *model.MagicLinkToken) error {
+	params := mapper.MapMagicLinkTokenToSQLCCreate(token)
+	return r.q.CreateMagicLinkToken(ctx, params)
+}
+
+func (r *MagicLinkTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*model.MagicLinkToken, error) {
+	rawToken, err := r.q.GetMagicLinkTokenByHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, pkgerrs.NewObjectNotFoundError("magic_link_token", tokenHash)
+		}
+		return nil, err
+	}
+	return mapper.MapSQLCToMagicLinkToken(rawToken), nil
+}
+
+func (r *MagicLinkTokenRepository) MarkUsed(ctx context.Context, token *model.MagicLinkToken) error {
+	var usedAt sql.NullTime
+	if token.UsedAt() != nil {
+		usedAt = sql.NullTime{Time: *token.UsedAt(), Valid: true}
+	}
+
+	params := sqlc.MarkMagicLinkTokenUsedParams{
+		ID:     token.ID(),
+		UsedAt: usedAt,
+	}
+
+	affected, err := r.q.MarkMagicLinkTokenUsed(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	// Somebody has already consumed this token
+	if affected == 0 {
+		return model.ErrTokenAlreadyUsed
+	}
+
+	return nil
+}
@@ -2,10 +2,14 @@ package mapper
 
 import (
 	"database/sql"
+	"strings"
 	"time"
 
 	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
 	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+
+	"github.com/google/uuid"
 )
 
 func MapAccountToSQLCCreate(account *model.Account) sqlc.CreateAccountParams {
@@ -49,3 +53,74 @@ func MapSQLCToAccount(rawAccount sqlc.Account) *model.Account {
 
 	return account
 }
+
+func MapAccountFilterToSQLCList(
+	filter port.AccountFilter, sort port.AccountSort,
+	after *port.AccountCursor, limit int,
+) sqlc.ListAccountsParams {
+	var params = sqlc.ListAccountsParams{
+		SortField: sort.Field.String(),
+		SortDesc:  sort.Desc,
+		PageSize:  int32(limit),
+	}
+	if filter.Status != nil {
+		params.Status = sql.NullString{String: filter.Status.String(), Valid: true}
+	}
+	if filter.Role != nil {
+		params.Role = sql.NullString{String: filter.Role.String(), Valid: true}
+	}
+	if filter.EmailVerified != nil {
+		params.EmailVerified = sql.NullBool{Bool: *filter.EmailVerified, Valid: true}
+	}
+	if filter.EmailPrefix != nil {
+		params.EmailPrefix = sql.NullString{String: *filter.EmailPrefix, Valid: true}
+	}
+	if filter.CreatedFrom != nil {
+		params.CreatedFrom = sql.NullTime{Time: *filter.CreatedFrom, Valid: true}
+	}
+	if filter.CreatedTo != nil {
+		params.CreatedTo = sql.NullTime{Time: *filter.CreatedTo, Valid: true}
+	}
+	if filter.LastLoginFrom != nil {
+		params.LastLoginFrom = sql.NullTime{Time: *filter.LastLoginFrom, Valid: true}
+	}
+	if filter.LastLoginTo != nil {
+		params.LastLoginTo = sql.NullTime{Time: *filter.LastLoginTo, Valid: true}
+	}
+	if after != nil {
+		// The query sorts accounts that never logged in at the epoch
+		lastLogin := after.LastLoginAt
+		if lastLogin.IsZero() {
+			lastLogin = time.Unix(0, 0)
+		}
+		params.AfterID = uuid.NullUUID{UUID: after.ID, Valid: true}
+		params.AfterCreatedAt = sql.NullTime{Time: after.CreatedAt, Valid: true}
+		params.AfterLastLoginAt = sql.NullTime{Time: lastLogin, Valid: true}
+		params.AfterEmail = sql.NullString{String: strings.ToLower(after.Email), Valid: true}
+	}
+	return params
+}
+
+func MapSQLCToAccountSummary(rawAccount sqlc.ListAccountsRow) *port.AccountSummary {
+	account := MapSQLCToAccount(sqlc.Account{
+		ID:            rawAccount.ID,
+		Email:         rawAccount.Email,
+		PasswordHash:  rawAccount.PasswordHash,
+		Status:        rawAccount.Status,
+		EmailVerified: rawAccount.EmailVerified,
+		CreatedAt:     rawAccount.CreatedAt,
+		UpdatedAt:     rawAccount.UpdatedAt,
+		LastLoginAt:   rawAccount.LastLoginAt,
+	})
+
+	var roles []model.Role
+	for _, role := range strings.Fields(rawAccount.Roles) {
+		roles = append(roles, model.Role(role))
+	}
+
+	return &port.AccountSummary{
+		Account:        account,
+		Roles:          roles,
+		ActiveSessions: int(rawAccount.ActiveSessions),
+	}
+}
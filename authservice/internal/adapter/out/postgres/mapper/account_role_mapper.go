@@ -3,20 +3,39 @@ package mapper
 import (
 	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
 	"github.com/maket12/ads-service/authservice/internal/domain/model"
+
+	"github.com/google/uuid"
 )
 
-func MapAccountRoleToSQLCCreate(accountRole *model.AccountRole) sqlc.CreateAccountRoleParams {
+func MapAccountRoleToSQLCCreate(accountID uuid.UUID, role model.Role) sqlc.CreateAccountRoleParams {
 	return sqlc.CreateAccountRoleParams{
-		AccountID: accountRole.AccountID(),
-		Role:      sqlc.RoleType(accountRole.Role()),
+		AccountID: accountID,
+		Role:      role.String(),
 	}
 }
 
-func MapSQLCToAccountRole(rawAccountRole sqlc.AccountRole) *model.AccountRole {
-	role := model.Role(rawAccountRole.Role)
-	accountRole := model.RestoreAccountRole(
-		rawAccountRole.AccountID,
-		role,
+// MapSQLCToAccountRole folds role-permission pairs, a role without
+// permissions comes with a null permission
+func MapSQLCToAccountRole(accountID uuid.UUID, rows []sqlc.GetAccountRolePermissionsRow) *model.AccountRole {
+	var (
+		roles       []model.Role
+		permissions []model.Permission
+		seen        = make(map[string]struct{})
 	)
-	return accountRole
+	for _, row := range rows {
+		role := model.Role(row.Role)
+		if len(roles) == 0 || roles[len(roles)-1] != role {
+			roles = append(roles, role)
+		}
+		if !row.Permission.Valid {
+			continue
+		}
+		if _, ok := seen[row.Permission.String]; ok {
+			continue
+		}
+		seen[row.Permission.String] = struct{}{}
+		permissions = append(permissions, model.Permission(row.Permission.String))
+	}
+
+	return model.RestoreAccountRole(accountID, roles, permissions)
 }
@@ -0,0 +1,61 @@
+package mapper
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+)
+
+func MapAPIKeyToSQLCCreate(key *model.APIKey) sqlc.CreateAPIKeyParams {
+	var expiresAt sql.NullTime
+	if key.ExpiresAt() != nil {
+		expiresAt = sql.NullTime{Time: *key.ExpiresAt(), Valid: true}
+	}
+
+	scopes := make([]string, 0, len(key.Scopes()))
+	for _, scope := range key.Scopes() {
+		scopes = append(scopes, scope.String())
+	}
+
+	return sqlc.CreateAPIKeyParams{
+		ID:         key.ID(),
+		AccountID:  key.AccountID(),
+		Name:       key.Name(),
+		Prefix:     key.Prefix(),
+		SecretHash: key.SecretHash(),
+		Scopes:     strings.Join(scopes, " "),
+		CreatedAt:  key.CreatedAt(),
+		ExpiresAt:  expiresAt,
+	}
+}
+
+func MapSQLCToAPIKey(rawKey sqlc.ApiKey) *model.APIKey {
+	var scopes []model.Permission
+	for _, scope := range strings.Fields(rawKey.Scopes) {
+		scopes = append(scopes, model.Permission(scope))
+	}
+
+	return model.RestoreAPIKey(
+		rawKey.ID,
+		rawKey.AccountID,
+		rawKey.Name,
+		rawKey.Prefix,
+		rawKey.SecretHash,
+		scopes,
+		rawKey.CreatedAt,
+		nullTimePtr(rawKey.ExpiresAt),
+		nullTimePtr(rawKey.LastUsedAt),
+		nullTimePtr(rawKey.RevokedAt),
+	)
+}
+
+func nullTimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := t.Time
+	return &v
+}
@@ -0,0 +1,122 @@
+package mapper
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+func MapAuthEventToSQLCCreate(event *model.AuthEvent) sqlc.CreateAuthEventParams {
+	var (
+		sessionID uuid.NullUUID
+		ip        sql.NullString
+		userAgent sql.NullString
+		actorID   uuid.NullUUID
+		reason    sql.NullString
+	)
+	if event.SessionID() != nil {
+		sessionID = uuid.NullUUID{UUID: *event.SessionID(), Valid: true}
+	}
+	if event.IP() != nil {
+		ip = sql.NullString{String: *event.IP(), Valid: true}
+	}
+	if event.UserAgent() != nil {
+		userAgent = sql.NullString{String: *event.UserAgent(), Valid: true}
+	}
+	if event.ActorID() != nil {
+		actorID = uuid.NullUUID{UUID: *event.ActorID(), Valid: true}
+	}
+	if event.Reason() != nil {
+		reason = sql.NullString{String: *event.Reason(), Valid: true}
+	}
+	// A map of strings always marshals
+	metadata, _ := json.Marshal(event.Metadata())
+
+	return sqlc.CreateAuthEventParams{
+		ID:        event.ID(),
+		AccountID: event.AccountID(),
+		EventType: event.EventType().String(),
+		SessionID: sessionID,
+		Ip:        ip,
+		UserAgent: userAgent,
+		ActorID:   actorID,
+		Reason:    reason,
+		Outcome:   event.Outcome().String(),
+		Metadata:  metadata,
+		CreatedAt: event.CreatedAt(),
+	}
+}
+
+func MapAuthEventFilterToSQLCList(
+	filter port.AuthEventFilter, after *port.AuthEventCursor, limit int,
+) sqlc.ListAuthEventsParams {
+	var params = sqlc.ListAuthEventsParams{PageSize: int32(limit)}
+	if filter.AccountID != nil {
+		params.AccountID = uuid.NullUUID{UUID: *filter.AccountID, Valid: true}
+	}
+	if filter.EventType != nil {
+		params.EventType = sql.NullString{String: filter.EventType.String(), Valid: true}
+	}
+	if filter.Outcome != nil {
+		params.Outcome = sql.NullString{String: filter.Outcome.String(), Valid: true}
+	}
+	if filter.From != nil {
+		params.CreatedFrom = sql.NullTime{Time: *filter.From, Valid: true}
+	}
+	if filter.To != nil {
+		params.CreatedTo = sql.NullTime{Time: *filter.To, Valid: true}
+	}
+	if after != nil {
+		params.AfterCreatedAt = sql.NullTime{Time: after.CreatedAt, Valid: true}
+		params.AfterID = uuid.NullUUID{UUID: after.ID, Valid: true}
+	}
+	return params
+}
+
+func MapSQLCToAuthEvent(rawEvent sqlc.ListAuthEventsRow) (*model.AuthEvent, error) {
+	var (
+		sessionID *uuid.UUID
+		ip        *string
+		userAgent *string
+		actorID   *uuid.UUID
+		reason    *string
+		metadata  map[string]string
+	)
+	if rawEvent.SessionID.Valid {
+		sessionID = &rawEvent.SessionID.UUID
+	}
+	if rawEvent.Ip.Valid {
+		ip = &rawEvent.Ip.String
+	}
+	if rawEvent.UserAgent.Valid {
+		userAgent = &rawEvent.UserAgent.String
+	}
+	if rawEvent.ActorID.Valid {
+		actorID = &rawEvent.ActorID.UUID
+	}
+	if rawEvent.Reason.Valid {
+		reason = &rawEvent.Reason.String
+	}
+	if err := json.Unmarshal(rawEvent.Metadata, &metadata); err != nil {
+		return nil, err
+	}
+
+	return model.RestoreAuthEvent(
+		rawEvent.ID,
+		rawEvent.AccountID,
+		model.AuthEventType(rawEvent.EventType),
+		sessionID,
+		ip,
+		userAgent,
+		actorID,
+		reason,
+		model.AuthEventOutcome(rawEvent.Outcome),
+		metadata,
+		rawEvent.CreatedAt,
+	), nil
+}
@@ -0,0 +1,44 @@
+package mapper
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+)
+
+func MapEmailChangeTokenToSQLCCreate(token *model.EmailChangeToken) sqlc.CreateEmailChangeTokenParams {
+	var usedAt sql.NullTime
+	if token.UsedAt() != nil {
+		usedAt = sql.NullTime{Time: *token.UsedAt(), Valid: true}
+	}
+
+	return sqlc.CreateEmailChangeTokenParams{
+		ID:        token.ID(),
+		AccountID: token.AccountID(),
+		NewEmail:  token.NewEmail(),
+		TokenHash: token.TokenHash(),
+		CreatedAt: token.CreatedAt(),
+		ExpiresAt: token.ExpiresAt(),
+		UsedAt:    usedAt,
+	}
+}
+
+func MapSQLCToEmailChangeToken(rawToken sqlc.EmailChangeToken) *model.EmailChangeToken {
+	var usedAt *time.Time
+	if rawToken.UsedAt.Valid {
+		t := rawToken.UsedAt.Time
+		usedAt = &t
+	}
+
+	return model.RestoreEmailChangeToken(
+		rawToken.ID,
+		rawToken.AccountID,
+		rawToken.NewEmail,
+		rawToken.TokenHash,
+		rawToken.CreatedAt,
+		rawToken.ExpiresAt,
+		usedAt,
+	)
+}
@@ -0,0 +1,28 @@
+package mapper
+
+import (
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+)
+
+func MapExternalIdentityToSQLCCreate(identity *model.ExternalIdentity) sqlc.CreateExternalIdentityParams {
+	return sqlc.CreateExternalIdentityParams{
+		ID:        identity.ID(),
+		AccountID: identity.AccountID(),
+		Provider:  identity.Provider(),
+		Subject:   identity.Subject(),
+		Email:     identity.Email(),
+		CreatedAt: identity.CreatedAt(),
+	}
+}
+
+func MapSQLCToExternalIdentity(rawIdentity sqlc.ExternalIdentity) *model.ExternalIdentity {
+	return model.RestoreExternalIdentity(
+		rawIdentity.ID,
+		rawIdentity.AccountID,
+		rawIdentity.Provider,
+		rawIdentity.Subject,
+		rawIdentity.Email,
+		rawIdentity.CreatedAt,
+	)
+}
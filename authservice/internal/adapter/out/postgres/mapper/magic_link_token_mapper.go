@@ -0,0 +1,42 @@
+package mapper
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+)
+
+func MapMagicLinkTokenToSQLCCreate(token *model.MagicLinkToken) sqlc.CreateMagicLinkTokenParams {
+	var usedAt sql.NullTime
+	if token.UsedAt() != nil {
+		usedAt = sql.NullTime{Time: *token.UsedAt(), Valid: true}
+	}
+
+	return sqlc.CreateMagicLinkTokenParams{
+		ID:        token.ID(),
+		AccountID: token.AccountID(),
+		TokenHash: token.TokenHash(),
+		CreatedAt: token.CreatedAt(),
+		ExpiresAt: token.ExpiresAt(),
+		UsedAt:    usedAt,
+	}
+}
+
+func MapSQLCToMagicLinkToken(rawToken sqlc.MagicLinkToken) *model.MagicLinkToken {
+	var usedAt *time.Time
+	if rawToken.UsedAt.Valid {
+		t := rawToken.UsedAt.Time
+		usedAt = &t
+	}
+
+	return model.RestoreMagicLinkToken(
+		rawToken.ID,
+		rawToken.AccountID,
+		rawToken.TokenHash,
+		rawToken.CreatedAt,
+		rawToken.ExpiresAt,
+		usedAt,
+	)
+}
@@ -0,0 +1,28 @@
+package mapper
+
+import (
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+)
+
+func MapOIDCLoginStateToSQLCCreate(state *model.OIDCLoginState) sqlc.CreateOIDCLoginStateParams {
+	return sqlc.CreateOIDCLoginStateParams{
+		StateHash:    state.StateHash(),
+		Provider:     state.Provider(),
+		CodeVerifier: state.CodeVerifier(),
+		Nonce:        state.Nonce(),
+		CreatedAt:    state.CreatedAt(),
+		ExpiresAt:    state.ExpiresAt(),
+	}
+}
+
+func MapSQLCToOIDCLoginState(rawState sqlc.OidcLoginState) *model.OIDCLoginState {
+	return model.RestoreOIDCLoginState(
+		rawState.StateHash,
+		rawState.Provider,
+		rawState.CodeVerifier,
+		rawState.Nonce,
+		rawState.CreatedAt,
+		rawState.ExpiresAt,
+	)
+}
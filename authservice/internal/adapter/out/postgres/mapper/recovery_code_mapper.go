@@ -0,0 +1,23 @@
+package mapper
+
+import (
+	"database/sql"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+)
+
+func MapRecoveryCodeToSQLCCreate(code *model.RecoveryCode) sqlc.CreateRecoveryCodeParams {
+	var usedAt sql.NullTime
+	if code.UsedAt() != nil {
+		usedAt = sql.NullTime{Time: *code.UsedAt(), Valid: true}
+	}
+
+	return sqlc.CreateRecoveryCodeParams{
+		ID:        code.ID(),
+		AccountID: code.AccountID(),
+		CodeHash:  code.CodeHash(),
+		CreatedAt: code.CreatedAt(),
+		UsedAt:    usedAt,
+	}
+}
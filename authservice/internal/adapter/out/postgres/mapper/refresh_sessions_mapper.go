@@ -17,7 +17,6 @@ func MapRefreshSessionToSQLCCreate(session *model.RefreshSession) sqlc.CreateRef
 		revokedAt    sql.NullTime
 		revokeReason sql.NullString
 		rotatedFrom  uuid.NullUUID
-		ip           pqtype.Inet
 		userAgent    sql.NullString
 	)
 	if session.RevokedAt() != nil {
@@ -38,28 +37,6 @@ func MapRefreshSessionToSQLCCreate(session *model.RefreshSession) sqlc.CreateRef
 			Valid: true,
 		}
 	}
-	if session.IP() != nil && *session.IP() != "" {
-		parsedIP := net.ParseIP(*session.IP())
-		if parsedIP != nil {
-			mask := net.CIDRMask(32, 32)
-			if parsedIP.To4() == nil {
-				mask = net.CIDRMask(128, 128)
-			}
-
-			ip = pqtype.Inet{
-				IPNet: net.IPNet{
-					IP:   parsedIP,
-					Mask: mask,
-				},
-				Valid: true,
-			}
-		} else {
-			ip = pqtype.Inet{Valid: false}
-		}
-	} else {
-		// IP = nil
-		ip = pqtype.Inet{Valid: false}
-	}
 	if session.UserAgent() != nil {
 		userAgent = sql.NullString{
 			String: *session.UserAgent(),
@@ -76,11 +53,34 @@ func MapRefreshSessionToSQLCCreate(session *model.RefreshSession) sqlc.CreateRef
 		RevokedAt:        revokedAt,
 		RevokeReason:     revokeReason,
 		RotatedFrom:      rotatedFrom,
-		Ip:               ip,
+		Ip:               MapIPToInet(session.IP()),
 		UserAgent:        userAgent,
 	}
 }
 
+// MapIPToInet keeps a single host address, a missing or malformed IP is NULL
+func MapIPToInet(ip *string) pqtype.Inet {
+	if ip == nil || *ip == "" {
+		return pqtype.Inet{Valid: false}
+	}
+	parsedIP := net.ParseIP(*ip)
+	if parsedIP == nil {
+		return pqtype.Inet{Valid: false}
+	}
+
+	mask := net.CIDRMask(32, 32)
+	if parsedIP.To4() == nil {
+		mask = net.CIDRMask(128, 128)
+	}
+	return pqtype.Inet{
+		IPNet: net.IPNet{
+			IP:   parsedIP,
+			Mask: mask,
+		},
+		Valid: true,
+	}
+}
+
 func MapSQLCToRefreshSession(rawSession sqlc.RefreshSession) *model.RefreshSession {
 	var (
 		revokedAt    *time.Time
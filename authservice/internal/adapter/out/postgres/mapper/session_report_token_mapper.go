@@ -0,0 +1,44 @@
+package mapper
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+)
+
+func MapSessionReportTokenToSQLCCreate(token *model.SessionReportToken) sqlc.CreateSessionReportTokenParams {
+	var usedAt sql.NullTime
+	if token.UsedAt() != nil {
+		usedAt = sql.NullTime{Time: *token.UsedAt(), Valid: true}
+	}
+
+	return sqlc.CreateSessionReportTokenParams{
+		ID:        token.ID(),
+		AccountID: token.AccountID(),
+		SessionID: token.SessionID(),
+		TokenHash: token.TokenHash(),
+		CreatedAt: token.CreatedAt(),
+		ExpiresAt: token.ExpiresAt(),
+		UsedAt:    usedAt,
+	}
+}
+
+func MapSQLCToSessionReportToken(rawToken sqlc.SessionReportToken) *model.SessionReportToken {
+	var usedAt *time.Time
+	if rawToken.UsedAt.Valid {
+		t := rawToken.UsedAt.Time
+		usedAt = &t
+	}
+
+	return model.RestoreSessionReportToken(
+		rawToken.ID,
+		rawToken.AccountID,
+		rawToken.SessionID,
+		rawToken.TokenHash,
+		rawToken.CreatedAt,
+		rawToken.ExpiresAt,
+		usedAt,
+	)
+}
@@ -0,0 +1,45 @@
+package mapper
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+)
+
+func MapTOTPFactorToSQLCSave(factor *model.TOTPFactor) sqlc.SaveTOTPFactorParams {
+	var confirmedAt sql.NullTime
+	if factor.ConfirmedAt() != nil {
+		confirmedAt = sql.NullTime{Time: *factor.ConfirmedAt(), Valid: true}
+	}
+
+	return sqlc.SaveTOTPFactorParams{
+		AccountID:       factor.AccountID(),
+		EncryptedSecret: factor.EncryptedSecret(),
+		CreatedAt:       factor.CreatedAt(),
+		ConfirmedAt:     confirmedAt,
+	}
+}
+
+func MapSQLCToTOTPFactor(rawFactor sqlc.TotpFactor) *model.TOTPFactor {
+	var confirmedAt *time.Time
+	if rawFactor.ConfirmedAt.Valid {
+		t := rawFactor.ConfirmedAt.Time
+		confirmedAt = &t
+	}
+
+	var lastUsedStep *int64
+	if rawFactor.LastUsedStep.Valid {
+		step := rawFactor.LastUsedStep.Int64
+		lastUsedStep = &step
+	}
+
+	return model.RestoreTOTPFactor(
+		rawFactor.AccountID,
+		rawFactor.EncryptedSecret,
+		rawFactor.CreatedAt,
+		confirmedAt,
+		lastUsedStep,
+	)
+}
@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/mapper"
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+	pkgpostgres "github.com/maket12/ads-service/pkg/postgres"
+)
+
+type OIDCStateRepository struct {
+	q *sqlc.Queries
+}
+
+func NewOIDCStatesRepository(pgClient *pkgpostgres.Client) *OIDCStateRepository {
+	queries := sqlc.New(pgClient.DB)
+	return &OIDCStateRepository{q: queries}
+}
+
+func (r *OIDCStateRepository) Create(ctx context.Context, state *model.OIDCLoginState) error {
+	// Abandoned logins never reach the callback, drop them here
+	if err := r.q.DeleteExpiredOIDCLoginStates(ctx, state.CreatedAt()); err != nil {
+		return err
+	}
+
+	params := mapper.MapOIDCLoginStateToSQLCCreate(state)
+	return r.q.CreateOIDCLoginState(ctx, params)
+}
+
+func (r *OIDCStateRepository) Consume(ctx context.Context, stateHash string) (*model.OIDCLoginState, error) {
+	rawState, err := r.q.ConsumeOIDCLoginState(ctx, stateHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, pkgerrs.NewObjectNotFoundError("oidc_login_state", stateHash)
+		}
+		return nil, err
+	}
+	return mapper.MapSQLCToOIDCLoginState(rawState), nil
+}
@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/mapper"
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+	pkgpostgres "github.com/maket12/ads-service/pkg/postgres"
+)
+
+type PasswordResetTokenRepository struct {
+	q *sqlc.Queries
+}
+
+func NewPasswordResetTokensRepository(pgClient *pkgpostgres.Client) *PasswordResetTokenRepository {
+	queries := sqlc.New(pgClient.DB)
+	return &PasswordResetTokenRepository{q: queries}
+}
+
+func (r *PasswordResetTokenRepository) Create(ctx context.Context, token *model.PasswordResetToken) error {
+	params := mapper.MapPasswordResetTokenToSQLCCreate(token)
+	return r.q.CreatePasswordResetToken(ctx, params)
+}
+
+func (r *PasswordResetTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*model.PasswordResetToken, error) {
+	rawToken, err := r.q.GetPasswordResetTokenByHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, pkgerrs.NewObjectNotFoundError("password_reset_token", tokenHash)
+		}
+		return nil, err
+	}
+	return mapper.MapSQLCToPasswordResetToken(rawToken), nil
+}
+
+func (r *PasswordResetTokenRepository) MarkUsed(ctx context.Context, token *model.PasswordResetToken) error {
+	var usedAt sql.NullTime
+	if token.UsedAt() != nil {
+		usedAt = sql.NullTime{Time: *token.UsedAt(), Valid: true}
+	}
+
+	params := sqlc.MarkPasswordResetTokenUsedParams{
+		ID:     token.ID(),
+		UsedAt: usedAt,
+	}
+
+	consumed, err := r.q.MarkPasswordResetTokenUsed(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	// Somebody has already consumed this token
+	if consumed == 0 {
+		return model.ErrTokenAlreadyUsed
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+-- name: AddToAccessTokenDenylist :exec
+INSERT INTO access_token_denylist (denylist_key, expires_at)
+VALUES ($1, $2)
+ON CONFLICT (denylist_key) DO UPDATE
+SET expires_at = GREATEST(access_token_denylist.expires_at, EXCLUDED.expires_at);
+
+-- name: IsInAccessTokenDenylist :one
+SELECT EXISTS (
+    SELECT 1
+    FROM access_token_denylist
+    WHERE denylist_key = $1
+        AND expires_at > now()
+)::bool AS denied;
+
+-- name: DeleteExpiredFromAccessTokenDenylist :execrows
+DELETE FROM access_token_denylist
+WHERE denylist_key IN (
+    SELECT d.denylist_key
+    FROM access_token_denylist d
+    WHERE d.expires_at <= sqlc.arg(expired_before)
+    LIMIT sqlc.arg(batch_size)
+);
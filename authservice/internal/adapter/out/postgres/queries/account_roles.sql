@@ -4,20 +4,24 @@ INSERT INTO account_roles (
     role
 ) VALUES (
     $1, $2
- );
+ )
+ON CONFLICT (account_id, role) DO NOTHING;
 
--- name: GetAccountRole :one
+-- name: GetAccountRolePermissions :many
 SELECT
-    account_id,
-    role
-FROM account_roles
-WHERE account_id = $1;
+    ar.role,
+    rp.permission
+FROM account_roles ar
+LEFT JOIN role_permissions rp ON rp.role = ar.role
+WHERE ar.account_id = $1
+ORDER BY ar.role, rp.permission;
 
--- name: UpdateAccountRole :exec
-UPDATE account_roles
-SET role = $2
-WHERE account_id = $1;
+-- name: DeleteAccountRole :execrows
+DELETE FROM account_roles
+WHERE account_roles.account_id = $1
+  AND account_roles.role = $2
+  AND (SELECT count(*) FROM account_roles ar WHERE ar.account_id = $1) > 1;
 
--- name: DeleteAccountRole :exec
+-- name: DeleteAccountRoles :exec
 DELETE FROM account_roles
 WHERE account_id = $1;
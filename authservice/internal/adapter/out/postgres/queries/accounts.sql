@@ -72,3 +72,65 @@ SET
     email_verified = true,
     updated_at = $2
 WHERE id = $1;
+
+-- name: ListAccounts :many
+-- Accounts that never logged in sort as if they did at the epoch, emails
+-- sort case-insensitively. The id breaks ties in the sort direction
+SELECT
+    a.id,
+    a.email,
+    a.password_hash,
+    a.status,
+    a.email_verified,
+    a.created_at,
+    a.updated_at,
+    a.last_login_at,
+    COALESCE(
+        (SELECT string_agg(ar.role, ' ' ORDER BY ar.role) FROM account_roles ar WHERE ar.account_id = a.id),
+        ''
+    )::text AS roles,
+    (
+        SELECT count(*) FROM refresh_sessions rs
+        WHERE rs.account_id = a.id AND rs.revoked_at IS NULL AND rs.expires_at > now()
+    ) AS active_sessions
+FROM accounts a
+WHERE (sqlc.narg(status)::text IS NULL OR a.status::text = sqlc.narg(status)::text)
+    AND (
+        sqlc.narg(role)::text IS NULL
+        OR EXISTS (SELECT 1 FROM account_roles ar WHERE ar.account_id = a.id AND ar.role = sqlc.narg(role)::text)
+    )
+    AND (sqlc.narg(email_verified)::boolean IS NULL OR a.email_verified = sqlc.narg(email_verified)::boolean)
+    AND (sqlc.narg(email_prefix)::text IS NULL OR starts_with(lower(a.email::text), lower(sqlc.narg(email_prefix)::text)))
+    AND (sqlc.narg(created_from)::timestamptz IS NULL OR a.created_at >= sqlc.narg(created_from)::timestamptz)
+    AND (sqlc.narg(created_to)::timestamptz IS NULL OR a.created_at < sqlc.narg(created_to)::timestamptz)
+    AND (sqlc.narg(last_login_from)::timestamptz IS NULL OR a.last_login_at >= sqlc.narg(last_login_from)::timestamptz)
+    AND (sqlc.narg(last_login_to)::timestamptz IS NULL OR a.last_login_at < sqlc.narg(last_login_to)::timestamptz)
+    AND (
+        sqlc.narg(after_id)::uuid IS NULL
+        OR (sqlc.arg(sort_field)::text = 'created_at' AND NOT sqlc.arg(sort_desc)::boolean
+            AND (a.created_at, a.id) > (sqlc.narg(after_created_at)::timestamptz, sqlc.narg(after_id)::uuid))
+        OR (sqlc.arg(sort_field)::text = 'created_at' AND sqlc.arg(sort_desc)::boolean
+            AND (a.created_at, a.id) < (sqlc.narg(after_created_at)::timestamptz, sqlc.narg(after_id)::uuid))
+        OR (sqlc.arg(sort_field)::text = 'last_login_at' AND NOT sqlc.arg(sort_desc)::boolean
+            AND (COALESCE(a.last_login_at, 'epoch'::timestamptz), a.id)
+                > (sqlc.narg(after_last_login_at)::timestamptz, sqlc.narg(after_id)::uuid))
+        OR (sqlc.arg(sort_field)::text = 'last_login_at' AND sqlc.arg(sort_desc)::boolean
+            AND (COALESCE(a.last_login_at, 'epoch'::timestamptz), a.id)
+                < (sqlc.narg(after_last_login_at)::timestamptz, sqlc.narg(after_id)::uuid))
+        OR (sqlc.arg(sort_field)::text = 'email' AND NOT sqlc.arg(sort_desc)::boolean
+            AND (lower(a.email::text), a.id) > (sqlc.narg(after_email)::text, sqlc.narg(after_id)::uuid))
+        OR (sqlc.arg(sort_field)::text = 'email' AND sqlc.arg(sort_desc)::boolean
+            AND (lower(a.email::text), a.id) < (sqlc.narg(after_email)::text, sqlc.narg(after_id)::uuid))
+    )
+ORDER BY
+    CASE WHEN sqlc.arg(sort_field)::text = 'created_at' AND NOT sqlc.arg(sort_desc)::boolean THEN a.created_at END ASC,
+    CASE WHEN sqlc.arg(sort_field)::text = 'created_at' AND sqlc.arg(sort_desc)::boolean THEN a.created_at END DESC,
+    CASE WHEN sqlc.arg(sort_field)::text = 'last_login_at' AND NOT sqlc.arg(sort_desc)::boolean
+        THEN COALESCE(a.last_login_at, 'epoch'::timestamptz) END ASC,
+    CASE WHEN sqlc.arg(sort_field)::text = 'last_login_at' AND sqlc.arg(sort_desc)::boolean
+        THEN COALESCE(a.last_login_at, 'epoch'::timestamptz) END DESC,
+    CASE WHEN sqlc.arg(sort_field)::text = 'email' AND NOT sqlc.arg(sort_desc)::boolean THEN lower(a.email::text) END ASC,
+    CASE WHEN sqlc.arg(sort_field)::text = 'email' AND sqlc.arg(sort_desc)::boolean THEN lower(a.email::text) END DESC,
+    CASE WHEN NOT sqlc.arg(sort_desc)::boolean THEN a.id END ASC,
+    CASE WHEN sqlc.arg(sort_desc)::boolean THEN a.id END DESC
+LIMIT sqlc.arg(page_size);
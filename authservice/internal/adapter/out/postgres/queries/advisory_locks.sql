@@ -0,0 +1,5 @@
+-- name: TryAdvisoryLock :one
+SELECT pg_try_advisory_lock(hashtext(sqlc.arg(lock_name)::text)) AS acquired;
+
+-- name: AdvisoryUnlock :one
+SELECT pg_advisory_unlock(hashtext(sqlc.arg(lock_name)::text)) AS released;
@@ -0,0 +1,70 @@
+-- name: CreateAPIKey :exec
+INSERT INTO api_keys (
+    id,
+    account_id,
+    name,
+    prefix,
+    secret_hash,
+    scopes,
+    created_at,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+);
+
+-- name: GetAPIKeyByID :one
+SELECT
+    id,
+    account_id,
+    name,
+    prefix,
+    secret_hash,
+    scopes,
+    created_at,
+    expires_at,
+    last_used_at,
+    revoked_at
+FROM api_keys
+WHERE id = $1;
+
+-- name: GetAPIKeyByPrefix :one
+SELECT
+    id,
+    account_id,
+    name,
+    prefix,
+    secret_hash,
+    scopes,
+    created_at,
+    expires_at,
+    last_used_at,
+    revoked_at
+FROM api_keys
+WHERE prefix = $1;
+
+-- name: ListAPIKeysByAccount :many
+SELECT
+    id,
+    account_id,
+    name,
+    prefix,
+    secret_hash,
+    scopes,
+    created_at,
+    expires_at,
+    last_used_at,
+    revoked_at
+FROM api_keys
+WHERE account_id = $1
+ORDER BY created_at DESC;
+
+-- name: RevokeAPIKey :exec
+UPDATE api_keys
+SET revoked_at = $2
+WHERE id = $1
+    AND revoked_at IS NULL;
+
+-- name: MarkAPIKeyUsed :exec
+UPDATE api_keys
+SET last_used_at = $2
+WHERE id = $1;
@@ -0,0 +1,42 @@
+-- name: CreateAuthEvent :exec
+INSERT INTO auth_events (
+    id,
+    account_id,
+    event_type,
+    session_id,
+    ip,
+    user_agent,
+    actor_id,
+    reason,
+    outcome,
+    metadata,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+);
+
+-- name: ListAuthEvents :many
+SELECT
+    id,
+    account_id,
+    event_type,
+    session_id,
+    ip,
+    user_agent,
+    actor_id,
+    reason,
+    outcome,
+    metadata,
+    created_at
+FROM auth_events
+WHERE (sqlc.narg(account_id)::uuid IS NULL OR account_id = sqlc.narg(account_id)::uuid)
+    AND (sqlc.narg(event_type)::text IS NULL OR event_type = sqlc.narg(event_type)::text)
+    AND (sqlc.narg(outcome)::text IS NULL OR outcome = sqlc.narg(outcome)::text)
+    AND (sqlc.narg(created_from)::timestamptz IS NULL OR created_at >= sqlc.narg(created_from)::timestamptz)
+    AND (sqlc.narg(created_to)::timestamptz IS NULL OR created_at < sqlc.narg(created_to)::timestamptz)
+    AND (
+        sqlc.narg(after_created_at)::timestamptz IS NULL
+        OR (created_at, id) < (sqlc.narg(after_created_at)::timestamptz, sqlc.narg(after_id)::uuid)
+    )
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(page_size);
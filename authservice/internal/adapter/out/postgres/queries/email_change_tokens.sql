@@ -0,0 +1,40 @@
+-- name: CreateEmailChangeToken :exec
+INSERT INTO email_change_tokens (
+    id,
+    account_id,
+    new_email,
+    token_hash,
+    created_at,
+    expires_at,
+    used_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: GetEmailChangeTokenByHash :one
+SELECT
+    id,
+    account_id,
+    new_email,
+    token_hash,
+    created_at,
+    expires_at,
+    used_at
+FROM email_change_tokens
+WHERE token_hash = $1;
+
+-- name: ConfirmEmailChange :execrows
+WITH used_token AS (
+    UPDATE email_change_tokens
+    SET used_at = sqlc.arg(used_at)
+    WHERE email_change_tokens.id = sqlc.arg(id)
+        AND email_change_tokens.used_at IS NULL
+    RETURNING email_change_tokens.account_id, email_change_tokens.new_email
+)
+UPDATE accounts
+SET
+    email = used_token.new_email,
+    email_verified = true,
+    updated_at = sqlc.arg(used_at)
+FROM used_token
+WHERE accounts.id = used_token.account_id;
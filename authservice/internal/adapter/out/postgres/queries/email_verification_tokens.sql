@@ -0,0 +1,33 @@
+-- name: CreateEmailVerificationToken :exec
+INSERT INTO email_verification_tokens (
+    id,
+    account_id,
+    token_hash,
+    created_at,
+    expires_at,
+    used_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+);
+
+-- name: GetEmailVerificationTokenByHash :one
+SELECT
+    id,
+    account_id,
+    token_hash,
+    created_at,
+    expires_at,
+    used_at
+FROM email_verification_tokens
+WHERE token_hash = $1;
+
+-- name: MarkEmailVerificationTokenUsed :execrows
+UPDATE email_verification_tokens
+SET used_at = $2
+WHERE id = $1
+    AND used_at IS NULL;
+
+-- name: DeleteUnusedEmailVerificationTokens :exec
+DELETE FROM email_verification_tokens
+WHERE account_id = $1
+    AND used_at IS NULL;
@@ -0,0 +1,23 @@
+-- name: CreateExternalIdentity :exec
+INSERT INTO external_identities (
+    id,
+    account_id,
+    provider,
+    subject,
+    email,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+);
+
+-- name: GetExternalIdentityByProviderSubject :one
+SELECT
+    id,
+    account_id,
+    provider,
+    subject,
+    email,
+    created_at
+FROM external_identities
+WHERE provider = $1
+    AND subject = $2;
@@ -0,0 +1,29 @@
+-- name: CreateLoginFailure :exec
+INSERT INTO login_failures (throttle_key, failed_at)
+VALUES ($1, $2);
+
+-- name: DeleteExpiredLoginFailures :exec
+DELETE FROM login_failures
+WHERE throttle_key = sqlc.arg(throttle_key)
+    AND failed_at < sqlc.arg(expired_before);
+
+-- name: GetLoginFailures :one
+SELECT
+    count(*)::int AS failures,
+    COALESCE(max(failed_at), 'epoch'::timestamptz)::timestamptz AS last_failed_at
+FROM login_failures
+WHERE throttle_key = sqlc.arg(throttle_key)
+    AND failed_at >= sqlc.arg(since);
+
+-- name: DeleteLoginFailures :exec
+DELETE FROM login_failures
+WHERE throttle_key = $1;
+
+-- name: DeleteStaleLoginFailures :execrows
+DELETE FROM login_failures
+WHERE id IN (
+    SELECT lf.id
+    FROM login_failures lf
+    WHERE lf.failed_at < sqlc.arg(expired_before)
+    LIMIT sqlc.arg(batch_size)
+);
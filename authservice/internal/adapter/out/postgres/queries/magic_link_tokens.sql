@@ -0,0 +1,28 @@
+-- name: CreateMagicLinkToken :exec
+INSERT INTO magic_link_tokens (
+    id,
+    account_id,
+    token_hash,
+    created_at,
+    expires_at,
+    used_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+);
+
+-- name: GetMagicLinkTokenByHash :one
+SELECT
+    id,
+    account_id,
+    token_hash,
+    created_at,
+    expires_at,
+    used_at
+FROM magic_link_tokens
+WHERE token_hash = $1;
+
+-- name: MarkMagicLinkTokenUsed :execrows
+UPDATE magic_link_tokens
+SET used_at = $2
+WHERE id = $1
+    AND used_at IS NULL;
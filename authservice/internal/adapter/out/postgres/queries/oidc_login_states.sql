@@ -0,0 +1,26 @@
+-- name: CreateOIDCLoginState :exec
+INSERT INTO oidc_login_states (
+    state_hash,
+    provider,
+    code_verifier,
+    nonce,
+    created_at,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+);
+
+-- name: DeleteExpiredOIDCLoginStates :exec
+DELETE FROM oidc_login_states
+WHERE expires_at < $1;
+
+-- name: ConsumeOIDCLoginState :one
+DELETE FROM oidc_login_states
+WHERE state_hash = $1
+RETURNING
+    state_hash,
+    provider,
+    code_verifier,
+    nonce,
+    created_at,
+    expires_at;
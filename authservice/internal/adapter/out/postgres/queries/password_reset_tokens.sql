@@ -0,0 +1,39 @@
+-- name: CreatePasswordResetToken :exec
+INSERT INTO password_reset_tokens (
+    id,
+    account_id,
+    token_hash,
+    created_at,
+    expires_at,
+    used_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+);
+
+-- name: GetPasswordResetTokenByHash :one
+SELECT
+    id,
+    account_id,
+    token_hash,
+    created_at,
+    expires_at,
+    used_at
+FROM password_reset_tokens
+WHERE token_hash = $1;
+
+-- name: MarkPasswordResetTokenUsed :one
+WITH consumed AS (
+    UPDATE password_reset_tokens prt
+    SET used_at = sqlc.arg(used_at)
+    WHERE prt.id = sqlc.arg(id)
+        AND prt.used_at IS NULL
+    RETURNING prt.id, prt.account_id
+), dropped AS (
+    DELETE FROM password_reset_tokens t
+    USING consumed c
+    WHERE t.account_id = c.account_id
+        AND t.id <> c.id
+        AND t.used_at IS NULL
+)
+SELECT count(*)::int AS consumed
+FROM consumed;
@@ -0,0 +1,21 @@
+-- name: CreateRecoveryCode :exec
+INSERT INTO recovery_codes (
+    id,
+    account_id,
+    code_hash,
+    created_at,
+    used_at
+) VALUES (
+    $1, $2, $3, $4, $5
+);
+
+-- name: DeleteAccountRecoveryCodes :exec
+DELETE FROM recovery_codes
+WHERE account_id = $1;
+
+-- name: UseRecoveryCode :execrows
+UPDATE recovery_codes
+SET used_at = $3
+WHERE account_id = $1
+    AND code_hash = $2
+    AND used_at IS NULL;
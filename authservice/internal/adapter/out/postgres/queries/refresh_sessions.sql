@@ -44,12 +44,13 @@ SELECT
 FROM refresh_sessions
 WHERE refresh_token_hash = $1;
 
--- name: RevokeRefreshSession :exec
+-- name: RevokeRefreshSession :execrows
 UPDATE refresh_sessions
 SET
     revoked_at = $2,
     revoke_reason = $3
-WHERE id = $1;
+WHERE id = $1
+    AND revoked_at IS NULL;
 
 -- name: RevokeRefreshSessionDescendants :exec
 WITH RECURSIVE chain(target_id) AS (
@@ -100,3 +101,33 @@ SET
     revoke_reason = $3
 WHERE account_id = $1
     AND revoked_at IS NULL;
+
+-- name: RevokeAllAccountRefreshSessionsExcept :exec
+UPDATE refresh_sessions
+SET
+    revoked_at = $3,
+    revoke_reason = $4
+WHERE account_id = $1
+    AND id <> $2
+    AND revoked_at IS NULL;
+
+-- name: DeleteStaleRefreshSessions :execrows
+DELETE FROM refresh_sessions
+WHERE id IN (
+    SELECT rs.id
+    FROM refresh_sessions rs
+    WHERE rs.expires_at <= sqlc.arg(expired_before)
+        OR rs.revoked_at <= sqlc.arg(revoked_before)
+    LIMIT sqlc.arg(batch_size)
+);
+
+-- name: CountAccountRecentRefreshSessions :one
+SELECT
+    count(*) AS total,
+    count(*) FILTER (
+        WHERE rs.ip IS NOT DISTINCT FROM sqlc.narg(ip)::inet
+            AND rs.user_agent IS NOT DISTINCT FROM sqlc.narg(user_agent)::text
+    ) AS same_device
+FROM refresh_sessions rs
+WHERE rs.account_id = sqlc.arg(account_id)
+    AND rs.created_at >= sqlc.arg(created_after);
@@ -0,0 +1,26 @@
+-- name: CreateRequestCount :exec
+INSERT INTO request_counts (limit_key, requested_at)
+VALUES ($1, $2);
+
+-- name: DeleteExpiredRequestCounts :exec
+DELETE FROM request_counts
+WHERE limit_key = sqlc.arg(limit_key)
+    AND requested_at < sqlc.arg(expired_before);
+
+-- name: GetRequestCounts :one
+SELECT
+    count(*)::int AS requests,
+    COALESCE(min(requested_at), 'epoch'::timestamptz)::timestamptz AS first_requested_at,
+    COALESCE(max(requested_at), 'epoch'::timestamptz)::timestamptz AS last_requested_at
+FROM request_counts
+WHERE limit_key = sqlc.arg(limit_key)
+    AND requested_at >= sqlc.arg(since);
+
+-- name: DeleteStaleRequestCounts :execrows
+DELETE FROM request_counts
+WHERE id IN (
+    SELECT rc.id
+    FROM request_counts rc
+    WHERE rc.requested_at < sqlc.arg(expired_before)
+    LIMIT sqlc.arg(batch_size)
+);
@@ -0,0 +1,30 @@
+-- name: CreateSessionReportToken :exec
+INSERT INTO session_report_tokens (
+    id,
+    account_id,
+    session_id,
+    token_hash,
+    created_at,
+    expires_at,
+    used_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: GetSessionReportTokenByHash :one
+SELECT
+    id,
+    account_id,
+    session_id,
+    token_hash,
+    created_at,
+    expires_at,
+    used_at
+FROM session_report_tokens
+WHERE token_hash = $1;
+
+-- name: MarkSessionReportTokenUsed :execrows
+UPDATE session_report_tokens
+SET used_at = $2
+WHERE id = $1
+    AND used_at IS NULL;
@@ -0,0 +1,37 @@
+-- name: SaveTOTPFactor :execrows
+INSERT INTO totp_factors (
+    account_id,
+    encrypted_secret,
+    created_at,
+    confirmed_at
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (account_id) DO UPDATE
+SET
+    encrypted_secret = EXCLUDED.encrypted_secret,
+    created_at = EXCLUDED.created_at,
+    confirmed_at = EXCLUDED.confirmed_at
+WHERE totp_factors.confirmed_at IS NULL;
+
+-- name: GetTOTPFactorByAccountID :one
+SELECT
+    account_id,
+    encrypted_secret,
+    created_at,
+    confirmed_at,
+    last_used_step
+FROM totp_factors
+WHERE account_id = $1;
+
+-- name: ConfirmTOTPFactor :execrows
+UPDATE totp_factors
+SET confirmed_at = $2
+WHERE account_id = $1
+    AND confirmed_at IS NULL;
+
+-- name: UseTOTPFactorStep :execrows
+UPDATE totp_factors
+SET last_used_step = $2
+WHERE account_id = $1
+    AND (last_used_step IS NULL OR last_used_step < $2);
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/mapper"
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+	pkgpostgres "github.com/maket12/ads-service/pkg/postgres"
+
+	"github.com/google/uuid"
+)
+
+type RecoveryCodeRepository struct {
+	db *sql.DB
+	q  *sqlc.Queries
+}
+
+func NewRecoveryCodesRepository(pgClient *pkgpostgres.Client) *RecoveryCodeRepository {
+	queries := sqlc.New(pgClient.DB)
+	return &RecoveryCodeRepository{db: pgClient.DB, q: queries}
+}
+
+// Replace swaps the whole set in one transaction, so old codes
+// never stay valid next to the new ones
+func (r *RecoveryCodeRepository) Replace(ctx context.Context, accountID uuid.UUID, codes []*model.RecoveryCode) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	q := r.q.WithTx(tx)
+	if err := q.DeleteAccountRecoveryCodes(ctx, accountID); err != nil {
+		return err
+	}
+	for _, code := range codes {
+		if err := q.CreateRecoveryCode(ctx, mapper.MapRecoveryCodeToSQLCCreate(code)); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *RecoveryCodeRepository) Use(ctx context.Context, accountID uuid.UUID, codeHash string) error {
+	params := sqlc.UseRecoveryCodeParams{
+		AccountID: accountID,
+		CodeHash:  codeHash,
+		UsedAt:    sql.NullTime{Time: time.Now(), Valid: true},
+	}
+
+	affected, err := r.q.UseRecoveryCode(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	// Unknown or already used code
+	if affected == 0 {
+		return pkgerrs.NewObjectNotFoundError("recovery_code", codeHash)
+	}
+
+	return nil
+}
@@ -26,11 +26,11 @@ func NewRefreshSessionsRepository(pgClient *pkgpostgres.Client) *RefreshSessionR
 
 func (r *RefreshSessionRepository) Create(ctx context.Context, session *model.RefreshSession) error {
 	params := mapper.MapRefreshSessionToSQLCCreate(session)
-	return r.q.CreateRefreshSession(ctx, params)
+	return queriesFor(ctx, r.q).CreateRefreshSession(ctx, params)
 }
 
 func (r *RefreshSessionRepository) GetByHash(ctx context.Context, tokenHash string) (*model.RefreshSession, error) {
-	rawSession, err := r.q.GetRefreshSessionByHash(ctx, tokenHash)
+	rawSession, err := queriesFor(ctx, r.q).GetRefreshSessionByHash(ctx, tokenHash)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, pkgerrs.NewObjectNotFoundError("refresh_session", tokenHash)
@@ -42,7 +42,7 @@ func (r *RefreshSessionRepository) GetByHash(ctx context.Context, tokenHash stri
 }
 
 func (r *RefreshSessionRepository) GetByID(ctx context.Context, tokenID uuid.UUID) (*model.RefreshSession, error) {
-	rawSession, err := r.q.GetRefreshSessionByID(ctx, tokenID)
+	rawSession, err := queriesFor(ctx, r.q).GetRefreshSessionByID(ctx, tokenID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, pkgerrs.NewObjectNotFoundError("refresh_session", tokenID)
@@ -77,7 +77,17 @@ func (r *RefreshSessionRepository) Revoke(ctx context.Context, session *model.Re
 		RevokeReason: revokeReason,
 	}
 
-	return r.q.RevokeRefreshSession(ctx, params)
+	affected, err := queriesFor(ctx, r.q).RevokeRefreshSession(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	// Somebody has already revoked or rotated this session
+	if affected == 0 {
+		return model.ErrTokenAlreadyRevoked
+	}
+
+	return nil
 }
 
 func (r *RefreshSessionRepository) RevokeAllForAccount(ctx context.Context, accountID uuid.UUID, reason *string) error {
@@ -96,7 +106,29 @@ func (r *RefreshSessionRepository) RevokeAllForAccount(ctx context.Context, acco
 		},
 		RevokeReason: revokeReason,
 	}
-	return r.q.RevokeAllAccountRefreshSessions(ctx, params)
+	return queriesFor(ctx, r.q).RevokeAllAccountRefreshSessions(ctx, params)
+}
+
+func (r *RefreshSessionRepository) RevokeAllForAccountExcept(
+	ctx context.Context, accountID, exceptSessionID uuid.UUID, reason *string,
+) error {
+	var revokeReason sql.NullString
+	if reason != nil {
+		revokeReason = sql.NullString{
+			String: *reason,
+			Valid:  true,
+		}
+	}
+	params := sqlc.RevokeAllAccountRefreshSessionsExceptParams{
+		AccountID: accountID,
+		ID:        exceptSessionID,
+		RevokedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		RevokeReason: revokeReason,
+	}
+	return queriesFor(ctx, r.q).RevokeAllAccountRefreshSessionsExcept(ctx, params)
 }
 
 func (r *RefreshSessionRepository) RevokeDescendants(ctx context.Context, sessionID uuid.UUID, reason *string) error {
@@ -115,11 +147,27 @@ func (r *RefreshSessionRepository) RevokeDescendants(ctx context.Context, sessio
 		},
 		RevokeReason: revokeReason,
 	}
-	return r.q.RevokeRefreshSessionDescendants(ctx, params)
+	return queriesFor(ctx, r.q).RevokeRefreshSessionDescendants(ctx, params)
 }
 
 func (r *RefreshSessionRepository) DeleteExpired(ctx context.Context, expiresAt time.Time) error {
-	return r.q.DeleteExpiredRefreshSessions(ctx, expiresAt)
+	return queriesFor(ctx, r.q).DeleteExpiredRefreshSessions(ctx, expiresAt)
+}
+
+// DeleteStale removes at most limit sessions that are expired
+// or were revoked before revokedBefore, returns the number of deleted rows
+func (r *RefreshSessionRepository) DeleteStale(
+	ctx context.Context, expiredBefore, revokedBefore time.Time, limit int,
+) (int64, error) {
+	params := sqlc.DeleteStaleRefreshSessionsParams{
+		ExpiredBefore: expiredBefore,
+		RevokedBefore: sql.NullTime{
+			Time:  revokedBefore,
+			Valid: true,
+		},
+		BatchSize: int32(limit),
+	}
+	return queriesFor(ctx, r.q).DeleteStaleRefreshSessions(ctx, params)
 }
 
 func (r *RefreshSessionRepository) ListActiveForAccount(ctx context.Context, accountID uuid.UUID) ([]*model.RefreshSession, error) {
@@ -127,7 +175,7 @@ func (r *RefreshSessionRepository) ListActiveForAccount(ctx context.Context, acc
 		AccountID: accountID,
 		ExpiresAt: time.Now(),
 	}
-	rawList, err := r.q.ListAccountActiveRefreshSessions(ctx, params)
+	rawList, err := queriesFor(ctx, r.q).ListAccountActiveRefreshSessions(ctx, params)
 	if err != nil {
 		return nil, err
 	}
@@ -140,3 +188,23 @@ func (r *RefreshSessionRepository) ListActiveForAccount(ctx context.Context, acc
 
 	return result, nil
 }
+
+func (r *RefreshSessionRepository) CountRecent(
+	ctx context.Context, accountID uuid.UUID, ip, userAgent *string, createdAfter time.Time,
+) (int, int, error) {
+	var ua sql.NullString
+	if userAgent != nil {
+		ua = sql.NullString{String: *userAgent, Valid: true}
+	}
+	params := sqlc.CountAccountRecentRefreshSessionsParams{
+		Ip:           mapper.MapIPToInet(ip),
+		UserAgent:    ua,
+		AccountID:    accountID,
+		CreatedAfter: createdAfter,
+	}
+	row, err := queriesFor(ctx, r.q).CountAccountRecentRefreshSessions(ctx, params)
+	if err != nil {
+		return 0, 0, err
+	}
+	return int(row.Total), int(row.SameDevice), nil
+}
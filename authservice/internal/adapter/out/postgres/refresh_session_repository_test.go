@@ -35,7 +35,7 @@ func TestRefreshSessionRepoSuite(t *testing.T) {
 }
 
 func (s *RefreshSessionsRepoSuite) setupDatabase() {
-	const targetVersion = 3
+	const targetVersion = 8
 
 	dbConfig := pkgpostgres.NewConfig(
 		"localhost", 5432,
@@ -203,6 +203,10 @@ func (s *RefreshSessionsRepoSuite) TestRevoke() {
 	// Ensure the session has been revoked
 	session, _ := s.repo.GetByID(s.ctx, s.testSession.ID())
 	s.Require().Equal(revokedSession.RevokeReason(), session.RevokeReason())
+
+	// The second revoke finds nothing to revoke
+	err = s.repo.Revoke(s.ctx, &revokedSession)
+	s.Require().ErrorIs(err, model.ErrTokenAlreadyRevoked)
 }
 
 func (s *RefreshSessionsRepoSuite) TestRevokeAllForAccount() {
@@ -233,6 +237,36 @@ func (s *RefreshSessionsRepoSuite) TestRevokeAllForAccount() {
 	s.Require().Equal(reason, *sess.RevokeReason())
 }
 
+func (s *RefreshSessionsRepoSuite) TestRevokeAllForAccountExcept() {
+	var anotherSession, _ = model.NewRefreshSession(
+		uuid.New(),
+		s.testSession.AccountID(),
+		"hashed",
+		nil,
+		nil,
+		nil,
+		time.Minute,
+	)
+
+	// Create some sessions for the same account
+	_ = s.repo.Create(s.ctx, s.testSession)
+	_ = s.repo.Create(s.ctx, anotherSession)
+
+	var reason = "tests"
+
+	err := s.repo.RevokeAllForAccountExcept(
+		s.ctx, s.testSession.AccountID(), s.testSession.ID(), &reason,
+	)
+	s.Require().NoError(err)
+
+	// The kept session is still active, another one is revoked
+	sess, _ := s.repo.GetByID(s.ctx, s.testSession.ID())
+	s.Require().Nil(sess.RevokeReason())
+
+	sess, _ = s.repo.GetByID(s.ctx, anotherSession.ID())
+	s.Require().Equal(reason, *sess.RevokeReason())
+}
+
 func (s *RefreshSessionsRepoSuite) TestRevokeDescendants() {
 	// Create sessions - one is the descendant of the second
 	var (
@@ -274,6 +308,70 @@ func (s *RefreshSessionsRepoSuite) TestDeleteExpired() {
 	s.Require().ErrorIs(err, pkgerrs.ErrObjectNotFound)
 }
 
+func (s *RefreshSessionsRepoSuite) TestDeleteStale() {
+	// Long-revoked parent and its active descendant
+	var (
+		parentID          = s.testSession.ID()
+		anotherSession, _ = model.NewRefreshSession(
+			uuid.New(),
+			s.testSession.AccountID(),
+			"hashed",
+			&parentID,
+			nil,
+			nil,
+			time.Hour,
+		)
+		reason = model.RevokeReasonRotation
+	)
+	_ = s.repo.Create(s.ctx, s.testSession)
+	_ = s.repo.Create(s.ctx, anotherSession)
+
+	revokedSession := *s.testSession
+	_ = revokedSession.Revoke(&reason)
+	_ = s.repo.Revoke(s.ctx, &revokedSession)
+
+	// Nothing is expired, the parent is revoked before the border
+	deleted, err := s.repo.DeleteStale(
+		s.ctx, time.Now().Add(-time.Hour), time.Now().Add(time.Minute), 10,
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), deleted)
+
+	// The descendant survives without the link
+	_, err = s.repo.GetByID(s.ctx, s.testSession.ID())
+	s.Require().ErrorIs(err, pkgerrs.ErrObjectNotFound)
+
+	session, err := s.repo.GetByID(s.ctx, anotherSession.ID())
+	s.Require().NoError(err)
+	s.Require().Nil(session.RotatedFrom())
+}
+
+func (s *RefreshSessionsRepoSuite) TestDeleteStale_Batch() {
+	const batchSize = 1
+
+	var anotherSession, _ = model.NewRefreshSession(
+		uuid.New(),
+		s.testSession.AccountID(),
+		"hashed",
+		nil,
+		nil,
+		nil,
+		time.Minute,
+	)
+	_ = s.repo.Create(s.ctx, s.testSession)
+	_ = s.repo.Create(s.ctx, anotherSession)
+
+	// Both are expired, but only one row per call is removed
+	var expiredBefore = time.Now().Add(time.Hour)
+	deleted, err := s.repo.DeleteStale(s.ctx, expiredBefore, time.Time{}, batchSize)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(batchSize), deleted)
+
+	deleted, err = s.repo.DeleteStale(s.ctx, expiredBefore, time.Time{}, batchSize)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(batchSize), deleted)
+}
+
 func (s *RefreshSessionsRepoSuite) TestListActiveForAccount() {
 	const sessionsAmount = 2
 
@@ -312,3 +410,47 @@ func (s *RefreshSessionsRepoSuite) TestListActiveForAccount() {
 	s.Require().Truef(sndFound, "expected %v\n in %v",
 		anotherSession, sessions)
 }
+
+func (s *RefreshSessionsRepoSuite) TestCountRecent() {
+	var (
+		ip        = "203.0.113.7"
+		otherIP   = "198.51.100.1"
+		userAgent = "Mozilla/5.0 (Windows NT 10.0) Chrome/120.0"
+	)
+
+	var knownDevice, _ = model.NewRefreshSession(
+		uuid.New(),
+		s.testSession.AccountID(),
+		"hashed-known",
+		nil,
+		&ip,
+		&userAgent,
+		time.Minute,
+	)
+
+	// Revoked sessions still tell the device is known
+	_ = s.repo.Create(s.ctx, s.testSession)
+	_ = s.repo.Create(s.ctx, knownDevice)
+	_ = s.repo.RevokeAllForAccount(s.ctx, s.testSession.AccountID(), nil)
+
+	total, sameDevice, err := s.repo.CountRecent(
+		s.ctx, s.testSession.AccountID(), &ip, &userAgent, time.Now().Add(-time.Hour),
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(2, total)
+	s.Require().Equal(1, sameDevice)
+
+	total, sameDevice, err = s.repo.CountRecent(
+		s.ctx, s.testSession.AccountID(), &otherIP, &userAgent, time.Now().Add(-time.Hour),
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(2, total)
+	s.Require().Zero(sameDevice)
+
+	// Older sessions are out of the window
+	total, _, err = s.repo.CountRecent(
+		s.ctx, s.testSession.AccountID(), &ip, &userAgent, time.Now().Add(time.Minute),
+	)
+	s.Require().NoError(err)
+	s.Require().Zero(total)
+}
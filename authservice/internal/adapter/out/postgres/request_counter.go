@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	pkgpostgres "github.com/maket12/ads-service/pkg/postgres"
+)
+
+type RequestCounter struct {
+	q *sqlc.Queries
+}
+
+func NewRequestCounter(pgClient *pkgpostgres.Client) *RequestCounter {
+	queries := sqlc.New(pgClient.DB)
+	return &RequestCounter{q: queries}
+}
+
+func (c *RequestCounter) Add(ctx context.Context, key string, at time.Time, window time.Duration) error {
+	// Only requests within the window matter
+	if err := c.q.DeleteExpiredRequestCounts(ctx, sqlc.DeleteExpiredRequestCountsParams{
+		LimitKey:      key,
+		ExpiredBefore: at.Add(-window),
+	}); err != nil {
+		return err
+	}
+
+	return c.q.CreateRequestCount(ctx, sqlc.CreateRequestCountParams{
+		LimitKey:    key,
+		RequestedAt: at,
+	})
+}
+
+func (c *RequestCounter) Count(ctx context.Context, key string, since time.Time) (int, time.Time, time.Time, error) {
+	row, err := c.q.GetRequestCounts(ctx, sqlc.GetRequestCountsParams{
+		LimitKey: key,
+		Since:    since,
+	})
+	if err != nil {
+		return 0, time.Time{}, time.Time{}, err
+	}
+	if row.Requests == 0 {
+		return 0, time.Time{}, time.Time{}, nil
+	}
+	return int(row.Requests), row.FirstRequestedAt, row.LastRequestedAt, nil
+}
+
+func (c *RequestCounter) DeleteExpired(ctx context.Context, expiredBefore time.Time, limit int) (int64, error) {
+	return c.q.DeleteStaleRequestCounts(ctx, sqlc.DeleteStaleRequestCountsParams{
+		ExpiredBefore: expiredBefore,
+		BatchSize:     int32(limit),
+	})
+}
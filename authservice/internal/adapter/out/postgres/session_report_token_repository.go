@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/mapper"
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+	pkgpostgres "github.com/maket12/ads-service/pkg/postgres"
+)
+
+type SessionReportTokenRepository struct {
+	q *sqlc.Queries
+}
+
+func NewSessionReportTokensRepository(pgClient *pkgpostgres.Client) *SessionReportTokenRepository {
+	queries := sqlc.New(pgClient.DB)
+	return &SessionReportTokenRepository{q: queries}
+}
+
+func (r *SessionReportTokenRepository) Create(ctx context.Context, token *model.SessionReportToken) error {
+	params := mapper.MapSessionReportTokenToSQLCCreate(token)
+	return r.q.CreateSessionReportToken(ctx, params)
+}
+
+func (r *SessionReportTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*model.SessionReportToken, error) {
+	rawToken, err := r.q.GetSessionReportTokenByHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, pkgerrs.NewObjectNotFoundError("session_report_token", tokenHash)
+		}
+		return nil, err
+	}
+	return mapper.MapSQLCToSessionReportToken(rawToken), nil
+}
+
+func (r *SessionReportTokenRepository) MarkUsed(ctx context.Context, token *model.SessionReportToken) error {
+	var usedAt sql.NullTime
+	if token.UsedAt() != nil {
+		usedAt = sql.NullTime{Time: *token.UsedAt(), Valid: true}
+	}
+
+	params := sqlc.MarkSessionReportTokenUsedParams{
+		ID:     token.ID(),
+		UsedAt: usedAt,
+	}
+
+	affected, err := r.q.MarkSessionReportTokenUsed(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	// Somebody has already followed this link
+	if affected == 0 {
+		return model.ErrTokenAlreadyUsed
+	}
+
+	return nil
+}
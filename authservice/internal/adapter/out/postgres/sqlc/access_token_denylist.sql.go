@@ -0,0 +1,67 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: access_token_denylist.sql
+
+package sqlc
+
+import (
+	"context"
+	"time"
+)
+
+const addToAccessTokenDenylist = `-- name: AddToAccessTokenDenylist :exec
+INSERT INTO access_token_denylist (denylist_key, expires_at)
+VALUES ($1, $2)
+ON CONFLICT (denylist_key) DO UPDATE
+SET expires_at = GREATEST(access_token_denylist.expires_at, EXCLUDED.expires_at)
+`
+
+type AddToAccessTokenDenylistParams struct {
+	DenylistKey string
+	ExpiresAt   time.Time
+}
+
+func (q *Queries) AddToAccessTokenDenylist(ctx context.Context, arg AddToAccessTokenDenylistParams) error {
+	_, err := q.db.ExecContext(ctx, addToAccessTokenDenylist, arg.DenylistKey, arg.ExpiresAt)
+	return err
+}
+
+const deleteExpiredFromAccessTokenDenylist = `-- name: DeleteExpiredFromAccessTokenDenylist :execrows
+DELETE FROM access_token_denylist
+WHERE denylist_key IN (
+    SELECT d.denylist_key
+    FROM access_token_denylist d
+    WHERE d.expires_at <= $1
+    LIMIT $2
+)
+`
+
+type DeleteExpiredFromAccessTokenDenylistParams struct {
+	ExpiredBefore time.Time
+	BatchSize     int32
+}
+
+func (q *Queries) DeleteExpiredFromAccessTokenDenylist(ctx context.Context, arg DeleteExpiredFromAccessTokenDenylistParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExpiredFromAccessTokenDenylist, arg.ExpiredBefore, arg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const isInAccessTokenDenylist = `-- name: IsInAccessTokenDenylist :one
+SELECT EXISTS (
+    SELECT 1
+    FROM access_token_denylist
+    WHERE denylist_key = $1
+        AND expires_at > now()
+)::bool AS denied
+`
+
+func (q *Queries) IsInAccessTokenDenylist(ctx context.Context, denylistKey string) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isInAccessTokenDenylist, denylistKey)
+	var denied bool
+	err := row.Scan(&denied)
+	return denied, err
+}
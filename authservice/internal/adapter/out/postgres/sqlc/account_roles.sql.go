@@ -7,6 +7,7 @@ package sqlc
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/google/uuid"
 )
@@ -18,11 +19,12 @@ INSERT INTO account_roles (
 ) VALUES (
     $1, $2
  )
+ON CONFLICT (account_id, role) DO NOTHING
 `
 
 type CreateAccountRoleParams struct {
 	AccountID uuid.UUID
-	Role      RoleType
+	Role      string
 }
 
 func (q *Queries) CreateAccountRole(ctx context.Context, arg CreateAccountRoleParams) error {
@@ -30,43 +32,70 @@ func (q *Queries) CreateAccountRole(ctx context.Context, arg CreateAccountRolePa
 	return err
 }
 
-const deleteAccountRole = `-- name: DeleteAccountRole :exec
+const deleteAccountRole = `-- name: DeleteAccountRole :execrows
 DELETE FROM account_roles
-WHERE account_id = $1
+WHERE account_roles.account_id = $1
+  AND account_roles.role = $2
+  AND (SELECT count(*) FROM account_roles ar WHERE ar.account_id = $1) > 1
 `
 
-func (q *Queries) DeleteAccountRole(ctx context.Context, accountID uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, deleteAccountRole, accountID)
-	return err
+type DeleteAccountRoleParams struct {
+	AccountID uuid.UUID
+	Role      string
 }
 
-const getAccountRole = `-- name: GetAccountRole :one
-SELECT
-    account_id,
-    role
-FROM account_roles
+func (q *Queries) DeleteAccountRole(ctx context.Context, arg DeleteAccountRoleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteAccountRole, arg.AccountID, arg.Role)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteAccountRoles = `-- name: DeleteAccountRoles :exec
+DELETE FROM account_roles
 WHERE account_id = $1
 `
 
-func (q *Queries) GetAccountRole(ctx context.Context, accountID uuid.UUID) (AccountRole, error) {
-	row := q.db.QueryRowContext(ctx, getAccountRole, accountID)
-	var i AccountRole
-	err := row.Scan(&i.AccountID, &i.Role)
-	return i, err
+func (q *Queries) DeleteAccountRoles(ctx context.Context, accountID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteAccountRoles, accountID)
+	return err
 }
 
-const updateAccountRole = `-- name: UpdateAccountRole :exec
-UPDATE account_roles
-SET role = $2
-WHERE account_id = $1
+const getAccountRolePermissions = `-- name: GetAccountRolePermissions :many
+SELECT
+    ar.role,
+    rp.permission
+FROM account_roles ar
+LEFT JOIN role_permissions rp ON rp.role = ar.role
+WHERE ar.account_id = $1
+ORDER BY ar.role, rp.permission
 `
 
-type UpdateAccountRoleParams struct {
-	AccountID uuid.UUID
-	Role      RoleType
+type GetAccountRolePermissionsRow struct {
+	Role       string
+	Permission sql.NullString
 }
 
-func (q *Queries) UpdateAccountRole(ctx context.Context, arg UpdateAccountRoleParams) error {
-	_, err := q.db.ExecContext(ctx, updateAccountRole, arg.AccountID, arg.Role)
-	return err
+func (q *Queries) GetAccountRolePermissions(ctx context.Context, accountID uuid.UUID) ([]GetAccountRolePermissionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getAccountRolePermissions, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAccountRolePermissionsRow
+	for rows.Next() {
+		var i GetAccountRolePermissionsRow
+		if err := rows.Scan(&i.Role, &i.Permission); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
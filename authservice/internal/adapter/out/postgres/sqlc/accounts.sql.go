@@ -113,6 +113,150 @@ func (q *Queries) GetAccountByID(ctx context.Context, id uuid.UUID) (Account, er
 	return i, err
 }
 
+const listAccounts = `-- name: ListAccounts :many
+SELECT
+    a.id,
+    a.email,
+    a.password_hash,
+    a.status,
+    a.email_verified,
+    a.created_at,
+    a.updated_at,
+    a.last_login_at,
+    COALESCE(
+        (SELECT string_agg(ar.role, ' ' ORDER BY ar.role) FROM account_roles ar WHERE ar.account_id = a.id),
+        ''
+    )::text AS roles,
+    (
+        SELECT count(*) FROM refresh_sessions rs
+        WHERE rs.account_id = a.id AND rs.revoked_at IS NULL AND rs.expires_at > now()
+    ) AS active_sessions
+FROM accounts a
+WHERE ($1::text IS NULL OR a.status::text = $1::text)
+    AND (
+        $2::text IS NULL
+        OR EXISTS (SELECT 1 FROM account_roles ar WHERE ar.account_id = a.id AND ar.role = $2::text)
+    )
+    AND ($3::boolean IS NULL OR a.email_verified = $3::boolean)
+    AND ($4::text IS NULL OR starts_with(lower(a.email::text), lower($4::text)))
+    AND ($5::timestamptz IS NULL OR a.created_at >= $5::timestamptz)
+    AND ($6::timestamptz IS NULL OR a.created_at < $6::timestamptz)
+    AND ($7::timestamptz IS NULL OR a.last_login_at >= $7::timestamptz)
+    AND ($8::timestamptz IS NULL OR a.last_login_at < $8::timestamptz)
+    AND (
+        $9::uuid IS NULL
+        OR ($10::text = 'created_at' AND NOT $11::boolean
+            AND (a.created_at, a.id) > ($12::timestamptz, $9::uuid))
+        OR ($10::text = 'created_at' AND $11::boolean
+            AND (a.created_at, a.id) < ($12::timestamptz, $9::uuid))
+        OR ($10::text = 'last_login_at' AND NOT $11::boolean
+            AND (COALESCE(a.last_login_at, 'epoch'::timestamptz), a.id)
+                > ($13::timestamptz, $9::uuid))
+        OR ($10::text = 'last_login_at' AND $11::boolean
+            AND (COALESCE(a.last_login_at, 'epoch'::timestamptz), a.id)
+                < ($13::timestamptz, $9::uuid))
+        OR ($10::text = 'email' AND NOT $11::boolean
+            AND (lower(a.email::text), a.id) > ($14::text, $9::uuid))
+        OR ($10::text = 'email' AND $11::boolean
+            AND (lower(a.email::text), a.id) < ($14::text, $9::uuid))
+    )
+ORDER BY
+    CASE WHEN $10::text = 'created_at' AND NOT $11::boolean THEN a.created_at END ASC,
+    CASE WHEN $10::text = 'created_at' AND $11::boolean THEN a.created_at END DESC,
+    CASE WHEN $10::text = 'last_login_at' AND NOT $11::boolean
+        THEN COALESCE(a.last_login_at, 'epoch'::timestamptz) END ASC,
+    CASE WHEN $10::text = 'last_login_at' AND $11::boolean
+        THEN COALESCE(a.last_login_at, 'epoch'::timestamptz) END DESC,
+    CASE WHEN $10::text = 'email' AND NOT $11::boolean THEN lower(a.email::text) END ASC,
+    CASE WHEN $10::text = 'email' AND $11::boolean THEN lower(a.email::text) END DESC,
+    CASE WHEN NOT $11::boolean THEN a.id END ASC,
+    CASE WHEN $11::boolean THEN a.id END DESC
+LIMIT $15
+`
+
+type ListAccountsParams struct {
+	Status           sql.NullString
+	Role             sql.NullString
+	EmailVerified    sql.NullBool
+	EmailPrefix      sql.NullString
+	CreatedFrom      sql.NullTime
+	CreatedTo        sql.NullTime
+	LastLoginFrom    sql.NullTime
+	LastLoginTo      sql.NullTime
+	AfterID          uuid.NullUUID
+	SortField        string
+	SortDesc         bool
+	AfterCreatedAt   sql.NullTime
+	AfterLastLoginAt sql.NullTime
+	AfterEmail       sql.NullString
+	PageSize         int32
+}
+
+type ListAccountsRow struct {
+	ID             uuid.UUID
+	Email          string
+	PasswordHash   string
+	Status         AccountStatus
+	EmailVerified  bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	LastLoginAt    sql.NullTime
+	Roles          string
+	ActiveSessions int64
+}
+
+// Accounts that never logged in sort as if they did at the epoch, emails
+// sort case-insensitively. The id breaks ties in the sort direction
+func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]ListAccountsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAccounts,
+		arg.Status,
+		arg.Role,
+		arg.EmailVerified,
+		arg.EmailPrefix,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.LastLoginFrom,
+		arg.LastLoginTo,
+		arg.AfterID,
+		arg.SortField,
+		arg.SortDesc,
+		arg.AfterCreatedAt,
+		arg.AfterLastLoginAt,
+		arg.AfterEmail,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAccountsRow
+	for rows.Next() {
+		var i ListAccountsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.PasswordHash,
+			&i.Status,
+			&i.EmailVerified,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.LastLoginAt,
+			&i.Roles,
+			&i.ActiveSessions,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const markAccountLogin = `-- name: MarkAccountLogin :exec
 UPDATE accounts
 SET
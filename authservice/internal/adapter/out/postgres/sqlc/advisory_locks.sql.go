@@ -0,0 +1,32 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: advisory_locks.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const advisoryUnlock = `-- name: AdvisoryUnlock :one
+SELECT pg_advisory_unlock(hashtext($1::text)) AS released
+`
+
+func (q *Queries) AdvisoryUnlock(ctx context.Context, lockName string) (bool, error) {
+	row := q.db.QueryRowContext(ctx, advisoryUnlock, lockName)
+	var released bool
+	err := row.Scan(&released)
+	return released, err
+}
+
+const tryAdvisoryLock = `-- name: TryAdvisoryLock :one
+SELECT pg_try_advisory_lock(hashtext($1::text)) AS acquired
+`
+
+func (q *Queries) TryAdvisoryLock(ctx context.Context, lockName string) (bool, error) {
+	row := q.db.QueryRowContext(ctx, tryAdvisoryLock, lockName)
+	var acquired bool
+	err := row.Scan(&acquired)
+	return acquired, err
+}
@@ -0,0 +1,206 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: api_keys.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createAPIKey = `-- name: CreateAPIKey :exec
+INSERT INTO api_keys (
+    id,
+    account_id,
+    name,
+    prefix,
+    secret_hash,
+    scopes,
+    created_at,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+)
+`
+
+type CreateAPIKeyParams struct {
+	ID         uuid.UUID
+	AccountID  uuid.UUID
+	Name       string
+	Prefix     string
+	SecretHash string
+	Scopes     string
+	CreatedAt  time.Time
+	ExpiresAt  sql.NullTime
+}
+
+func (q *Queries) CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) error {
+	_, err := q.db.ExecContext(ctx, createAPIKey,
+		arg.ID,
+		arg.AccountID,
+		arg.Name,
+		arg.Prefix,
+		arg.SecretHash,
+		arg.Scopes,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const getAPIKeyByID = `-- name: GetAPIKeyByID :one
+SELECT
+    id,
+    account_id,
+    name,
+    prefix,
+    secret_hash,
+    scopes,
+    created_at,
+    expires_at,
+    last_used_at,
+    revoked_at
+FROM api_keys
+WHERE id = $1
+`
+
+func (q *Queries) GetAPIKeyByID(ctx context.Context, id uuid.UUID) (ApiKey, error) {
+	row := q.db.QueryRowContext(ctx, getAPIKeyByID, id)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Prefix,
+		&i.SecretHash,
+		&i.Scopes,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getAPIKeyByPrefix = `-- name: GetAPIKeyByPrefix :one
+SELECT
+    id,
+    account_id,
+    name,
+    prefix,
+    secret_hash,
+    scopes,
+    created_at,
+    expires_at,
+    last_used_at,
+    revoked_at
+FROM api_keys
+WHERE prefix = $1
+`
+
+func (q *Queries) GetAPIKeyByPrefix(ctx context.Context, prefix string) (ApiKey, error) {
+	row := q.db.QueryRowContext(ctx, getAPIKeyByPrefix, prefix)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Prefix,
+		&i.SecretHash,
+		&i.Scopes,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const listAPIKeysByAccount = `-- name: ListAPIKeysByAccount :many
+SELECT
+    id,
+    account_id,
+    name,
+    prefix,
+    secret_hash,
+    scopes,
+    created_at,
+    expires_at,
+    last_used_at,
+    revoked_at
+FROM api_keys
+WHERE account_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListAPIKeysByAccount(ctx context.Context, accountID uuid.UUID) ([]ApiKey, error) {
+	rows, err := q.db.QueryContext(ctx, listAPIKeysByAccount, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ApiKey
+	for rows.Next() {
+		var i ApiKey
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Name,
+			&i.Prefix,
+			&i.SecretHash,
+			&i.Scopes,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markAPIKeyUsed = `-- name: MarkAPIKeyUsed :exec
+UPDATE api_keys
+SET last_used_at = $2
+WHERE id = $1
+`
+
+type MarkAPIKeyUsedParams struct {
+	ID         uuid.UUID
+	LastUsedAt sql.NullTime
+}
+
+func (q *Queries) MarkAPIKeyUsed(ctx context.Context, arg MarkAPIKeyUsedParams) error {
+	_, err := q.db.ExecContext(ctx, markAPIKeyUsed, arg.ID, arg.LastUsedAt)
+	return err
+}
+
+const revokeAPIKey = `-- name: RevokeAPIKey :exec
+UPDATE api_keys
+SET revoked_at = $2
+WHERE id = $1
+    AND revoked_at IS NULL
+`
+
+type RevokeAPIKeyParams struct {
+	ID        uuid.UUID
+	RevokedAt sql.NullTime
+}
+
+func (q *Queries) RevokeAPIKey(ctx context.Context, arg RevokeAPIKeyParams) error {
+	_, err := q.db.ExecContext(ctx, revokeAPIKey, arg.ID, arg.RevokedAt)
+	return err
+}
@@ -0,0 +1,160 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: auth_events.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createAuthEvent = `-- name: CreateAuthEvent :exec
+INSERT INTO auth_events (
+    id,
+    account_id,
+    event_type,
+    session_id,
+    ip,
+    user_agent,
+    actor_id,
+    reason,
+    outcome,
+    metadata,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+)
+`
+
+type CreateAuthEventParams struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	EventType string
+	SessionID uuid.NullUUID
+	Ip        sql.NullString
+	UserAgent sql.NullString
+	ActorID   uuid.NullUUID
+	Reason    sql.NullString
+	Outcome   string
+	Metadata  json.RawMessage
+	CreatedAt time.Time
+}
+
+func (q *Queries) CreateAuthEvent(ctx context.Context, arg CreateAuthEventParams) error {
+	_, err := q.db.ExecContext(ctx, createAuthEvent,
+		arg.ID,
+		arg.AccountID,
+		arg.EventType,
+		arg.SessionID,
+		arg.Ip,
+		arg.UserAgent,
+		arg.ActorID,
+		arg.Reason,
+		arg.Outcome,
+		arg.Metadata,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const listAuthEvents = `-- name: ListAuthEvents :many
+SELECT
+    id,
+    account_id,
+    event_type,
+    session_id,
+    ip,
+    user_agent,
+    actor_id,
+    reason,
+    outcome,
+    metadata,
+    created_at
+FROM auth_events
+WHERE ($1::uuid IS NULL OR account_id = $1::uuid)
+    AND ($2::text IS NULL OR event_type = $2::text)
+    AND ($3::text IS NULL OR outcome = $3::text)
+    AND ($4::timestamptz IS NULL OR created_at >= $4::timestamptz)
+    AND ($5::timestamptz IS NULL OR created_at < $5::timestamptz)
+    AND (
+        $6::timestamptz IS NULL
+        OR (created_at, id) < ($6::timestamptz, $7::uuid)
+    )
+ORDER BY created_at DESC, id DESC
+LIMIT $8
+`
+
+type ListAuthEventsParams struct {
+	AccountID      uuid.NullUUID
+	EventType      sql.NullString
+	Outcome        sql.NullString
+	CreatedFrom    sql.NullTime
+	CreatedTo      sql.NullTime
+	AfterCreatedAt sql.NullTime
+	AfterID        uuid.NullUUID
+	PageSize       int32
+}
+
+type ListAuthEventsRow struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	EventType string
+	SessionID uuid.NullUUID
+	Ip        sql.NullString
+	UserAgent sql.NullString
+	ActorID   uuid.NullUUID
+	Reason    sql.NullString
+	Outcome   string
+	Metadata  json.RawMessage
+	CreatedAt time.Time
+}
+
+func (q *Queries) ListAuthEvents(ctx context.Context, arg ListAuthEventsParams) ([]ListAuthEventsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAuthEvents,
+		arg.AccountID,
+		arg.EventType,
+		arg.Outcome,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAuthEventsRow
+	for rows.Next() {
+		var i ListAuthEventsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.EventType,
+			&i.SessionID,
+			&i.Ip,
+			&i.UserAgent,
+			&i.ActorID,
+			&i.Reason,
+			&i.Outcome,
+			&i.Metadata,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,109 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: email_change_tokens.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const confirmEmailChange = `-- name: ConfirmEmailChange :execrows
+WITH used_token AS (
+    UPDATE email_change_tokens
+    SET used_at = $1
+    WHERE email_change_tokens.id = $2
+        AND email_change_tokens.used_at IS NULL
+    RETURNING email_change_tokens.account_id, email_change_tokens.new_email
+)
+UPDATE accounts
+SET
+    email = used_token.new_email,
+    email_verified = true,
+    updated_at = $1
+FROM used_token
+WHERE accounts.id = used_token.account_id
+`
+
+type ConfirmEmailChangeParams struct {
+	UsedAt time.Time
+	ID     uuid.UUID
+}
+
+func (q *Queries) ConfirmEmailChange(ctx context.Context, arg ConfirmEmailChangeParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, confirmEmailChange, arg.UsedAt, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createEmailChangeToken = `-- name: CreateEmailChangeToken :exec
+INSERT INTO email_change_tokens (
+    id,
+    account_id,
+    new_email,
+    token_hash,
+    created_at,
+    expires_at,
+    used_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+`
+
+type CreateEmailChangeTokenParams struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	NewEmail  string
+	TokenHash string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	UsedAt    sql.NullTime
+}
+
+func (q *Queries) CreateEmailChangeToken(ctx context.Context, arg CreateEmailChangeTokenParams) error {
+	_, err := q.db.ExecContext(ctx, createEmailChangeToken,
+		arg.ID,
+		arg.AccountID,
+		arg.NewEmail,
+		arg.TokenHash,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+		arg.UsedAt,
+	)
+	return err
+}
+
+const getEmailChangeTokenByHash = `-- name: GetEmailChangeTokenByHash :one
+SELECT
+    id,
+    account_id,
+    new_email,
+    token_hash,
+    created_at,
+    expires_at,
+    used_at
+FROM email_change_tokens
+WHERE token_hash = $1
+`
+
+func (q *Queries) GetEmailChangeTokenByHash(ctx context.Context, tokenHash string) (EmailChangeToken, error) {
+	row := q.db.QueryRowContext(ctx, getEmailChangeTokenByHash, tokenHash)
+	var i EmailChangeToken
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.NewEmail,
+		&i.TokenHash,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.UsedAt,
+	)
+	return i, err
+}
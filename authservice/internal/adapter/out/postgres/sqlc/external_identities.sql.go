@@ -0,0 +1,79 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: external_identities.sql
+
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createExternalIdentity = `-- name: CreateExternalIdentity :exec
+INSERT INTO external_identities (
+    id,
+    account_id,
+    provider,
+    subject,
+    email,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+`
+
+type CreateExternalIdentityParams struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	Provider  string
+	Subject   string
+	Email     string
+	CreatedAt time.Time
+}
+
+func (q *Queries) CreateExternalIdentity(ctx context.Context, arg CreateExternalIdentityParams) error {
+	_, err := q.db.ExecContext(ctx, createExternalIdentity,
+		arg.ID,
+		arg.AccountID,
+		arg.Provider,
+		arg.Subject,
+		arg.Email,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const getExternalIdentityByProviderSubject = `-- name: GetExternalIdentityByProviderSubject :one
+SELECT
+    id,
+    account_id,
+    provider,
+    subject,
+    email,
+    created_at
+FROM external_identities
+WHERE provider = $1
+    AND subject = $2
+`
+
+type GetExternalIdentityByProviderSubjectParams struct {
+	Provider string
+	Subject  string
+}
+
+func (q *Queries) GetExternalIdentityByProviderSubject(ctx context.Context, arg GetExternalIdentityByProviderSubjectParams) (ExternalIdentity, error) {
+	row := q.db.QueryRowContext(ctx, getExternalIdentityByProviderSubject, arg.Provider, arg.Subject)
+	var i ExternalIdentity
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Provider,
+		&i.Subject,
+		&i.Email,
+		&i.CreatedAt,
+	)
+	return i, err
+}
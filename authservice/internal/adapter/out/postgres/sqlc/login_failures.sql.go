@@ -0,0 +1,101 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: login_failures.sql
+
+package sqlc
+
+import (
+	"context"
+	"time"
+)
+
+const createLoginFailure = `-- name: CreateLoginFailure :exec
+INSERT INTO login_failures (throttle_key, failed_at)
+VALUES ($1, $2)
+`
+
+type CreateLoginFailureParams struct {
+	ThrottleKey string
+	FailedAt    time.Time
+}
+
+func (q *Queries) CreateLoginFailure(ctx context.Context, arg CreateLoginFailureParams) error {
+	_, err := q.db.ExecContext(ctx, createLoginFailure, arg.ThrottleKey, arg.FailedAt)
+	return err
+}
+
+const deleteExpiredLoginFailures = `-- name: DeleteExpiredLoginFailures :exec
+DELETE FROM login_failures
+WHERE throttle_key = $1
+    AND failed_at < $2
+`
+
+type DeleteExpiredLoginFailuresParams struct {
+	ThrottleKey   string
+	ExpiredBefore time.Time
+}
+
+func (q *Queries) DeleteExpiredLoginFailures(ctx context.Context, arg DeleteExpiredLoginFailuresParams) error {
+	_, err := q.db.ExecContext(ctx, deleteExpiredLoginFailures, arg.ThrottleKey, arg.ExpiredBefore)
+	return err
+}
+
+const deleteLoginFailures = `-- name: DeleteLoginFailures :exec
+DELETE FROM login_failures
+WHERE throttle_key = $1
+`
+
+func (q *Queries) DeleteLoginFailures(ctx context.Context, throttleKey string) error {
+	_, err := q.db.ExecContext(ctx, deleteLoginFailures, throttleKey)
+	return err
+}
+
+const deleteStaleLoginFailures = `-- name: DeleteStaleLoginFailures :execrows
+DELETE FROM login_failures
+WHERE id IN (
+    SELECT lf.id
+    FROM login_failures lf
+    WHERE lf.failed_at < $1
+    LIMIT $2
+)
+`
+
+type DeleteStaleLoginFailuresParams struct {
+	ExpiredBefore time.Time
+	BatchSize     int32
+}
+
+func (q *Queries) DeleteStaleLoginFailures(ctx context.Context, arg DeleteStaleLoginFailuresParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteStaleLoginFailures, arg.ExpiredBefore, arg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getLoginFailures = `-- name: GetLoginFailures :one
+SELECT
+    count(*)::int AS failures,
+    COALESCE(max(failed_at), 'epoch'::timestamptz)::timestamptz AS last_failed_at
+FROM login_failures
+WHERE throttle_key = $1
+    AND failed_at >= $2
+`
+
+type GetLoginFailuresParams struct {
+	ThrottleKey string
+	Since       time.Time
+}
+
+type GetLoginFailuresRow struct {
+	Failures     int32
+	LastFailedAt time.Time
+}
+
+func (q *Queries) GetLoginFailures(ctx context.Context, arg GetLoginFailuresParams) (GetLoginFailuresRow, error) {
+	row := q.db.QueryRowContext(ctx, getLoginFailures, arg.ThrottleKey, arg.Since)
+	var i GetLoginFailuresRow
+	err := row.Scan(&i.Failures, &i.LastFailedAt)
+	return i, err
+}
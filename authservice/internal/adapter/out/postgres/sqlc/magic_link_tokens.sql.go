@@ -0,0 +1,94 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: magic_link_tokens.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createMagicLinkToken = `-- name: CreateMagicLinkToken :exec
+INSERT INTO magic_link_tokens (
+    id,
+    account_id,
+    token_hash,
+    created_at,
+    expires_at,
+    used_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+`
+
+type CreateMagicLinkTokenParams struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	TokenHash string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	UsedAt    sql.NullTime
+}
+
+func (q *Queries) CreateMagicLinkToken(ctx context.Context, arg CreateMagicLinkTokenParams) error {
+	_, err := q.db.ExecContext(ctx, createMagicLinkToken,
+		arg.ID,
+		arg.AccountID,
+		arg.TokenHash,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+		arg.UsedAt,
+	)
+	return err
+}
+
+const getMagicLinkTokenByHash = `-- name: GetMagicLinkTokenByHash :one
+SELECT
+    id,
+    account_id,
+    token_hash,
+    created_at,
+    expires_at,
+    used_at
+FROM magic_link_tokens
+WHERE token_hash = $1
+`
+
+func (q *Queries) GetMagicLinkTokenByHash(ctx context.Context, tokenHash string) (MagicLinkToken, error) {
+	row := q.db.QueryRowContext(ctx, getMagicLinkTokenByHash, tokenHash)
+	var i MagicLinkToken
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.TokenHash,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.UsedAt,
+	)
+	return i, err
+}
+
+const markMagicLinkTokenUsed = `-- name: MarkMagicLinkTokenUsed :execrows
+UPDATE magic_link_tokens
+SET used_at = $2
+WHERE id = $1
+    AND used_at IS NULL
+`
+
+type MarkMagicLinkTokenUsedParams struct {
+	ID     uuid.UUID
+	UsedAt sql.NullTime
+}
+
+func (q *Queries) MarkMagicLinkTokenUsed(ctx context.Context, arg MarkMagicLinkTokenUsedParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, markMagicLinkTokenUsed, arg.ID, arg.UsedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -7,6 +7,7 @@ package sqlc
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -57,46 +58,10 @@ func (ns NullAccountStatus) Value() (driver.Value, error) {
 	return string(ns.AccountStatus), nil
 }
 
-type RoleType string
-
-const (
-	RoleTypeUser  RoleType = "user"
-	RoleTypeAdmin RoleType = "admin"
-)
-
-func (e *RoleType) Scan(src interface{}) error {
-	switch s := src.(type) {
-	case []byte:
-		*e = RoleType(s)
-	case string:
-		*e = RoleType(s)
-	default:
-		return fmt.Errorf("unsupported scan type for RoleType: %T", src)
-	}
-	return nil
-}
-
-type NullRoleType struct {
-	RoleType RoleType
-	Valid    bool // Valid is true if RoleType is not NULL
-}
-
-// Scan implements the Scanner interface.
-func (ns *NullRoleType) Scan(value interface{}) error {
-	if value == nil {
-		ns.RoleType, ns.Valid = "", false
-		return nil
-	}
-	ns.Valid = true
-	return ns.RoleType.Scan(value)
-}
-
-// Value implements the driver Valuer interface.
-func (ns NullRoleType) Value() (driver.Value, error) {
-	if !ns.Valid {
-		return nil, nil
-	}
-	return string(ns.RoleType), nil
+type AccessTokenDenylist struct {
+	DenylistKey string
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
 }
 
 type Account struct {
@@ -112,7 +77,119 @@ type Account struct {
 
 type AccountRole struct {
 	AccountID uuid.UUID
-	Role      RoleType
+	Role      string
+}
+
+type ApiKey struct {
+	ID         uuid.UUID
+	AccountID  uuid.UUID
+	Name       string
+	Prefix     string
+	SecretHash string
+	Scopes     string
+	CreatedAt  time.Time
+	ExpiresAt  sql.NullTime
+	LastUsedAt sql.NullTime
+	RevokedAt  sql.NullTime
+}
+
+type AuthEvent struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	EventType string
+	SessionID uuid.NullUUID
+	Ip        sql.NullString
+	UserAgent sql.NullString
+	CreatedAt time.Time
+	ActorID   uuid.NullUUID
+	Reason    sql.NullString
+	Outcome   string
+	Metadata  json.RawMessage
+}
+
+type EmailChangeToken struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	NewEmail  string
+	TokenHash string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	UsedAt    sql.NullTime
+}
+
+type EmailVerificationToken struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	TokenHash string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	UsedAt    sql.NullTime
+}
+
+type ExternalIdentity struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	Provider  string
+	Subject   string
+	Email     string
+	CreatedAt time.Time
+}
+
+type LoginFailure struct {
+	ID          int64
+	ThrottleKey string
+	FailedAt    time.Time
+}
+
+type MagicLinkToken struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	TokenHash string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	UsedAt    sql.NullTime
+}
+
+type OidcLoginState struct {
+	StateHash    string
+	Provider     string
+	CodeVerifier string
+	Nonce        string
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+}
+
+type OutboxMessage struct {
+	ID            uuid.UUID
+	RoutingKey    string
+	Payload       json.RawMessage
+	Attempts      int32
+	LastError     sql.NullString
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	SentAt        sql.NullTime
+}
+
+type PasswordResetToken struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	TokenHash string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	UsedAt    sql.NullTime
+}
+
+type Permission struct {
+	Name        string
+	Description string
+}
+
+type RecoveryCode struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	CodeHash  string
+	CreatedAt time.Time
+	UsedAt    sql.NullTime
 }
 
 type RefreshSession struct {
@@ -127,3 +204,37 @@ type RefreshSession struct {
 	Ip               pqtype.Inet
 	UserAgent        sql.NullString
 }
+
+type RequestCount struct {
+	ID          int64
+	LimitKey    string
+	RequestedAt time.Time
+}
+
+type Role struct {
+	Name        string
+	Description string
+}
+
+type RolePermission struct {
+	Role       string
+	Permission string
+}
+
+type SessionReportToken struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	SessionID uuid.UUID
+	TokenHash string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	UsedAt    sql.NullTime
+}
+
+type TotpFactor struct {
+	AccountID       uuid.UUID
+	EncryptedSecret string
+	CreatedAt       time.Time
+	ConfirmedAt     sql.NullTime
+	LastUsedStep    sql.NullInt64
+}
@@ -0,0 +1,104 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: password_reset_tokens.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createPasswordResetToken = `-- name: CreatePasswordResetToken :exec
+INSERT INTO password_reset_tokens (
+    id,
+    account_id,
+    token_hash,
+    created_at,
+    expires_at,
+    used_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+`
+
+type CreatePasswordResetTokenParams struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	TokenHash string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	UsedAt    sql.NullTime
+}
+
+func (q *Queries) CreatePasswordResetToken(ctx context.Context, arg CreatePasswordResetTokenParams) error {
+	_, err := q.db.ExecContext(ctx, createPasswordResetToken,
+		arg.ID,
+		arg.AccountID,
+		arg.TokenHash,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+		arg.UsedAt,
+	)
+	return err
+}
+
+const getPasswordResetTokenByHash = `-- name: GetPasswordResetTokenByHash :one
+SELECT
+    id,
+    account_id,
+    token_hash,
+    created_at,
+    expires_at,
+    used_at
+FROM password_reset_tokens
+WHERE token_hash = $1
+`
+
+func (q *Queries) GetPasswordResetTokenByHash(ctx context.Context, tokenHash string) (PasswordResetToken, error) {
+	row := q.db.QueryRowContext(ctx, getPasswordResetTokenByHash, tokenHash)
+	var i PasswordResetToken
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.TokenHash,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.UsedAt,
+	)
+	return i, err
+}
+
+const markPasswordResetTokenUsed = `-- name: MarkPasswordResetTokenUsed :one
+WITH consumed AS (
+    UPDATE password_reset_tokens prt
+    SET used_at = $1
+    WHERE prt.id = $2
+        AND prt.used_at IS NULL
+    RETURNING prt.id, prt.account_id
+), dropped AS (
+    DELETE FROM password_reset_tokens t
+    USING consumed c
+    WHERE t.account_id = c.account_id
+        AND t.id <> c.id
+        AND t.used_at IS NULL
+)
+SELECT count(*)::int AS consumed
+FROM consumed
+`
+
+type MarkPasswordResetTokenUsedParams struct {
+	UsedAt sql.NullTime
+	ID     uuid.UUID
+}
+
+func (q *Queries) MarkPasswordResetTokenUsed(ctx context.Context, arg MarkPasswordResetTokenUsedParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, markPasswordResetTokenUsed, arg.UsedAt, arg.ID)
+	var consumed int32
+	err := row.Scan(&consumed)
+	return consumed, err
+}
@@ -0,0 +1,77 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: recovery_codes.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createRecoveryCode = `-- name: CreateRecoveryCode :exec
+INSERT INTO recovery_codes (
+    id,
+    account_id,
+    code_hash,
+    created_at,
+    used_at
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+`
+
+type CreateRecoveryCodeParams struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	CodeHash  string
+	CreatedAt time.Time
+	UsedAt    sql.NullTime
+}
+
+func (q *Queries) CreateRecoveryCode(ctx context.Context, arg CreateRecoveryCodeParams) error {
+	_, err := q.db.ExecContext(ctx, createRecoveryCode,
+		arg.ID,
+		arg.AccountID,
+		arg.CodeHash,
+		arg.CreatedAt,
+		arg.UsedAt,
+	)
+	return err
+}
+
+const deleteAccountRecoveryCodes = `-- name: DeleteAccountRecoveryCodes :exec
+DELETE FROM recovery_codes
+WHERE account_id = $1
+`
+
+func (q *Queries) DeleteAccountRecoveryCodes(ctx context.Context, accountID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteAccountRecoveryCodes, accountID)
+	return err
+}
+
+const useRecoveryCode = `-- name: UseRecoveryCode :execrows
+UPDATE recovery_codes
+SET used_at = $3
+WHERE account_id = $1
+    AND code_hash = $2
+    AND used_at IS NULL
+`
+
+type UseRecoveryCodeParams struct {
+	AccountID uuid.UUID
+	CodeHash  string
+	UsedAt    sql.NullTime
+}
+
+func (q *Queries) UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, useRecoveryCode, arg.AccountID, arg.CodeHash, arg.UsedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
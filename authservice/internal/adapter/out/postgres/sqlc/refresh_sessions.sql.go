@@ -14,6 +14,42 @@ import (
 	"github.com/sqlc-dev/pqtype"
 )
 
+const countAccountRecentRefreshSessions = `-- name: CountAccountRecentRefreshSessions :one
+SELECT
+    count(*) AS total,
+    count(*) FILTER (
+        WHERE rs.ip IS NOT DISTINCT FROM $1::inet
+            AND rs.user_agent IS NOT DISTINCT FROM $2::text
+    ) AS same_device
+FROM refresh_sessions rs
+WHERE rs.account_id = $3
+    AND rs.created_at >= $4
+`
+
+type CountAccountRecentRefreshSessionsParams struct {
+	Ip           pqtype.Inet
+	UserAgent    sql.NullString
+	AccountID    uuid.UUID
+	CreatedAfter time.Time
+}
+
+type CountAccountRecentRefreshSessionsRow struct {
+	Total      int64
+	SameDevice int64
+}
+
+func (q *Queries) CountAccountRecentRefreshSessions(ctx context.Context, arg CountAccountRecentRefreshSessionsParams) (CountAccountRecentRefreshSessionsRow, error) {
+	row := q.db.QueryRowContext(ctx, countAccountRecentRefreshSessions,
+		arg.Ip,
+		arg.UserAgent,
+		arg.AccountID,
+		arg.CreatedAfter,
+	)
+	var i CountAccountRecentRefreshSessionsRow
+	err := row.Scan(&i.Total, &i.SameDevice)
+	return i, err
+}
+
 const createRefreshSession = `-- name: CreateRefreshSession :exec
 INSERT INTO refresh_sessions (
   id,
@@ -70,6 +106,31 @@ func (q *Queries) DeleteExpiredRefreshSessions(ctx context.Context, expiresAt ti
 	return err
 }
 
+const deleteStaleRefreshSessions = `-- name: DeleteStaleRefreshSessions :execrows
+DELETE FROM refresh_sessions
+WHERE id IN (
+    SELECT rs.id
+    FROM refresh_sessions rs
+    WHERE rs.expires_at <= $1
+        OR rs.revoked_at <= $2
+    LIMIT $3
+)
+`
+
+type DeleteStaleRefreshSessionsParams struct {
+	ExpiredBefore time.Time
+	RevokedBefore sql.NullTime
+	BatchSize     int32
+}
+
+func (q *Queries) DeleteStaleRefreshSessions(ctx context.Context, arg DeleteStaleRefreshSessionsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteStaleRefreshSessions, arg.ExpiredBefore, arg.RevokedBefore, arg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const getRefreshSessionByHash = `-- name: GetRefreshSessionByHash :one
 SELECT
     id,
@@ -216,12 +277,40 @@ func (q *Queries) RevokeAllAccountRefreshSessions(ctx context.Context, arg Revok
 	return err
 }
 
-const revokeRefreshSession = `-- name: RevokeRefreshSession :exec
+const revokeAllAccountRefreshSessionsExcept = `-- name: RevokeAllAccountRefreshSessionsExcept :exec
+UPDATE refresh_sessions
+SET
+    revoked_at = $3,
+    revoke_reason = $4
+WHERE account_id = $1
+    AND id <> $2
+    AND revoked_at IS NULL
+`
+
+type RevokeAllAccountRefreshSessionsExceptParams struct {
+	AccountID    uuid.UUID
+	ID           uuid.UUID
+	RevokedAt    sql.NullTime
+	RevokeReason sql.NullString
+}
+
+func (q *Queries) RevokeAllAccountRefreshSessionsExcept(ctx context.Context, arg RevokeAllAccountRefreshSessionsExceptParams) error {
+	_, err := q.db.ExecContext(ctx, revokeAllAccountRefreshSessionsExcept,
+		arg.AccountID,
+		arg.ID,
+		arg.RevokedAt,
+		arg.RevokeReason,
+	)
+	return err
+}
+
+const revokeRefreshSession = `-- name: RevokeRefreshSession :execrows
 UPDATE refresh_sessions
 SET
     revoked_at = $2,
     revoke_reason = $3
 WHERE id = $1
+    AND revoked_at IS NULL
 `
 
 type RevokeRefreshSessionParams struct {
@@ -230,9 +319,12 @@ type RevokeRefreshSessionParams struct {
 	RevokeReason sql.NullString
 }
 
-func (q *Queries) RevokeRefreshSession(ctx context.Context, arg RevokeRefreshSessionParams) error {
-	_, err := q.db.ExecContext(ctx, revokeRefreshSession, arg.ID, arg.RevokedAt, arg.RevokeReason)
-	return err
+func (q *Queries) RevokeRefreshSession(ctx context.Context, arg RevokeRefreshSessionParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, revokeRefreshSession, arg.ID, arg.RevokedAt, arg.RevokeReason)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
 const revokeRefreshSessionDescendants = `-- name: RevokeRefreshSessionDescendants :exec
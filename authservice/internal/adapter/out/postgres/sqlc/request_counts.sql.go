@@ -0,0 +1,93 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: request_counts.sql
+
+package sqlc
+
+import (
+	"context"
+	"time"
+)
+
+const createRequestCount = `-- name: CreateRequestCount :exec
+INSERT INTO request_counts (limit_key, requested_at)
+VALUES ($1, $2)
+`
+
+type CreateRequestCountParams struct {
+	LimitKey    string
+	RequestedAt time.Time
+}
+
+func (q *Queries) CreateRequestCount(ctx context.Context, arg CreateRequestCountParams) error {
+	_, err := q.db.ExecContext(ctx, createRequestCount, arg.LimitKey, arg.RequestedAt)
+	return err
+}
+
+const deleteExpiredRequestCounts = `-- name: DeleteExpiredRequestCounts :exec
+DELETE FROM request_counts
+WHERE limit_key = $1
+    AND requested_at < $2
+`
+
+type DeleteExpiredRequestCountsParams struct {
+	LimitKey      string
+	ExpiredBefore time.Time
+}
+
+func (q *Queries) DeleteExpiredRequestCounts(ctx context.Context, arg DeleteExpiredRequestCountsParams) error {
+	_, err := q.db.ExecContext(ctx, deleteExpiredRequestCounts, arg.LimitKey, arg.ExpiredBefore)
+	return err
+}
+
+const deleteStaleRequestCounts = `-- name: DeleteStaleRequestCounts :execrows
+DELETE FROM request_counts
+WHERE id IN (
+    SELECT rc.id
+    FROM request_counts rc
+    WHERE rc.requested_at < $1
+    LIMIT $2
+)
+`
+
+type DeleteStaleRequestCountsParams struct {
+	ExpiredBefore time.Time
+	BatchSize     int32
+}
+
+func (q *Queries) DeleteStaleRequestCounts(ctx context.Context, arg DeleteStaleRequestCountsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteStaleRequestCounts, arg.ExpiredBefore, arg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getRequestCounts = `-- name: GetRequestCounts :one
+SELECT
+    count(*)::int AS requests,
+    COALESCE(min(requested_at), 'epoch'::timestamptz)::timestamptz AS first_requested_at,
+    COALESCE(max(requested_at), 'epoch'::timestamptz)::timestamptz AS last_requested_at
+FROM request_counts
+WHERE limit_key = $1
+    AND requested_at >= $2
+`
+
+type GetRequestCountsParams struct {
+	LimitKey string
+	Since    time.Time
+}
+
+type GetRequestCountsRow struct {
+	Requests         int32
+	FirstRequestedAt time.Time
+	LastRequestedAt  time.Time
+}
+
+func (q *Queries) GetRequestCounts(ctx context.Context, arg GetRequestCountsParams) (GetRequestCountsRow, error) {
+	row := q.db.QueryRowContext(ctx, getRequestCounts, arg.LimitKey, arg.Since)
+	var i GetRequestCountsRow
+	err := row.Scan(&i.Requests, &i.FirstRequestedAt, &i.LastRequestedAt)
+	return i, err
+}
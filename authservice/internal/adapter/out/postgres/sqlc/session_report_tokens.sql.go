@@ -0,0 +1,99 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: session_report_tokens.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createSessionReportToken = `-- name: CreateSessionReportToken :exec
+INSERT INTO session_report_tokens (
+    id,
+    account_id,
+    session_id,
+    token_hash,
+    created_at,
+    expires_at,
+    used_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+`
+
+type CreateSessionReportTokenParams struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	SessionID uuid.UUID
+	TokenHash string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	UsedAt    sql.NullTime
+}
+
+func (q *Queries) CreateSessionReportToken(ctx context.Context, arg CreateSessionReportTokenParams) error {
+	_, err := q.db.ExecContext(ctx, createSessionReportToken,
+		arg.ID,
+		arg.AccountID,
+		arg.SessionID,
+		arg.TokenHash,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+		arg.UsedAt,
+	)
+	return err
+}
+
+const getSessionReportTokenByHash = `-- name: GetSessionReportTokenByHash :one
+SELECT
+    id,
+    account_id,
+    session_id,
+    token_hash,
+    created_at,
+    expires_at,
+    used_at
+FROM session_report_tokens
+WHERE token_hash = $1
+`
+
+func (q *Queries) GetSessionReportTokenByHash(ctx context.Context, tokenHash string) (SessionReportToken, error) {
+	row := q.db.QueryRowContext(ctx, getSessionReportTokenByHash, tokenHash)
+	var i SessionReportToken
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.SessionID,
+		&i.TokenHash,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.UsedAt,
+	)
+	return i, err
+}
+
+const markSessionReportTokenUsed = `-- name: MarkSessionReportTokenUsed :execrows
+UPDATE session_report_tokens
+SET used_at = $2
+WHERE id = $1
+    AND used_at IS NULL
+`
+
+type MarkSessionReportTokenUsedParams struct {
+	ID     uuid.UUID
+	UsedAt sql.NullTime
+}
+
+func (q *Queries) MarkSessionReportTokenUsed(ctx context.Context, arg MarkSessionReportTokenUsedParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, markSessionReportTokenUsed, arg.ID, arg.UsedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,115 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: totp_factors.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const confirmTOTPFactor = `-- name: ConfirmTOTPFactor :execrows
+UPDATE totp_factors
+SET confirmed_at = $2
+WHERE account_id = $1
+    AND confirmed_at IS NULL
+`
+
+type ConfirmTOTPFactorParams struct {
+	AccountID   uuid.UUID
+	ConfirmedAt sql.NullTime
+}
+
+func (q *Queries) ConfirmTOTPFactor(ctx context.Context, arg ConfirmTOTPFactorParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, confirmTOTPFactor, arg.AccountID, arg.ConfirmedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getTOTPFactorByAccountID = `-- name: GetTOTPFactorByAccountID :one
+SELECT
+    account_id,
+    encrypted_secret,
+    created_at,
+    confirmed_at,
+    last_used_step
+FROM totp_factors
+WHERE account_id = $1
+`
+
+func (q *Queries) GetTOTPFactorByAccountID(ctx context.Context, accountID uuid.UUID) (TotpFactor, error) {
+	row := q.db.QueryRowContext(ctx, getTOTPFactorByAccountID, accountID)
+	var i TotpFactor
+	err := row.Scan(
+		&i.AccountID,
+		&i.EncryptedSecret,
+		&i.CreatedAt,
+		&i.ConfirmedAt,
+		&i.LastUsedStep,
+	)
+	return i, err
+}
+
+const saveTOTPFactor = `-- name: SaveTOTPFactor :execrows
+INSERT INTO totp_factors (
+    account_id,
+    encrypted_secret,
+    created_at,
+    confirmed_at
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (account_id) DO UPDATE
+SET
+    encrypted_secret = EXCLUDED.encrypted_secret,
+    created_at = EXCLUDED.created_at,
+    confirmed_at = EXCLUDED.confirmed_at
+WHERE totp_factors.confirmed_at IS NULL
+`
+
+type SaveTOTPFactorParams struct {
+	AccountID       uuid.UUID
+	EncryptedSecret string
+	CreatedAt       time.Time
+	ConfirmedAt     sql.NullTime
+}
+
+func (q *Queries) SaveTOTPFactor(ctx context.Context, arg SaveTOTPFactorParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, saveTOTPFactor,
+		arg.AccountID,
+		arg.EncryptedSecret,
+		arg.CreatedAt,
+		arg.ConfirmedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const useTOTPFactorStep = `-- name: UseTOTPFactorStep :execrows
+UPDATE totp_factors
+SET last_used_step = $2
+WHERE account_id = $1
+    AND (last_used_step IS NULL OR last_used_step < $2)
+`
+
+type UseTOTPFactorStepParams struct {
+	AccountID    uuid.UUID
+	LastUsedStep sql.NullInt64
+}
+
+func (q *Queries) UseTOTPFactorStep(ctx context.Context, arg UseTOTPFactorStepParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, useTOTPFactorStep, arg.AccountID, arg.LastUsedStep)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
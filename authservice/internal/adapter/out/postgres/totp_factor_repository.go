@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/mapper"
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+	pkgpostgres "github.com/maket12/ads-service/pkg/postgres"
+
+	"github.com/google/uuid"
+)
+
+type TOTPFactorRepository struct {
+	q *sqlc.Queries
+}
+
+func NewTOTPFactorsRepository(pgClient *pkgpostgres.Client) *TOTPFactorRepository {
+	queries := sqlc.New(pgClient.DB)
+	return &TOTPFactorRepository{q: queries}
+}
+
+func (r *TOTPFactorRepository) Save(ctx context.Context, factor *model.TOTPFactor) error {
+	params := mapper.MapTOTPFactorToSQLCSave(factor)
+
+	affected, err := r.q.SaveTOTPFactor(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	// A confirmed factor is never overwritten
+	if affected == 0 {
+		return model.ErrTOTPAlreadyConfirmed
+	}
+
+	return nil
+}
+
+func (r *TOTPFactorRepository) GetByAccountID(ctx context.Context, accountID uuid.UUID) (*model.TOTPFactor, error) {
+	rawFactor, err := r.q.GetTOTPFactorByAccountID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, pkgerrs.NewObjectNotFoundError("totp_factor", accountID)
+		}
+		return nil, err
+	}
+	return mapper.MapSQLCToTOTPFactor(rawFactor), nil
+}
+
+func (r *TOTPFactorRepository) Confirm(ctx context.Context, factor *model.TOTPFactor) error {
+	var confirmedAt sql.NullTime
+	if factor.ConfirmedAt() != nil {
+		confirmedAt = sql.NullTime{Time: *factor.ConfirmedAt(), Valid: true}
+	}
+
+	params := sqlc.ConfirmTOTPFactorParams{
+		AccountID:   factor.AccountID(),
+		ConfirmedAt: confirmedAt,
+	}
+
+	affected, err := r.q.ConfirmTOTPFactor(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	// Somebody has already confirmed this factor
+	if affected == 0 {
+		return model.ErrTOTPAlreadyConfirmed
+	}
+
+	return nil
+}
+
+func (r *TOTPFactorRepository) UseStep(ctx context.Context, factor *model.TOTPFactor) error {
+	var step sql.NullInt64
+	if factor.LastUsedStep() != nil {
+		step = sql.NullInt64{Int64: *factor.LastUsedStep(), Valid: true}
+	}
+
+	params := sqlc.UseTOTPFactorStepParams{
+		AccountID:    factor.AccountID(),
+		LastUsedStep: step,
+	}
+
+	affected, err := r.q.UseTOTPFactorStep(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	// The same code or a later one has been accepted concurrently
+	if affected == 0 {
+		return model.ErrTOTPCodeReused
+	}
+
+	return nil
+}
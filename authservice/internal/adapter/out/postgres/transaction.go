@@ -0,0 +1,17 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/postgres/sqlc"
+	pkgpostgres "github.com/maket12/ads-service/pkg/postgres"
+)
+
+// Binds the queries to the transaction started by the transaction
+// manager, outside of a transaction they run on the pool as usual
+func queriesFor(ctx context.Context, q *sqlc.Queries) *sqlc.Queries {
+	if tx, ok := pkgpostgres.TxFromContext(ctx); ok {
+		return q.WithTx(tx)
+	}
+	return q
+}
@@ -0,0 +1,100 @@
+package rabbitmq
+
+import (
+	"context"
+	"time"
+
+	"github.com/maket12/ads-service/pkg/outbox"
+	"github.com/maket12/ads-service/pkg/rabbitmq"
+
+	"github.com/google/uuid"
+)
+
+// AccountOutbox stores account events for the outbox relay, which
+// sends them to the exchange of the publisher config
+type AccountOutbox struct {
+	cfg   *PublisherConfig
+	store *outbox.PostgresStore
+}
+
+func NewAccountOutbox(cfg *PublisherConfig, store *outbox.PostgresStore) *AccountOutbox {
+	return &AccountOutbox{
+		cfg:   cfg,
+		store: store,
+	}
+}
+
+func (o *AccountOutbox) AddAccountCreated(ctx context.Context, accountID uuid.UUID) error {
+	event := rabbitmq.AccountCreatedEvent{AccountID: accountID}
+	return o.store.Add(ctx, o.cfg.RoutingKey, event)
+}
+
+func (o *AccountOutbox) AddSessionCompromised(
+	ctx context.Context, accountID, sessionID uuid.UUID, ip, userAgent *string,
+) error {
+	event := rabbitmq.SessionCompromisedEvent{
+		AccountID:  accountID,
+		SessionID:  sessionID,
+		IP:         ip,
+		UserAgent:  userAgent,
+		DetectedAt: time.Now(),
+	}
+	return o.store.Add(ctx, rabbitmq.SessionCompromisedRoutingKey, event)
+}
+
+func (o *AccountOutbox) AddSessionAnomaly(
+	ctx context.Context, accountID, sessionID uuid.UUID,
+	previousIP, previousUserAgent, ip, userAgent *string,
+) error {
+	event := rabbitmq.SessionAnomalyEvent{
+		AccountID:         accountID,
+		SessionID:         sessionID,
+		PreviousIP:        previousIP,
+		PreviousUserAgent: previousUserAgent,
+		IP:                ip,
+		UserAgent:         userAgent,
+		DetectedAt:        time.Now(),
+	}
+	return o.store.Add(ctx, rabbitmq.SessionAnomalyRoutingKey, event)
+}
+
+func (o *AccountOutbox) AddNewDeviceLogin(
+	ctx context.Context, accountID, sessionID uuid.UUID,
+	ip *string, browser, os string,
+) error {
+	event := rabbitmq.NewDeviceLoginEvent{
+		AccountID:  accountID,
+		SessionID:  sessionID,
+		IP:         ip,
+		Browser:    browser,
+		OS:         os,
+		LoggedInAt: time.Now(),
+	}
+	return o.store.Add(ctx, rabbitmq.NewDeviceLoginRoutingKey, event)
+}
+
+func (o *AccountOutbox) AddAccountBlocked(ctx context.Context, accountID uuid.UUID, reason string) error {
+	event := rabbitmq.AccountBlockedEvent{
+		AccountID: accountID,
+		Reason:    reason,
+		BlockedAt: time.Now(),
+	}
+	return o.store.Add(ctx, rabbitmq.AccountBlockedRoutingKey, event)
+}
+
+func (o *AccountOutbox) AddAccountUnblocked(ctx context.Context, accountID uuid.UUID) error {
+	event := rabbitmq.AccountUnblockedEvent{
+		AccountID:   accountID,
+		UnblockedAt: time.Now(),
+	}
+	return o.store.Add(ctx, rabbitmq.AccountUnblockedRoutingKey, event)
+}
+
+func (o *AccountOutbox) AddAccountDeleted(ctx context.Context, accountID uuid.UUID, reason string) error {
+	event := rabbitmq.AccountDeletedEvent{
+		AccountID: accountID,
+		Reason:    reason,
+		DeletedAt: time.Now(),
+	}
+	return o.store.Add(ctx, rabbitmq.AccountDeletedRoutingKey, event)
+}
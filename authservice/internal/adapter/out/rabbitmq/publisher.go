@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/maket12/ads-service/pkg/rabbitmq"
 
@@ -58,9 +59,20 @@ func NewAccountPublisher(
 	}, nil
 }
 
-func (p *AccountPublisher) PublishAccountCreate(ctx context.Context, accountID uuid.UUID) error {
-	event := rabbitmq.AccountCreatedEvent{AccountID: accountID}
+func (p *AccountPublisher) PublishAccountEmailChanged(
+	ctx context.Context, accountID uuid.UUID, oldEmail, newEmail string,
+) error {
+	event := rabbitmq.AccountEmailChangedEvent{
+		AccountID: accountID,
+		OldEmail:  oldEmail,
+		NewEmail:  newEmail,
+		ChangedAt: time.Now(),
+	}
+	return p.publish(ctx, rabbitmq.AccountEmailChangedRoutingKey, event)
+}
 
+// Marshals the event and sends it to the exchange with the given routing key
+func (p *AccountPublisher) publish(ctx context.Context, routingKey string, event any) error {
 	body, err := json.Marshal(event)
 	if err != nil {
 		return fmt.Errorf("failed to marshal event: %w", err)
@@ -69,7 +81,7 @@ func (p *AccountPublisher) PublishAccountCreate(ctx context.Context, accountID u
 	if err = p.channel.PublishWithContext(
 		ctx,
 		p.cfg.Exchange,
-		p.cfg.RoutingKey,
+		routingKey,
 		false,
 		false,
 		amqp.Publishing{
@@ -0,0 +1,74 @@
+package totp_test
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/adapter/out/totp"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test vector from RFC 6238 Appendix B (SHA1, last 6 digits)
+func TestGenerator_Code_RFCVector(t *testing.T) {
+	t.Parallel()
+
+	var (
+		gen    = totp.NewGenerator("Ads")
+		secret = base32.StdEncoding.WithPadding(base32.NoPadding).
+			EncodeToString([]byte("12345678901234567890"))
+	)
+
+	code, err := gen.Code(secret, time.Unix(59, 0))
+	require.NoError(t, err)
+	assert.Equal(t, "287082", code)
+
+	code, err = gen.Code(secret, time.Unix(1111111109, 0))
+	require.NoError(t, err)
+	assert.Equal(t, "081804", code)
+}
+
+func TestGenerator_Validate(t *testing.T) {
+	t.Parallel()
+
+	gen := totp.NewGenerator("Ads")
+	secret, err := gen.GenerateSecret()
+	require.NoError(t, err)
+
+	now := time.Now()
+	code, err := gen.Code(secret, now)
+	require.NoError(t, err)
+
+	step, ok := gen.Validate(secret, code, now)
+	assert.True(t, ok)
+	assert.Equal(t, now.Unix()/30, step)
+
+	neighbourStep, ok := gen.Validate(secret, code, now.Add(30*time.Second))
+	assert.True(t, ok, "neighbour step is accepted")
+	assert.Equal(t, step, neighbourStep, "the step of the code itself is returned")
+
+	_, ok = gen.Validate(secret, code, now.Add(2*time.Minute))
+	assert.False(t, ok)
+	_, ok = gen.Validate(secret, "12345", now)
+	assert.False(t, ok)
+	_, ok = gen.Validate("not base32!", code, now)
+	assert.False(t, ok)
+}
+
+func TestGenerator_URI(t *testing.T) {
+	t.Parallel()
+
+	gen := totp.NewGenerator("Ads")
+	uri := gen.URI("SECRET", "user@email.com")
+
+	u, err := url.Parse(uri)
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/Ads:user@email.com", u.Path)
+	assert.Equal(t, "SECRET", u.Query().Get("secret"))
+	assert.Equal(t, "Ads", u.Query().Get("issuer"))
+}
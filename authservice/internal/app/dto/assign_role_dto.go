@@ -3,6 +3,7 @@ package dto
 import "github.com/google/uuid"
 
 type AssignRoleInput struct {
+	AdminID   uuid.UUID
 	AccountID uuid.UUID
 	Role      string
 }
@@ -0,0 +1,14 @@
+package dto
+
+import "github.com/google/uuid"
+
+type ChangePasswordInput struct {
+	AccountID    uuid.UUID
+	RefreshToken string // identifies the caller's session, it survives the change
+	OldPassword  string
+	NewPassword  string
+}
+
+type ChangePasswordOutput struct {
+	Changed bool
+}
@@ -0,0 +1,15 @@
+package dto
+
+import "time"
+
+type CleanupRefreshSessionsInput struct {
+	Now time.Time
+}
+
+type CleanupRefreshSessionsOutput struct {
+	Deleted         int64
+	DeniedDeleted   int64 // expired access token denylist entries
+	FailuresDeleted int64 // login failures older than every throttle window
+	RequestsDeleted int64 // limited requests older than every limit window
+	Skipped         bool  // another replica holds the lock
+}
@@ -0,0 +1,12 @@
+package dto
+
+import "github.com/google/uuid"
+
+type ConfirmTOTPInput struct {
+	AccountID uuid.UUID
+	Code      string
+}
+
+type ConfirmTOTPOutput struct {
+	RecoveryCodes []string // shown once, only hashes are stored
+}
@@ -0,0 +1,22 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateAPIKeyInput struct {
+	AccountID uuid.UUID
+	Name      string
+	Scopes    []string
+	ExpiresAt *time.Time // optional, the key never expires without it
+
+	CallerPermissions []string // what the credential of the request may do
+}
+
+type CreateAPIKeyOutput struct {
+	KeyID  uuid.UUID
+	Key    string // shown once, only its hash is stored
+	Prefix string
+}
@@ -0,0 +1,13 @@
+package dto
+
+import "github.com/google/uuid"
+
+type DeleteAccountInput struct {
+	ActorID   uuid.UUID // admin who performs the action
+	AccountID uuid.UUID
+	Reason    string
+}
+
+type DeleteAccountOutput struct {
+	Deleted bool
+}
@@ -0,0 +1,21 @@
+package dto
+
+import "github.com/google/uuid"
+
+type RequestEmailChangeInput struct {
+	AccountID uuid.UUID
+	Password  string
+	NewEmail  string
+}
+
+type RequestEmailChangeOutput struct {
+	Sent bool
+}
+
+type ConfirmEmailChangeInput struct {
+	Token string
+}
+
+type ConfirmEmailChangeOutput struct {
+	Changed bool
+}
@@ -0,0 +1,12 @@
+package dto
+
+import "github.com/google/uuid"
+
+type EnrollTOTPInput struct {
+	AccountID uuid.UUID
+}
+
+type EnrollTOTPOutput struct {
+	Secret string // base32, for manual entry
+	URI    string // otpauth:// link for a QR code
+}
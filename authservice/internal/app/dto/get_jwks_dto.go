@@ -0,0 +1,20 @@
+package dto
+
+type GetJWKSInput struct{}
+
+// JWK is a public key in the RFC 7517 JSON Web Key representation,
+// N/E are filled for RSA keys and Curve/X for OKP keys
+type JWK struct {
+	KeyID     string
+	KeyType   string
+	Algorithm string
+	Use       string
+	N         string
+	E         string
+	Curve     string
+	X         string
+}
+
+type GetJWKSOutput struct {
+	Keys []JWK
+}
@@ -0,0 +1,12 @@
+package dto
+
+import "github.com/google/uuid"
+
+type GetUserRolesInput struct {
+	AccountID uuid.UUID
+}
+
+type GetUserRolesOutput struct {
+	Roles       []string
+	Permissions []string
+}
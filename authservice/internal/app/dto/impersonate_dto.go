@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ImpersonateInput struct {
+	ActorID   uuid.UUID // admin who acts on behalf of the account
+	AccountID uuid.UUID
+	Reason    string
+}
+
+// ImpersonateOutput has no refresh token, the access is over when it expires
+type ImpersonateOutput struct {
+	AccessToken string
+	ExpiresAt   time.Time
+}
@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IntrospectTokenInput carries the credential of the calling client and
+// the token to check, the hint is "access_token" or "refresh_token"
+type IntrospectTokenInput struct {
+	ClientID      string
+	ClientSecret  string
+	Token         string
+	TokenTypeHint string
+}
+
+// IntrospectTokenOutput describes an active token, for an inactive one
+// only Active is set so nothing is revealed about it
+type IntrospectTokenOutput struct {
+	Active    bool
+	TokenType string
+	AccountID uuid.UUID
+	Roles     []string
+	SessionID *uuid.UUID
+	ActorID   *uuid.UUID
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
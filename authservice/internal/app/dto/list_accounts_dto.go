@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ListAccountsInput filters are optional, accounts are sorted by created_at,
+// last_login_at or email (created_at by default). A zero limit means the
+// default page size. A cursor is only valid for the sort it was issued for
+type ListAccountsInput struct {
+	Status        *string
+	Role          *string
+	EmailVerified *bool
+	EmailPrefix   *string
+	CreatedFrom   *time.Time
+	CreatedTo     *time.Time
+	LastLoginFrom *time.Time
+	LastLoginTo   *time.Time
+	SortBy        *string
+	SortDesc      bool
+	Cursor        *string
+	Limit         int
+}
+
+type AccountInfo struct {
+	AccountID      uuid.UUID
+	Email          string
+	Status         string
+	EmailVerified  bool
+	Roles          []string
+	ActiveSessions int
+	CreatedAt      time.Time
+	LastLoginAt    *time.Time
+}
+
+type ListAccountsOutput struct {
+	Accounts   []AccountInfo
+	NextCursor *string // nil on the last page
+}
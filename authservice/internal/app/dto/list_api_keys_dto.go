@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ListAPIKeysInput struct {
+	AccountID uuid.UUID
+}
+
+type APIKeyInfo struct {
+	KeyID      uuid.UUID
+	Name       string
+	Prefix     string
+	Scopes     []string
+	CreatedAt  time.Time
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+type ListAPIKeysOutput struct {
+	Keys []APIKeyInfo
+}
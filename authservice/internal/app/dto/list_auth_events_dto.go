@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ListAuthEventsInput filters are optional, a zero limit means the default page size
+type ListAuthEventsInput struct {
+	AccountID *uuid.UUID
+	EventType *string
+	Outcome   *string
+	From      *time.Time
+	To        *time.Time
+	Cursor    *string
+	Limit     int
+}
+
+type AuthEventInfo struct {
+	EventID   uuid.UUID
+	AccountID uuid.UUID
+	EventType string
+	Outcome   string
+	SessionID *uuid.UUID
+	IP        *string
+	UserAgent *string
+	ActorID   *uuid.UUID
+	Reason    *string
+	Metadata  map[string]string
+	CreatedAt time.Time
+}
+
+type ListAuthEventsOutput struct {
+	Events     []AuthEventInfo
+	NextCursor *string // nil on the last page
+}
@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ListSessionsInput struct {
+	AccountID    uuid.UUID
+	RefreshToken *string // optional, used to flag the caller's session
+}
+
+type SessionInfo struct {
+	SessionID uuid.UUID
+	IP        *string
+	UserAgent *string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	Current   bool
+}
+
+type ListSessionsOutput struct {
+	Sessions []SessionInfo
+}
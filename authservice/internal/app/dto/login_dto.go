@@ -7,7 +7,11 @@ type LoginInput struct {
 	UserAgent *string
 }
 
+// LoginOutput carries either the token pair or, when the account
+// has 2FA enabled, a challenge token for VerifyMFA
 type LoginOutput struct {
 	AccessToken  string
 	RefreshToken string
+	MFARequired  bool
+	MFAToken     string
 }
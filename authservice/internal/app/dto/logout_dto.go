@@ -2,6 +2,7 @@ package dto
 
 type LogoutInput struct {
 	RefreshToken string
+	AccessToken  *string // optional, revoked right away when given
 }
 
 type LogoutOutput struct {
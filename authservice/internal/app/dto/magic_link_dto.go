@@ -0,0 +1,24 @@
+package dto
+
+type RequestMagicLinkInput struct {
+	Email string
+}
+
+type RequestMagicLinkOutput struct {
+	Sent bool
+}
+
+type ConsumeMagicLinkInput struct {
+	Token     string
+	IP        *string
+	UserAgent *string
+}
+
+// ConsumeMagicLinkOutput is the same as LoginOutput,
+// 2FA still applies to sign-in links
+type ConsumeMagicLinkOutput struct {
+	AccessToken  string
+	RefreshToken string
+	MFARequired  bool
+	MFAToken     string
+}
@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotifyNewDeviceLoginInput struct {
+	AccountID  uuid.UUID
+	SessionID  uuid.UUID
+	IP         *string
+	Browser    string
+	OS         string
+	LoggedInAt time.Time
+}
+
+type NotifyNewDeviceLoginOutput struct {
+	Sent bool
+}
+
+type ReportSessionInput struct {
+	Token     string
+	IP        *string
+	UserAgent *string
+}
+
+type ReportSessionOutput struct {
+	Revoked bool
+}
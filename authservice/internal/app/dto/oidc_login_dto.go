@@ -0,0 +1,30 @@
+package dto
+
+type StartOIDCLoginInput struct {
+	Provider string
+}
+
+// StartOIDCLoginOutput the client redirects the user to AuthURL and
+// passes State back together with the code from the callback
+type StartOIDCLoginOutput struct {
+	AuthURL string
+	State   string
+}
+
+type CompleteOIDCLoginInput struct {
+	Provider  string
+	State     string
+	Code      string
+	IP        *string
+	UserAgent *string
+}
+
+// CompleteOIDCLoginOutput same as LoginOutput, AccountCreated is set
+// when the login has registered a new account
+type CompleteOIDCLoginOutput struct {
+	AccessToken    string
+	RefreshToken   string
+	MFARequired    bool
+	MFAToken       string
+	AccountCreated bool
+}
@@ -0,0 +1,10 @@
+package dto
+
+type RequestPasswordResetInput struct {
+	Email string
+	IP    *string
+}
+
+type RequestPasswordResetOutput struct {
+	Sent bool
+}
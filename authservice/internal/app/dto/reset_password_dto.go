@@ -0,0 +1,10 @@
+package dto
+
+type ResetPasswordInput struct {
+	Token       string
+	NewPassword string
+}
+
+type ResetPasswordOutput struct {
+	Reset bool
+}
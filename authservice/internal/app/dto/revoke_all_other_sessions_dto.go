@@ -0,0 +1,12 @@
+package dto
+
+import "github.com/google/uuid"
+
+type RevokeAllOtherSessionsInput struct {
+	AccountID    uuid.UUID
+	RefreshToken string // identifies the caller's session, it stays active
+}
+
+type RevokeAllOtherSessionsOutput struct {
+	Revoked bool
+}
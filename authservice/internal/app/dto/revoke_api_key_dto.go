@@ -0,0 +1,12 @@
+package dto
+
+import "github.com/google/uuid"
+
+type RevokeAPIKeyInput struct {
+	AccountID uuid.UUID
+	KeyID     uuid.UUID
+}
+
+type RevokeAPIKeyOutput struct {
+	Revoked bool
+}
@@ -0,0 +1,13 @@
+package dto
+
+import "github.com/google/uuid"
+
+type RevokeRoleInput struct {
+	AdminID   uuid.UUID
+	AccountID uuid.UUID
+	Role      string
+}
+
+type RevokeRoleOutput struct {
+	Revoked bool
+}
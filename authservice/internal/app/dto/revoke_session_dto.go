@@ -0,0 +1,12 @@
+package dto
+
+import "github.com/google/uuid"
+
+type RevokeSessionInput struct {
+	AccountID uuid.UUID
+	SessionID uuid.UUID
+}
+
+type RevokeSessionOutput struct {
+	Revoked bool
+}
@@ -0,0 +1,11 @@
+package dto
+
+import "github.com/google/uuid"
+
+type SendVerificationEmailInput struct {
+	AccountID uuid.UUID
+}
+
+type SendVerificationEmailOutput struct {
+	Sent bool
+}
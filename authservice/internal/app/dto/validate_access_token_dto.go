@@ -7,6 +7,8 @@ type ValidateAccessTokenInput struct {
 }
 
 type ValidateAccessTokenOutput struct {
-	AccountID uuid.UUID
-	Role      string
+	AccountID   uuid.UUID
+	Roles       []string
+	Permissions []string
+	ActorID     *uuid.UUID // set when an admin acts on behalf of the account
 }
@@ -0,0 +1,14 @@
+package dto
+
+import "github.com/google/uuid"
+
+type ValidateAPIKeyInput struct {
+	Key string
+}
+
+type ValidateAPIKeyOutput struct {
+	AccountID   uuid.UUID
+	KeyID       uuid.UUID
+	Roles       []string
+	Permissions []string
+}
@@ -0,0 +1,13 @@
+package dto
+
+type VerifyMFAInput struct {
+	MFAToken  string
+	Code      string // TOTP code or one of the recovery codes
+	IP        *string
+	UserAgent *string
+}
+
+type VerifyMFAOutput struct {
+	AccessToken  string
+	RefreshToken string
+}
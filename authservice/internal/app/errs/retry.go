@@ -0,0 +1,24 @@
+package errs
+
+import "time"
+
+// RetryAfterError tells the caller when the throttled action may be retried
+type RetryAfterError struct {
+	Public     error
+	RetryAfter time.Duration
+}
+
+func (e *RetryAfterError) Error() string {
+	return e.Public.Error()
+}
+
+func (e *RetryAfterError) Unwrap() error {
+	return e.Public
+}
+
+func RetryAfter(public error, retryAfter time.Duration) error {
+	return &RetryAfterError{
+		Public:     public,
+		RetryAfter: retryAfter,
+	}
+}
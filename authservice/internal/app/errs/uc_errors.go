@@ -10,9 +10,63 @@ var (
 	ErrCannotLogin         = errors.New("account either is blocked or not exists")
 	ErrInvalidAccountID    = errors.New("account id is invalid or account with this id not found")
 	ErrCannotAssign        = errors.New("account can not be assigned to this role")
+	ErrCannotRevokeRole    = errors.New("role is not assigned or is the last role of the account")
 	ErrInvalidRefreshToken = errors.New("refresh token is invalid or not found")
 	ErrCannotRevoke        = errors.New("refresh token has been already rotated or invalid")
 	ErrInvalidAccessToken  = errors.New("access token is invalid")
+	ErrRefreshTokenReused  = errors.New("refresh token has been reused, the whole session chain is revoked")
+	ErrInvalidSessionID    = errors.New("session id is invalid or session with this id not found")
+
+	ErrEmailAlreadyVerified     = errors.New("email has been already verified")
+	ErrInvalidVerificationToken = errors.New("verification token is invalid, expired or already used")
+
+	ErrWeakPassword      = errors.New("password does not satisfy the password policy")
+	ErrInvalidResetToken = errors.New("reset token is invalid, expired or already used")
+	ErrWrongPassword     = errors.New("current password is incorrect")
+
+	ErrPermissionDenied        = errors.New("not enough permissions for this action")
+	ErrReasonRequired          = errors.New("reason is required")
+	ErrCannotModerateSelf      = errors.New("admin can not moderate own account")
+	ErrCannotModeratePeer      = errors.New("account with the same or a higher role can not be moderated")
+	ErrInvalidStatusTransition = errors.New("account status can not be changed this way")
+	ErrCannotImpersonateSelf   = errors.New("admin can not impersonate own account")
+	ErrImpersonationForbidden  = errors.New("action is not allowed while impersonating an account")
+	ErrAPIKeyForbidden         = errors.New("action is not allowed with an api key")
+
+	ErrTooManyLoginAttempts = errors.New("too many failed login attempts, try again later")
+	ErrTooManyMagicLinks    = errors.New("too many sign-in links requested, try again later")
+	ErrTooManyVerifications = errors.New("too many verification emails requested, try again later")
+	ErrTooManyResetRequests = errors.New("too many password reset links requested, try again later")
+	ErrTooManySessions      = errors.New("active sessions limit is reached, sign out on another device first")
+	ErrInvalidMagicLink     = errors.New("sign-in link is invalid, expired or already used")
+
+	ErrInvalidSessionReport = errors.New("session report link is invalid, expired or already used")
+
+	ErrMFAAlreadyEnabled = errors.New("two-factor authentication has been already enabled")
+	ErrMFANotEnrolled    = errors.New("two-factor authentication is not enrolled")
+	ErrInvalidMFAToken   = errors.New("mfa token is invalid or expired")
+	ErrInvalidMFACode    = errors.New("one-time code is invalid")
+
+	ErrUnknownOIDCProvider  = errors.New("identity provider is not configured")
+	ErrInvalidOIDCState     = errors.New("login state is invalid, expired or already used")
+	ErrOIDCAuthFailed       = errors.New("identity provider rejected the login")
+	ErrOIDCEmailNotVerified = errors.New("identity provider did not return a verified email")
+	ErrCannotLinkIdentity   = errors.New("account with this email exists, verify the email before signing in with a provider")
+
+	ErrInvalidAPIKey        = errors.New("api key is invalid, expired or revoked")
+	ErrInvalidAPIKeyID      = errors.New("api key id is invalid or api key with this id not found")
+	ErrInvalidScope         = errors.New("api key scopes must be a subset of the account permissions")
+	ErrAPIKeyAlreadyRevoked = errors.New("api key has been already revoked")
+
+	ErrInvalidClient = errors.New("client is unknown or its secret is wrong")
+
+	ErrSameEmail               = errors.New("new email matches the current one")
+	ErrInvalidEmailChangeToken = errors.New("email change token is invalid, expired or already used")
+
+	ErrInvalidCursor      = errors.New("page cursor is invalid")
+	ErrInvalidEventFilter = errors.New("auth event filter is invalid")
+
+	ErrInvalidAccountFilter = errors.New("account filter or sort is invalid")
 
 	ErrInvalidInput = errors.New("invalid input") // for rich models
 )
@@ -25,6 +79,15 @@ var (
 	ErrGenerateAccessToken  = errors.New("failed to generate access token")
 	ErrGenerateRefreshToken = errors.New("failed to generate refresh token")
 	ErrPublishEvent         = errors.New("failed to publish event")
+	ErrGenerateOneTimeToken = errors.New("failed to generate one-time token")
+	ErrSendEmail            = errors.New("failed to send email")
+	ErrGetPublicKeys        = errors.New("failed to get public signing keys")
+	ErrAcquireLock          = errors.New("failed to acquire lock")
+	ErrGenerateMFAToken     = errors.New("failed to generate mfa token")
+	ErrGenerateTOTPSecret   = errors.New("failed to generate totp secret")
+	ErrEncryptSecret        = errors.New("failed to encrypt secret")
+	ErrDecryptSecret        = errors.New("failed to decrypt secret")
+	ErrGenerateAPIKey       = errors.New("failed to generate api key")
 )
 
 /*
@@ -42,4 +105,67 @@ var (
 	ErrCreateRefreshSessionDB  = errors.New("failed to create refresh session using db")
 	ErrGetRefreshSessionByIDDB = errors.New("failed to get refresh session by ID using db")
 	ErrRevokeRefreshSessionDB  = errors.New("failed to revoke refresh session using db")
+	ErrListRefreshSessionsDB   = errors.New("failed to list refresh sessions using db")
+	ErrDeleteRefreshSessionsDB = errors.New("failed to delete refresh sessions using db")
+
+	ErrCreateVerificationTokenDB = errors.New("failed to create email verification token using db")
+	ErrGetVerificationTokenDB    = errors.New("failed to get email verification token using db")
+	ErrUseVerificationTokenDB    = errors.New("failed to mark email verification token as used using db")
+	ErrDeleteVerificationTokenDB = errors.New("failed to delete email verification tokens using db")
+
+	ErrCreateResetTokenDB = errors.New("failed to create password reset token using db")
+	ErrGetResetTokenDB    = errors.New("failed to get password reset token using db")
+	ErrUseResetTokenDB    = errors.New("failed to mark password reset token as used using db")
+
+	ErrCreateEmailChangeTokenDB = errors.New("failed to create email change token using db")
+	ErrGetEmailChangeTokenDB    = errors.New("failed to get email change token using db")
+	ErrConfirmEmailChangeDB     = errors.New("failed to confirm email change using db")
+
+	ErrCreateMagicLinkTokenDB = errors.New("failed to create magic link token using db")
+	ErrGetMagicLinkTokenDB    = errors.New("failed to get magic link token using db")
+	ErrUseMagicLinkTokenDB    = errors.New("failed to mark magic link token as used using db")
+
+	ErrCreateSessionReportTokenDB = errors.New("failed to create session report token using db")
+	ErrGetSessionReportTokenDB    = errors.New("failed to get session report token using db")
+	ErrUseSessionReportTokenDB    = errors.New("failed to mark session report token as used using db")
+
+	ErrCreateAuthEventDB = errors.New("failed to create auth event using db")
+
+	ErrTransactionDB    = errors.New("failed to run transaction using db")
+	ErrAddOutboxEventDB = errors.New("failed to add event to outbox using db")
+
+	ErrGetLoginAttemptsDB     = errors.New("failed to get login attempts using db")
+	ErrRecordLoginAttemptDB   = errors.New("failed to record login attempt using db")
+	ErrClearLoginAttemptsDB   = errors.New("failed to clear login attempts using db")
+	ErrCleanupLoginAttemptsDB = errors.New("failed to delete expired login attempts using db")
+
+	ErrCountRequestsDB   = errors.New("failed to count requests using db")
+	ErrAddRequestDB      = errors.New("failed to record request using db")
+	ErrCleanupRequestsDB = errors.New("failed to delete expired requests using db")
+
+	ErrGetTOTPFactorDB     = errors.New("failed to get totp factor using db")
+	ErrSaveTOTPFactorDB    = errors.New("failed to save totp factor using db")
+	ErrConfirmTOTPFactorDB = errors.New("failed to confirm totp factor using db")
+	ErrUseTOTPStepDB       = errors.New("failed to store used totp step using db")
+	ErrSaveRecoveryCodesDB = errors.New("failed to save recovery codes using db")
+	ErrUseRecoveryCodeDB   = errors.New("failed to use recovery code using db")
+
+	ErrCreateOIDCStateDB        = errors.New("failed to create oidc login state using db")
+	ErrConsumeOIDCStateDB       = errors.New("failed to consume oidc login state using db")
+	ErrGetExternalIdentityDB    = errors.New("failed to get external identity using db")
+	ErrCreateExternalIdentityDB = errors.New("failed to create external identity using db")
+
+	ErrCreateAPIKeyDB   = errors.New("failed to create api key using db")
+	ErrGetAPIKeyDB      = errors.New("failed to get api key using db")
+	ErrListAPIKeysDB    = errors.New("failed to list api keys using db")
+	ErrRevokeAPIKeyDB   = errors.New("failed to revoke api key using db")
+	ErrMarkAPIKeyUsedDB = errors.New("failed to mark api key as used using db")
+
+	ErrDenyAccessTokenDB = errors.New("failed to add access token to denylist using db")
+	ErrCheckDenylistDB   = errors.New("failed to check access token denylist using db")
+	ErrCleanupDenylistDB = errors.New("failed to delete expired denylist entries using db")
+
+	ErrListAuthEventsDB = errors.New("failed to list auth events using db")
+
+	ErrListAccountsDB = errors.New("failed to list accounts using db")
 )
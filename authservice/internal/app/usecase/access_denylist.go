@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+// Revokes the access tokens already issued for the sessions. No token
+// outlives accessTTL, so the entries are kept exactly that long
+func denySessions(
+	ctx context.Context, denylist port.AccessTokenDenylist,
+	sessions []*model.RefreshSession, exceptID uuid.UUID, accessTTL time.Duration,
+) error {
+	expiresAt := time.Now().Add(accessTTL)
+	for _, session := range sessions {
+		if session.ID() == exceptID {
+			continue
+		}
+		if err := denylist.Add(
+			ctx, model.DenylistKeyForSession(session.ID()), expiresAt,
+		); err != nil {
+			return ucerrs.Wrap(ucerrs.ErrDenyAccessTokenDB, err)
+		}
+	}
+	return nil
+}
+
+// Revokes every access token of the account, the sessions are listed
+// before they are revoked since revoked ones are not listed anymore
+func denyAccountSessions(
+	ctx context.Context, refreshSession port.RefreshSessionRepository,
+	denylist port.AccessTokenDenylist,
+	accountID, exceptID uuid.UUID, accessTTL time.Duration,
+) error {
+	sessions, err := refreshSession.ListActiveForAccount(ctx, accountID)
+	if err != nil {
+		return ucerrs.Wrap(ucerrs.ErrListRefreshSessionsDB, err)
+	}
+	return denySessions(ctx, denylist, sessions, exceptID, accessTTL)
+}
+
+// Revokes the session with its descendants and returns the ones that were
+// still active, they are found by comparing the active sessions around it
+func revokeSessionChain(
+	ctx context.Context, refreshSession port.RefreshSessionRepository,
+	accountID, sessionID uuid.UUID, reason string,
+) ([]*model.RefreshSession, error) {
+	before, err := refreshSession.ListActiveForAccount(ctx, accountID)
+	if err != nil {
+		return nil, ucerrs.Wrap(ucerrs.ErrListRefreshSessionsDB, err)
+	}
+
+	if err := refreshSession.RevokeDescendants(ctx, sessionID, &reason); err != nil {
+		return nil, ucerrs.Wrap(ucerrs.ErrRevokeRefreshSessionDB, err)
+	}
+
+	after, err := refreshSession.ListActiveForAccount(ctx, accountID)
+	if err != nil {
+		return nil, ucerrs.Wrap(ucerrs.ErrListRefreshSessionsDB, err)
+	}
+
+	var stillActive = make(map[uuid.UUID]struct{}, len(after))
+	for _, session := range after {
+		stillActive[session.ID()] = struct{}{}
+	}
+
+	var ended []*model.RefreshSession
+	for _, session := range before {
+		if _, ok := stillActive[session.ID()]; !ok {
+			ended = append(ended, session)
+		}
+	}
+	return ended, nil
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// Validates the moderation request and applies the status transition.
+// The new status is saved in one transaction with whatever apply writes
+// along with it, so a failed step leaves the account as it was
+func moderateAccount(
+	ctx context.Context, txManager port.TransactionManager,
+	account port.AccountRepository, accountRole port.AccountRoleRepository,
+	actorID, accountID uuid.UUID, reason string,
+	transition func(*model.Account) error,
+	apply func(ctx context.Context, acc *model.Account) error,
+) error {
+	if strings.TrimSpace(reason) == "" {
+		return ucerrs.ErrReasonRequired
+	}
+	if actorID == accountID {
+		return ucerrs.ErrCannotModerateSelf
+	}
+
+	acc, err := account.GetByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return ucerrs.ErrInvalidAccountID
+		}
+		return ucerrs.Wrap(ucerrs.ErrGetAccountByIDDB, err)
+	}
+
+	// Staff can not moderate each other or those above them
+	actorRole, err := accountRole.Get(ctx, actorID)
+	if err != nil {
+		return ucerrs.Wrap(ucerrs.ErrGetAccountRoleDB, err)
+	}
+	targetRole, err := accountRole.Get(ctx, accountID)
+	if err != nil {
+		return ucerrs.Wrap(ucerrs.ErrGetAccountRoleDB, err)
+	}
+	if !actorRole.Outranks(targetRole) {
+		return ucerrs.ErrCannotModeratePeer
+	}
+
+	if err := transition(acc); err != nil {
+		return ucerrs.ErrInvalidStatusTransition
+	}
+
+	return withinTx(ctx, txManager, func(ctx context.Context) error {
+		if err := account.UpdateStatus(ctx, acc); err != nil {
+			return ucerrs.Wrap(ucerrs.ErrUpdateAccountDB, err)
+		}
+		return apply(ctx, acc)
+	})
+}
+
+// Writes the moderation action with its actor and reason to the audit log
+func recordModeration(
+	ctx context.Context, authEvent port.AuthEventRepository,
+	eventType model.AuthEventType, actorID, accountID uuid.UUID, reason string,
+) error {
+	event, err := model.NewAuthEvent(accountID, eventType, nil, nil, nil)
+	if err != nil {
+		return ucerrs.Wrap(ucerrs.ErrInvalidInput, err)
+	}
+	if err := event.SetActor(actorID, reason); err != nil {
+		return ucerrs.Wrap(ucerrs.ErrInvalidInput, err)
+	}
+	if err := authEvent.Create(ctx, event); err != nil {
+		return ucerrs.Wrap(ucerrs.ErrCreateAuthEventDB, err)
+	}
+	return nil
+}
@@ -6,16 +6,24 @@ import (
 
 	"github.com/maket12/ads-service/authservice/internal/app/dto"
 	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
 	"github.com/maket12/ads-service/authservice/internal/domain/port"
 	pkgerrs "github.com/maket12/ads-service/pkg/errs"
 )
 
 type AssignRoleUC struct {
 	accountRole port.AccountRoleRepository
+	authEvent   port.AuthEventRepository
 }
 
-func NewAssignRoleUC(accountRole port.AccountRoleRepository) *AssignRoleUC {
-	return &AssignRoleUC{accountRole: accountRole}
+func NewAssignRoleUC(
+	accountRole port.AccountRoleRepository,
+	authEvent port.AuthEventRepository,
+) *AssignRoleUC {
+	return &AssignRoleUC{
+		accountRole: accountRole,
+		authEvent:   authEvent,
+	}
 }
 
 func (uc *AssignRoleUC) Execute(ctx context.Context, in dto.AssignRoleInput) (dto.AssignRoleOutput, error) {
@@ -31,17 +39,25 @@ func (uc *AssignRoleUC) Execute(ctx context.Context, in dto.AssignRoleInput) (dt
 	}
 
 	// Assign
-	if err := accRole.Assign(in.Role); err != nil {
+	role, err := accRole.Assign(in.Role)
+	if err != nil {
 		return dto.AssignRoleOutput{Assign: false},
 			ucerrs.ErrCannotAssign
 	}
 
 	// Update db
-	if err := uc.accountRole.Update(ctx, accRole); err != nil {
+	if err := uc.accountRole.AddRole(ctx, accRole.AccountID(), role); err != nil {
 		return dto.AssignRoleOutput{Assign: false},
 			ucerrs.Wrap(ucerrs.ErrUpdateAccountRoleDB, err)
 	}
 
+	if err := recordModeration(
+		ctx, uc.authEvent, model.AuthEventRoleAssigned,
+		in.AdminID, accRole.AccountID(), "granted role "+role.String(),
+	); err != nil {
+		return dto.AssignRoleOutput{Assign: false}, err
+	}
+
 	// Output
 	return dto.AssignRoleOutput{Assign: true}, nil
 }
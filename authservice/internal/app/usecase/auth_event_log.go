@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+// How the account has proven its identity, stored as "method" metadata of logins
+const (
+	loginMethodPassword  = "password"
+	loginMethodMFA       = "mfa"
+	loginMethodOIDC      = "oidc"
+	loginMethodMagicLink = "magic_link"
+)
+
+// Writes a security event of the account to the audit log
+func recordAuthEvent(
+	ctx context.Context, authEvent port.AuthEventRepository,
+	accountID uuid.UUID, eventType model.AuthEventType, outcome model.AuthEventOutcome,
+	sessionID *uuid.UUID, ip, userAgent *string, metadata map[string]string,
+) error {
+	event, err := model.NewAuthEvent(accountID, eventType, sessionID, ip, userAgent)
+	if err != nil {
+		return ucerrs.Wrap(ucerrs.ErrInvalidInput, err)
+	}
+	if outcome == model.AuthEventFailure {
+		event.Fail()
+	}
+	for key, value := range metadata {
+		if err := event.SetMetadata(key, value); err != nil {
+			return ucerrs.Wrap(ucerrs.ErrInvalidInput, err)
+		}
+	}
+
+	if err := authEvent.Create(ctx, event); err != nil {
+		return ucerrs.Wrap(ucerrs.ErrCreateAuthEventDB, err)
+	}
+	return nil
+}
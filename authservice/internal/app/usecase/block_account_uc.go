@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+type BlockAccountUC struct {
+	account        port.AccountRepository
+	accountRole    port.AccountRoleRepository
+	refreshSession port.RefreshSessionRepository
+	authEvent      port.AuthEventRepository
+	txManager      port.TransactionManager
+	accountOutbox  port.AccountOutbox
+	denylist       port.AccessTokenDenylist
+	accessTTL      time.Duration
+}
+
+func NewBlockAccountUC(
+	account port.AccountRepository,
+	accountRole port.AccountRoleRepository,
+	refreshSession port.RefreshSessionRepository,
+	authEvent port.AuthEventRepository,
+	txManager port.TransactionManager,
+	accountOutbox port.AccountOutbox,
+	denylist port.AccessTokenDenylist,
+	accessTTL time.Duration,
+) *BlockAccountUC {
+	return &BlockAccountUC{
+		account:        account,
+		accountRole:    accountRole,
+		refreshSession: refreshSession,
+		authEvent:      authEvent,
+		txManager:      txManager,
+		accountOutbox:  accountOutbox,
+		denylist:       denylist,
+		accessTTL:      accessTTL,
+	}
+}
+
+func (uc *BlockAccountUC) Execute(ctx context.Context, in dto.BlockAccountInput) (dto.BlockAccountOutput, error) {
+	// Change the status
+	if err := moderateAccount(
+		ctx, uc.txManager, uc.account, uc.accountRole,
+		in.ActorID, in.AccountID, in.Reason,
+		(*model.Account).Block,
+		func(ctx context.Context, acc *model.Account) error {
+			// Log out from every device, issued access tokens included
+			if err := denyAccountSessions(
+				ctx, uc.refreshSession, uc.denylist, acc.ID(), uuid.Nil, uc.accessTTL,
+			); err != nil {
+				return err
+			}
+
+			var reason = "account blocked"
+			if err := uc.refreshSession.RevokeAllForAccount(ctx, acc.ID(), &reason); err != nil {
+				return ucerrs.Wrap(ucerrs.ErrRevokeRefreshSessionDB, err)
+			}
+
+			// Audit
+			if err := recordModeration(
+				ctx, uc.authEvent, model.AuthEventAccountBlocked,
+				in.ActorID, acc.ID(), in.Reason,
+			); err != nil {
+				return err
+			}
+
+			// Published by the outbox relay after commit (notify other services)
+			if err := uc.accountOutbox.AddAccountBlocked(ctx, acc.ID(), in.Reason); err != nil {
+				return ucerrs.Wrap(ucerrs.ErrAddOutboxEventDB, err)
+			}
+			return nil
+		},
+	); err != nil {
+		return dto.BlockAccountOutput{}, err
+	}
+
+	return dto.BlockAccountOutput{Blocked: true}, nil
+}
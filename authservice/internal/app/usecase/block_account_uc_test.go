@@ -0,0 +1,281 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBlockAccountUC_Execute(t *testing.T) {
+	type adapter struct {
+		account        *mocks.AccountRepository
+		refreshSession *mocks.RefreshSessionRepository
+		authEvent      *mocks.AuthEventRepository
+		accountRole    *mocks.AccountRoleRepository
+		txManager      *mocks.TransactionManager
+		accountOutbox  *mocks.AccountOutbox
+		denylist       *mocks.AccessTokenDenylist
+	}
+
+	type testCase struct {
+		name    string
+		input   dto.BlockAccountInput
+		prepare func(a adapter)
+		wantErr error
+	}
+
+	var (
+		actorID   = uuid.New()
+		accountID = uuid.New()
+		reason    = "spam"
+	)
+
+	newAccount := func(status model.AccountStatus) *model.Account {
+		return model.RestoreAccount(
+			accountID, "test@example.com", "hashed",
+			status, true, time.Now(), time.Now(), nil,
+		)
+	}
+
+	// The actor is an admin, the account a regular user
+	outranked := func(a adapter) {
+		a.accountRole.On("Get", mock.Anything, actorID).
+			Return(model.RestoreAccountRole(actorID, []model.Role{model.RoleAdmin}, nil), nil)
+		a.accountRole.On("Get", mock.Anything, accountID).
+			Return(model.RestoreAccountRole(accountID, []model.Role{model.RoleUser}, nil), nil)
+	}
+
+	session, _ := model.NewRefreshSession(
+		uuid.New(), accountID, "hash", nil, nil, nil, time.Hour,
+	)
+	sessionKey := model.DenylistKeyForSession(session.ID())
+
+	input := dto.BlockAccountInput{ActorID: actorID, AccountID: accountID, Reason: reason}
+
+	var tests = []testCase{
+		{
+			name:  "Success",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountActive), nil)
+				outranked(a)
+				a.account.On("UpdateStatus", mock.Anything, mock.MatchedBy(func(acc *model.Account) bool {
+					return acc.IsBlocked()
+				})).Return(nil)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, accountID).
+					Return([]*model.RefreshSession{session}, nil)
+				a.denylist.On("Add", mock.Anything, sessionKey, mock.Anything).Return(nil)
+				a.refreshSession.On("RevokeAllForAccount", mock.Anything, accountID, mock.MatchedBy(func(r *string) bool {
+					return *r == "account blocked"
+				})).Return(nil)
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.EventType() == model.AuthEventAccountBlocked &&
+						*e.ActorID() == actorID && *e.Reason() == reason
+				})).Return(nil)
+				a.accountOutbox.On("AddAccountBlocked", mock.Anything, accountID, reason).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "Fail - Empty Reason",
+			input:   dto.BlockAccountInput{ActorID: actorID, AccountID: accountID, Reason: "  "},
+			prepare: func(a adapter) {},
+			wantErr: ucerrs.ErrReasonRequired,
+		},
+		{
+			name:    "Fail - Own Account",
+			input:   dto.BlockAccountInput{ActorID: actorID, AccountID: actorID, Reason: reason},
+			prepare: func(a adapter) {},
+			wantErr: ucerrs.ErrCannotModerateSelf,
+		},
+		{
+			name:  "Fail - Account Not Found",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+			wantErr: ucerrs.ErrInvalidAccountID,
+		},
+		{
+			name:  "Fail - DB Error On Get",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(nil, assert.AnError)
+			},
+			wantErr: ucerrs.ErrGetAccountByIDDB,
+		},
+		{
+			name:  "Fail - Account Of The Same Rank",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountActive), nil)
+				a.accountRole.On("Get", mock.Anything, actorID).
+					Return(model.RestoreAccountRole(actorID, []model.Role{model.RoleSupport}, nil), nil)
+				a.accountRole.On("Get", mock.Anything, accountID).
+					Return(model.RestoreAccountRole(accountID, []model.Role{model.RoleModerator}, nil), nil)
+			},
+			wantErr: ucerrs.ErrCannotModeratePeer,
+		},
+		{
+			name:  "Fail - DB Error On Get Role",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountActive), nil)
+				a.accountRole.On("Get", mock.Anything, actorID).
+					Return(nil, assert.AnError)
+			},
+			wantErr: ucerrs.ErrGetAccountRoleDB,
+		},
+		{
+			name:  "Fail - Already Blocked",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountBlocked), nil)
+				outranked(a)
+			},
+			wantErr: ucerrs.ErrInvalidStatusTransition,
+		},
+		{
+			name:  "Fail - DB Error On Update",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountActive), nil)
+				outranked(a)
+				a.account.On("UpdateStatus", mock.Anything, mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrUpdateAccountDB,
+		},
+		{
+			name:  "Fail - DB Error On List Sessions",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountActive), nil)
+				outranked(a)
+				a.account.On("UpdateStatus", mock.Anything, mock.Anything).Return(nil)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, accountID).
+					Return(nil, assert.AnError)
+			},
+			wantErr: ucerrs.ErrListRefreshSessionsDB,
+		},
+		{
+			name:  "Fail - DB Error On Deny Access Tokens",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountActive), nil)
+				outranked(a)
+				a.account.On("UpdateStatus", mock.Anything, mock.Anything).Return(nil)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, accountID).
+					Return([]*model.RefreshSession{session}, nil)
+				a.denylist.On("Add", mock.Anything, sessionKey, mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrDenyAccessTokenDB,
+		},
+		{
+			name:  "Fail - DB Error On Revoke",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountActive), nil)
+				outranked(a)
+				a.account.On("UpdateStatus", mock.Anything, mock.Anything).Return(nil)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, accountID).
+					Return([]*model.RefreshSession{session}, nil)
+				a.denylist.On("Add", mock.Anything, sessionKey, mock.Anything).Return(nil)
+				a.refreshSession.On("RevokeAllForAccount", mock.Anything, accountID, mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrRevokeRefreshSessionDB,
+		},
+		{
+			name:  "Fail - DB Error On Audit",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountActive), nil)
+				outranked(a)
+				a.account.On("UpdateStatus", mock.Anything, mock.Anything).Return(nil)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, accountID).
+					Return([]*model.RefreshSession{session}, nil)
+				a.denylist.On("Add", mock.Anything, sessionKey, mock.Anything).Return(nil)
+				a.refreshSession.On("RevokeAllForAccount", mock.Anything, accountID, mock.Anything).
+					Return(nil)
+				a.authEvent.On("Create", mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrCreateAuthEventDB,
+		},
+		{
+			name:  "Fail - Outbox Error",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountActive), nil)
+				outranked(a)
+				a.account.On("UpdateStatus", mock.Anything, mock.Anything).Return(nil)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, accountID).
+					Return([]*model.RefreshSession{session}, nil)
+				a.denylist.On("Add", mock.Anything, sessionKey, mock.Anything).Return(nil)
+				a.refreshSession.On("RevokeAllForAccount", mock.Anything, accountID, mock.Anything).
+					Return(nil)
+				a.authEvent.On("Create", mock.Anything, mock.Anything).Return(nil)
+				a.accountOutbox.On("AddAccountBlocked", mock.Anything, accountID, reason).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrAddOutboxEventDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				account:        mocks.NewAccountRepository(t),
+				refreshSession: mocks.NewRefreshSessionRepository(t),
+				authEvent:      mocks.NewAuthEventRepository(t),
+				accountRole:    mocks.NewAccountRoleRepository(t),
+				txManager:      mocks.NewTransactionManager(t),
+				accountOutbox:  mocks.NewAccountOutbox(t),
+				denylist:       mocks.NewAccessTokenDenylist(t),
+			}
+
+			allowTx(a.txManager)
+			tt.prepare(a)
+
+			uc := usecase.NewBlockAccountUC(
+				a.account, a.accountRole, a.refreshSession, a.authEvent,
+				a.txManager, a.accountOutbox, a.denylist, time.Minute*15,
+			)
+
+			res, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, res.Blocked)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, res.Blocked)
+			}
+		})
+	}
+}
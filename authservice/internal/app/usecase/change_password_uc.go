@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+type ChangePasswordUC struct {
+	account        port.AccountRepository
+	refreshSession port.RefreshSessionRepository
+	passwordHasher port.PasswordHasher
+	tokenGenerator port.TokenGenerator
+	authEvent      port.AuthEventRepository
+	denylist       port.AccessTokenDenylist
+	accessTTL      time.Duration
+}
+
+func NewChangePasswordUC(
+	account port.AccountRepository,
+	refreshSession port.RefreshSessionRepository,
+	passwordHasher port.PasswordHasher,
+	tokenGenerator port.TokenGenerator,
+	authEvent port.AuthEventRepository,
+	denylist port.AccessTokenDenylist,
+	accessTTL time.Duration,
+) *ChangePasswordUC {
+	return &ChangePasswordUC{
+		account:        account,
+		refreshSession: refreshSession,
+		passwordHasher: passwordHasher,
+		tokenGenerator: tokenGenerator,
+		authEvent:      authEvent,
+		denylist:       denylist,
+		accessTTL:      accessTTL,
+	}
+}
+
+func (uc *ChangePasswordUC) Execute(ctx context.Context, in dto.ChangePasswordInput) (dto.ChangePasswordOutput, error) {
+	// Password policy
+	if err := model.ValidatePassword(in.NewPassword); err != nil {
+		return dto.ChangePasswordOutput{}, ucerrs.ErrWeakPassword
+	}
+
+	// Find the caller's session, it must belong to the same account
+	session, err := currentSession(
+		ctx, uc.tokenGenerator, uc.refreshSession,
+		in.AccountID, in.RefreshToken,
+	)
+	if err != nil {
+		return dto.ChangePasswordOutput{}, err
+	}
+
+	// Find account
+	account, err := uc.account.GetByID(ctx, in.AccountID)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.ChangePasswordOutput{}, ucerrs.ErrInvalidAccountID
+		}
+		return dto.ChangePasswordOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetAccountByIDDB, err,
+		)
+	}
+
+	if !account.CanLogin() {
+		return dto.ChangePasswordOutput{}, ucerrs.ErrCannotLogin
+	}
+
+	// Check the current password
+	if !uc.passwordHasher.Compare(account.PasswordHash(), in.OldPassword) {
+		return dto.ChangePasswordOutput{}, ucerrs.ErrWrongPassword
+	}
+
+	// Hashing the new password
+	hashedPassword, err := uc.passwordHasher.Hash(in.NewPassword)
+	if err != nil {
+		return dto.ChangePasswordOutput{}, ucerrs.Wrap(
+			ucerrs.ErrHashPassword, err,
+		)
+	}
+
+	if err := account.ChangePassword(hashedPassword); err != nil {
+		return dto.ChangePasswordOutput{}, ucerrs.Wrap(
+			ucerrs.ErrInvalidInput, err,
+		)
+	}
+
+	if err := uc.account.UpdatePassword(ctx, account); err != nil {
+		return dto.ChangePasswordOutput{}, ucerrs.Wrap(
+			ucerrs.ErrUpdateAccountDB, err,
+		)
+	}
+
+	// Other devices have to log in again, the current one stays
+	if err := denyAccountSessions(
+		ctx, uc.refreshSession, uc.denylist, account.ID(), session.ID(), uc.accessTTL,
+	); err != nil {
+		return dto.ChangePasswordOutput{}, err
+	}
+
+	var reason = "password change"
+	if err := uc.refreshSession.RevokeAllForAccountExcept(
+		ctx, account.ID(), session.ID(), &reason,
+	); err != nil {
+		return dto.ChangePasswordOutput{}, ucerrs.Wrap(
+			ucerrs.ErrRevokeRefreshSessionDB, err,
+		)
+	}
+
+	var sessionID = session.ID()
+	if err := recordAuthEvent(
+		ctx, uc.authEvent, account.ID(), model.AuthEventPasswordChanged, model.AuthEventSuccess,
+		&sessionID, session.IP(), session.UserAgent(), nil,
+	); err != nil {
+		return dto.ChangePasswordOutput{}, err
+	}
+
+	// Output
+	return dto.ChangePasswordOutput{Changed: true}, nil
+}
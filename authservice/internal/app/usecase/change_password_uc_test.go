@@ -0,0 +1,196 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestChangePasswordUC_Execute(t *testing.T) {
+	type adapter struct {
+		account        *mocks.AccountRepository
+		refreshSession *mocks.RefreshSessionRepository
+		passwordHasher *mocks.PasswordHasher
+		tokenGenerator *mocks.TokenGenerator
+		authEvent      *mocks.AuthEventRepository
+		denylist       *mocks.AccessTokenDenylist
+	}
+
+	type testCase struct {
+		name    string
+		input   dto.ChangePasswordInput
+		prepare func(a adapter)
+		wantErr error
+	}
+
+	account, _ := model.NewAccount("user@test.com", "old_hashed")
+	sessionID := uuid.New()
+	token := "valid-refresh-token"
+	hashedToken := utils.HashToken(token)
+	oldPassword := "oldPassword1"
+	newPassword := "brandNewPassword42"
+
+	newSession := func() *model.RefreshSession {
+		s, _ := model.NewRefreshSession(
+			sessionID, account.ID(), hashedToken, nil, nil, nil, time.Hour,
+		)
+		return s
+	}
+
+	other, _ := model.NewRefreshSession(
+		uuid.New(), account.ID(), "other-hash", nil, nil, nil, time.Hour,
+	)
+
+	validInput := dto.ChangePasswordInput{
+		AccountID:    account.ID(),
+		RefreshToken: token,
+		OldPassword:  oldPassword,
+		NewPassword:  newPassword,
+	}
+
+	var tests = []testCase{
+		{
+			name:  "Success",
+			input: validInput,
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, token).
+					Return(account.ID(), sessionID, nil)
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).
+					Return(newSession(), nil)
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.passwordHasher.On("Compare", "old_hashed", oldPassword).Return(true)
+				a.passwordHasher.On("Hash", newPassword).Return("new_hashed", nil)
+				a.account.On("UpdatePassword", mock.Anything, mock.MatchedBy(func(acc *model.Account) bool {
+					return acc.PasswordHash() == "new_hashed"
+				})).Return(nil)
+				// Access tokens of the current session stay valid
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, account.ID()).
+					Return([]*model.RefreshSession{newSession(), other}, nil)
+				a.denylist.On("Add", mock.Anything,
+					model.DenylistKeyForSession(other.ID()), mock.Anything).Return(nil)
+				a.refreshSession.On("RevokeAllForAccountExcept", mock.Anything,
+					account.ID(), sessionID, mock.MatchedBy(func(r *string) bool {
+						return r != nil && *r == "password change"
+					})).Return(nil)
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.EventType() == model.AuthEventPasswordChanged &&
+						e.AccountID() == account.ID() && *e.SessionID() == sessionID
+				})).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Fail - Weak Password",
+			input: dto.ChangePasswordInput{
+				AccountID:    account.ID(),
+				RefreshToken: token,
+				OldPassword:  oldPassword,
+				NewPassword:  "short",
+			},
+			prepare: func(a adapter) {},
+			wantErr: ucerrs.ErrWeakPassword,
+		},
+		{
+			name:  "Fail - Token Of Another Account",
+			input: validInput,
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, token).
+					Return(uuid.New(), sessionID, nil)
+			},
+			wantErr: ucerrs.ErrInvalidRefreshToken,
+		},
+		{
+			name:  "Fail - Wrong Current Password",
+			input: validInput,
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, token).
+					Return(account.ID(), sessionID, nil)
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).
+					Return(newSession(), nil)
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.passwordHasher.On("Compare", mock.Anything, oldPassword).Return(false)
+			},
+			wantErr: ucerrs.ErrWrongPassword,
+		},
+		{
+			name:  "Fail - DB Error On Deny Access Tokens",
+			input: validInput,
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, token).
+					Return(account.ID(), sessionID, nil)
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).
+					Return(newSession(), nil)
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.passwordHasher.On("Compare", mock.Anything, oldPassword).Return(true)
+				a.passwordHasher.On("Hash", newPassword).Return("new_hashed", nil)
+				a.account.On("UpdatePassword", mock.Anything, mock.Anything).Return(nil)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, account.ID()).
+					Return([]*model.RefreshSession{other}, nil)
+				a.denylist.On("Add", mock.Anything, mock.Anything, mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrDenyAccessTokenDB,
+		},
+		{
+			name:  "Fail - DB Error On Sessions Revoke",
+			input: validInput,
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, token).
+					Return(account.ID(), sessionID, nil)
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).
+					Return(newSession(), nil)
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.passwordHasher.On("Compare", mock.Anything, oldPassword).Return(true)
+				a.passwordHasher.On("Hash", newPassword).Return("new_hashed", nil)
+				a.account.On("UpdatePassword", mock.Anything, mock.Anything).Return(nil)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, account.ID()).
+					Return([]*model.RefreshSession{newSession(), other}, nil)
+				a.denylist.On("Add", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				a.refreshSession.On("RevokeAllForAccountExcept", mock.Anything,
+					account.ID(), sessionID, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrRevokeRefreshSessionDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				account:        mocks.NewAccountRepository(t),
+				refreshSession: mocks.NewRefreshSessionRepository(t),
+				passwordHasher: mocks.NewPasswordHasher(t),
+				tokenGenerator: mocks.NewTokenGenerator(t),
+				authEvent:      mocks.NewAuthEventRepository(t),
+				denylist:       mocks.NewAccessTokenDenylist(t),
+			}
+
+			tt.prepare(a)
+
+			uc := usecase.NewChangePasswordUC(
+				a.account, a.refreshSession, a.passwordHasher, a.tokenGenerator,
+				a.authEvent, a.denylist, time.Minute*15,
+			)
+
+			res, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, res.Changed)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, res.Changed)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+)
+
+const cleanupRefreshSessionsLock = "authservice.cleanup_refresh_sessions"
+
+type CleanupRefreshSessionsUC struct {
+	refreshSession   port.RefreshSessionRepository
+	denylist         port.AccessTokenDenylist
+	loginAttempt     port.LoginAttemptRepository
+	requests         port.RequestCounter
+	locker           port.Locker
+	revokedRetention time.Duration
+	limitRetention   time.Duration
+	batchSize        int
+}
+
+func NewCleanupRefreshSessionsUC(
+	refreshSession port.RefreshSessionRepository,
+	denylist port.AccessTokenDenylist,
+	loginAttempt port.LoginAttemptRepository,
+	requests port.RequestCounter,
+	locker port.Locker,
+	revokedRetention, limitRetention time.Duration,
+	batchSize int,
+) *CleanupRefreshSessionsUC {
+	return &CleanupRefreshSessionsUC{
+		refreshSession:   refreshSession,
+		denylist:         denylist,
+		loginAttempt:     loginAttempt,
+		requests:         requests,
+		locker:           locker,
+		revokedRetention: revokedRetention,
+		limitRetention:   limitRetention,
+		batchSize:        batchSize,
+	}
+}
+
+func (uc *CleanupRefreshSessionsUC) Execute(ctx context.Context, in dto.CleanupRefreshSessionsInput) (dto.CleanupRefreshSessionsOutput, error) {
+	// Only one replica cleans at a time
+	unlock, acquired, err := uc.locker.TryLock(ctx, cleanupRefreshSessionsLock)
+	if err != nil {
+		return dto.CleanupRefreshSessionsOutput{}, ucerrs.Wrap(ucerrs.ErrAcquireLock, err)
+	}
+	if !acquired {
+		return dto.CleanupRefreshSessionsOutput{Skipped: true}, nil
+	}
+	defer func() { _ = unlock(context.WithoutCancel(ctx)) }()
+
+	// Delete in small batches to keep row locks short
+	var (
+		revokedBefore = in.Now.Add(-uc.revokedRetention)
+		total         int64
+	)
+	for ctx.Err() == nil {
+		deleted, err := uc.refreshSession.DeleteStale(
+			ctx, in.Now, revokedBefore, uc.batchSize,
+		)
+		if err != nil {
+			return dto.CleanupRefreshSessionsOutput{Deleted: total},
+				ucerrs.Wrap(ucerrs.ErrDeleteRefreshSessionsDB, err)
+		}
+		total += deleted
+		if deleted < int64(uc.batchSize) {
+			break
+		}
+	}
+
+	// Denylist entries are useless once the tokens they deny have expired
+	var denied int64
+	for ctx.Err() == nil {
+		deleted, err := uc.denylist.DeleteExpired(ctx, in.Now, uc.batchSize)
+		if err != nil {
+			return dto.CleanupRefreshSessionsOutput{Deleted: total, DeniedDeleted: denied},
+				ucerrs.Wrap(ucerrs.ErrCleanupDenylistDB, err)
+		}
+		denied += deleted
+		if deleted < int64(uc.batchSize) {
+			break
+		}
+	}
+
+	// Failures and requests of any key are never read once they fall out of the windows
+	var (
+		limitedBefore = in.Now.Add(-uc.limitRetention)
+		failures      int64
+		requests      int64
+	)
+	for ctx.Err() == nil {
+		deleted, err := uc.loginAttempt.DeleteExpired(ctx, limitedBefore, uc.batchSize)
+		if err != nil {
+			return dto.CleanupRefreshSessionsOutput{
+				Deleted: total, DeniedDeleted: denied, FailuresDeleted: failures,
+			}, ucerrs.Wrap(ucerrs.ErrCleanupLoginAttemptsDB, err)
+		}
+		failures += deleted
+		if deleted < int64(uc.batchSize) {
+			break
+		}
+	}
+	for ctx.Err() == nil {
+		deleted, err := uc.requests.DeleteExpired(ctx, limitedBefore, uc.batchSize)
+		if err != nil {
+			return dto.CleanupRefreshSessionsOutput{
+				Deleted: total, DeniedDeleted: denied,
+				FailuresDeleted: failures, RequestsDeleted: requests,
+			}, ucerrs.Wrap(ucerrs.ErrCleanupRequestsDB, err)
+		}
+		requests += deleted
+		if deleted < int64(uc.batchSize) {
+			break
+		}
+	}
+
+	return dto.CleanupRefreshSessionsOutput{
+		Deleted: total, DeniedDeleted: denied,
+		FailuresDeleted: failures, RequestsDeleted: requests,
+	}, nil
+}
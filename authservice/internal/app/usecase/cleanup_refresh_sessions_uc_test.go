@@ -0,0 +1,189 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCleanupRefreshSessionsUC_Execute(t *testing.T) {
+	type adapter struct {
+		refreshSession *mocks.RefreshSessionRepository
+		denylist       *mocks.AccessTokenDenylist
+		loginAttempt   *mocks.LoginAttemptRepository
+		requests       *mocks.RequestCounter
+		locker         *mocks.Locker
+	}
+
+	type testCase struct {
+		name        string
+		prepare     func(a adapter)
+		wantErr     error
+		wantDeleted int64
+		wantDenied  int64
+		wantFailed  int64
+		wantLimited int64
+		wantSkipped bool
+		wantUnlock  bool
+	}
+
+	const (
+		batchSize      = 2
+		retention      = time.Hour
+		limitRetention = time.Minute * 15
+	)
+
+	var (
+		now      = time.Now()
+		unlocked bool
+		unlock   = func(context.Context) error {
+			unlocked = true
+			return nil
+		}
+	)
+
+	var tests = []testCase{
+		{
+			name: "Success - Several Batches",
+			prepare: func(a adapter) {
+				a.locker.On("TryLock", mock.Anything, mock.Anything).
+					Return(unlock, true, nil)
+				a.refreshSession.On("DeleteStale", mock.Anything, now, now.Add(-retention), batchSize).
+					Return(int64(batchSize), nil).Twice()
+				a.refreshSession.On("DeleteStale", mock.Anything, now, now.Add(-retention), batchSize).
+					Return(int64(1), nil).Once()
+				a.denylist.On("DeleteExpired", mock.Anything, now, batchSize).
+					Return(int64(batchSize), nil).Once()
+				a.denylist.On("DeleteExpired", mock.Anything, now, batchSize).
+					Return(int64(0), nil).Once()
+				a.loginAttempt.On("DeleteExpired", mock.Anything, now.Add(-limitRetention), batchSize).
+					Return(int64(batchSize), nil).Once()
+				a.loginAttempt.On("DeleteExpired", mock.Anything, now.Add(-limitRetention), batchSize).
+					Return(int64(1), nil).Once()
+				a.requests.On("DeleteExpired", mock.Anything, now.Add(-limitRetention), batchSize).
+					Return(int64(1), nil).Once()
+			},
+			wantErr:     nil,
+			wantDeleted: 2*batchSize + 1,
+			wantDenied:  batchSize,
+			wantFailed:  batchSize + 1,
+			wantLimited: 1,
+			wantUnlock:  true,
+		},
+		{
+			name: "Success - Locked By Another Replica",
+			prepare: func(a adapter) {
+				a.locker.On("TryLock", mock.Anything, mock.Anything).
+					Return(nil, false, nil)
+			},
+			wantErr:     nil,
+			wantSkipped: true,
+		},
+		{
+			name: "Fail - Lock Error",
+			prepare: func(a adapter) {
+				a.locker.On("TryLock", mock.Anything, mock.Anything).
+					Return(nil, false, assert.AnError)
+			},
+			wantErr: ucerrs.ErrAcquireLock,
+		},
+		{
+			name: "Fail - DB Error On Delete",
+			prepare: func(a adapter) {
+				a.locker.On("TryLock", mock.Anything, mock.Anything).
+					Return(unlock, true, nil)
+				a.refreshSession.On("DeleteStale", mock.Anything, mock.Anything, mock.Anything, batchSize).
+					Return(int64(0), assert.AnError)
+			},
+			wantErr:    ucerrs.ErrDeleteRefreshSessionsDB,
+			wantUnlock: true,
+		},
+		{
+			name: "Fail - DB Error On Denylist Cleanup",
+			prepare: func(a adapter) {
+				a.locker.On("TryLock", mock.Anything, mock.Anything).
+					Return(unlock, true, nil)
+				a.refreshSession.On("DeleteStale", mock.Anything, mock.Anything, mock.Anything, batchSize).
+					Return(int64(0), nil)
+				a.denylist.On("DeleteExpired", mock.Anything, now, batchSize).
+					Return(int64(0), assert.AnError)
+			},
+			wantErr:    ucerrs.ErrCleanupDenylistDB,
+			wantUnlock: true,
+		},
+		{
+			name: "Fail - DB Error On Login Failures Cleanup",
+			prepare: func(a adapter) {
+				a.locker.On("TryLock", mock.Anything, mock.Anything).
+					Return(unlock, true, nil)
+				a.refreshSession.On("DeleteStale", mock.Anything, mock.Anything, mock.Anything, batchSize).
+					Return(int64(0), nil)
+				a.denylist.On("DeleteExpired", mock.Anything, now, batchSize).
+					Return(int64(0), nil)
+				a.loginAttempt.On("DeleteExpired", mock.Anything, now.Add(-limitRetention), batchSize).
+					Return(int64(0), assert.AnError)
+			},
+			wantErr:    ucerrs.ErrCleanupLoginAttemptsDB,
+			wantUnlock: true,
+		},
+		{
+			name: "Fail - DB Error On Requests Cleanup",
+			prepare: func(a adapter) {
+				a.locker.On("TryLock", mock.Anything, mock.Anything).
+					Return(unlock, true, nil)
+				a.refreshSession.On("DeleteStale", mock.Anything, mock.Anything, mock.Anything, batchSize).
+					Return(int64(0), nil)
+				a.denylist.On("DeleteExpired", mock.Anything, now, batchSize).
+					Return(int64(0), nil)
+				a.loginAttempt.On("DeleteExpired", mock.Anything, now.Add(-limitRetention), batchSize).
+					Return(int64(0), nil)
+				a.requests.On("DeleteExpired", mock.Anything, now.Add(-limitRetention), batchSize).
+					Return(int64(0), assert.AnError)
+			},
+			wantErr:    ucerrs.ErrCleanupRequestsDB,
+			wantUnlock: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unlocked = false
+			a := adapter{
+				refreshSession: mocks.NewRefreshSessionRepository(t),
+				denylist:       mocks.NewAccessTokenDenylist(t),
+				loginAttempt:   mocks.NewLoginAttemptRepository(t),
+				requests:       mocks.NewRequestCounter(t),
+				locker:         mocks.NewLocker(t),
+			}
+
+			tt.prepare(a)
+
+			uc := usecase.NewCleanupRefreshSessionsUC(
+				a.refreshSession, a.denylist, a.loginAttempt, a.requests, a.locker,
+				retention, limitRetention, batchSize,
+			)
+
+			res, err := uc.Execute(context.Background(), dto.CleanupRefreshSessionsInput{Now: now})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantDeleted, res.Deleted)
+				assert.Equal(t, tt.wantDenied, res.DeniedDeleted)
+				assert.Equal(t, tt.wantFailed, res.FailuresDeleted)
+				assert.Equal(t, tt.wantLimited, res.RequestsDeleted)
+				assert.Equal(t, tt.wantSkipped, res.Skipped)
+			}
+			assert.Equal(t, tt.wantUnlock, unlocked)
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+type CompleteOIDCLoginUC struct {
+	account          port.AccountRepository
+	accountRole      port.AccountRoleRepository
+	totpFactor       port.TOTPFactorRepository
+	externalIdentity port.ExternalIdentityRepository
+	oidcState        port.OIDCStateRepository
+	passwordHasher   port.PasswordHasher
+	tokenGenerator   port.TokenGenerator
+	txManager        port.TransactionManager
+	accountOutbox    port.AccountOutbox
+	providers        map[string]port.OIDCProvider
+	sessions         *sessionIssuer
+}
+
+func NewCompleteOIDCLoginUC(
+	account port.AccountRepository,
+	accountRole port.AccountRoleRepository,
+	refreshSession port.RefreshSessionRepository,
+	totpFactor port.TOTPFactorRepository,
+	externalIdentity port.ExternalIdentityRepository,
+	oidcState port.OIDCStateRepository,
+	passwordHasher port.PasswordHasher,
+	tokenGenerator port.TokenGenerator,
+	authEvent port.AuthEventRepository,
+	txManager port.TransactionManager,
+	accountOutbox port.AccountOutbox,
+	providers map[string]port.OIDCProvider,
+	sessionLimit *model.SessionLimitPolicy,
+	newDeviceWindow time.Duration,
+	refreshSessionTTL time.Duration,
+) *CompleteOIDCLoginUC {
+	return &CompleteOIDCLoginUC{
+		account:          account,
+		accountRole:      accountRole,
+		totpFactor:       totpFactor,
+		externalIdentity: externalIdentity,
+		oidcState:        oidcState,
+		passwordHasher:   passwordHasher,
+		tokenGenerator:   tokenGenerator,
+		txManager:        txManager,
+		accountOutbox:    accountOutbox,
+		providers:        providers,
+		sessions: newSessionIssuer(
+			txManager, account, accountRole, refreshSession,
+			tokenGenerator, authEvent, accountOutbox,
+			sessionLimit, newDeviceWindow, refreshSessionTTL,
+		),
+	}
+}
+
+func (uc *CompleteOIDCLoginUC) Execute(ctx context.Context, in dto.CompleteOIDCLoginInput) (dto.CompleteOIDCLoginOutput, error) {
+	provider, ok := uc.providers[in.Provider]
+	if !ok {
+		return dto.CompleteOIDCLoginOutput{}, ucerrs.ErrUnknownOIDCProvider
+	}
+
+	// The state is consumed even when the rest fails, so it can't be replayed
+	loginState, err := uc.oidcState.Consume(ctx, utils.HashToken(in.State))
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.CompleteOIDCLoginOutput{}, ucerrs.ErrInvalidOIDCState
+		}
+		return dto.CompleteOIDCLoginOutput{}, ucerrs.Wrap(
+			ucerrs.ErrConsumeOIDCStateDB, err,
+		)
+	}
+	if loginState.Provider() != in.Provider || loginState.IsExpired() {
+		return dto.CompleteOIDCLoginOutput{}, ucerrs.ErrInvalidOIDCState
+	}
+
+	claims, err := provider.Exchange(
+		ctx, in.Code, loginState.CodeVerifier(), loginState.Nonce(),
+	)
+	if err != nil {
+		return dto.CompleteOIDCLoginOutput{}, ucerrs.Wrap(
+			ucerrs.ErrOIDCAuthFailed, err,
+		)
+	}
+
+	account, created, err := uc.resolveAccount(ctx, in.Provider, claims)
+	if err != nil {
+		return dto.CompleteOIDCLoginOutput{}, err
+	}
+
+	// Account validation
+	if ok := account.CanLogin(); !ok {
+		return dto.CompleteOIDCLoginOutput{}, ucerrs.ErrCannotLogin
+	}
+
+	// 2FA applies to social logins as well
+	login, ok, err := mfaChallenge(
+		ctx, uc.totpFactor, uc.tokenGenerator, account.ID(),
+	)
+	if err != nil {
+		return dto.CompleteOIDCLoginOutput{}, err
+	}
+	if !ok {
+		login, err = uc.sessions.issue(
+			ctx, account, loginMethodOIDC, in.IP, in.UserAgent,
+		)
+		if err != nil {
+			return dto.CompleteOIDCLoginOutput{}, err
+		}
+	}
+
+	return dto.CompleteOIDCLoginOutput{
+		AccessToken:    login.AccessToken,
+		RefreshToken:   login.RefreshToken,
+		MFARequired:    login.MFARequired,
+		MFAToken:       login.MFAToken,
+		AccountCreated: created,
+	}, nil
+}
+
+// Finds the account linked to the provider subject. An unknown subject is
+// linked to the account with the same email, or a new account is registered
+func (uc *CompleteOIDCLoginUC) resolveAccount(
+	ctx context.Context, provider string, claims *model.OIDCClaims,
+) (*model.Account, bool, error) {
+	identity, err := uc.externalIdentity.GetByProviderSubject(ctx, provider, claims.Subject)
+	if err == nil {
+		account, err := uc.account.GetByID(ctx, identity.AccountID())
+		if err != nil {
+			return nil, false, ucerrs.Wrap(ucerrs.ErrGetAccountByIDDB, err)
+		}
+		return account, false, nil
+	}
+	if !errors.Is(err, pkgerrs.ErrObjectNotFound) {
+		return nil, false, ucerrs.Wrap(ucerrs.ErrGetExternalIdentityDB, err)
+	}
+
+	// Otherwise anybody could claim an account by an unverified email
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, false, ucerrs.ErrOIDCEmailNotVerified
+	}
+
+	var created bool
+	account, err := uc.account.GetByEmail(ctx, claims.Email)
+	switch {
+	case err == nil:
+		// The owner of the password account has to prove the email as well
+		if !account.EmailVerified() {
+			return nil, false, ucerrs.ErrCannotLinkIdentity
+		}
+	case errors.Is(err, pkgerrs.ErrObjectNotFound):
+		account, err = uc.register(ctx, claims.Email)
+		if err != nil {
+			return nil, false, err
+		}
+		created = true
+	default:
+		return nil, false, ucerrs.Wrap(ucerrs.ErrGetAccountByEmailDB, err)
+	}
+
+	identity, err = model.NewExternalIdentity(
+		account.ID(), provider, claims.Subject, claims.Email,
+	)
+	if err != nil {
+		return nil, false, ucerrs.Wrap(ucerrs.ErrInvalidInput, err)
+	}
+	if err := uc.externalIdentity.Create(ctx, identity); err != nil {
+		return nil, false, ucerrs.Wrap(ucerrs.ErrCreateExternalIdentityDB, err)
+	}
+
+	return account, created, nil
+}
+
+// Same as RegisterUC, the email is already verified by the provider.
+// The password is random, the owner can set one with a password reset
+func (uc *CompleteOIDCLoginUC) register(ctx context.Context, email string) (*model.Account, error) {
+	password, err := utils.GenerateToken()
+	if err != nil {
+		return nil, ucerrs.Wrap(ucerrs.ErrGenerateOneTimeToken, err)
+	}
+	hashedPassword, err := uc.passwordHasher.Hash(password)
+	if err != nil {
+		return nil, ucerrs.Wrap(ucerrs.ErrHashPassword, err)
+	}
+
+	account, err := model.NewAccount(email, hashedPassword)
+	if err != nil {
+		return nil, ucerrs.Wrap(ucerrs.ErrInvalidInput, err)
+	}
+	account.VerifyEmail()
+
+	accountRole, err := model.NewAccountRole(account.ID())
+	if err != nil {
+		return nil, ucerrs.Wrap(ucerrs.ErrInvalidInput, err)
+	}
+
+	if err := createAccount(
+		ctx, uc.txManager, uc.account, uc.accountRole, uc.accountOutbox,
+		account, accountRole,
+	); err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
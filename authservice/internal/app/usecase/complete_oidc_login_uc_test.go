@@ -0,0 +1,340 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCompleteOIDCLoginUC_Execute(t *testing.T) {
+	type adapter struct {
+		account          *mocks.AccountRepository
+		accountRole      *mocks.AccountRoleRepository
+		refreshSession   *mocks.RefreshSessionRepository
+		totpFactor       *mocks.TOTPFactorRepository
+		externalIdentity *mocks.ExternalIdentityRepository
+		oidcState        *mocks.OIDCStateRepository
+		passwordHasher   *mocks.PasswordHasher
+		tokenGenerator   *mocks.TokenGenerator
+		authEvent        *mocks.AuthEventRepository
+		txManager        *mocks.TransactionManager
+		accountOutbox    *mocks.AccountOutbox
+		provider         *mocks.OIDCProvider
+	}
+
+	type testCase struct {
+		name        string
+		prepare     func(a adapter)
+		wantErr     error
+		wantCreated bool
+		wantMFA     bool
+	}
+
+	const (
+		state = "state-value"
+		code  = "auth-code"
+	)
+
+	noSessionLimit, _ := model.NewSessionLimitPolicy("evict_oldest", nil)
+
+	var (
+		stateHash  = utils.HashToken(state)
+		loginState = model.RestoreOIDCLoginState(
+			stateHash, "google", "verifier", "nonce",
+			time.Now(), time.Now().Add(time.Minute),
+		)
+		claims = &model.OIDCClaims{
+			Subject: "google-subject", Email: "user@test.com", EmailVerified: true,
+		}
+		unverified = &model.OIDCClaims{
+			Subject: "google-subject", Email: "user@test.com", EmailVerified: false,
+		}
+
+		verifiedAccount = model.RestoreAccount(
+			uuid.New(), "user@test.com", "hashed_db", model.AccountActive, true,
+			time.Now(), time.Now(), nil,
+		)
+		unverifiedAccount = model.RestoreAccount(
+			uuid.New(), "user@test.com", "hashed_db", model.AccountActive, false,
+			time.Now(), time.Now(), nil,
+		)
+		blockedAccount = model.RestoreAccount(
+			uuid.New(), "user@test.com", "hashed_db", model.AccountBlocked, true,
+			time.Now(), time.Now(), nil,
+		)
+		confirmedFactor, _ = model.NewTOTPFactor(verifiedAccount.ID(), "sealed")
+	)
+	_ = confirmedFactor.Confirm()
+
+	// Callback was accepted by the provider
+	exchanged := func(a adapter, c *model.OIDCClaims) {
+		a.oidcState.On("Consume", mock.Anything, stateHash).Return(loginState, nil)
+		a.provider.On("Exchange", mock.Anything, code, "verifier", "nonce").Return(c, nil)
+	}
+	identityNotFound := func(a adapter) {
+		a.externalIdentity.On("GetByProviderSubject", mock.Anything, "google", "google-subject").
+			Return(nil, pkgerrs.ErrObjectNotFound)
+	}
+	// Tokens are issued without a second factor
+	sessionIssued := func(a adapter) {
+		a.totpFactor.On("GetByAccountID", mock.Anything, mock.Anything).
+			Return(nil, pkgerrs.ErrObjectNotFound)
+		a.account.On("MarkLogin", mock.Anything, mock.Anything).Return(nil)
+		a.accountRole.On("Get", mock.Anything, mock.Anything).
+			Return(func(_ context.Context, id uuid.UUID) (*model.AccountRole, error) {
+				return model.NewAccountRole(id)
+			})
+		a.tokenGenerator.On("GenerateAccessToken", mock.Anything, mock.MatchedBy(func(c *model.AccessTokenClaims) bool {
+			return c.Roles[0] == model.RoleUser
+		})).
+			Return("access", nil)
+		a.tokenGenerator.On("GenerateRefreshToken", mock.Anything, mock.Anything, mock.Anything).
+			Return("refresh", nil)
+		a.refreshSession.On("Create", mock.Anything, mock.Anything).Return(nil)
+		a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+			return e.EventType() == model.AuthEventLogin && e.Metadata()["method"] == "oidc"
+		})).Return(nil)
+	}
+
+	var tests = []testCase{
+		{
+			name: "Success - Known Identity",
+			prepare: func(a adapter) {
+				exchanged(a, claims)
+				identity := model.RestoreExternalIdentity(
+					uuid.New(), verifiedAccount.ID(), "google", "google-subject",
+					"user@test.com", time.Now(),
+				)
+				a.externalIdentity.On("GetByProviderSubject", mock.Anything, "google", "google-subject").
+					Return(identity, nil)
+				a.account.On("GetByID", mock.Anything, verifiedAccount.ID()).
+					Return(verifiedAccount, nil)
+				sessionIssued(a)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Success - Register New Account",
+			prepare: func(a adapter) {
+				exchanged(a, claims)
+				identityNotFound(a)
+				a.account.On("GetByEmail", mock.Anything, "user@test.com").
+					Return(nil, pkgerrs.ErrObjectNotFound)
+				a.passwordHasher.On("Hash", mock.Anything).Return("hashed_random", nil)
+				expectTx(a.txManager)
+				a.account.On("Create", mock.Anything, mock.MatchedBy(func(acc *model.Account) bool {
+					return acc.Email() == "user@test.com" && acc.EmailVerified()
+				})).Return(nil)
+				a.accountRole.On("Create", mock.Anything, mock.Anything).Return(nil)
+				a.accountOutbox.On("AddAccountCreated", mock.Anything, mock.Anything).Return(nil)
+				a.externalIdentity.On("Create", mock.Anything, mock.MatchedBy(func(i *model.ExternalIdentity) bool {
+					return i.Provider() == "google" && i.Subject() == "google-subject"
+				})).Return(nil)
+				sessionIssued(a)
+			},
+			wantErr:     nil,
+			wantCreated: true,
+		},
+		{
+			name: "Success - Link Verified Email",
+			prepare: func(a adapter) {
+				exchanged(a, claims)
+				identityNotFound(a)
+				a.account.On("GetByEmail", mock.Anything, "user@test.com").
+					Return(verifiedAccount, nil)
+				a.externalIdentity.On("Create", mock.Anything, mock.MatchedBy(func(i *model.ExternalIdentity) bool {
+					return i.AccountID() == verifiedAccount.ID()
+				})).Return(nil)
+				sessionIssued(a)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Success - MFA Required",
+			prepare: func(a adapter) {
+				exchanged(a, claims)
+				identityNotFound(a)
+				a.account.On("GetByEmail", mock.Anything, "user@test.com").
+					Return(verifiedAccount, nil)
+				a.externalIdentity.On("Create", mock.Anything, mock.Anything).Return(nil)
+				a.totpFactor.On("GetByAccountID", mock.Anything, verifiedAccount.ID()).
+					Return(confirmedFactor, nil)
+				a.tokenGenerator.On("GenerateMFAToken", mock.Anything, verifiedAccount.ID()).
+					Return("mfa-token", nil)
+			},
+			wantErr: nil,
+			wantMFA: true,
+		},
+		{
+			name: "Fail - Unknown State",
+			prepare: func(a adapter) {
+				a.oidcState.On("Consume", mock.Anything, stateHash).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+			wantErr: ucerrs.ErrInvalidOIDCState,
+		},
+		{
+			name: "Fail - Expired State",
+			prepare: func(a adapter) {
+				expired := model.RestoreOIDCLoginState(
+					stateHash, "google", "verifier", "nonce",
+					time.Now().Add(-time.Hour), time.Now().Add(-time.Minute),
+				)
+				a.oidcState.On("Consume", mock.Anything, stateHash).Return(expired, nil)
+			},
+			wantErr: ucerrs.ErrInvalidOIDCState,
+		},
+		{
+			name: "Fail - State Of Another Provider",
+			prepare: func(a adapter) {
+				other := model.RestoreOIDCLoginState(
+					stateHash, "github", "verifier", "nonce",
+					time.Now(), time.Now().Add(time.Minute),
+				)
+				a.oidcState.On("Consume", mock.Anything, stateHash).Return(other, nil)
+			},
+			wantErr: ucerrs.ErrInvalidOIDCState,
+		},
+		{
+			name: "Fail - Exchange Rejected",
+			prepare: func(a adapter) {
+				a.oidcState.On("Consume", mock.Anything, stateHash).Return(loginState, nil)
+				a.provider.On("Exchange", mock.Anything, code, "verifier", "nonce").
+					Return(nil, assert.AnError)
+			},
+			wantErr: ucerrs.ErrOIDCAuthFailed,
+		},
+		{
+			name: "Fail - Unverified Provider Email",
+			prepare: func(a adapter) {
+				exchanged(a, unverified)
+				identityNotFound(a)
+			},
+			wantErr: ucerrs.ErrOIDCEmailNotVerified,
+		},
+		{
+			name: "Fail - Existing Account Not Verified",
+			prepare: func(a adapter) {
+				exchanged(a, claims)
+				identityNotFound(a)
+				a.account.On("GetByEmail", mock.Anything, "user@test.com").
+					Return(unverifiedAccount, nil)
+			},
+			wantErr: ucerrs.ErrCannotLinkIdentity,
+		},
+		{
+			name: "Fail - Blocked Account",
+			prepare: func(a adapter) {
+				exchanged(a, claims)
+				identity := model.RestoreExternalIdentity(
+					uuid.New(), blockedAccount.ID(), "google", "google-subject",
+					"user@test.com", time.Now(),
+				)
+				a.externalIdentity.On("GetByProviderSubject", mock.Anything, "google", "google-subject").
+					Return(identity, nil)
+				a.account.On("GetByID", mock.Anything, blockedAccount.ID()).
+					Return(blockedAccount, nil)
+			},
+			wantErr: ucerrs.ErrCannotLogin,
+		},
+		{
+			name: "Fail - Outbox Error On Register",
+			prepare: func(a adapter) {
+				exchanged(a, claims)
+				identityNotFound(a)
+				a.account.On("GetByEmail", mock.Anything, "user@test.com").
+					Return(nil, pkgerrs.ErrObjectNotFound)
+				a.passwordHasher.On("Hash", mock.Anything).Return("hashed_random", nil)
+				expectTx(a.txManager)
+				a.account.On("Create", mock.Anything, mock.Anything).Return(nil)
+				a.accountRole.On("Create", mock.Anything, mock.Anything).Return(nil)
+				a.accountOutbox.On("AddAccountCreated", mock.Anything, mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrAddOutboxEventDB,
+		},
+		{
+			name: "Fail - DB Error On Identity Lookup",
+			prepare: func(a adapter) {
+				exchanged(a, claims)
+				a.externalIdentity.On("GetByProviderSubject", mock.Anything, "google", "google-subject").
+					Return(nil, assert.AnError)
+			},
+			wantErr: ucerrs.ErrGetExternalIdentityDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				account:          mocks.NewAccountRepository(t),
+				accountRole:      mocks.NewAccountRoleRepository(t),
+				refreshSession:   mocks.NewRefreshSessionRepository(t),
+				totpFactor:       mocks.NewTOTPFactorRepository(t),
+				externalIdentity: mocks.NewExternalIdentityRepository(t),
+				oidcState:        mocks.NewOIDCStateRepository(t),
+				passwordHasher:   mocks.NewPasswordHasher(t),
+				tokenGenerator:   mocks.NewTokenGenerator(t),
+				authEvent:        mocks.NewAuthEventRepository(t),
+				txManager:        mocks.NewTransactionManager(t),
+				accountOutbox:    mocks.NewAccountOutbox(t),
+				provider:         mocks.NewOIDCProvider(t),
+			}
+
+			allowTx(a.txManager)
+			tt.prepare(a)
+
+			uc := usecase.NewCompleteOIDCLoginUC(
+				a.account, a.accountRole, a.refreshSession, a.totpFactor,
+				a.externalIdentity, a.oidcState, a.passwordHasher,
+				a.tokenGenerator, a.authEvent, a.txManager, a.accountOutbox,
+				map[string]port.OIDCProvider{"google": a.provider}, noSessionLimit, 0, time.Hour,
+			)
+
+			res, err := uc.Execute(context.Background(), dto.CompleteOIDCLoginInput{
+				Provider: "google",
+				State:    state,
+				Code:     code,
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, res.AccessToken)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantCreated, res.AccountCreated)
+				if tt.wantMFA {
+					assert.True(t, res.MFARequired)
+					assert.Equal(t, "mfa-token", res.MFAToken)
+					assert.Empty(t, res.AccessToken)
+				} else {
+					assert.Equal(t, "access", res.AccessToken)
+					assert.Equal(t, "refresh", res.RefreshToken)
+				}
+			}
+		})
+	}
+}
+
+func TestCompleteOIDCLoginUC_Execute_UnknownProvider(t *testing.T) {
+	uc := usecase.NewCompleteOIDCLoginUC(
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		map[string]port.OIDCProvider{}, nil, 0, time.Hour,
+	)
+
+	_, err := uc.Execute(context.Background(), dto.CompleteOIDCLoginInput{Provider: "google"})
+	assert.ErrorIs(t, err, ucerrs.ErrUnknownOIDCProvider)
+}
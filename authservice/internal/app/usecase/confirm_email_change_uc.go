@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+const emailChangedAlertSubject = "Your email has been changed"
+
+type ConfirmEmailChangeUC struct {
+	account          port.AccountRepository
+	emailChangeToken port.EmailChangeTokenRepository
+	authEvent        port.AuthEventRepository
+	mailer           port.Mailer
+	accountPublisher port.AccountPublisher
+}
+
+func NewConfirmEmailChangeUC(
+	account port.AccountRepository,
+	emailChangeToken port.EmailChangeTokenRepository,
+	authEvent port.AuthEventRepository,
+	mailer port.Mailer,
+	accountPublisher port.AccountPublisher,
+) *ConfirmEmailChangeUC {
+	return &ConfirmEmailChangeUC{
+		account:          account,
+		emailChangeToken: emailChangeToken,
+		authEvent:        authEvent,
+		mailer:           mailer,
+		accountPublisher: accountPublisher,
+	}
+}
+
+func (uc *ConfirmEmailChangeUC) Execute(ctx context.Context, in dto.ConfirmEmailChangeInput) (dto.ConfirmEmailChangeOutput, error) {
+	// Find token
+	token, err := uc.emailChangeToken.GetByHash(ctx, utils.HashToken(in.Token))
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.ConfirmEmailChangeOutput{}, ucerrs.ErrInvalidEmailChangeToken
+		}
+		return dto.ConfirmEmailChangeOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetEmailChangeTokenDB, err,
+		)
+	}
+
+	if !token.IsActive() {
+		return dto.ConfirmEmailChangeOutput{}, ucerrs.ErrInvalidEmailChangeToken
+	}
+
+	// Find account
+	account, err := uc.account.GetByID(ctx, token.AccountID())
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.ConfirmEmailChangeOutput{}, ucerrs.ErrInvalidEmailChangeToken
+		}
+		return dto.ConfirmEmailChangeOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetAccountByIDDB, err,
+		)
+	}
+
+	if !account.CanLogin() {
+		return dto.ConfirmEmailChangeOutput{}, ucerrs.ErrCannotLogin
+	}
+
+	// Consume token and swap the email
+	var oldEmail = account.Email()
+	if err := token.Use(); err != nil {
+		return dto.ConfirmEmailChangeOutput{}, ucerrs.ErrInvalidEmailChangeToken
+	}
+	if err := account.ChangeEmail(token.NewEmail()); err != nil {
+		return dto.ConfirmEmailChangeOutput{}, ucerrs.Wrap(
+			ucerrs.ErrInvalidInput, err,
+		)
+	}
+
+	if err := uc.emailChangeToken.Confirm(ctx, token); err != nil {
+		switch {
+		case errors.Is(err, model.ErrTokenAlreadyUsed):
+			return dto.ConfirmEmailChangeOutput{}, ucerrs.ErrInvalidEmailChangeToken
+		case errors.Is(err, pkgerrs.ErrObjectAlreadyExists):
+			return dto.ConfirmEmailChangeOutput{}, ucerrs.ErrAccountAlreadyExists
+		default:
+			return dto.ConfirmEmailChangeOutput{}, ucerrs.Wrap(
+				ucerrs.ErrConfirmEmailChangeDB, err,
+			)
+		}
+	}
+
+	if err := recordAuthEvent(
+		ctx, uc.authEvent, account.ID(), model.AuthEventEmailChanged, model.AuthEventSuccess,
+		nil, nil, nil,
+		map[string]string{"old_email": oldEmail, "new_email": account.Email()},
+	); err != nil {
+		return dto.ConfirmEmailChangeOutput{}, err
+	}
+
+	// The previous owner of the address should know if it was not them
+	body := fmt.Sprintf(
+		"The email of your account has been changed to %s. "+
+			"If it was not you, contact support immediately.",
+		account.Email(),
+	)
+	if err := uc.mailer.Send(ctx, oldEmail, emailChangedAlertSubject, body); err != nil {
+		return dto.ConfirmEmailChangeOutput{}, ucerrs.Wrap(
+			ucerrs.ErrSendEmail, err,
+		)
+	}
+
+	if err := uc.accountPublisher.PublishAccountEmailChanged(
+		ctx, account.ID(), oldEmail, account.Email(),
+	); err != nil {
+		return dto.ConfirmEmailChangeOutput{}, ucerrs.Wrap(
+			ucerrs.ErrPublishEvent, err,
+		)
+	}
+
+	// Output
+	return dto.ConfirmEmailChangeOutput{Changed: true}, nil
+}
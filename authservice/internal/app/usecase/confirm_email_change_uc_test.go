@@ -0,0 +1,181 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestConfirmEmailChangeUC_Execute(t *testing.T) {
+	type adapter struct {
+		account          *mocks.AccountRepository
+		emailChangeToken *mocks.EmailChangeTokenRepository
+		authEvent        *mocks.AuthEventRepository
+		mailer           *mocks.Mailer
+		accountPublisher *mocks.AccountPublisher
+	}
+
+	type testCase struct {
+		name    string
+		input   dto.ConfirmEmailChangeInput
+		prepare func(a adapter)
+		wantErr error
+	}
+
+	rawToken := "raw-email-change-token"
+	hashedToken := utils.HashToken(rawToken)
+	oldEmail := "user@test.com"
+	newEmail := "new@test.com"
+
+	// Every case gets a fresh account, a successful run changes its email
+	newAccount := func() *model.Account {
+		acc, _ := model.NewAccount(oldEmail, "hashed_db")
+		return acc
+	}
+	newToken := func(accountID uuid.UUID) *model.EmailChangeToken {
+		tk, _ := model.NewEmailChangeToken(accountID, newEmail, hashedToken, time.Hour)
+		return tk
+	}
+
+	input := dto.ConfirmEmailChangeInput{Token: rawToken}
+
+	var tests = []testCase{
+		{
+			name:  "Success",
+			input: input,
+			prepare: func(a adapter) {
+				account := newAccount()
+				a.emailChangeToken.On("GetByHash", mock.Anything, hashedToken).
+					Return(newToken(account.ID()), nil)
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.emailChangeToken.On("Confirm", mock.Anything, mock.MatchedBy(func(tk *model.EmailChangeToken) bool {
+					return tk.IsUsed()
+				})).Return(nil)
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.EventType() == model.AuthEventEmailChanged &&
+						e.Metadata()["old_email"] == oldEmail && e.Metadata()["new_email"] == newEmail
+				})).Return(nil)
+				a.mailer.On("Send", mock.Anything, oldEmail, mock.Anything, mock.Anything).Return(nil)
+				a.accountPublisher.On("PublishAccountEmailChanged", mock.Anything,
+					account.ID(), oldEmail, newEmail).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Fail - Token Not Found",
+			input: dto.ConfirmEmailChangeInput{Token: "unknown"},
+			prepare: func(a adapter) {
+				a.emailChangeToken.On("GetByHash", mock.Anything, utils.HashToken("unknown")).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+			wantErr: ucerrs.ErrInvalidEmailChangeToken,
+		},
+		{
+			name:  "Fail - Token Expired",
+			input: input,
+			prepare: func(a adapter) {
+				expired := model.RestoreEmailChangeToken(
+					uuid.New(), uuid.New(), newEmail, hashedToken,
+					time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour), nil,
+				)
+				a.emailChangeToken.On("GetByHash", mock.Anything, hashedToken).
+					Return(expired, nil)
+			},
+			wantErr: ucerrs.ErrInvalidEmailChangeToken,
+		},
+		{
+			name:  "Fail - Blocked Account",
+			input: input,
+			prepare: func(a adapter) {
+				account := newAccount()
+				account.Block()
+				a.emailChangeToken.On("GetByHash", mock.Anything, hashedToken).
+					Return(newToken(account.ID()), nil)
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+			},
+			wantErr: ucerrs.ErrCannotLogin,
+		},
+		{
+			name:  "Fail - Concurrent Use",
+			input: input,
+			prepare: func(a adapter) {
+				account := newAccount()
+				a.emailChangeToken.On("GetByHash", mock.Anything, hashedToken).
+					Return(newToken(account.ID()), nil)
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.emailChangeToken.On("Confirm", mock.Anything, mock.Anything).
+					Return(model.ErrTokenAlreadyUsed)
+			},
+			wantErr: ucerrs.ErrInvalidEmailChangeToken,
+		},
+		{
+			name:  "Fail - Email Taken Meanwhile",
+			input: input,
+			prepare: func(a adapter) {
+				account := newAccount()
+				a.emailChangeToken.On("GetByHash", mock.Anything, hashedToken).
+					Return(newToken(account.ID()), nil)
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.emailChangeToken.On("Confirm", mock.Anything, mock.Anything).
+					Return(pkgerrs.ErrObjectAlreadyExists)
+			},
+			wantErr: ucerrs.ErrAccountAlreadyExists,
+		},
+		{
+			name:  "Fail - Publisher Error",
+			input: input,
+			prepare: func(a adapter) {
+				account := newAccount()
+				a.emailChangeToken.On("GetByHash", mock.Anything, hashedToken).
+					Return(newToken(account.ID()), nil)
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.emailChangeToken.On("Confirm", mock.Anything, mock.Anything).Return(nil)
+				a.authEvent.On("Create", mock.Anything, mock.Anything).Return(nil)
+				a.mailer.On("Send", mock.Anything, oldEmail, mock.Anything, mock.Anything).Return(nil)
+				a.accountPublisher.On("PublishAccountEmailChanged", mock.Anything,
+					mock.Anything, mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrPublishEvent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				account:          mocks.NewAccountRepository(t),
+				emailChangeToken: mocks.NewEmailChangeTokenRepository(t),
+				authEvent:        mocks.NewAuthEventRepository(t),
+				mailer:           mocks.NewMailer(t),
+				accountPublisher: mocks.NewAccountPublisher(t),
+			}
+
+			tt.prepare(a)
+
+			uc := usecase.NewConfirmEmailChangeUC(
+				a.account, a.emailChangeToken, a.authEvent, a.mailer, a.accountPublisher,
+			)
+
+			res, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, res.Changed)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, res.Changed)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+type ConfirmTOTPUC struct {
+	totpFactor   port.TOTPFactorRepository
+	recoveryCode port.RecoveryCodeRepository
+	totpProvider port.TOTPProvider
+	secretCipher port.SecretCipher
+}
+
+func NewConfirmTOTPUC(
+	totpFactor port.TOTPFactorRepository,
+	recoveryCode port.RecoveryCodeRepository,
+	totpProvider port.TOTPProvider,
+	secretCipher port.SecretCipher,
+) *ConfirmTOTPUC {
+	return &ConfirmTOTPUC{
+		totpFactor:   totpFactor,
+		recoveryCode: recoveryCode,
+		totpProvider: totpProvider,
+		secretCipher: secretCipher,
+	}
+}
+
+func (uc *ConfirmTOTPUC) Execute(ctx context.Context, in dto.ConfirmTOTPInput) (dto.ConfirmTOTPOutput, error) {
+	// Find the pending factor
+	factor, err := uc.totpFactor.GetByAccountID(ctx, in.AccountID)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.ConfirmTOTPOutput{}, ucerrs.ErrMFANotEnrolled
+		}
+		return dto.ConfirmTOTPOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetTOTPFactorDB, err,
+		)
+	}
+
+	if factor.IsConfirmed() {
+		return dto.ConfirmTOTPOutput{}, ucerrs.ErrMFAAlreadyEnabled
+	}
+
+	// The code proves the app has the secret
+	secret, err := uc.secretCipher.Decrypt(factor.EncryptedSecret())
+	if err != nil {
+		return dto.ConfirmTOTPOutput{}, ucerrs.Wrap(
+			ucerrs.ErrDecryptSecret, err,
+		)
+	}
+
+	if _, ok := uc.totpProvider.Validate(secret, in.Code, time.Now()); !ok {
+		return dto.ConfirmTOTPOutput{}, ucerrs.ErrInvalidMFACode
+	}
+
+	// Issue recovery codes, only hashes are stored
+	var (
+		plainCodes = make([]string, 0, model.RecoveryCodesCount)
+		codes      = make([]*model.RecoveryCode, 0, model.RecoveryCodesCount)
+	)
+	for range model.RecoveryCodesCount {
+		plain, err := utils.GenerateRecoveryCode()
+		if err != nil {
+			return dto.ConfirmTOTPOutput{}, ucerrs.Wrap(
+				ucerrs.ErrGenerateOneTimeToken, err,
+			)
+		}
+
+		code, err := model.NewRecoveryCode(
+			factor.AccountID(), utils.HashToken(utils.NormalizeRecoveryCode(plain)),
+		)
+		if err != nil {
+			return dto.ConfirmTOTPOutput{}, ucerrs.Wrap(
+				ucerrs.ErrInvalidInput, err,
+			)
+		}
+
+		plainCodes = append(plainCodes, plain)
+		codes = append(codes, code)
+	}
+
+	// Enable the factor, a concurrent confirmation loses here
+	// before it could overwrite the codes
+	if err := factor.Confirm(); err != nil {
+		return dto.ConfirmTOTPOutput{}, ucerrs.ErrMFAAlreadyEnabled
+	}
+
+	if err := uc.totpFactor.Confirm(ctx, factor); err != nil {
+		if errors.Is(err, model.ErrTOTPAlreadyConfirmed) {
+			return dto.ConfirmTOTPOutput{}, ucerrs.ErrMFAAlreadyEnabled
+		}
+		return dto.ConfirmTOTPOutput{}, ucerrs.Wrap(
+			ucerrs.ErrConfirmTOTPFactorDB, err,
+		)
+	}
+
+	// Store the codes
+	if err := uc.recoveryCode.Replace(ctx, factor.AccountID(), codes); err != nil {
+		return dto.ConfirmTOTPOutput{}, ucerrs.Wrap(
+			ucerrs.ErrSaveRecoveryCodesDB, err,
+		)
+	}
+
+	// Output
+	return dto.ConfirmTOTPOutput{RecoveryCodes: plainCodes}, nil
+}
@@ -0,0 +1,157 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestConfirmTOTPUC_Execute(t *testing.T) {
+	type adapter struct {
+		totpFactor   *mocks.TOTPFactorRepository
+		recoveryCode *mocks.RecoveryCodeRepository
+		totpProvider *mocks.TOTPProvider
+		secretCipher *mocks.SecretCipher
+	}
+
+	type testCase struct {
+		name    string
+		prepare func(a adapter)
+		wantErr error
+	}
+
+	var (
+		accountID = uuid.New()
+		code      = "123456"
+		storedSet []*model.RecoveryCode
+	)
+
+	newPending := func() *model.TOTPFactor {
+		factor, _ := model.NewTOTPFactor(accountID, "sealed")
+		return factor
+	}
+
+	var tests = []testCase{
+		{
+			name: "Success",
+			prepare: func(a adapter) {
+				a.totpFactor.On("GetByAccountID", mock.Anything, accountID).Return(newPending(), nil)
+				a.secretCipher.On("Decrypt", "sealed").Return("SECRET", nil)
+				a.totpProvider.On("Validate", "SECRET", code, mock.Anything).Return(int64(1), true)
+				a.totpFactor.On("Confirm", mock.Anything, mock.MatchedBy(func(f *model.TOTPFactor) bool {
+					return f.IsConfirmed()
+				})).Return(nil)
+				a.recoveryCode.On("Replace", mock.Anything, accountID, mock.Anything).
+					Run(func(args mock.Arguments) {
+						storedSet = args.Get(2).([]*model.RecoveryCode)
+					}).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Fail - Not Enrolled",
+			prepare: func(a adapter) {
+				a.totpFactor.On("GetByAccountID", mock.Anything, accountID).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+			wantErr: ucerrs.ErrMFANotEnrolled,
+		},
+		{
+			name: "Fail - Already Enabled",
+			prepare: func(a adapter) {
+				factor := newPending()
+				_ = factor.Confirm()
+				a.totpFactor.On("GetByAccountID", mock.Anything, accountID).Return(factor, nil)
+			},
+			wantErr: ucerrs.ErrMFAAlreadyEnabled,
+		},
+		{
+			name: "Fail - Wrong Code",
+			prepare: func(a adapter) {
+				a.totpFactor.On("GetByAccountID", mock.Anything, accountID).Return(newPending(), nil)
+				a.secretCipher.On("Decrypt", "sealed").Return("SECRET", nil)
+				a.totpProvider.On("Validate", "SECRET", code, mock.Anything).Return(int64(0), false)
+			},
+			wantErr: ucerrs.ErrInvalidMFACode,
+		},
+		{
+			name: "Fail - Decrypt Error",
+			prepare: func(a adapter) {
+				a.totpFactor.On("GetByAccountID", mock.Anything, accountID).Return(newPending(), nil)
+				a.secretCipher.On("Decrypt", "sealed").Return("", assert.AnError)
+			},
+			wantErr: ucerrs.ErrDecryptSecret,
+		},
+		{
+			name: "Fail - Confirmed Concurrently",
+			prepare: func(a adapter) {
+				a.totpFactor.On("GetByAccountID", mock.Anything, accountID).Return(newPending(), nil)
+				a.secretCipher.On("Decrypt", "sealed").Return("SECRET", nil)
+				a.totpProvider.On("Validate", "SECRET", code, mock.Anything).Return(int64(1), true)
+				a.totpFactor.On("Confirm", mock.Anything, mock.Anything).
+					Return(model.ErrTOTPAlreadyConfirmed)
+			},
+			wantErr: ucerrs.ErrMFAAlreadyEnabled,
+		},
+		{
+			name: "Fail - DB Error On Recovery Codes",
+			prepare: func(a adapter) {
+				a.totpFactor.On("GetByAccountID", mock.Anything, accountID).Return(newPending(), nil)
+				a.secretCipher.On("Decrypt", "sealed").Return("SECRET", nil)
+				a.totpProvider.On("Validate", "SECRET", code, mock.Anything).Return(int64(1), true)
+				a.totpFactor.On("Confirm", mock.Anything, mock.Anything).Return(nil)
+				a.recoveryCode.On("Replace", mock.Anything, accountID, mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrSaveRecoveryCodesDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storedSet = nil
+			a := adapter{
+				totpFactor:   mocks.NewTOTPFactorRepository(t),
+				recoveryCode: mocks.NewRecoveryCodeRepository(t),
+				totpProvider: mocks.NewTOTPProvider(t),
+				secretCipher: mocks.NewSecretCipher(t),
+			}
+
+			tt.prepare(a)
+
+			uc := usecase.NewConfirmTOTPUC(
+				a.totpFactor, a.recoveryCode, a.totpProvider, a.secretCipher,
+			)
+
+			res, err := uc.Execute(context.Background(), dto.ConfirmTOTPInput{
+				AccountID: accountID, Code: code,
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, res.RecoveryCodes)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, res.RecoveryCodes, model.RecoveryCodesCount)
+
+				// Only hashes of the returned codes are stored
+				assert.Len(t, storedSet, model.RecoveryCodesCount)
+				for i, plain := range res.RecoveryCodes {
+					assert.Equal(t, utils.HashToken(utils.NormalizeRecoveryCode(plain)), storedSet[i].CodeHash())
+				}
+			}
+		})
+	}
+}
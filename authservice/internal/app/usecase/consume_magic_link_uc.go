@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+type ConsumeMagicLinkUC struct {
+	account        port.AccountRepository
+	totpFactor     port.TOTPFactorRepository
+	magicLinkToken port.MagicLinkTokenRepository
+	tokenGenerator port.TokenGenerator
+	sessions       *sessionIssuer
+}
+
+func NewConsumeMagicLinkUC(
+	account port.AccountRepository,
+	accountRole port.AccountRoleRepository,
+	refreshSession port.RefreshSessionRepository,
+	totpFactor port.TOTPFactorRepository,
+	magicLinkToken port.MagicLinkTokenRepository,
+	tokenGenerator port.TokenGenerator,
+	authEvent port.AuthEventRepository,
+	txManager port.TransactionManager,
+	accountOutbox port.AccountOutbox,
+	sessionLimit *model.SessionLimitPolicy,
+	newDeviceWindow time.Duration,
+	refreshSessionTTL time.Duration,
+) *ConsumeMagicLinkUC {
+	return &ConsumeMagicLinkUC{
+		account:        account,
+		totpFactor:     totpFactor,
+		magicLinkToken: magicLinkToken,
+		tokenGenerator: tokenGenerator,
+		sessions: newSessionIssuer(
+			txManager, account, accountRole, refreshSession,
+			tokenGenerator, authEvent, accountOutbox,
+			sessionLimit, newDeviceWindow, refreshSessionTTL,
+		),
+	}
+}
+
+func (uc *ConsumeMagicLinkUC) Execute(ctx context.Context, in dto.ConsumeMagicLinkInput) (dto.ConsumeMagicLinkOutput, error) {
+	// Find token
+	token, err := uc.magicLinkToken.GetByHash(ctx, utils.HashToken(in.Token))
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.ConsumeMagicLinkOutput{}, ucerrs.ErrInvalidMagicLink
+		}
+		return dto.ConsumeMagicLinkOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetMagicLinkTokenDB, err,
+		)
+	}
+
+	if !token.IsActive() {
+		return dto.ConsumeMagicLinkOutput{}, ucerrs.ErrInvalidMagicLink
+	}
+
+	// Consume token, concurrent clicks on the same link get one session only
+	if err := token.Use(); err != nil {
+		return dto.ConsumeMagicLinkOutput{}, ucerrs.ErrInvalidMagicLink
+	}
+	if err := uc.magicLinkToken.MarkUsed(ctx, token); err != nil {
+		if errors.Is(err, model.ErrTokenAlreadyUsed) {
+			return dto.ConsumeMagicLinkOutput{}, ucerrs.ErrInvalidMagicLink
+		}
+		return dto.ConsumeMagicLinkOutput{}, ucerrs.Wrap(
+			ucerrs.ErrUseMagicLinkTokenDB, err,
+		)
+	}
+
+	// Find account
+	account, err := uc.account.GetByID(ctx, token.AccountID())
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.ConsumeMagicLinkOutput{}, ucerrs.ErrInvalidMagicLink
+		}
+		return dto.ConsumeMagicLinkOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetAccountByIDDB, err,
+		)
+	}
+
+	// Account validation, it could be blocked after the link was sent
+	if ok := account.CanLogin(); !ok {
+		return dto.ConsumeMagicLinkOutput{}, ucerrs.ErrCannotLogin
+	}
+
+	// The link replaces the password only, 2FA still applies
+	login, ok, err := mfaChallenge(
+		ctx, uc.totpFactor, uc.tokenGenerator, account.ID(),
+	)
+	if err != nil {
+		return dto.ConsumeMagicLinkOutput{}, err
+	}
+	if !ok {
+		login, err = uc.sessions.issue(
+			ctx, account, loginMethodMagicLink, in.IP, in.UserAgent,
+		)
+		if err != nil {
+			return dto.ConsumeMagicLinkOutput{}, err
+		}
+	}
+
+	// Output
+	return dto.ConsumeMagicLinkOutput{
+		AccessToken:  login.AccessToken,
+		RefreshToken: login.RefreshToken,
+		MFARequired:  login.MFARequired,
+		MFAToken:     login.MFAToken,
+	}, nil
+}
@@ -0,0 +1,203 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestConsumeMagicLinkUC_Execute(t *testing.T) {
+	type adapter struct {
+		account        *mocks.AccountRepository
+		accountRole    *mocks.AccountRoleRepository
+		refreshSession *mocks.RefreshSessionRepository
+		totpFactor     *mocks.TOTPFactorRepository
+		magicLinkToken *mocks.MagicLinkTokenRepository
+		tokenGenerator *mocks.TokenGenerator
+		authEvent      *mocks.AuthEventRepository
+		txManager      *mocks.TransactionManager
+	}
+
+	type testCase struct {
+		name    string
+		input   dto.ConsumeMagicLinkInput
+		prepare func(a adapter)
+		wantErr error
+		wantMFA bool
+	}
+
+	rawToken := "raw-magic-link-token"
+	hashedToken := utils.HashToken(rawToken)
+	ip, ua := "127.0.0.1", "Mozilla/5.0"
+
+	account, _ := model.NewAccount("user@test.com", "hashed_db")
+	blockedAccount, _ := model.NewAccount("blocked@test.com", "hashed_db")
+	blockedAccount.Block()
+
+	noSessionLimit, _ := model.NewSessionLimitPolicy("evict_oldest", nil)
+
+	confirmedFactor, _ := model.NewTOTPFactor(account.ID(), "sealed")
+	_ = confirmedFactor.Confirm()
+
+	newToken := func(accountID uuid.UUID) *model.MagicLinkToken {
+		tk, _ := model.NewMagicLinkToken(accountID, hashedToken, 15*time.Minute)
+		return tk
+	}
+	// The link is valid and consumed
+	consumed := func(a adapter, acc *model.Account) {
+		a.magicLinkToken.On("GetByHash", mock.Anything, hashedToken).
+			Return(newToken(acc.ID()), nil)
+		a.magicLinkToken.On("MarkUsed", mock.Anything, mock.MatchedBy(func(tk *model.MagicLinkToken) bool {
+			return tk.IsUsed()
+		})).Return(nil)
+		a.account.On("GetByID", mock.Anything, acc.ID()).Return(acc, nil)
+	}
+
+	input := dto.ConsumeMagicLinkInput{Token: rawToken, IP: &ip, UserAgent: &ua}
+
+	var tests = []testCase{
+		{
+			name:  "Success",
+			input: input,
+			prepare: func(a adapter) {
+				consumed(a, account)
+				a.totpFactor.On("GetByAccountID", mock.Anything, account.ID()).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+				a.account.On("MarkLogin", mock.Anything, account).Return(nil)
+				a.accountRole.On("Get", mock.Anything, account.ID()).
+					Return(func(_ context.Context, id uuid.UUID) (*model.AccountRole, error) {
+						return model.NewAccountRole(id)
+					})
+				a.tokenGenerator.On("GenerateAccessToken", mock.Anything, mock.Anything).
+					Return("access", nil)
+				a.tokenGenerator.On("GenerateRefreshToken", mock.Anything, account.ID(), mock.Anything).
+					Return("refresh", nil)
+				a.refreshSession.On("Create", mock.Anything, mock.MatchedBy(func(s *model.RefreshSession) bool {
+					return s.AccountID() == account.ID() && *s.IP() == ip && *s.UserAgent() == ua
+				})).Return(nil)
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.EventType() == model.AuthEventLogin && e.Metadata()["method"] == "magic_link"
+				})).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Success - MFA Required",
+			input: input,
+			prepare: func(a adapter) {
+				consumed(a, account)
+				a.totpFactor.On("GetByAccountID", mock.Anything, account.ID()).
+					Return(confirmedFactor, nil)
+				a.tokenGenerator.On("GenerateMFAToken", mock.Anything, account.ID()).
+					Return("mfa-token", nil)
+			},
+			wantErr: nil,
+			wantMFA: true,
+		},
+		{
+			name:  "Fail - Token Not Found",
+			input: dto.ConsumeMagicLinkInput{Token: "unknown"},
+			prepare: func(a adapter) {
+				a.magicLinkToken.On("GetByHash", mock.Anything, utils.HashToken("unknown")).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+			wantErr: ucerrs.ErrInvalidMagicLink,
+		},
+		{
+			name:  "Fail - Token Expired",
+			input: input,
+			prepare: func(a adapter) {
+				expired := model.RestoreMagicLinkToken(
+					uuid.New(), account.ID(), hashedToken,
+					time.Now().Add(-time.Hour), time.Now().Add(-time.Minute), nil,
+				)
+				a.magicLinkToken.On("GetByHash", mock.Anything, hashedToken).
+					Return(expired, nil)
+			},
+			wantErr: ucerrs.ErrInvalidMagicLink,
+		},
+		{
+			name:  "Fail - Concurrent Use",
+			input: input,
+			prepare: func(a adapter) {
+				a.magicLinkToken.On("GetByHash", mock.Anything, hashedToken).
+					Return(newToken(account.ID()), nil)
+				a.magicLinkToken.On("MarkUsed", mock.Anything, mock.Anything).
+					Return(model.ErrTokenAlreadyUsed)
+			},
+			wantErr: ucerrs.ErrInvalidMagicLink,
+		},
+		{
+			name:  "Fail - DB Error On Mark Used",
+			input: input,
+			prepare: func(a adapter) {
+				a.magicLinkToken.On("GetByHash", mock.Anything, hashedToken).
+					Return(newToken(account.ID()), nil)
+				a.magicLinkToken.On("MarkUsed", mock.Anything, mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrUseMagicLinkTokenDB,
+		},
+		{
+			name:  "Fail - Blocked Account",
+			input: input,
+			prepare: func(a adapter) {
+				consumed(a, blockedAccount)
+			},
+			wantErr: ucerrs.ErrCannotLogin,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				account:        mocks.NewAccountRepository(t),
+				accountRole:    mocks.NewAccountRoleRepository(t),
+				refreshSession: mocks.NewRefreshSessionRepository(t),
+				totpFactor:     mocks.NewTOTPFactorRepository(t),
+				magicLinkToken: mocks.NewMagicLinkTokenRepository(t),
+				tokenGenerator: mocks.NewTokenGenerator(t),
+				authEvent:      mocks.NewAuthEventRepository(t),
+				txManager:      mocks.NewTransactionManager(t),
+			}
+
+			allowTx(a.txManager)
+			tt.prepare(a)
+
+			uc := usecase.NewConsumeMagicLinkUC(
+				a.account, a.accountRole, a.refreshSession, a.totpFactor,
+				a.magicLinkToken, a.tokenGenerator, a.authEvent, a.txManager,
+				nil, noSessionLimit, 0, time.Hour,
+			)
+
+			res, err := uc.Execute(context.Background(), tt.input)
+
+			switch {
+			case tt.wantErr != nil:
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, res.AccessToken)
+			case tt.wantMFA:
+				assert.NoError(t, err)
+				assert.True(t, res.MFARequired)
+				assert.Equal(t, "mfa-token", res.MFAToken)
+				assert.Empty(t, res.AccessToken)
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, "access", res.AccessToken)
+				assert.Equal(t, "refresh", res.RefreshToken)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+// Saves a new account with its roles and queues AccountCreated in one
+// transaction, so other services learn about every account that exists
+// and only about those
+func createAccount(
+	ctx context.Context,
+	txManager port.TransactionManager,
+	accountRepo port.AccountRepository,
+	accountRoleRepo port.AccountRoleRepository,
+	accountOutbox port.AccountOutbox,
+	account *model.Account, accountRole *model.AccountRole,
+) error {
+	return withinTx(ctx, txManager, func(ctx context.Context) error {
+		if err := accountRepo.Create(ctx, account); err != nil {
+			if errors.Is(err, pkgerrs.ErrObjectAlreadyExists) {
+				return ucerrs.ErrAccountAlreadyExists
+			}
+			return ucerrs.Wrap(ucerrs.ErrCreateAccountDB, err)
+		}
+		if err := accountRoleRepo.Create(ctx, accountRole); err != nil {
+			return ucerrs.Wrap(ucerrs.ErrCreateAccountRoleDB, err)
+		}
+
+		// Published by the outbox relay after commit (create profile)
+		if err := accountOutbox.AddAccountCreated(ctx, account.ID()); err != nil {
+			return ucerrs.Wrap(ucerrs.ErrAddOutboxEventDB, err)
+		}
+		return nil
+	})
+}
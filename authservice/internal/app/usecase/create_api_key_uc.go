@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+type CreateAPIKeyUC struct {
+	account     port.AccountRepository
+	accountRole port.AccountRoleRepository
+	apiKey      port.APIKeyRepository
+}
+
+func NewCreateAPIKeyUC(
+	account port.AccountRepository,
+	accountRole port.AccountRoleRepository,
+	apiKey port.APIKeyRepository,
+) *CreateAPIKeyUC {
+	return &CreateAPIKeyUC{
+		account:     account,
+		accountRole: accountRole,
+		apiKey:      apiKey,
+	}
+}
+
+func (uc *CreateAPIKeyUC) Execute(ctx context.Context, in dto.CreateAPIKeyInput) (dto.CreateAPIKeyOutput, error) {
+	// Find account and check if it is active
+	account, err := uc.account.GetByID(ctx, in.AccountID)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.CreateAPIKeyOutput{}, ucerrs.ErrInvalidAccountID
+		}
+		return dto.CreateAPIKeyOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetAccountByIDDB, err,
+		)
+	}
+	if !account.CanLogin() {
+		return dto.CreateAPIKeyOutput{}, ucerrs.ErrCannotLogin
+	}
+
+	// A key can not grant more than the account has,
+	// nor more than the credential creating it may do
+	accRole, err := uc.accountRole.Get(ctx, account.ID())
+	if err != nil {
+		return dto.CreateAPIKeyOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetAccountRoleDB, err,
+		)
+	}
+
+	var scopes = make([]model.Permission, 0, len(in.Scopes))
+	for _, rawScope := range in.Scopes {
+		scope := model.Permission(rawScope)
+		if !accRole.HasPermission(scope) || !slices.Contains(in.CallerPermissions, rawScope) {
+			return dto.CreateAPIKeyOutput{}, ucerrs.ErrInvalidScope
+		}
+		scopes = append(scopes, scope)
+	}
+
+	// Generate the key, only its hash is stored
+	prefix, key, err := utils.GenerateAPIKey()
+	if err != nil {
+		return dto.CreateAPIKeyOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGenerateAPIKey, err,
+		)
+	}
+
+	apiKey, err := model.NewAPIKey(
+		account.ID(), in.Name, prefix, utils.HashToken(key), scopes, in.ExpiresAt,
+	)
+	if err != nil {
+		return dto.CreateAPIKeyOutput{}, ucerrs.Wrap(
+			ucerrs.ErrInvalidInput, err,
+		)
+	}
+
+	if err := uc.apiKey.Create(ctx, apiKey); err != nil {
+		return dto.CreateAPIKeyOutput{}, ucerrs.Wrap(
+			ucerrs.ErrCreateAPIKeyDB, err,
+		)
+	}
+
+	// Output
+	return dto.CreateAPIKeyOutput{
+		KeyID:  apiKey.ID(),
+		Key:    key,
+		Prefix: prefix,
+	}, nil
+}
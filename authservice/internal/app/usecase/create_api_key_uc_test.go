@@ -0,0 +1,143 @@
+package usecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateAPIKeyUC_Execute(t *testing.T) {
+	type adapter struct {
+		account     *mocks.AccountRepository
+		accountRole *mocks.AccountRoleRepository
+		apiKey      *mocks.APIKeyRepository
+	}
+
+	type testCase struct {
+		name    string
+		scopes  []string
+		caller  []string // what the requesting credential may do, all by default
+		prepare func(a adapter)
+		wantErr error
+	}
+
+	account, _ := model.NewAccount("seller@test.com", "hashed_db")
+	accRole := model.RestoreAccountRole(
+		account.ID(),
+		[]model.Role{model.RoleUser},
+		[]model.Permission{model.PermissionAdsRead, model.PermissionAdsWrite},
+	)
+
+	var tests = []testCase{
+		{
+			name:   "Success",
+			scopes: []string{"ads:write"},
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.accountRole.On("Get", mock.Anything, account.ID()).Return(accRole, nil)
+				a.apiKey.On("Create", mock.Anything, mock.MatchedBy(func(k *model.APIKey) bool {
+					return k.AccountID() == account.ID() && k.Name() == "ci" &&
+						len(k.Scopes()) == 1 && k.Scopes()[0] == model.PermissionAdsWrite
+				})).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "Fail - Account Not Found",
+			scopes: []string{"ads:write"},
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+			wantErr: ucerrs.ErrInvalidAccountID,
+		},
+		{
+			name:   "Fail - Scope Not Granted",
+			scopes: []string{"ads:write", "users:block"},
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.accountRole.On("Get", mock.Anything, account.ID()).Return(accRole, nil)
+			},
+			wantErr: ucerrs.ErrInvalidScope,
+		},
+		{
+			name:   "Fail - Scope Beyond Caller Credential",
+			scopes: []string{"ads:write"},
+			caller: []string{"ads:read"},
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.accountRole.On("Get", mock.Anything, account.ID()).Return(accRole, nil)
+			},
+			wantErr: ucerrs.ErrInvalidScope,
+		},
+		{
+			name:   "Fail - No Scopes",
+			scopes: nil,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.accountRole.On("Get", mock.Anything, account.ID()).Return(accRole, nil)
+			},
+			wantErr: ucerrs.ErrInvalidInput,
+		},
+		{
+			name:   "Fail - DB Error On Create",
+			scopes: []string{"ads:read"},
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.accountRole.On("Get", mock.Anything, account.ID()).Return(accRole, nil)
+				a.apiKey.On("Create", mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrCreateAPIKeyDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				account:     mocks.NewAccountRepository(t),
+				accountRole: mocks.NewAccountRoleRepository(t),
+				apiKey:      mocks.NewAPIKeyRepository(t),
+			}
+
+			tt.prepare(a)
+
+			uc := usecase.NewCreateAPIKeyUC(a.account, a.accountRole, a.apiKey)
+
+			caller := tt.caller
+			if caller == nil {
+				caller = []string{"ads:read", "ads:write"}
+			}
+
+			res, err := uc.Execute(context.Background(), dto.CreateAPIKeyInput{
+				AccountID: account.ID(),
+				Name:      "ci",
+				Scopes:    tt.scopes,
+
+				CallerPermissions: caller,
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, res.Key)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, strings.HasPrefix(res.Key, res.Prefix+"_"))
+
+				prefix, ok := utils.APIKeyPrefix(res.Key)
+				assert.True(t, ok)
+				assert.Equal(t, res.Prefix, prefix)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// Resolves the caller's own session by its refresh token. The session
+// must be active and belong to the account taken from the request context
+func currentSession(
+	ctx context.Context,
+	tokenGenerator port.TokenGenerator,
+	refreshSession port.RefreshSessionRepository,
+	accountID uuid.UUID, refreshToken string,
+) (*model.RefreshSession, error) {
+	tokenAccountID, sessionID, err := tokenGenerator.ValidateRefreshToken(
+		ctx, refreshToken,
+	)
+	if err != nil || tokenAccountID != accountID {
+		return nil, ucerrs.ErrInvalidRefreshToken
+	}
+
+	session, err := refreshSession.GetByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return nil, ucerrs.ErrInvalidRefreshToken
+		}
+		return nil, ucerrs.Wrap(ucerrs.ErrGetRefreshSessionByIDDB, err)
+	}
+
+	if !session.IsActive() ||
+		utils.HashToken(refreshToken) != session.RefreshTokenHash() {
+		return nil, ucerrs.ErrInvalidRefreshToken
+	}
+
+	return session, nil
+}
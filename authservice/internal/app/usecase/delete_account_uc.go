@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+)
+
+type DeleteAccountUC struct {
+	account        port.AccountRepository
+	accountRole    port.AccountRoleRepository
+	refreshSession port.RefreshSessionRepository
+	authEvent      port.AuthEventRepository
+	txManager      port.TransactionManager
+	accountOutbox  port.AccountOutbox
+}
+
+func NewDeleteAccountUC(
+	account port.AccountRepository,
+	accountRole port.AccountRoleRepository,
+	refreshSession port.RefreshSessionRepository,
+	authEvent port.AuthEventRepository,
+	txManager port.TransactionManager,
+	accountOutbox port.AccountOutbox,
+) *DeleteAccountUC {
+	return &DeleteAccountUC{
+		account:        account,
+		accountRole:    accountRole,
+		refreshSession: refreshSession,
+		authEvent:      authEvent,
+		txManager:      txManager,
+		accountOutbox:  accountOutbox,
+	}
+}
+
+func (uc *DeleteAccountUC) Execute(ctx context.Context, in dto.DeleteAccountInput) (dto.DeleteAccountOutput, error) {
+	// Change the status
+	if err := moderateAccount(
+		ctx, uc.txManager, uc.account, uc.accountRole,
+		in.ActorID, in.AccountID, in.Reason,
+		(*model.Account).Delete,
+		func(ctx context.Context, acc *model.Account) error {
+			// Log out from every device
+			var reason = "account deleted"
+			if err := uc.refreshSession.RevokeAllForAccount(ctx, acc.ID(), &reason); err != nil {
+				return ucerrs.Wrap(ucerrs.ErrRevokeRefreshSessionDB, err)
+			}
+
+			// Audit
+			if err := recordModeration(
+				ctx, uc.authEvent, model.AuthEventAccountDeleted,
+				in.ActorID, acc.ID(), in.Reason,
+			); err != nil {
+				return err
+			}
+
+			// Published by the outbox relay after commit (notify other services)
+			if err := uc.accountOutbox.AddAccountDeleted(ctx, acc.ID(), in.Reason); err != nil {
+				return ucerrs.Wrap(ucerrs.ErrAddOutboxEventDB, err)
+			}
+			return nil
+		},
+	); err != nil {
+		return dto.DeleteAccountOutput{}, err
+	}
+
+	return dto.DeleteAccountOutput{Deleted: true}, nil
+}
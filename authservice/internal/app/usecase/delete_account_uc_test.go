@@ -0,0 +1,144 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeleteAccountUC_Execute(t *testing.T) {
+	type adapter struct {
+		account        *mocks.AccountRepository
+		refreshSession *mocks.RefreshSessionRepository
+		authEvent      *mocks.AuthEventRepository
+		accountRole    *mocks.AccountRoleRepository
+		txManager      *mocks.TransactionManager
+		accountOutbox  *mocks.AccountOutbox
+	}
+
+	type testCase struct {
+		name    string
+		prepare func(a adapter)
+		wantErr error
+	}
+
+	var (
+		actorID   = uuid.New()
+		accountID = uuid.New()
+		reason    = "fraud"
+	)
+
+	newAccount := func(status model.AccountStatus) *model.Account {
+		return model.RestoreAccount(
+			accountID, "test@example.com", "hashed",
+			status, true, time.Now(), time.Now(), nil,
+		)
+	}
+
+	// The actor is an admin, the account a regular user
+	outranked := func(a adapter) {
+		a.accountRole.On("Get", mock.Anything, actorID).
+			Return(model.RestoreAccountRole(actorID, []model.Role{model.RoleAdmin}, nil), nil)
+		a.accountRole.On("Get", mock.Anything, accountID).
+			Return(model.RestoreAccountRole(accountID, []model.Role{model.RoleUser}, nil), nil)
+	}
+
+	var tests = []testCase{
+		{
+			name: "Success - Blocked Account",
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountBlocked), nil)
+				outranked(a)
+				a.account.On("UpdateStatus", mock.Anything, mock.MatchedBy(func(acc *model.Account) bool {
+					return acc.IsDeleted()
+				})).Return(nil)
+				a.refreshSession.On("RevokeAllForAccount", mock.Anything, accountID, mock.MatchedBy(func(r *string) bool {
+					return *r == "account deleted"
+				})).Return(nil)
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.EventType() == model.AuthEventAccountDeleted
+				})).Return(nil)
+				a.accountOutbox.On("AddAccountDeleted", mock.Anything, accountID, reason).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Fail - Admin Account",
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountActive), nil)
+				a.accountRole.On("Get", mock.Anything, actorID).
+					Return(model.RestoreAccountRole(actorID, []model.Role{model.RoleAdmin}, nil), nil)
+				a.accountRole.On("Get", mock.Anything, accountID).
+					Return(model.RestoreAccountRole(accountID, []model.Role{model.RoleUser, model.RoleAdmin}, nil), nil)
+			},
+			wantErr: ucerrs.ErrCannotModeratePeer,
+		},
+		{
+			name: "Fail - Already Deleted",
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountDeleted), nil)
+				outranked(a)
+			},
+			wantErr: ucerrs.ErrInvalidStatusTransition,
+		},
+		{
+			name: "Fail - DB Error On Revoke",
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountActive), nil)
+				outranked(a)
+				a.account.On("UpdateStatus", mock.Anything, mock.Anything).Return(nil)
+				a.refreshSession.On("RevokeAllForAccount", mock.Anything, accountID, mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrRevokeRefreshSessionDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				account:        mocks.NewAccountRepository(t),
+				refreshSession: mocks.NewRefreshSessionRepository(t),
+				authEvent:      mocks.NewAuthEventRepository(t),
+				accountRole:    mocks.NewAccountRoleRepository(t),
+				txManager:      mocks.NewTransactionManager(t),
+				accountOutbox:  mocks.NewAccountOutbox(t),
+			}
+
+			allowTx(a.txManager)
+			tt.prepare(a)
+
+			uc := usecase.NewDeleteAccountUC(
+				a.account, a.accountRole, a.refreshSession, a.authEvent,
+				a.txManager, a.accountOutbox,
+			)
+
+			res, err := uc.Execute(context.Background(), dto.DeleteAccountInput{
+				ActorID: actorID, AccountID: accountID, Reason: reason,
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, res.Deleted)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, res.Deleted)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+type EnrollTOTPUC struct {
+	account      port.AccountRepository
+	totpFactor   port.TOTPFactorRepository
+	totpProvider port.TOTPProvider
+	secretCipher port.SecretCipher
+}
+
+func NewEnrollTOTPUC(
+	account port.AccountRepository,
+	totpFactor port.TOTPFactorRepository,
+	totpProvider port.TOTPProvider,
+	secretCipher port.SecretCipher,
+) *EnrollTOTPUC {
+	return &EnrollTOTPUC{
+		account:      account,
+		totpFactor:   totpFactor,
+		totpProvider: totpProvider,
+		secretCipher: secretCipher,
+	}
+}
+
+func (uc *EnrollTOTPUC) Execute(ctx context.Context, in dto.EnrollTOTPInput) (dto.EnrollTOTPOutput, error) {
+	// Find account, its email labels the entry in an authenticator app
+	account, err := uc.account.GetByID(ctx, in.AccountID)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.EnrollTOTPOutput{}, ucerrs.ErrInvalidAccountID
+		}
+		return dto.EnrollTOTPOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetAccountByIDDB, err,
+		)
+	}
+
+	if !account.CanLogin() {
+		return dto.EnrollTOTPOutput{}, ucerrs.ErrCannotLogin
+	}
+
+	// Generate and seal the secret
+	secret, err := uc.totpProvider.GenerateSecret()
+	if err != nil {
+		return dto.EnrollTOTPOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGenerateTOTPSecret, err,
+		)
+	}
+
+	encryptedSecret, err := uc.secretCipher.Encrypt(secret)
+	if err != nil {
+		return dto.EnrollTOTPOutput{}, ucerrs.Wrap(
+			ucerrs.ErrEncryptSecret, err,
+		)
+	}
+
+	factor, err := model.NewTOTPFactor(account.ID(), encryptedSecret)
+	if err != nil {
+		return dto.EnrollTOTPOutput{}, ucerrs.Wrap(
+			ucerrs.ErrInvalidInput, err,
+		)
+	}
+
+	// A pending enrollment is replaced, a confirmed one is kept
+	if err := uc.totpFactor.Save(ctx, factor); err != nil {
+		if errors.Is(err, model.ErrTOTPAlreadyConfirmed) {
+			return dto.EnrollTOTPOutput{}, ucerrs.ErrMFAAlreadyEnabled
+		}
+		return dto.EnrollTOTPOutput{}, ucerrs.Wrap(
+			ucerrs.ErrSaveTOTPFactorDB, err,
+		)
+	}
+
+	// Output
+	return dto.EnrollTOTPOutput{
+		Secret: secret,
+		URI:    uc.totpProvider.URI(secret, account.Email()),
+	}, nil
+}
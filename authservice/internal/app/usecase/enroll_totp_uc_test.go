@@ -0,0 +1,117 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEnrollTOTPUC_Execute(t *testing.T) {
+	type adapter struct {
+		account      *mocks.AccountRepository
+		totpFactor   *mocks.TOTPFactorRepository
+		totpProvider *mocks.TOTPProvider
+		secretCipher *mocks.SecretCipher
+	}
+
+	type testCase struct {
+		name    string
+		prepare func(a adapter)
+		wantErr error
+	}
+
+	account, _ := model.NewAccount("seller@test.com", "hashed_db")
+
+	var tests = []testCase{
+		{
+			name: "Success",
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.totpProvider.On("GenerateSecret").Return("SECRET", nil)
+				a.secretCipher.On("Encrypt", "SECRET").Return("sealed", nil)
+				a.totpFactor.On("Save", mock.Anything, mock.MatchedBy(func(f *model.TOTPFactor) bool {
+					return f.AccountID() == account.ID() &&
+						f.EncryptedSecret() == "sealed" && !f.IsConfirmed()
+				})).Return(nil)
+				a.totpProvider.On("URI", "SECRET", "seller@test.com").
+					Return("otpauth://totp/Ads:seller@test.com?secret=SECRET")
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Fail - Account Not Found",
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+			wantErr: ucerrs.ErrInvalidAccountID,
+		},
+		{
+			name: "Fail - Already Enabled",
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.totpProvider.On("GenerateSecret").Return("SECRET", nil)
+				a.secretCipher.On("Encrypt", "SECRET").Return("sealed", nil)
+				a.totpFactor.On("Save", mock.Anything, mock.Anything).
+					Return(model.ErrTOTPAlreadyConfirmed)
+			},
+			wantErr: ucerrs.ErrMFAAlreadyEnabled,
+		},
+		{
+			name: "Fail - Encrypt Error",
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.totpProvider.On("GenerateSecret").Return("SECRET", nil)
+				a.secretCipher.On("Encrypt", "SECRET").Return("", assert.AnError)
+			},
+			wantErr: ucerrs.ErrEncryptSecret,
+		},
+		{
+			name: "Fail - DB Error On Save",
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.totpProvider.On("GenerateSecret").Return("SECRET", nil)
+				a.secretCipher.On("Encrypt", "SECRET").Return("sealed", nil)
+				a.totpFactor.On("Save", mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrSaveTOTPFactorDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				account:      mocks.NewAccountRepository(t),
+				totpFactor:   mocks.NewTOTPFactorRepository(t),
+				totpProvider: mocks.NewTOTPProvider(t),
+				secretCipher: mocks.NewSecretCipher(t),
+			}
+
+			tt.prepare(a)
+
+			uc := usecase.NewEnrollTOTPUC(
+				a.account, a.totpFactor, a.totpProvider, a.secretCipher,
+			)
+
+			res, err := uc.Execute(context.Background(), dto.EnrollTOTPInput{AccountID: account.ID()})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, res.Secret)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "SECRET", res.Secret)
+				assert.Contains(t, res.URI, "otpauth://")
+			}
+		})
+	}
+}
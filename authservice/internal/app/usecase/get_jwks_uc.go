@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+)
+
+type GetJWKSUC struct {
+	keySet port.KeySet
+}
+
+func NewGetJWKSUC(keySet port.KeySet) *GetJWKSUC {
+	return &GetJWKSUC{keySet: keySet}
+}
+
+func (uc *GetJWKSUC) Execute(ctx context.Context, _ dto.GetJWKSInput) (dto.GetJWKSOutput, error) {
+	// Get public keys
+	keys, err := uc.keySet.PublicKeys(ctx)
+	if err != nil {
+		return dto.GetJWKSOutput{}, ucerrs.Wrap(ucerrs.ErrGetPublicKeys, err)
+	}
+
+	// Output
+	var out = dto.GetJWKSOutput{Keys: make([]dto.JWK, 0, len(keys))}
+	for _, key := range keys {
+		jwk, err := toJWK(key)
+		if err != nil {
+			return dto.GetJWKSOutput{}, ucerrs.Wrap(ucerrs.ErrGetPublicKeys, err)
+		}
+		out.Keys = append(out.Keys, jwk)
+	}
+
+	return out, nil
+}
+
+// Encodes the key parameters as described in RFC 7518 (RSA) and RFC 8037 (OKP)
+func toJWK(key *model.PublicKey) (dto.JWK, error) {
+	var jwk = dto.JWK{
+		KeyID:     key.KeyID(),
+		Algorithm: key.Algorithm().String(),
+		Use:       "sig",
+	}
+
+	switch pub := key.Key().(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	default:
+		return dto.JWK{}, fmt.Errorf("unsupported public key type %T", pub)
+	}
+
+	return jwk, nil
+}
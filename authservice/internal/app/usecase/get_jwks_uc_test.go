@@ -0,0 +1,100 @@
+package usecase_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"testing"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetJWKSUC_Execute(t *testing.T) {
+	type adapter struct {
+		keySet *mocks.KeySet
+	}
+
+	type testCase struct {
+		name     string
+		prepare  func(a adapter)
+		wantErr  error
+		wantKeys []dto.JWK
+	}
+
+	edPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	activeKey, _ := model.NewPublicKey("active", model.SigningAlgEdDSA, edPub, true)
+	retiredKey, _ := model.NewPublicKey("retired", model.SigningAlgRS256, &rsaKey.PublicKey, false)
+
+	var tests = []testCase{
+		{
+			name: "Success",
+			prepare: func(a adapter) {
+				a.keySet.On("PublicKeys", mock.Anything).
+					Return([]*model.PublicKey{activeKey, retiredKey}, nil)
+			},
+			wantErr: nil,
+			wantKeys: []dto.JWK{
+				{
+					KeyID:     "active",
+					KeyType:   "OKP",
+					Algorithm: "EdDSA",
+					Use:       "sig",
+					Curve:     "Ed25519",
+					X:         base64.RawURLEncoding.EncodeToString(edPub),
+				},
+				{
+					KeyID:     "retired",
+					KeyType:   "RSA",
+					Algorithm: "RS256",
+					Use:       "sig",
+					N:         base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()),
+					E:         "AQAB",
+				},
+			},
+		},
+		{
+			name: "Fail - Key Set Error",
+			prepare: func(a adapter) {
+				a.keySet.On("PublicKeys", mock.Anything).
+					Return(nil, assert.AnError)
+			},
+			wantErr: ucerrs.ErrGetPublicKeys,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				keySet: mocks.NewKeySet(t),
+			}
+
+			tt.prepare(a)
+
+			uc := usecase.NewGetJWKSUC(a.keySet)
+
+			res, err := uc.Execute(context.Background(), dto.GetJWKSInput{})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, res.Keys)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantKeys, res.Keys)
+			}
+		})
+	}
+}
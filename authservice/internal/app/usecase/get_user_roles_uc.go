@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+type GetUserRolesUC struct {
+	accountRole port.AccountRoleRepository
+}
+
+func NewGetUserRolesUC(accountRole port.AccountRoleRepository) *GetUserRolesUC {
+	return &GetUserRolesUC{accountRole: accountRole}
+}
+
+func (uc *GetUserRolesUC) Execute(ctx context.Context, in dto.GetUserRolesInput) (dto.GetUserRolesOutput, error) {
+	// Get roles with their permissions
+	accRole, err := uc.accountRole.Get(ctx, in.AccountID)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.GetUserRolesOutput{}, ucerrs.ErrInvalidAccountID
+		}
+		return dto.GetUserRolesOutput{}, ucerrs.Wrap(ucerrs.ErrGetAccountRoleDB, err)
+	}
+
+	// Output
+	out := dto.GetUserRolesOutput{
+		Roles:       make([]string, 0, len(accRole.Roles())),
+		Permissions: make([]string, 0, len(accRole.Permissions())),
+	}
+	for _, role := range accRole.Roles() {
+		out.Roles = append(out.Roles, role.String())
+	}
+	for _, permission := range accRole.Permissions() {
+		out.Permissions = append(out.Permissions, permission.String())
+	}
+
+	return out, nil
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+type ImpersonateUC struct {
+	account          port.AccountRepository
+	accountRole      port.AccountRoleRepository
+	tokenGenerator   port.TokenGenerator
+	authEvent        port.AuthEventRepository
+	impersonationTTL time.Duration
+}
+
+func NewImpersonateUC(
+	account port.AccountRepository,
+	accountRole port.AccountRoleRepository,
+	tokenGenerator port.TokenGenerator,
+	authEvent port.AuthEventRepository,
+	impersonationTTL time.Duration,
+) *ImpersonateUC {
+	return &ImpersonateUC{
+		account:          account,
+		accountRole:      accountRole,
+		tokenGenerator:   tokenGenerator,
+		authEvent:        authEvent,
+		impersonationTTL: impersonationTTL,
+	}
+}
+
+func (uc *ImpersonateUC) Execute(ctx context.Context, in dto.ImpersonateInput) (dto.ImpersonateOutput, error) {
+	if strings.TrimSpace(in.Reason) == "" {
+		return dto.ImpersonateOutput{}, ucerrs.ErrReasonRequired
+	}
+	if in.ActorID == in.AccountID {
+		return dto.ImpersonateOutput{}, ucerrs.ErrCannotImpersonateSelf
+	}
+
+	// Find account, a blocked one can not be entered either
+	account, err := uc.account.GetByID(ctx, in.AccountID)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.ImpersonateOutput{}, ucerrs.ErrInvalidAccountID
+		}
+		return dto.ImpersonateOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetAccountByIDDB, err,
+		)
+	}
+	if !account.CanLogin() {
+		return dto.ImpersonateOutput{}, ucerrs.ErrCannotLogin
+	}
+
+	// Find an account role
+	accRole, err := uc.accountRole.Get(ctx, account.ID())
+	if err != nil {
+		return dto.ImpersonateOutput{}, ucerrs.Wrap(ucerrs.ErrGetAccountRoleDB, err)
+	}
+
+	// Generate access token, there is no session to refresh
+	claims := model.NewImpersonationClaims(accRole, in.ActorID, uc.impersonationTTL)
+	accessToken, err := uc.tokenGenerator.GenerateAccessToken(ctx, claims)
+	if err != nil {
+		return dto.ImpersonateOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGenerateAccessToken, err,
+		)
+	}
+
+	// Audit
+	if err := recordModeration(
+		ctx, uc.authEvent, model.AuthEventImpersonation,
+		in.ActorID, account.ID(), in.Reason,
+	); err != nil {
+		return dto.ImpersonateOutput{}, err
+	}
+
+	// Output
+	return dto.ImpersonateOutput{
+		AccessToken: accessToken,
+		ExpiresAt:   claims.ExpiresAt,
+	}, nil
+}
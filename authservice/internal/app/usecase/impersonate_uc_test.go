@@ -0,0 +1,175 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestImpersonateUC_Execute(t *testing.T) {
+	type adapter struct {
+		account        *mocks.AccountRepository
+		accountRole    *mocks.AccountRoleRepository
+		tokenGenerator *mocks.TokenGenerator
+		authEvent      *mocks.AuthEventRepository
+	}
+
+	type testCase struct {
+		name    string
+		input   dto.ImpersonateInput
+		prepare func(a adapter)
+		wantErr error
+	}
+
+	var (
+		actorID   = uuid.New()
+		accountID = uuid.New()
+		reason    = "ticket #42"
+	)
+
+	newAccount := func(status model.AccountStatus) *model.Account {
+		return model.RestoreAccount(
+			accountID, "test@example.com", "hashed",
+			status, true, time.Now(), time.Now(), nil,
+		)
+	}
+	accountRole := model.RestoreAccountRole(
+		accountID, []model.Role{model.RoleUser}, []model.Permission{model.PermissionAdsWrite},
+	)
+
+	input := dto.ImpersonateInput{ActorID: actorID, AccountID: accountID, Reason: reason}
+
+	var tests = []testCase{
+		{
+			name:  "Success",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountActive), nil)
+				a.accountRole.On("Get", mock.Anything, accountID).Return(accountRole, nil)
+				a.tokenGenerator.On("GenerateAccessToken", mock.Anything, mock.MatchedBy(func(c *model.AccessTokenClaims) bool {
+					return c.AccountID == accountID && *c.ActorID == actorID &&
+						c.SessionID == uuid.Nil && !c.ExpiresAt.IsZero()
+				})).Return("access", nil)
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.EventType() == model.AuthEventImpersonation && e.AccountID() == accountID &&
+						*e.ActorID() == actorID && *e.Reason() == reason
+				})).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "Fail - Empty Reason",
+			input:   dto.ImpersonateInput{ActorID: actorID, AccountID: accountID, Reason: " "},
+			prepare: func(a adapter) {},
+			wantErr: ucerrs.ErrReasonRequired,
+		},
+		{
+			name:    "Fail - Own Account",
+			input:   dto.ImpersonateInput{ActorID: actorID, AccountID: actorID, Reason: reason},
+			prepare: func(a adapter) {},
+			wantErr: ucerrs.ErrCannotImpersonateSelf,
+		},
+		{
+			name:  "Fail - Account Not Found",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+			wantErr: ucerrs.ErrInvalidAccountID,
+		},
+		{
+			name:  "Fail - DB Error On Get",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(nil, assert.AnError)
+			},
+			wantErr: ucerrs.ErrGetAccountByIDDB,
+		},
+		{
+			name:  "Fail - Blocked Account",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountBlocked), nil)
+			},
+			wantErr: ucerrs.ErrCannotLogin,
+		},
+		{
+			name:  "Fail - DB Error On Get Role",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountActive), nil)
+				a.accountRole.On("Get", mock.Anything, accountID).Return(nil, assert.AnError)
+			},
+			wantErr: ucerrs.ErrGetAccountRoleDB,
+		},
+		{
+			name:  "Fail - Token Generation Error",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountActive), nil)
+				a.accountRole.On("Get", mock.Anything, accountID).Return(accountRole, nil)
+				a.tokenGenerator.On("GenerateAccessToken", mock.Anything, mock.Anything).
+					Return("", assert.AnError)
+			},
+			wantErr: ucerrs.ErrGenerateAccessToken,
+		},
+		{
+			name:  "Fail - DB Error On Audit",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountActive), nil)
+				a.accountRole.On("Get", mock.Anything, accountID).Return(accountRole, nil)
+				a.tokenGenerator.On("GenerateAccessToken", mock.Anything, mock.Anything).
+					Return("access", nil)
+				a.authEvent.On("Create", mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrCreateAuthEventDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				account:        mocks.NewAccountRepository(t),
+				accountRole:    mocks.NewAccountRoleRepository(t),
+				tokenGenerator: mocks.NewTokenGenerator(t),
+				authEvent:      mocks.NewAuthEventRepository(t),
+			}
+
+			tt.prepare(a)
+
+			uc := usecase.NewImpersonateUC(
+				a.account, a.accountRole, a.tokenGenerator, a.authEvent, time.Minute*10,
+			)
+
+			res, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, res.AccessToken)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "access", res.AccessToken)
+				assert.WithinDuration(t, time.Now().Add(time.Minute*10), res.ExpiresAt, time.Second*2)
+			}
+		})
+	}
+}
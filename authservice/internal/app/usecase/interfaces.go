@@ -29,3 +29,139 @@ type RegisterUseCase interface {
 type ValidateAccessTokenUseCase interface {
 	Execute(ctx context.Context, in dto.ValidateAccessTokenInput) (dto.ValidateAccessTokenOutput, error)
 }
+
+type SendVerificationEmailUseCase interface {
+	Execute(ctx context.Context, in dto.SendVerificationEmailInput) (dto.SendVerificationEmailOutput, error)
+}
+
+type VerifyEmailUseCase interface {
+	Execute(ctx context.Context, in dto.VerifyEmailInput) (dto.VerifyEmailOutput, error)
+}
+
+type RequestPasswordResetUseCase interface {
+	Execute(ctx context.Context, in dto.RequestPasswordResetInput) (dto.RequestPasswordResetOutput, error)
+}
+
+type ResetPasswordUseCase interface {
+	Execute(ctx context.Context, in dto.ResetPasswordInput) (dto.ResetPasswordOutput, error)
+}
+
+type ChangePasswordUseCase interface {
+	Execute(ctx context.Context, in dto.ChangePasswordInput) (dto.ChangePasswordOutput, error)
+}
+
+type ListSessionsUseCase interface {
+	Execute(ctx context.Context, in dto.ListSessionsInput) (dto.ListSessionsOutput, error)
+}
+
+type RevokeSessionUseCase interface {
+	Execute(ctx context.Context, in dto.RevokeSessionInput) (dto.RevokeSessionOutput, error)
+}
+
+type RevokeAllOtherSessionsUseCase interface {
+	Execute(ctx context.Context, in dto.RevokeAllOtherSessionsInput) (dto.RevokeAllOtherSessionsOutput, error)
+}
+
+type GetJWKSUseCase interface {
+	Execute(ctx context.Context, in dto.GetJWKSInput) (dto.GetJWKSOutput, error)
+}
+
+type CleanupRefreshSessionsUseCase interface {
+	Execute(ctx context.Context, in dto.CleanupRefreshSessionsInput) (dto.CleanupRefreshSessionsOutput, error)
+}
+
+type BlockAccountUseCase interface {
+	Execute(ctx context.Context, in dto.BlockAccountInput) (dto.BlockAccountOutput, error)
+}
+
+type UnblockAccountUseCase interface {
+	Execute(ctx context.Context, in dto.UnblockAccountInput) (dto.UnblockAccountOutput, error)
+}
+
+type DeleteAccountUseCase interface {
+	Execute(ctx context.Context, in dto.DeleteAccountInput) (dto.DeleteAccountOutput, error)
+}
+
+type EnrollTOTPUseCase interface {
+	Execute(ctx context.Context, in dto.EnrollTOTPInput) (dto.EnrollTOTPOutput, error)
+}
+
+type ConfirmTOTPUseCase interface {
+	Execute(ctx context.Context, in dto.ConfirmTOTPInput) (dto.ConfirmTOTPOutput, error)
+}
+
+type VerifyMFAUseCase interface {
+	Execute(ctx context.Context, in dto.VerifyMFAInput) (dto.VerifyMFAOutput, error)
+}
+
+type StartOIDCLoginUseCase interface {
+	Execute(ctx context.Context, in dto.StartOIDCLoginInput) (dto.StartOIDCLoginOutput, error)
+}
+
+type CompleteOIDCLoginUseCase interface {
+	Execute(ctx context.Context, in dto.CompleteOIDCLoginInput) (dto.CompleteOIDCLoginOutput, error)
+}
+
+type RevokeRoleUseCase interface {
+	Execute(ctx context.Context, in dto.RevokeRoleInput) (dto.RevokeRoleOutput, error)
+}
+
+type GetUserRolesUseCase interface {
+	Execute(ctx context.Context, in dto.GetUserRolesInput) (dto.GetUserRolesOutput, error)
+}
+
+type CreateAPIKeyUseCase interface {
+	Execute(ctx context.Context, in dto.CreateAPIKeyInput) (dto.CreateAPIKeyOutput, error)
+}
+
+type ListAPIKeysUseCase interface {
+	Execute(ctx context.Context, in dto.ListAPIKeysInput) (dto.ListAPIKeysOutput, error)
+}
+
+type RevokeAPIKeyUseCase interface {
+	Execute(ctx context.Context, in dto.RevokeAPIKeyInput) (dto.RevokeAPIKeyOutput, error)
+}
+
+type ValidateAPIKeyUseCase interface {
+	Execute(ctx context.Context, in dto.ValidateAPIKeyInput) (dto.ValidateAPIKeyOutput, error)
+}
+
+type ListAuthEventsUseCase interface {
+	Execute(ctx context.Context, in dto.ListAuthEventsInput) (dto.ListAuthEventsOutput, error)
+}
+
+type RequestEmailChangeUseCase interface {
+	Execute(ctx context.Context, in dto.RequestEmailChangeInput) (dto.RequestEmailChangeOutput, error)
+}
+
+type ConfirmEmailChangeUseCase interface {
+	Execute(ctx context.Context, in dto.ConfirmEmailChangeInput) (dto.ConfirmEmailChangeOutput, error)
+}
+
+type RequestMagicLinkUseCase interface {
+	Execute(ctx context.Context, in dto.RequestMagicLinkInput) (dto.RequestMagicLinkOutput, error)
+}
+
+type ConsumeMagicLinkUseCase interface {
+	Execute(ctx context.Context, in dto.ConsumeMagicLinkInput) (dto.ConsumeMagicLinkOutput, error)
+}
+
+type ImpersonateUseCase interface {
+	Execute(ctx context.Context, in dto.ImpersonateInput) (dto.ImpersonateOutput, error)
+}
+
+type IntrospectTokenUseCase interface {
+	Execute(ctx context.Context, in dto.IntrospectTokenInput) (dto.IntrospectTokenOutput, error)
+}
+
+type ListAccountsUseCase interface {
+	Execute(ctx context.Context, in dto.ListAccountsInput) (dto.ListAccountsOutput, error)
+}
+
+type NotifyNewDeviceLoginUseCase interface {
+	Execute(ctx context.Context, in dto.NotifyNewDeviceLoginInput) (dto.NotifyNewDeviceLoginOutput, error)
+}
+
+type ReportSessionUseCase interface {
+	Execute(ctx context.Context, in dto.ReportSessionInput) (dto.ReportSessionOutput, error)
+}
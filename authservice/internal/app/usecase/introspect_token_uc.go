@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// Token type names from RFC 7009, used both as hints and in the output
+const (
+	tokenTypeAccess  = "access_token"
+	tokenTypeRefresh = "refresh_token"
+)
+
+type IntrospectTokenUC struct {
+	account        port.AccountRepository
+	accountRole    port.AccountRoleRepository
+	refreshSession port.RefreshSessionRepository
+	tokenGenerator port.TokenGenerator
+	denylist       port.AccessTokenDenylist
+
+	clients map[string]string // client id -> secret hash
+}
+
+func NewIntrospectTokenUC(
+	account port.AccountRepository,
+	accountRole port.AccountRoleRepository,
+	refreshSession port.RefreshSessionRepository,
+	tokenGenerator port.TokenGenerator,
+	denylist port.AccessTokenDenylist,
+	clients map[string]string,
+) *IntrospectTokenUC {
+	var hashed = make(map[string]string, len(clients))
+	for id, secret := range clients {
+		hashed[id] = utils.HashToken(secret)
+	}
+	return &IntrospectTokenUC{
+		account:        account,
+		accountRole:    accountRole,
+		refreshSession: refreshSession,
+		tokenGenerator: tokenGenerator,
+		denylist:       denylist,
+		clients:        hashed,
+	}
+}
+
+func (uc *IntrospectTokenUC) Execute(ctx context.Context, in dto.IntrospectTokenInput) (dto.IntrospectTokenOutput, error) {
+	// Authenticate the client
+	secretHash, ok := uc.clients[in.ClientID]
+	if !ok {
+		return dto.IntrospectTokenOutput{}, ucerrs.ErrInvalidClient
+	}
+	hash := utils.HashToken(in.ClientSecret)
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(secretHash)) != 1 {
+		return dto.IntrospectTokenOutput{}, ucerrs.ErrInvalidClient
+	}
+
+	// The hint only decides which kind of token is tried first
+	var inspect = []func(context.Context, string) (*dto.IntrospectTokenOutput, error){
+		uc.inspectAccessToken, uc.inspectRefreshToken,
+	}
+	if in.TokenTypeHint == tokenTypeRefresh {
+		inspect[0], inspect[1] = inspect[1], inspect[0]
+	}
+
+	for _, fn := range inspect {
+		out, err := fn(ctx, in.Token)
+		if err != nil {
+			return dto.IntrospectTokenOutput{}, err
+		}
+		if out != nil {
+			return *out, nil
+		}
+	}
+
+	// Output
+	return dto.IntrospectTokenOutput{Active: false}, nil
+}
+
+// inspectAccessToken returns nil when the token is not an access token
+func (uc *IntrospectTokenUC) inspectAccessToken(ctx context.Context, token string) (*dto.IntrospectTokenOutput, error) {
+	claims, err := uc.tokenGenerator.ValidateAccessToken(ctx, token)
+	if err != nil {
+		return nil, nil
+	}
+
+	// Revoked by itself or together with its session
+	for _, key := range claims.DenylistKeys() {
+		denied, err := uc.denylist.Contains(ctx, key)
+		if err != nil {
+			return nil, ucerrs.Wrap(ucerrs.ErrCheckDenylistDB, err)
+		}
+		if denied {
+			return &dto.IntrospectTokenOutput{Active: false}, nil
+		}
+	}
+
+	active, err := uc.accountActive(ctx, claims.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	if !active {
+		return &dto.IntrospectTokenOutput{Active: false}, nil
+	}
+
+	out := &dto.IntrospectTokenOutput{
+		Active:    true,
+		TokenType: tokenTypeAccess,
+		AccountID: claims.AccountID,
+		Roles:     make([]string, 0, len(claims.Roles)),
+		ActorID:   claims.ActorID,
+		IssuedAt:  claims.IssuedAt,
+		ExpiresAt: claims.ExpiresAt,
+	}
+	// Impersonation tokens are not bound to a session
+	if claims.SessionID != uuid.Nil {
+		out.SessionID = &claims.SessionID
+	}
+	for _, role := range claims.Roles {
+		out.Roles = append(out.Roles, role.String())
+	}
+
+	return out, nil
+}
+
+// inspectRefreshToken returns nil when the token is not a refresh token
+func (uc *IntrospectTokenUC) inspectRefreshToken(ctx context.Context, token string) (*dto.IntrospectTokenOutput, error) {
+	accountID, sessionID, err := uc.tokenGenerator.ValidateRefreshToken(ctx, token)
+	if err != nil {
+		return nil, nil
+	}
+
+	// A rotated token still names its session, but the session is revoked
+	session, err := uc.refreshSession.GetByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return &dto.IntrospectTokenOutput{Active: false}, nil
+		}
+		return nil, ucerrs.Wrap(ucerrs.ErrGetRefreshSessionByIDDB, err)
+	}
+	if !session.IsActive() || utils.HashToken(token) != session.RefreshTokenHash() {
+		return &dto.IntrospectTokenOutput{Active: false}, nil
+	}
+
+	active, err := uc.accountActive(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if !active {
+		return &dto.IntrospectTokenOutput{Active: false}, nil
+	}
+
+	// Roles are the ones the next access token would get
+	accRole, err := uc.accountRole.Get(ctx, accountID)
+	if err != nil {
+		return nil, ucerrs.Wrap(ucerrs.ErrGetAccountRoleDB, err)
+	}
+
+	out := &dto.IntrospectTokenOutput{
+		Active:    true,
+		TokenType: tokenTypeRefresh,
+		AccountID: accountID,
+		Roles:     make([]string, 0, len(accRole.Roles())),
+		SessionID: &sessionID,
+		IssuedAt:  session.CreatedAt(),
+		ExpiresAt: session.ExpiresAt(),
+	}
+	for _, role := range accRole.Roles() {
+		out.Roles = append(out.Roles, role.String())
+	}
+
+	return out, nil
+}
+
+func (uc *IntrospectTokenUC) accountActive(ctx context.Context, accountID uuid.UUID) (bool, error) {
+	account, err := uc.account.GetByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return false, nil
+		}
+		return false, ucerrs.Wrap(ucerrs.ErrGetAccountByIDDB, err)
+	}
+	return account.CanLogin(), nil
+}
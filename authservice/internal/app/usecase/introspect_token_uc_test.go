@@ -0,0 +1,240 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestIntrospectTokenUC_Execute(t *testing.T) {
+	type adapter struct {
+		account        *mocks.AccountRepository
+		accountRole    *mocks.AccountRoleRepository
+		refreshSession *mocks.RefreshSessionRepository
+		tokenGenerator *mocks.TokenGenerator
+		denylist       *mocks.AccessTokenDenylist
+	}
+
+	type testCase struct {
+		name          string
+		input         dto.IntrospectTokenInput
+		prepare       func(a adapter)
+		wantErr       error
+		wantActive    bool
+		wantTokenType string
+	}
+
+	const (
+		accessToken  = "access-token"
+		refreshToken = "refresh-token"
+	)
+
+	activeAcc, _ := model.NewAccount("test@test.com", "hash")
+	bannedAcc, _ := model.NewAccount("banned@test.com", "hash")
+	bannedAcc.Block()
+
+	sessionID := uuid.New()
+	claims := &model.AccessTokenClaims{
+		AccountID: activeAcc.ID(),
+		SessionID: sessionID,
+		TokenID:   "token-id",
+		Roles:     []model.Role{model.RoleUser},
+		IssuedAt:  time.Now(),
+		ExpiresAt: time.Now().Add(time.Minute),
+	}
+	session := model.RestoreRefreshSession(
+		sessionID, activeAcc.ID(), utils.HashToken(refreshToken),
+		time.Now(), time.Now().Add(time.Hour), nil, nil, nil, nil, nil,
+	)
+	revokedAt := time.Now()
+	rotatedSession := model.RestoreRefreshSession(
+		sessionID, activeAcc.ID(), utils.HashToken(refreshToken),
+		time.Now(), time.Now().Add(time.Hour), &revokedAt, nil, nil, nil, nil,
+	)
+	accRole := model.RestoreAccountRole(activeAcc.ID(), []model.Role{model.RoleUser}, nil)
+
+	input := func(token, hint string) dto.IntrospectTokenInput {
+		return dto.IntrospectTokenInput{
+			ClientID:      "billing",
+			ClientSecret:  "billing-secret",
+			Token:         token,
+			TokenTypeHint: hint,
+		}
+	}
+	notAccessToken := func(a adapter, token string) {
+		a.tokenGenerator.On("ValidateAccessToken", mock.Anything, token).
+			Return(nil, assert.AnError)
+	}
+
+	var tests = []testCase{
+		{
+			name:  "Success - Access Token",
+			input: input(accessToken, ""),
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateAccessToken", mock.Anything, accessToken).
+					Return(claims, nil)
+				a.denylist.On("Contains", mock.Anything, mock.Anything).Return(false, nil)
+				a.account.On("GetByID", mock.Anything, activeAcc.ID()).Return(activeAcc, nil)
+			},
+			wantActive:    true,
+			wantTokenType: "access_token",
+		},
+		{
+			name:  "Success - Refresh Token Without Hint",
+			input: input(refreshToken, ""),
+			prepare: func(a adapter) {
+				notAccessToken(a, refreshToken)
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, refreshToken).
+					Return(activeAcc.ID(), sessionID, nil)
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).Return(session, nil)
+				a.account.On("GetByID", mock.Anything, activeAcc.ID()).Return(activeAcc, nil)
+				a.accountRole.On("Get", mock.Anything, activeAcc.ID()).Return(accRole, nil)
+			},
+			wantActive:    true,
+			wantTokenType: "refresh_token",
+		},
+		{
+			name:  "Success - Refresh Token Hint Is Tried First",
+			input: input(refreshToken, "refresh_token"),
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, refreshToken).
+					Return(activeAcc.ID(), sessionID, nil)
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).Return(session, nil)
+				a.account.On("GetByID", mock.Anything, activeAcc.ID()).Return(activeAcc, nil)
+				a.accountRole.On("Get", mock.Anything, activeAcc.ID()).Return(accRole, nil)
+			},
+			wantActive:    true,
+			wantTokenType: "refresh_token",
+		},
+		{
+			name:    "Fail - Unknown Client",
+			input:   dto.IntrospectTokenInput{ClientID: "other", ClientSecret: "billing-secret", Token: accessToken},
+			prepare: func(a adapter) {},
+			wantErr: ucerrs.ErrInvalidClient,
+		},
+		{
+			name:    "Fail - Wrong Client Secret",
+			input:   dto.IntrospectTokenInput{ClientID: "billing", ClientSecret: "guess", Token: accessToken},
+			prepare: func(a adapter) {},
+			wantErr: ucerrs.ErrInvalidClient,
+		},
+		{
+			name:  "Inactive - Not A Token",
+			input: input("garbage", ""),
+			prepare: func(a adapter) {
+				notAccessToken(a, "garbage")
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, "garbage").
+					Return(uuid.Nil, uuid.Nil, assert.AnError)
+			},
+		},
+		{
+			name:  "Inactive - Access Token Revoked",
+			input: input(accessToken, ""),
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateAccessToken", mock.Anything, accessToken).
+					Return(claims, nil)
+				a.denylist.On("Contains", mock.Anything, model.DenylistKeyForToken("token-id")).
+					Return(true, nil)
+			},
+		},
+		{
+			name:  "Inactive - Account Blocked",
+			input: input(accessToken, ""),
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateAccessToken", mock.Anything, accessToken).
+					Return(claims, nil)
+				a.denylist.On("Contains", mock.Anything, mock.Anything).Return(false, nil)
+				a.account.On("GetByID", mock.Anything, activeAcc.ID()).Return(bannedAcc, nil)
+			},
+		},
+		{
+			name:  "Inactive - Account Deleted",
+			input: input(accessToken, ""),
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateAccessToken", mock.Anything, accessToken).
+					Return(claims, nil)
+				a.denylist.On("Contains", mock.Anything, mock.Anything).Return(false, nil)
+				a.account.On("GetByID", mock.Anything, activeAcc.ID()).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+		},
+		{
+			name:  "Inactive - Refresh Token Rotated",
+			input: input(refreshToken, "refresh_token"),
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, refreshToken).
+					Return(activeAcc.ID(), sessionID, nil)
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).Return(rotatedSession, nil)
+			},
+		},
+		{
+			name:  "Fail - Denylist DB Error",
+			input: input(accessToken, ""),
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateAccessToken", mock.Anything, accessToken).
+					Return(claims, nil)
+				a.denylist.On("Contains", mock.Anything, mock.Anything).Return(false, assert.AnError)
+			},
+			wantErr: ucerrs.ErrCheckDenylistDB,
+		},
+		{
+			name:  "Fail - Session DB Error",
+			input: input(refreshToken, "refresh_token"),
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, refreshToken).
+					Return(activeAcc.ID(), sessionID, nil)
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).Return(nil, assert.AnError)
+			},
+			wantErr: ucerrs.ErrGetRefreshSessionByIDDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				account:        mocks.NewAccountRepository(t),
+				accountRole:    mocks.NewAccountRoleRepository(t),
+				refreshSession: mocks.NewRefreshSessionRepository(t),
+				tokenGenerator: mocks.NewTokenGenerator(t),
+				denylist:       mocks.NewAccessTokenDenylist(t),
+			}
+			tt.prepare(a)
+
+			uc := usecase.NewIntrospectTokenUC(
+				a.account, a.accountRole, a.refreshSession, a.tokenGenerator, a.denylist,
+				map[string]string{"billing": "billing-secret"},
+			)
+
+			res, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, res.Active)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantActive, res.Active)
+			assert.Equal(t, tt.wantTokenType, res.TokenType)
+			if tt.wantActive {
+				assert.Equal(t, activeAcc.ID(), res.AccountID)
+				assert.Equal(t, []string{"user"}, res.Roles)
+				assert.Equal(t, &sessionID, res.SessionID)
+				assert.False(t, res.ExpiresAt.IsZero())
+			} else {
+				assert.Equal(t, uuid.Nil, res.AccountID)
+			}
+		})
+	}
+}
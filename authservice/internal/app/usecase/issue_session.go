@@ -0,0 +1,266 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// sessionIssuer finishes a successful authentication for every login method
+type sessionIssuer struct {
+	txManager      port.TransactionManager
+	account        port.AccountRepository
+	accountRole    port.AccountRoleRepository
+	refreshSession port.RefreshSessionRepository
+	tokenGenerator port.TokenGenerator
+	authEvent      port.AuthEventRepository
+	accountOutbox  port.AccountOutbox
+
+	sessionLimit      *model.SessionLimitPolicy
+	newDeviceWindow   time.Duration
+	refreshSessionTTL time.Duration
+}
+
+func newSessionIssuer(
+	txManager port.TransactionManager,
+	account port.AccountRepository,
+	accountRole port.AccountRoleRepository,
+	refreshSession port.RefreshSessionRepository,
+	tokenGenerator port.TokenGenerator,
+	authEvent port.AuthEventRepository,
+	accountOutbox port.AccountOutbox,
+	sessionLimit *model.SessionLimitPolicy,
+	newDeviceWindow time.Duration,
+	refreshSessionTTL time.Duration,
+) *sessionIssuer {
+	return &sessionIssuer{
+		txManager:         txManager,
+		account:           account,
+		accountRole:       accountRole,
+		refreshSession:    refreshSession,
+		tokenGenerator:    tokenGenerator,
+		authEvent:         authEvent,
+		accountOutbox:     accountOutbox,
+		sessionLimit:      sessionLimit,
+		newDeviceWindow:   newDeviceWindow,
+		refreshSessionTTL: refreshSessionTTL,
+	}
+}
+
+// Keeps the account under its sessions cap, marks the login, issues
+// the token pair, stores a new refresh session for the device, writes
+// the login to the audit log and reports a new device
+func (s *sessionIssuer) issue(
+	ctx context.Context, account *model.Account, method string,
+	ip, userAgent *string,
+) (dto.LoginOutput, error) {
+	// Find an account role
+	accRole, err := s.accountRole.Get(ctx, account.ID())
+	if err != nil {
+		return dto.LoginOutput{}, ucerrs.Wrap(ucerrs.ErrGetAccountRoleDB, err)
+	}
+
+	var (
+		sessionID = uuid.New()
+		newDevice bool
+		out       dto.LoginOutput
+	)
+	if err := withinTx(ctx, s.txManager, func(ctx context.Context) error {
+		// Marking the login locks the account row until commit, so concurrent
+		// logins of the account take turns and each one counts the sessions
+		// stored by the previous ones. A refused login is rolled back
+		account.MarkLogin()
+		if err := s.account.MarkLogin(ctx, account); err != nil {
+			return ucerrs.Wrap(ucerrs.ErrUpdateAccountDB, err)
+		}
+
+		evicted, err := s.sessionsOverLimit(ctx, account.ID(), accRole.Roles())
+		if err != nil {
+			return err
+		}
+
+		// The device is compared with the sessions stored before this one
+		newDevice, err = s.isNewDevice(ctx, account.ID(), ip, userAgent)
+		if err != nil {
+			return err
+		}
+
+		out, err = s.createSession(ctx, account.ID(), accRole, sessionID, ip, userAgent)
+		if err != nil {
+			return err
+		}
+
+		var reason = model.RevokeReasonSessionLimit
+		for _, old := range evicted {
+			if err := old.Revoke(&reason); err != nil {
+				continue
+			}
+			// Ended meanwhile by a logout or a rotation
+			err := s.refreshSession.Revoke(ctx, old)
+			if err != nil && !errors.Is(err, model.ErrTokenAlreadyRevoked) {
+				return ucerrs.Wrap(ucerrs.ErrRevokeRefreshSessionDB, err)
+			}
+		}
+
+		var metadata = map[string]string{"method": method}
+		if newDevice {
+			metadata["new_device"] = "true"
+		}
+		if len(evicted) > 0 {
+			metadata["evicted_sessions"] = strconv.Itoa(len(evicted))
+		}
+		if err := recordAuthEvent(
+			ctx, s.authEvent, account.ID(), model.AuthEventLogin, model.AuthEventSuccess,
+			&sessionID, ip, userAgent, metadata,
+		); err != nil {
+			return err
+		}
+
+		if !newDevice {
+			return nil
+		}
+
+		// Published by the outbox relay after commit (mail the owner)
+		var device model.UserAgentInfo
+		if userAgent != nil {
+			device = model.ParseUserAgent(*userAgent)
+		}
+		if err := s.accountOutbox.AddNewDeviceLogin(
+			ctx, account.ID(), sessionID, ip, device.Browser, device.OS,
+		); err != nil {
+			return ucerrs.Wrap(ucerrs.ErrAddOutboxEventDB, err)
+		}
+		return nil
+	}); err != nil {
+		return dto.LoginOutput{}, err
+	}
+
+	return out, nil
+}
+
+// Generates the token pair and stores the refresh session
+func (s *sessionIssuer) createSession(
+	ctx context.Context, accountID uuid.UUID, accRole *model.AccountRole,
+	sessionID uuid.UUID, ip, userAgent *string,
+) (dto.LoginOutput, error) {
+	accessToken, err := s.tokenGenerator.GenerateAccessToken(
+		ctx, model.NewAccessTokenClaims(accRole, sessionID),
+	)
+	if err != nil {
+		return dto.LoginOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGenerateAccessToken, err,
+		)
+	}
+
+	refreshToken, err := s.tokenGenerator.GenerateRefreshToken(
+		ctx, accountID, sessionID,
+	)
+	if err != nil {
+		return dto.LoginOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGenerateRefreshToken, err,
+		)
+	}
+
+	session, err := model.NewRefreshSession(
+		sessionID, accountID, utils.HashToken(refreshToken), nil,
+		ip, userAgent, s.refreshSessionTTL,
+	)
+	if err != nil {
+		return dto.LoginOutput{}, ucerrs.Wrap(
+			ucerrs.ErrInvalidInput, err,
+		)
+	}
+
+	if err := s.refreshSession.Create(ctx, session); err != nil {
+		return dto.LoginOutput{}, ucerrs.Wrap(
+			ucerrs.ErrCreateRefreshSessionDB, err,
+		)
+	}
+
+	return dto.LoginOutput{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// Returns the sessions to evict for the new one to fit under the cap,
+// in the deny mode the login is refused instead
+func (s *sessionIssuer) sessionsOverLimit(
+	ctx context.Context, accountID uuid.UUID, roles []model.Role,
+) ([]*model.RefreshSession, error) {
+	if s.sessionLimit.Limit(roles) == 0 {
+		return nil, nil
+	}
+
+	active, err := s.refreshSession.ListActiveForAccount(ctx, accountID)
+	if err != nil {
+		return nil, ucerrs.Wrap(ucerrs.ErrListRefreshSessionsDB, err)
+	}
+
+	excess := s.sessionLimit.Excess(active, roles)
+	if len(excess) > 0 && s.sessionLimit.Mode() == model.SessionLimitDenyNew {
+		return nil, ucerrs.ErrTooManySessions
+	}
+	return excess, nil
+}
+
+// A device is new when none of the account sessions created within
+// the window had the same IP and user agent. Nothing is reported for
+// the first session in the window, there is nothing to compare it with,
+// nor for a client that sent neither of them
+func (s *sessionIssuer) isNewDevice(
+	ctx context.Context, accountID uuid.UUID, ip, userAgent *string,
+) (bool, error) {
+	if s.newDeviceWindow <= 0 || (ip == nil && userAgent == nil) {
+		return false, nil
+	}
+
+	total, sameDevice, err := s.refreshSession.CountRecent(
+		ctx, accountID, ip, userAgent, time.Now().Add(-s.newDeviceWindow),
+	)
+	if err != nil {
+		return false, ucerrs.Wrap(ucerrs.ErrListRefreshSessionsDB, err)
+	}
+	return total > 0 && sameDevice == 0, nil
+}
+
+// Starts the second step when the account has 2FA on,
+// ok is false when the tokens can be issued right away
+func mfaChallenge(
+	ctx context.Context,
+	totpFactor port.TOTPFactorRepository,
+	tokenGenerator port.TokenGenerator,
+	accountID uuid.UUID,
+) (out dto.LoginOutput, ok bool, err error) {
+	factor, err := totpFactor.GetByAccountID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.LoginOutput{}, false, nil
+		}
+		return dto.LoginOutput{}, false, ucerrs.Wrap(
+			ucerrs.ErrGetTOTPFactorDB, err,
+		)
+	}
+	if !factor.IsConfirmed() {
+		return dto.LoginOutput{}, false, nil
+	}
+
+	mfaToken, err := tokenGenerator.GenerateMFAToken(ctx, accountID)
+	if err != nil {
+		return dto.LoginOutput{}, false, ucerrs.Wrap(
+			ucerrs.ErrGenerateMFAToken, err,
+		)
+	}
+
+	return dto.LoginOutput{MFARequired: true, MFAToken: mfaToken}, true, nil
+}
@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultAccountsPage = 50
+	maxAccountsPage     = 100
+)
+
+type ListAccountsUC struct {
+	account port.AccountRepository
+}
+
+func NewListAccountsUC(account port.AccountRepository) *ListAccountsUC {
+	return &ListAccountsUC{account: account}
+}
+
+func (uc *ListAccountsUC) Execute(ctx context.Context, in dto.ListAccountsInput) (dto.ListAccountsOutput, error) {
+	// Filters and sort
+	filter, err := accountFilter(in)
+	if err != nil {
+		return dto.ListAccountsOutput{}, err
+	}
+
+	var sort = port.AccountSort{Field: port.AccountSortCreatedAt, Desc: in.SortDesc}
+	if in.SortBy != nil && *in.SortBy != "" {
+		switch field := port.AccountSortField(*in.SortBy); field {
+		case port.AccountSortCreatedAt, port.AccountSortLastLoginAt, port.AccountSortEmail:
+			sort.Field = field
+		default:
+			return dto.ListAccountsOutput{}, ucerrs.ErrInvalidAccountFilter
+		}
+	}
+
+	var after *port.AccountCursor
+	if in.Cursor != nil && *in.Cursor != "" {
+		after, err = decodeAccountCursor(*in.Cursor, sort)
+		if err != nil {
+			return dto.ListAccountsOutput{}, ucerrs.ErrInvalidCursor
+		}
+	}
+
+	limit := in.Limit
+	if limit <= 0 {
+		limit = defaultAccountsPage
+	}
+	limit = min(limit, maxAccountsPage)
+
+	// One extra row tells whether there is a next page
+	accounts, err := uc.account.List(ctx, filter, sort, after, limit+1)
+	if err != nil {
+		return dto.ListAccountsOutput{}, ucerrs.Wrap(
+			ucerrs.ErrListAccountsDB, err,
+		)
+	}
+
+	// Output
+	var out dto.ListAccountsOutput
+	if len(accounts) > limit {
+		accounts = accounts[:limit]
+		cursor := encodeAccountCursor(sort, accounts[limit-1].Account)
+		out.NextCursor = &cursor
+	}
+
+	out.Accounts = make([]dto.AccountInfo, 0, len(accounts))
+	for _, summary := range accounts {
+		account := summary.Account
+		info := dto.AccountInfo{
+			AccountID:      account.ID(),
+			Email:          account.Email(),
+			Status:         account.Status().String(),
+			EmailVerified:  account.EmailVerified(),
+			Roles:          make([]string, 0, len(summary.Roles)),
+			ActiveSessions: summary.ActiveSessions,
+			CreatedAt:      account.CreatedAt(),
+			LastLoginAt:    account.LastLoginAt(),
+		}
+		for _, role := range summary.Roles {
+			info.Roles = append(info.Roles, role.String())
+		}
+		out.Accounts = append(out.Accounts, info)
+	}
+
+	return out, nil
+}
+
+func accountFilter(in dto.ListAccountsInput) (port.AccountFilter, error) {
+	var filter = port.AccountFilter{
+		EmailVerified: in.EmailVerified,
+		CreatedFrom:   in.CreatedFrom,
+		CreatedTo:     in.CreatedTo,
+		LastLoginFrom: in.LastLoginFrom,
+		LastLoginTo:   in.LastLoginTo,
+	}
+	if in.Status != nil && *in.Status != "" {
+		status, err := model.ParseAccountStatus(*in.Status)
+		if err != nil {
+			return port.AccountFilter{}, ucerrs.ErrInvalidAccountFilter
+		}
+		filter.Status = &status
+	}
+	if in.Role != nil && *in.Role != "" {
+		role, err := model.ParseRole(*in.Role)
+		if err != nil {
+			return port.AccountFilter{}, ucerrs.ErrInvalidAccountFilter
+		}
+		filter.Role = &role
+	}
+	if in.EmailPrefix != nil {
+		if prefix := strings.TrimSpace(*in.EmailPrefix); prefix != "" {
+			filter.EmailPrefix = &prefix
+		}
+	}
+	if in.CreatedFrom != nil && in.CreatedTo != nil && !in.CreatedFrom.Before(*in.CreatedTo) {
+		return port.AccountFilter{}, ucerrs.ErrInvalidAccountFilter
+	}
+	if in.LastLoginFrom != nil && in.LastLoginTo != nil && !in.LastLoginFrom.Before(*in.LastLoginTo) {
+		return port.AccountFilter{}, ucerrs.ErrInvalidAccountFilter
+	}
+	return filter, nil
+}
+
+// The cursor is opaque for clients, it keeps the sort it was issued for and
+// the position of the last account as "<field>:<desc>:<account id>:<value>",
+// where a time value is in unix nanos and is empty for a missing last login
+func encodeAccountCursor(sort port.AccountSort, last *model.Account) string {
+	var value string
+	switch sort.Field {
+	case port.AccountSortCreatedAt:
+		value = strconv.FormatInt(last.CreatedAt().UnixNano(), 10)
+	case port.AccountSortLastLoginAt:
+		if last.LastLoginAt() != nil {
+			value = strconv.FormatInt(last.LastLoginAt().UnixNano(), 10)
+		}
+	case port.AccountSortEmail:
+		value = last.Email()
+	}
+	raw := strings.Join([]string{
+		sort.Field.String(), strconv.FormatBool(sort.Desc), last.ID().String(), value,
+	}, ":")
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeAccountCursor(cursor string, sort port.AccountSort) (*port.AccountCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.SplitN(string(raw), ":", 4)
+	if len(parts) != 4 || parts[0] != sort.Field.String() || parts[1] != strconv.FormatBool(sort.Desc) {
+		return nil, ucerrs.ErrInvalidCursor
+	}
+	id, err := uuid.Parse(parts[2])
+	if err != nil {
+		return nil, err
+	}
+
+	var after = &port.AccountCursor{ID: id}
+	switch sort.Field {
+	case port.AccountSortCreatedAt:
+		nanos, err := strconv.ParseInt(parts[3], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		after.CreatedAt = time.Unix(0, nanos)
+	case port.AccountSortLastLoginAt:
+		if parts[3] != "" {
+			nanos, err := strconv.ParseInt(parts[3], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			after.LastLoginAt = time.Unix(0, nanos)
+		}
+	case port.AccountSortEmail:
+		after.Email = parts[3]
+	}
+	return after, nil
+}
@@ -0,0 +1,176 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAccountsUC_Execute(t *testing.T) {
+	type testCase struct {
+		name     string
+		input    dto.ListAccountsInput
+		prepare  func(m *mocks.AccountRepository)
+		wantErr  error
+		wantLen  int
+		wantNext bool
+	}
+
+	summary := func(email string) *port.AccountSummary {
+		acc, _ := model.NewAccount(email, "hash")
+		return &port.AccountSummary{
+			Account:        acc,
+			Roles:          []model.Role{model.RoleUser},
+			ActiveSessions: 2,
+		}
+	}
+	page := []*port.AccountSummary{summary("a@test.com"), summary("b@test.com"), summary("c@test.com")}
+
+	blocked := "blocked"
+	support := "Support"
+	badRole := "root"
+	badStatus := "frozen"
+	badSort := "password_hash"
+	sortEmail := "email"
+	prefix := "  ali "
+	badCursor := "not-a-cursor"
+	from := time.Now().Add(-time.Hour)
+	to := time.Now().Add(-time.Hour * 2)
+
+	var tests = []testCase{
+		{
+			name: "Success - Filters Are Parsed",
+			input: dto.ListAccountsInput{
+				Status: &blocked, Role: &support, EmailPrefix: &prefix,
+				SortBy: &sortEmail, SortDesc: true, Limit: 2,
+			},
+			prepare: func(m *mocks.AccountRepository) {
+				m.On("List", mock.Anything, mock.MatchedBy(func(f port.AccountFilter) bool {
+					return *f.Status == model.AccountBlocked &&
+						*f.Role == model.RoleSupport &&
+						*f.EmailPrefix == "ali"
+				}), port.AccountSort{Field: port.AccountSortEmail, Desc: true},
+					(*port.AccountCursor)(nil), 3).Return(page, nil)
+			},
+			wantLen:  2,
+			wantNext: true,
+		},
+		{
+			name:  "Success - Default Sort And Last Page",
+			input: dto.ListAccountsInput{},
+			prepare: func(m *mocks.AccountRepository) {
+				m.On("List", mock.Anything, port.AccountFilter{},
+					port.AccountSort{Field: port.AccountSortCreatedAt},
+					(*port.AccountCursor)(nil), 51).Return(page, nil)
+			},
+			wantLen:  3,
+			wantNext: false,
+		},
+		{
+			name:    "Fail - Unknown Role",
+			input:   dto.ListAccountsInput{Role: &badRole},
+			prepare: func(m *mocks.AccountRepository) {},
+			wantErr: ucerrs.ErrInvalidAccountFilter,
+		},
+		{
+			name:    "Fail - Unknown Status",
+			input:   dto.ListAccountsInput{Status: &badStatus},
+			prepare: func(m *mocks.AccountRepository) {},
+			wantErr: ucerrs.ErrInvalidAccountFilter,
+		},
+		{
+			name:    "Fail - Unknown Sort",
+			input:   dto.ListAccountsInput{SortBy: &badSort},
+			prepare: func(m *mocks.AccountRepository) {},
+			wantErr: ucerrs.ErrInvalidAccountFilter,
+		},
+		{
+			name:    "Fail - Empty Last Login Range",
+			input:   dto.ListAccountsInput{LastLoginFrom: &from, LastLoginTo: &to},
+			prepare: func(m *mocks.AccountRepository) {},
+			wantErr: ucerrs.ErrInvalidAccountFilter,
+		},
+		{
+			name:    "Fail - Invalid Cursor",
+			input:   dto.ListAccountsInput{Cursor: &badCursor},
+			prepare: func(m *mocks.AccountRepository) {},
+			wantErr: ucerrs.ErrInvalidCursor,
+		},
+		{
+			name:  "Fail - DB Error",
+			input: dto.ListAccountsInput{},
+			prepare: func(m *mocks.AccountRepository) {
+				m.On("List", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, assert.AnError)
+			},
+			wantErr: ucerrs.ErrListAccountsDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account := mocks.NewAccountRepository(t)
+			tt.prepare(account)
+
+			uc := usecase.NewListAccountsUC(account)
+
+			res, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, res.Accounts)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, res.Accounts, tt.wantLen)
+			assert.Equal(t, tt.wantNext, res.NextCursor != nil)
+			assert.Equal(t, []string{"user"}, res.Accounts[0].Roles)
+			assert.Equal(t, 2, res.Accounts[0].ActiveSessions)
+		})
+	}
+}
+
+func TestListAccountsUC_CursorIsBoundToSort(t *testing.T) {
+	first, _ := model.NewAccount("a@test.com", "hash")
+	last, _ := model.NewAccount("b@test.com", "hash")
+	sortEmail := "email"
+
+	account := mocks.NewAccountRepository(t)
+	account.On("List", mock.Anything, mock.Anything, mock.Anything, (*port.AccountCursor)(nil), 2).
+		Return([]*port.AccountSummary{{Account: first}, {Account: last}}, nil).Once()
+
+	uc := usecase.NewListAccountsUC(account)
+
+	res, err := uc.Execute(context.Background(), dto.ListAccountsInput{SortBy: &sortEmail, Limit: 1})
+	require.NoError(t, err)
+	require.NotNil(t, res.NextCursor)
+
+	// The same sort continues after the last account
+	account.On("List", mock.Anything, mock.Anything, mock.Anything, mock.MatchedBy(func(c *port.AccountCursor) bool {
+		return c != nil && c.ID == first.ID() && c.Email == first.Email()
+	}), 2).Return([]*port.AccountSummary{{Account: last}}, nil).Once()
+
+	res2, err := uc.Execute(context.Background(), dto.ListAccountsInput{
+		SortBy: &sortEmail, Limit: 1, Cursor: res.NextCursor,
+	})
+	require.NoError(t, err)
+	assert.Len(t, res2.Accounts, 1)
+	assert.Nil(t, res2.NextCursor)
+
+	// Another sort does not accept it
+	_, err = uc.Execute(context.Background(), dto.ListAccountsInput{
+		SortBy: &sortEmail, SortDesc: true, Cursor: res.NextCursor,
+	})
+	assert.ErrorIs(t, err, ucerrs.ErrInvalidCursor)
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+)
+
+type ListAPIKeysUC struct {
+	apiKey port.APIKeyRepository
+}
+
+func NewListAPIKeysUC(apiKey port.APIKeyRepository) *ListAPIKeysUC {
+	return &ListAPIKeysUC{apiKey: apiKey}
+}
+
+func (uc *ListAPIKeysUC) Execute(ctx context.Context, in dto.ListAPIKeysInput) (dto.ListAPIKeysOutput, error) {
+	// List keys
+	keys, err := uc.apiKey.ListByAccount(ctx, in.AccountID)
+	if err != nil {
+		return dto.ListAPIKeysOutput{}, ucerrs.Wrap(
+			ucerrs.ErrListAPIKeysDB, err,
+		)
+	}
+
+	// Output
+	var out = dto.ListAPIKeysOutput{
+		Keys: make([]dto.APIKeyInfo, 0, len(keys)),
+	}
+	for _, k := range keys {
+		var scopes = make([]string, 0, len(k.Scopes()))
+		for _, scope := range k.Scopes() {
+			scopes = append(scopes, scope.String())
+		}
+
+		out.Keys = append(out.Keys, dto.APIKeyInfo{
+			KeyID:      k.ID(),
+			Name:       k.Name(),
+			Prefix:     k.Prefix(),
+			Scopes:     scopes,
+			CreatedAt:  k.CreatedAt(),
+			ExpiresAt:  k.ExpiresAt(),
+			LastUsedAt: k.LastUsedAt(),
+			RevokedAt:  k.RevokedAt(),
+		})
+	}
+
+	return out, nil
+}
@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultAuthEventsPage = 50
+	maxAuthEventsPage     = 100
+)
+
+type ListAuthEventsUC struct {
+	authEvent port.AuthEventRepository
+}
+
+func NewListAuthEventsUC(authEvent port.AuthEventRepository) *ListAuthEventsUC {
+	return &ListAuthEventsUC{authEvent: authEvent}
+}
+
+func (uc *ListAuthEventsUC) Execute(ctx context.Context, in dto.ListAuthEventsInput) (dto.ListAuthEventsOutput, error) {
+	// Filters
+	filter, err := authEventFilter(in)
+	if err != nil {
+		return dto.ListAuthEventsOutput{}, err
+	}
+
+	var after *port.AuthEventCursor
+	if in.Cursor != nil && *in.Cursor != "" {
+		after, err = decodeAuthEventCursor(*in.Cursor)
+		if err != nil {
+			return dto.ListAuthEventsOutput{}, ucerrs.ErrInvalidCursor
+		}
+	}
+
+	limit := in.Limit
+	if limit <= 0 {
+		limit = defaultAuthEventsPage
+	}
+	limit = min(limit, maxAuthEventsPage)
+
+	// One extra row tells whether there is a next page
+	events, err := uc.authEvent.List(ctx, filter, after, limit+1)
+	if err != nil {
+		return dto.ListAuthEventsOutput{}, ucerrs.Wrap(
+			ucerrs.ErrListAuthEventsDB, err,
+		)
+	}
+
+	// Output
+	var out dto.ListAuthEventsOutput
+	if len(events) > limit {
+		events = events[:limit]
+		last := events[limit-1]
+		cursor := encodeAuthEventCursor(last.CreatedAt(), last.ID())
+		out.NextCursor = &cursor
+	}
+
+	out.Events = make([]dto.AuthEventInfo, 0, len(events))
+	for _, event := range events {
+		out.Events = append(out.Events, dto.AuthEventInfo{
+			EventID:   event.ID(),
+			AccountID: event.AccountID(),
+			EventType: event.EventType().String(),
+			Outcome:   event.Outcome().String(),
+			SessionID: event.SessionID(),
+			IP:        event.IP(),
+			UserAgent: event.UserAgent(),
+			ActorID:   event.ActorID(),
+			Reason:    event.Reason(),
+			Metadata:  event.Metadata(),
+			CreatedAt: event.CreatedAt(),
+		})
+	}
+
+	return out, nil
+}
+
+func authEventFilter(in dto.ListAuthEventsInput) (port.AuthEventFilter, error) {
+	var filter = port.AuthEventFilter{
+		AccountID: in.AccountID,
+		From:      in.From,
+		To:        in.To,
+	}
+	if in.EventType != nil && *in.EventType != "" {
+		eventType, err := model.ParseAuthEventType(*in.EventType)
+		if err != nil {
+			return port.AuthEventFilter{}, ucerrs.ErrInvalidEventFilter
+		}
+		filter.EventType = &eventType
+	}
+	if in.Outcome != nil && *in.Outcome != "" {
+		outcome, err := model.ParseAuthEventOutcome(*in.Outcome)
+		if err != nil {
+			return port.AuthEventFilter{}, ucerrs.ErrInvalidEventFilter
+		}
+		filter.Outcome = &outcome
+	}
+	if in.From != nil && in.To != nil && !in.From.Before(*in.To) {
+		return port.AuthEventFilter{}, ucerrs.ErrInvalidEventFilter
+	}
+	return filter, nil
+}
+
+// The cursor is opaque for clients, it keeps the position of the last
+// event of the page as "<unix nanos>:<event id>"
+func encodeAuthEventCursor(createdAt time.Time, id uuid.UUID) string {
+	raw := strconv.FormatInt(createdAt.UnixNano(), 10) + ":" + id.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeAuthEventCursor(cursor string) (*port.AuthEventCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+	rawTime, rawID, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, ucerrs.ErrInvalidCursor
+	}
+	nanos, err := strconv.ParseInt(rawTime, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(rawID)
+	if err != nil {
+		return nil, err
+	}
+	return &port.AuthEventCursor{CreatedAt: time.Unix(0, nanos), ID: id}, nil
+}
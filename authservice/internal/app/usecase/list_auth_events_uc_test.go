@@ -0,0 +1,150 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAuthEventsUC_Execute(t *testing.T) {
+	type testCase struct {
+		name     string
+		input    dto.ListAuthEventsInput
+		prepare  func(m *mocks.AuthEventRepository)
+		wantErr  error
+		wantLen  int
+		wantNext bool
+	}
+
+	accountID := uuid.New()
+	now := time.Now()
+
+	event := func(at time.Time) *model.AuthEvent {
+		return model.RestoreAuthEvent(
+			uuid.New(), accountID, model.AuthEventLogin, nil, nil, nil, nil, nil,
+			model.AuthEventSuccess, map[string]string{"method": "password"}, at,
+		)
+	}
+	page := []*model.AuthEvent{event(now), event(now.Add(-time.Second)), event(now.Add(-time.Minute))}
+
+	loginType := "login"
+	badType := "password_guessed"
+	badCursor := "not-a-cursor"
+	from := now.Add(-time.Hour)
+	to := now.Add(-time.Hour * 2)
+
+	var tests = []testCase{
+		{
+			name:  "Success - Has Next Page",
+			input: dto.ListAuthEventsInput{AccountID: &accountID, EventType: &loginType, Limit: 2},
+			prepare: func(m *mocks.AuthEventRepository) {
+				m.On("List", mock.Anything, mock.MatchedBy(func(f port.AuthEventFilter) bool {
+					return *f.AccountID == accountID && *f.EventType == model.AuthEventLogin
+				}), (*port.AuthEventCursor)(nil), 3).Return(page, nil)
+			},
+			wantLen:  2,
+			wantNext: true,
+		},
+		{
+			name:  "Success - Last Page",
+			input: dto.ListAuthEventsInput{AccountID: &accountID},
+			prepare: func(m *mocks.AuthEventRepository) {
+				m.On("List", mock.Anything, mock.Anything, (*port.AuthEventCursor)(nil), 51).
+					Return(page, nil)
+			},
+			wantLen:  3,
+			wantNext: false,
+		},
+		{
+			name:    "Fail - Invalid Cursor",
+			input:   dto.ListAuthEventsInput{Cursor: &badCursor},
+			prepare: func(m *mocks.AuthEventRepository) {},
+			wantErr: ucerrs.ErrInvalidCursor,
+		},
+		{
+			name:    "Fail - Unknown Event Type",
+			input:   dto.ListAuthEventsInput{EventType: &badType},
+			prepare: func(m *mocks.AuthEventRepository) {},
+			wantErr: ucerrs.ErrInvalidEventFilter,
+		},
+		{
+			name:    "Fail - Empty Time Range",
+			input:   dto.ListAuthEventsInput{From: &from, To: &to},
+			prepare: func(m *mocks.AuthEventRepository) {},
+			wantErr: ucerrs.ErrInvalidEventFilter,
+		},
+		{
+			name:  "Fail - DB Error",
+			input: dto.ListAuthEventsInput{},
+			prepare: func(m *mocks.AuthEventRepository) {
+				m.On("List", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, assert.AnError)
+			},
+			wantErr: ucerrs.ErrListAuthEventsDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authEvent := mocks.NewAuthEventRepository(t)
+			tt.prepare(authEvent)
+
+			uc := usecase.NewListAuthEventsUC(authEvent)
+
+			res, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, res.Events)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, res.Events, tt.wantLen)
+			assert.Equal(t, tt.wantNext, res.NextCursor != nil)
+		})
+	}
+}
+
+func TestListAuthEventsUC_CursorContinuesAfterLastEvent(t *testing.T) {
+	accountID := uuid.New()
+	last := model.RestoreAuthEvent(
+		uuid.New(), accountID, model.AuthEventLogout, nil, nil, nil, nil, nil,
+		model.AuthEventSuccess, nil, time.Now().Add(-time.Minute),
+	)
+	first := model.RestoreAuthEvent(
+		uuid.New(), accountID, model.AuthEventLogin, nil, nil, nil, nil, nil,
+		model.AuthEventSuccess, nil, time.Now(),
+	)
+
+	authEvent := mocks.NewAuthEventRepository(t)
+	authEvent.On("List", mock.Anything, mock.Anything, (*port.AuthEventCursor)(nil), 2).
+		Return([]*model.AuthEvent{first, last}, nil).Once()
+
+	uc := usecase.NewListAuthEventsUC(authEvent)
+
+	res, err := uc.Execute(context.Background(), dto.ListAuthEventsInput{Limit: 1})
+	require.NoError(t, err)
+	require.NotNil(t, res.NextCursor)
+
+	authEvent.On("List", mock.Anything, mock.Anything, mock.MatchedBy(func(c *port.AuthEventCursor) bool {
+		return c != nil && c.ID == first.ID() && c.CreatedAt.Equal(first.CreatedAt())
+	}), 2).Return([]*model.AuthEvent{last}, nil).Once()
+
+	res, err = uc.Execute(context.Background(), dto.ListAuthEventsInput{Limit: 1, Cursor: res.NextCursor})
+	require.NoError(t, err)
+	assert.Len(t, res.Events, 1)
+	assert.Equal(t, last.ID(), res.Events[0].EventID)
+	assert.Nil(t, res.NextCursor)
+}
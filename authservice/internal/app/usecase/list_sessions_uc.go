@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+type ListSessionsUC struct {
+	refreshSession port.RefreshSessionRepository
+	tokenGenerator port.TokenGenerator
+}
+
+func NewListSessionsUC(
+	refreshSession port.RefreshSessionRepository,
+	tokenGenerator port.TokenGenerator,
+) *ListSessionsUC {
+	return &ListSessionsUC{
+		refreshSession: refreshSession,
+		tokenGenerator: tokenGenerator,
+	}
+}
+
+func (uc *ListSessionsUC) Execute(ctx context.Context, in dto.ListSessionsInput) (dto.ListSessionsOutput, error) {
+	// Find the caller's session to flag it
+	var currentID uuid.UUID
+	if in.RefreshToken != nil {
+		session, err := currentSession(
+			ctx, uc.tokenGenerator, uc.refreshSession,
+			in.AccountID, *in.RefreshToken,
+		)
+		if err != nil {
+			return dto.ListSessionsOutput{}, err
+		}
+		currentID = session.ID()
+	}
+
+	// List sessions
+	sessions, err := uc.refreshSession.ListActiveForAccount(ctx, in.AccountID)
+	if err != nil {
+		return dto.ListSessionsOutput{}, ucerrs.Wrap(
+			ucerrs.ErrListRefreshSessionsDB, err,
+		)
+	}
+
+	// Output
+	var out = dto.ListSessionsOutput{
+		Sessions: make([]dto.SessionInfo, 0, len(sessions)),
+	}
+	for _, s := range sessions {
+		out.Sessions = append(out.Sessions, dto.SessionInfo{
+			SessionID: s.ID(),
+			IP:        s.IP(),
+			UserAgent: s.UserAgent(),
+			CreatedAt: s.CreatedAt(),
+			ExpiresAt: s.ExpiresAt(),
+			Current:   s.ID() == currentID,
+		})
+	}
+
+	return out, nil
+}
@@ -0,0 +1,120 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListSessionsUC_Execute(t *testing.T) {
+	type adapter struct {
+		refreshSession *mocks.RefreshSessionRepository
+		tokenGenerator *mocks.TokenGenerator
+	}
+
+	type testCase struct {
+		name        string
+		input       dto.ListSessionsInput
+		prepare     func(a adapter)
+		wantErr     error
+		wantLen     int
+		wantCurrent *uuid.UUID
+	}
+
+	accountID := uuid.New()
+	token := "current-refresh-token"
+	ip := "127.0.0.1"
+	ua := "Mozilla/5.0"
+
+	current, _ := model.NewRefreshSession(
+		uuid.New(), accountID, utils.HashToken(token), nil, &ip, &ua, time.Hour,
+	)
+	another, _ := model.NewRefreshSession(
+		uuid.New(), accountID, "another-hash", nil, nil, nil, time.Hour,
+	)
+	currentID := current.ID()
+
+	var tests = []testCase{
+		{
+			name:  "Success - Current Session Flagged",
+			input: dto.ListSessionsInput{AccountID: accountID, RefreshToken: &token},
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, token).
+					Return(accountID, current.ID(), nil)
+				a.refreshSession.On("GetByID", mock.Anything, current.ID()).
+					Return(current, nil)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, accountID).
+					Return([]*model.RefreshSession{current, another}, nil)
+			},
+			wantErr:     nil,
+			wantLen:     2,
+			wantCurrent: &currentID,
+		},
+		{
+			name:  "Success - Without Refresh Token",
+			input: dto.ListSessionsInput{AccountID: accountID},
+			prepare: func(a adapter) {
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, accountID).
+					Return([]*model.RefreshSession{current, another}, nil)
+			},
+			wantErr: nil,
+			wantLen: 2,
+		},
+		{
+			name:  "Fail - Refresh Token Of Another Account",
+			input: dto.ListSessionsInput{AccountID: accountID, RefreshToken: &token},
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, token).
+					Return(uuid.New(), current.ID(), nil)
+			},
+			wantErr: ucerrs.ErrInvalidRefreshToken,
+		},
+		{
+			name:  "Fail - DB Error On List",
+			input: dto.ListSessionsInput{AccountID: accountID},
+			prepare: func(a adapter) {
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, accountID).
+					Return(nil, assert.AnError)
+			},
+			wantErr: ucerrs.ErrListRefreshSessionsDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				refreshSession: mocks.NewRefreshSessionRepository(t),
+				tokenGenerator: mocks.NewTokenGenerator(t),
+			}
+
+			tt.prepare(a)
+
+			uc := usecase.NewListSessionsUC(a.refreshSession, a.tokenGenerator)
+
+			res, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, res.Sessions, tt.wantLen)
+			for _, s := range res.Sessions {
+				isCurrent := tt.wantCurrent != nil && s.SessionID == *tt.wantCurrent
+				assert.Equal(t, isCurrent, s.Current)
+			}
+		})
+	}
+}
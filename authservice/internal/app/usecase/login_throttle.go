@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+)
+
+// Returns a retry-after error when any of the keys is delayed or locked out
+func checkLoginThrottle(
+	ctx context.Context,
+	loginAttempt port.LoginAttemptRepository,
+	throttle *model.LoginThrottle,
+	keys []string, now time.Time,
+) error {
+	var retryAfter time.Duration
+	for _, key := range keys {
+		failures, lastFailure, err := loginAttempt.GetFailures(
+			ctx, key, now.Add(-throttle.Window()),
+		)
+		if err != nil {
+			return ucerrs.Wrap(ucerrs.ErrGetLoginAttemptsDB, err)
+		}
+		retryAfter = max(retryAfter, throttle.RetryAfter(failures, lastFailure, now))
+	}
+
+	if retryAfter > 0 {
+		return ucerrs.RetryAfter(ucerrs.ErrTooManyLoginAttempts, retryAfter)
+	}
+	return nil
+}
+
+// Counts a failure for every key and returns failure as the result,
+// so callers can return it directly
+func recordLoginFailure(
+	ctx context.Context,
+	loginAttempt port.LoginAttemptRepository,
+	throttle *model.LoginThrottle,
+	keys []string, now time.Time, failure error,
+) error {
+	for _, key := range keys {
+		if err := loginAttempt.RecordFailure(
+			ctx, key, now, throttle.Window(),
+		); err != nil {
+			return ucerrs.Wrap(ucerrs.ErrRecordLoginAttemptDB, err)
+		}
+	}
+	return failure
+}
+
+func clearLoginFailures(
+	ctx context.Context,
+	loginAttempt port.LoginAttemptRepository,
+	keys []string,
+) error {
+	for _, key := range keys {
+		if err := loginAttempt.Clear(ctx, key); err != nil {
+			return ucerrs.Wrap(ucerrs.ErrClearLoginAttemptsDB, err)
+		}
+	}
+	return nil
+}
@@ -7,49 +7,85 @@ import (
 
 	"github.com/maket12/ads-service/authservice/internal/app/dto"
 	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
-	"github.com/maket12/ads-service/authservice/internal/app/utils"
 	"github.com/maket12/ads-service/authservice/internal/domain/model"
 	"github.com/maket12/ads-service/authservice/internal/domain/port"
 	pkgerrs "github.com/maket12/ads-service/pkg/errs"
-
-	"github.com/google/uuid"
 )
 
 type LoginUC struct {
 	account        port.AccountRepository
-	accountRole    port.AccountRoleRepository
-	refreshSession port.RefreshSessionRepository
+	totpFactor     port.TOTPFactorRepository
 	passwordHasher port.PasswordHasher
 	tokenGenerator port.TokenGenerator
+	loginAttempt   port.LoginAttemptRepository
+	authEvent      port.AuthEventRepository
+	sessions       *sessionIssuer
 
-	refreshSessionTTL time.Duration
+	throttle *model.LoginThrottle
 }
 
 func NewLoginUC(
 	account port.AccountRepository,
 	accountRole port.AccountRoleRepository,
 	refreshSession port.RefreshSessionRepository,
+	totpFactor port.TOTPFactorRepository,
 	passwordHasher port.PasswordHasher,
 	tokenGenerator port.TokenGenerator,
+	loginAttempt port.LoginAttemptRepository,
+	authEvent port.AuthEventRepository,
+	txManager port.TransactionManager,
+	accountOutbox port.AccountOutbox,
+	throttle *model.LoginThrottle,
+	sessionLimit *model.SessionLimitPolicy,
+	newDeviceWindow time.Duration,
 	refreshSessionTTL time.Duration,
 ) *LoginUC {
 	return &LoginUC{
-		account:           account,
-		accountRole:       accountRole,
-		refreshSession:    refreshSession,
-		passwordHasher:    passwordHasher,
-		tokenGenerator:    tokenGenerator,
-		refreshSessionTTL: refreshSessionTTL,
+		account:        account,
+		totpFactor:     totpFactor,
+		passwordHasher: passwordHasher,
+		tokenGenerator: tokenGenerator,
+		loginAttempt:   loginAttempt,
+		authEvent:      authEvent,
+		throttle:       throttle,
+		sessions: newSessionIssuer(
+			txManager, account, accountRole, refreshSession,
+			tokenGenerator, authEvent, accountOutbox,
+			sessionLimit, newDeviceWindow, refreshSessionTTL,
+		),
+	}
+}
+
+// Failures are counted per email and per client IP
+func (uc *LoginUC) throttleKeys(in dto.LoginInput) []string {
+	keys := []string{model.LoginKeyForEmail(in.Email)}
+	if in.IP != nil && *in.IP != "" {
+		keys = append(keys, model.LoginKeyForIP(*in.IP))
 	}
+	return keys
 }
 
 func (uc *LoginUC) Execute(ctx context.Context, in dto.LoginInput) (dto.LoginOutput, error) {
+	// Brute-force protection
+	var (
+		keys = uc.throttleKeys(in)
+		now  = time.Now()
+	)
+	if err := checkLoginThrottle(
+		ctx, uc.loginAttempt, uc.throttle, keys, now,
+	); err != nil {
+		return dto.LoginOutput{}, err
+	}
+
 	// Find account
 	account, err := uc.account.GetByEmail(ctx, in.Email)
 
 	if err != nil {
 		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
-			return dto.LoginOutput{}, ucerrs.ErrInvalidCredentials
+			return dto.LoginOutput{}, recordLoginFailure(
+				ctx, uc.loginAttempt, uc.throttle, keys, now,
+				ucerrs.ErrInvalidCredentials,
+			)
 		}
 		return dto.LoginOutput{}, ucerrs.Wrap(
 			ucerrs.ErrGetAccountByEmailDB, err,
@@ -57,70 +93,76 @@ func (uc *LoginUC) Execute(ctx context.Context, in dto.LoginInput) (dto.LoginOut
 	}
 
 	if !uc.passwordHasher.Compare(account.PasswordHash(), in.Password) {
-		return dto.LoginOutput{}, ucerrs.ErrInvalidCredentials
+		if err := uc.recordFailure(ctx, account, in, "wrong password"); err != nil {
+			return dto.LoginOutput{}, err
+		}
+		return dto.LoginOutput{}, recordLoginFailure(
+			ctx, uc.loginAttempt, uc.throttle, keys, now,
+			ucerrs.ErrInvalidCredentials,
+		)
 	}
 
 	// Account validation
 	if ok := account.CanLogin(); !ok {
+		if err := uc.recordFailure(ctx, account, in, "account "+account.Status().String()); err != nil {
+			return dto.LoginOutput{}, err
+		}
 		return dto.LoginOutput{}, ucerrs.ErrCannotLogin
 	}
 
-	// Update account
-	account.MarkLogin()
-	if err := uc.account.MarkLogin(ctx, account); err != nil {
-		return dto.LoginOutput{}, ucerrs.Wrap(
-			ucerrs.ErrUpdateAccountDB, err,
-		)
+	// The password is correct, forget previous failures of the account.
+	// The IP keeps its failures, otherwise an attacker could reset its
+	// counter by logging into an own account between guesses
+	if err := clearLoginFailures(
+		ctx, uc.loginAttempt, []string{model.LoginKeyForEmail(in.Email)},
+	); err != nil {
+		return dto.LoginOutput{}, err
 	}
 
-	// Find an account role
-	accRole, err := uc.accountRole.Get(ctx, account.ID())
-	if err != nil {
-		return dto.LoginOutput{}, ucerrs.Wrap(ucerrs.ErrGetAccountRoleDB, err)
+	// Upgrade an outdated hash while the plain password is at hand
+	if uc.passwordHasher.NeedsRehash(account.PasswordHash()) {
+		if err := uc.rehashPassword(ctx, account, in.Password); err != nil {
+			return dto.LoginOutput{}, err
+		}
 	}
 
-	// Generate tokens
-	accessToken, err := uc.tokenGenerator.GenerateAccessToken(
-		ctx, account.ID(), accRole.Role().String(),
+	// With 2FA on the tokens are issued by VerifyMFA
+	challenge, ok, err := mfaChallenge(
+		ctx, uc.totpFactor, uc.tokenGenerator, account.ID(),
 	)
-	if err != nil {
-		return dto.LoginOutput{}, ucerrs.Wrap(
-			ucerrs.ErrGenerateAccessToken, err,
-		)
+	if err != nil || ok {
+		return challenge, err
 	}
 
-	var sessionID = uuid.New()
-	refreshToken, err := uc.tokenGenerator.GenerateRefreshToken(
-		ctx, account.ID(), sessionID,
+	return uc.sessions.issue(
+		ctx, account, loginMethodPassword, in.IP, in.UserAgent,
 	)
-	if err != nil {
-		return dto.LoginOutput{}, ucerrs.Wrap(
-			ucerrs.ErrGenerateRefreshToken, err,
-		)
-	}
-
-	hashedRefreshToken := utils.HashToken(refreshToken)
+}
 
-	// Create refresh session
-	refreshSession, err := model.NewRefreshSession(
-		sessionID, account.ID(), hashedRefreshToken, nil,
-		in.IP, in.UserAgent, uc.refreshSessionTTL,
+// Failed attempts are audited only for existing accounts,
+// unknown emails are covered by the throttle
+func (uc *LoginUC) recordFailure(
+	ctx context.Context, account *model.Account, in dto.LoginInput, reason string,
+) error {
+	return recordAuthEvent(
+		ctx, uc.authEvent, account.ID(), model.AuthEventLogin, model.AuthEventFailure,
+		nil, in.IP, in.UserAgent,
+		map[string]string{"method": loginMethodPassword, "reason": reason},
 	)
+}
+
+func (uc *LoginUC) rehashPassword(ctx context.Context, account *model.Account, password string) error {
+	hash, err := uc.passwordHasher.Hash(password)
 	if err != nil {
-		return dto.LoginOutput{}, ucerrs.Wrap(
-			ucerrs.ErrInvalidInput, err,
-		)
+		return ucerrs.Wrap(ucerrs.ErrHashPassword, err)
 	}
 
-	if err := uc.refreshSession.Create(ctx, refreshSession); err != nil {
-		return dto.LoginOutput{}, ucerrs.Wrap(
-			ucerrs.ErrCreateRefreshSessionDB, err,
-		)
+	if err := account.ChangePassword(hash); err != nil {
+		return ucerrs.Wrap(ucerrs.ErrInvalidInput, err)
 	}
 
-	// Output
-	return dto.LoginOutput{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-	}, nil
+	if err := uc.account.UpdatePassword(ctx, account); err != nil {
+		return ucerrs.Wrap(ucerrs.ErrUpdateAccountDB, err)
+	}
+	return nil
 }
@@ -2,6 +2,7 @@ package usecase_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -12,8 +13,10 @@ import (
 	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
 	pkgerrs "github.com/maket12/ads-service/pkg/errs"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLoginUC_Execute(t *testing.T) {
@@ -23,6 +26,11 @@ func TestLoginUC_Execute(t *testing.T) {
 		refreshSession *mocks.RefreshSessionRepository
 		passwordHasher *mocks.PasswordHasher
 		tokenGenerator *mocks.TokenGenerator
+		loginAttempt   *mocks.LoginAttemptRepository
+		totpFactor     *mocks.TOTPFactorRepository
+		authEvent      *mocks.AuthEventRepository
+		txManager      *mocks.TransactionManager
+		accountOutbox  *mocks.AccountOutbox
 	}
 
 	type testCase struct {
@@ -30,6 +38,7 @@ func TestLoginUC_Execute(t *testing.T) {
 		input   dto.LoginInput
 		prepare func(a adapter)
 		wantErr error
+		wantMFA bool
 	}
 
 	email := "user@test.com"
@@ -40,30 +49,194 @@ func TestLoginUC_Execute(t *testing.T) {
 
 	role, _ := model.NewAccountRole(account.ID())
 
+	ip := "127.0.0.1"
+	emailKey := model.LoginKeyForEmail(email)
+	ipKey := model.LoginKeyForIP(ip)
+	throttle, _ := model.NewLoginThrottle(time.Minute*15, 3, 10, time.Second, time.Minute*15)
+	noSessionLimit, _ := model.NewSessionLimitPolicy("evict_oldest", nil)
+
+	failureAudited := func(a adapter, reason string) {
+		a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+			return e.EventType() == model.AuthEventLogin &&
+				e.Outcome() == model.AuthEventFailure && e.Metadata()["reason"] == reason
+		})).Return(nil)
+	}
+
+	noFailures := func(a adapter) {
+		a.loginAttempt.On("GetFailures", mock.Anything, mock.Anything, mock.Anything).
+			Return(0, time.Time{}, nil)
+	}
+
+	userAgent := "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 Chrome/120.0 Safari/537.36"
+	deviceInput := dto.LoginInput{Email: email, Password: pass, IP: &ip, UserAgent: &userAgent}
+
+	// Everything up to the device check goes fine
+	signedIn := func(a adapter) {
+		noFailures(a)
+		a.account.On("GetByEmail", mock.Anything, email).Return(account, nil)
+		a.passwordHasher.On("Compare", "hashed_db", pass).Return(true)
+		a.loginAttempt.On("Clear", mock.Anything, emailKey).Return(nil)
+		a.passwordHasher.On("NeedsRehash", "hashed_db").Return(false)
+		a.totpFactor.On("GetByAccountID", mock.Anything, account.ID()).
+			Return(nil, pkgerrs.ErrObjectNotFound)
+		a.account.On("MarkLogin", mock.Anything, mock.Anything).Return(nil)
+		a.accountRole.On("Get", mock.Anything, account.ID()).Return(role, nil)
+	}
+	tokensIssued := func(a adapter) {
+		a.tokenGenerator.On("GenerateAccessToken", mock.Anything, mock.Anything).
+			Return("access_token_val", nil)
+		a.tokenGenerator.On("GenerateRefreshToken", mock.Anything, account.ID(), mock.Anything).
+			Return("refresh_token_val", nil)
+	}
+	loginAudited := func(a adapter, newDevice bool) {
+		a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+			_, marked := e.Metadata()["new_device"]
+			return e.EventType() == model.AuthEventLogin && marked == newDevice
+		})).Return(nil)
+	}
+
 	var tests = []testCase{
 		{
 			name:  "Success",
 			input: dto.LoginInput{Email: email, Password: pass, IP: nil, UserAgent: nil},
 			prepare: func(a adapter) {
+				noFailures(a)
 				a.account.On("GetByEmail", mock.Anything, email).Return(account, nil)
 				a.passwordHasher.On("Compare", "hashed_db", pass).Return(true)
+				a.loginAttempt.On("Clear", mock.Anything, emailKey).Return(nil)
+				a.passwordHasher.On("NeedsRehash", "hashed_db").Return(false)
+				a.totpFactor.On("GetByAccountID", mock.Anything, account.ID()).
+					Return(nil, pkgerrs.ErrObjectNotFound)
 				a.account.On("MarkLogin", mock.Anything, mock.Anything).Return(nil)
 				a.accountRole.On("Get", mock.Anything, account.ID()).Return(role, nil)
-				a.tokenGenerator.On("GenerateAccessToken", mock.Anything, account.ID(), "user").
+				a.tokenGenerator.On("GenerateAccessToken", mock.Anything, mock.MatchedBy(func(c *model.AccessTokenClaims) bool {
+					return c.AccountID == account.ID() && c.Roles[0] == model.RoleUser
+				})).
 					Return("access_token_val", nil)
 				a.tokenGenerator.On("GenerateRefreshToken", mock.Anything, account.ID(), mock.Anything).
 					Return("refresh_token_val", nil)
 
 				a.refreshSession.On("Create", mock.Anything, mock.Anything).Return(nil)
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.EventType() == model.AuthEventLogin &&
+						e.Outcome() == model.AuthEventSuccess && e.Metadata()["method"] == "password"
+				})).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Success - Outdated Hash Is Upgraded",
+			input: dto.LoginInput{Email: email, Password: pass},
+			prepare: func(a adapter) {
+				legacyAcc, _ := model.NewAccount(email, "legacy_hash")
+
+				noFailures(a)
+				a.account.On("GetByEmail", mock.Anything, email).Return(legacyAcc, nil)
+				a.passwordHasher.On("Compare", "legacy_hash", pass).Return(true)
+				a.loginAttempt.On("Clear", mock.Anything, emailKey).Return(nil)
+				a.passwordHasher.On("NeedsRehash", "legacy_hash").Return(true)
+				a.passwordHasher.On("Hash", pass).Return("$argon2id$new_hash", nil)
+				a.account.On("UpdatePassword", mock.Anything, mock.MatchedBy(func(acc *model.Account) bool {
+					return acc.PasswordHash() == "$argon2id$new_hash"
+				})).Return(nil)
+				a.totpFactor.On("GetByAccountID", mock.Anything, legacyAcc.ID()).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+				a.account.On("MarkLogin", mock.Anything, mock.Anything).Return(nil)
+				a.accountRole.On("Get", mock.Anything, legacyAcc.ID()).Return(role, nil)
+				a.tokenGenerator.On("GenerateAccessToken", mock.Anything, mock.Anything).
+					Return("access_token_val", nil)
+				a.tokenGenerator.On("GenerateRefreshToken", mock.Anything, legacyAcc.ID(), mock.Anything).
+					Return("refresh_token_val", nil)
+				a.refreshSession.On("Create", mock.Anything, mock.Anything).Return(nil)
+				a.authEvent.On("Create", mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Fail - Rehash Update Error",
+			input: dto.LoginInput{Email: email, Password: pass},
+			prepare: func(a adapter) {
+				legacyAcc, _ := model.NewAccount(email, "legacy_hash")
+
+				noFailures(a)
+				a.account.On("GetByEmail", mock.Anything, email).Return(legacyAcc, nil)
+				a.passwordHasher.On("Compare", "legacy_hash", pass).Return(true)
+				a.loginAttempt.On("Clear", mock.Anything, emailKey).Return(nil)
+				a.passwordHasher.On("NeedsRehash", "legacy_hash").Return(true)
+				a.passwordHasher.On("Hash", pass).Return("$argon2id$new_hash", nil)
+				a.account.On("UpdatePassword", mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrUpdateAccountDB,
+		},
+		{
+			name:  "Success - MFA Required",
+			input: dto.LoginInput{Email: email, Password: pass},
+			prepare: func(a adapter) {
+				factor, _ := model.NewTOTPFactor(account.ID(), "sealed")
+				_ = factor.Confirm()
+
+				noFailures(a)
+				a.account.On("GetByEmail", mock.Anything, email).Return(account, nil)
+				a.passwordHasher.On("Compare", "hashed_db", pass).Return(true)
+				a.loginAttempt.On("Clear", mock.Anything, emailKey).Return(nil)
+				a.passwordHasher.On("NeedsRehash", "hashed_db").Return(false)
+				a.totpFactor.On("GetByAccountID", mock.Anything, account.ID()).
+					Return(factor, nil)
+				a.tokenGenerator.On("GenerateMFAToken", mock.Anything, account.ID()).
+					Return("mfa_token_val", nil)
 			},
 			wantErr: nil,
+			wantMFA: true,
+		},
+		{
+			name:  "Success - Pending Factor Is Ignored",
+			input: dto.LoginInput{Email: email, Password: pass},
+			prepare: func(a adapter) {
+				factor, _ := model.NewTOTPFactor(account.ID(), "sealed")
+
+				noFailures(a)
+				a.account.On("GetByEmail", mock.Anything, email).Return(account, nil)
+				a.passwordHasher.On("Compare", "hashed_db", pass).Return(true)
+				a.loginAttempt.On("Clear", mock.Anything, emailKey).Return(nil)
+				a.passwordHasher.On("NeedsRehash", "hashed_db").Return(false)
+				a.totpFactor.On("GetByAccountID", mock.Anything, account.ID()).
+					Return(factor, nil)
+				a.account.On("MarkLogin", mock.Anything, mock.Anything).Return(nil)
+				a.accountRole.On("Get", mock.Anything, account.ID()).Return(role, nil)
+				a.tokenGenerator.On("GenerateAccessToken", mock.Anything, mock.MatchedBy(func(c *model.AccessTokenClaims) bool {
+					return c.AccountID == account.ID() && c.Roles[0] == model.RoleUser
+				})).
+					Return("access_token_val", nil)
+				a.tokenGenerator.On("GenerateRefreshToken", mock.Anything, account.ID(), mock.Anything).
+					Return("refresh_token_val", nil)
+				a.refreshSession.On("Create", mock.Anything, mock.Anything).Return(nil)
+				a.authEvent.On("Create", mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Fail - Get TOTP Factor Error",
+			input: dto.LoginInput{Email: email, Password: pass},
+			prepare: func(a adapter) {
+				noFailures(a)
+				a.account.On("GetByEmail", mock.Anything, email).Return(account, nil)
+				a.passwordHasher.On("Compare", "hashed_db", pass).Return(true)
+				a.loginAttempt.On("Clear", mock.Anything, emailKey).Return(nil)
+				a.passwordHasher.On("NeedsRehash", "hashed_db").Return(false)
+				a.totpFactor.On("GetByAccountID", mock.Anything, account.ID()).
+					Return(nil, assert.AnError)
+			},
+			wantErr: ucerrs.ErrGetTOTPFactorDB,
 		},
 		{
 			name:  "Fail - account Not Found",
 			input: dto.LoginInput{Email: "unknown@test.com", Password: pass},
 			prepare: func(a adapter) {
+				noFailures(a)
 				a.account.On("GetByEmail", mock.Anything, "unknown@test.com").
 					Return(nil, pkgerrs.ErrObjectNotFound)
+				a.loginAttempt.On("RecordFailure", mock.Anything, model.LoginKeyForEmail("unknown@test.com"), mock.Anything, time.Minute*15).
+					Return(nil)
 			},
 			wantErr: ucerrs.ErrInvalidCredentials,
 		},
@@ -71,11 +244,85 @@ func TestLoginUC_Execute(t *testing.T) {
 			name:  "Fail - Password Mismatch",
 			input: dto.LoginInput{Email: email, Password: "wrong_password"},
 			prepare: func(a adapter) {
+				noFailures(a)
 				a.account.On("GetByEmail", mock.Anything, email).Return(account, nil)
 				a.passwordHasher.On("Compare", "hashed_db", "wrong_password").Return(false)
+				failureAudited(a, "wrong password")
+				a.loginAttempt.On("RecordFailure", mock.Anything, emailKey, mock.Anything, time.Minute*15).
+					Return(nil)
 			},
 			wantErr: ucerrs.ErrInvalidCredentials,
 		},
+		{
+			name:  "Fail - Password Mismatch Counted Per IP",
+			input: dto.LoginInput{Email: email, Password: "wrong_password", IP: &ip},
+			prepare: func(a adapter) {
+				noFailures(a)
+				a.account.On("GetByEmail", mock.Anything, email).Return(account, nil)
+				a.passwordHasher.On("Compare", "hashed_db", "wrong_password").Return(false)
+				failureAudited(a, "wrong password")
+				a.loginAttempt.On("RecordFailure", mock.Anything, emailKey, mock.Anything, time.Minute*15).
+					Return(nil)
+				a.loginAttempt.On("RecordFailure", mock.Anything, ipKey, mock.Anything, time.Minute*15).
+					Return(nil)
+			},
+			wantErr: ucerrs.ErrInvalidCredentials,
+		},
+		{
+			name:  "Fail - Account Locked Out",
+			input: dto.LoginInput{Email: email, Password: pass, IP: &ip},
+			prepare: func(a adapter) {
+				a.loginAttempt.On("GetFailures", mock.Anything, emailKey, mock.Anything).
+					Return(10, time.Now(), nil)
+				a.loginAttempt.On("GetFailures", mock.Anything, ipKey, mock.Anything).
+					Return(0, time.Time{}, nil)
+			},
+			wantErr: ucerrs.ErrTooManyLoginAttempts,
+		},
+		{
+			name:  "Fail - IP Delayed",
+			input: dto.LoginInput{Email: email, Password: pass, IP: &ip},
+			prepare: func(a adapter) {
+				a.loginAttempt.On("GetFailures", mock.Anything, emailKey, mock.Anything).
+					Return(0, time.Time{}, nil)
+				a.loginAttempt.On("GetFailures", mock.Anything, ipKey, mock.Anything).
+					Return(5, time.Now(), nil)
+			},
+			wantErr: ucerrs.ErrTooManyLoginAttempts,
+		},
+		{
+			name:  "Fail - Get Login Attempts Error",
+			input: dto.LoginInput{Email: email, Password: pass},
+			prepare: func(a adapter) {
+				a.loginAttempt.On("GetFailures", mock.Anything, emailKey, mock.Anything).
+					Return(0, time.Time{}, assert.AnError)
+			},
+			wantErr: ucerrs.ErrGetLoginAttemptsDB,
+		},
+		{
+			name:  "Fail - Record Login Attempt Error",
+			input: dto.LoginInput{Email: email, Password: "wrong_password"},
+			prepare: func(a adapter) {
+				noFailures(a)
+				a.account.On("GetByEmail", mock.Anything, email).Return(account, nil)
+				a.passwordHasher.On("Compare", "hashed_db", "wrong_password").Return(false)
+				failureAudited(a, "wrong password")
+				a.loginAttempt.On("RecordFailure", mock.Anything, emailKey, mock.Anything, mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrRecordLoginAttemptDB,
+		},
+		{
+			name:  "Fail - Audit Log Error",
+			input: dto.LoginInput{Email: email, Password: "wrong_password"},
+			prepare: func(a adapter) {
+				noFailures(a)
+				a.account.On("GetByEmail", mock.Anything, email).Return(account, nil)
+				a.passwordHasher.On("Compare", "hashed_db", "wrong_password").Return(false)
+				a.authEvent.On("Create", mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrCreateAuthEventDB,
+		},
 		{
 			name:  "Fail - account Banned",
 			input: dto.LoginInput{Email: email, Password: pass},
@@ -83,8 +330,10 @@ func TestLoginUC_Execute(t *testing.T) {
 				bannedAcc, _ := model.NewAccount(email, "hashed_db")
 				bannedAcc.Block()
 
+				noFailures(a)
 				a.account.On("GetByEmail", mock.Anything, email).Return(bannedAcc, nil)
 				a.passwordHasher.On("Compare", "hashed_db", pass).Return(true)
+				failureAudited(a, "account blocked")
 			},
 			wantErr: ucerrs.ErrCannotLogin,
 		},
@@ -92,16 +341,87 @@ func TestLoginUC_Execute(t *testing.T) {
 			name:  "Fail - Token Generation Error",
 			input: dto.LoginInput{Email: email, Password: pass},
 			prepare: func(a adapter) {
+				noFailures(a)
 				a.account.On("GetByEmail", mock.Anything, email).Return(account, nil)
 				a.passwordHasher.On("Compare", "hashed_db", pass).Return(true)
+				a.loginAttempt.On("Clear", mock.Anything, emailKey).Return(nil)
+				a.passwordHasher.On("NeedsRehash", "hashed_db").Return(false)
+				a.totpFactor.On("GetByAccountID", mock.Anything, account.ID()).
+					Return(nil, pkgerrs.ErrObjectNotFound)
 				a.account.On("MarkLogin", mock.Anything, mock.Anything).Return(nil)
 				a.accountRole.On("Get", mock.Anything, account.ID()).Return(role, nil)
 
-				a.tokenGenerator.On("GenerateAccessToken", mock.Anything, mock.Anything, mock.Anything).
+				a.tokenGenerator.On("GenerateAccessToken", mock.Anything, mock.Anything).
 					Return("", assert.AnError)
 			},
 			wantErr: ucerrs.ErrGenerateAccessToken,
 		},
+		{
+			name:  "Success - New Device Is Reported",
+			input: deviceInput,
+			prepare: func(a adapter) {
+				signedIn(a)
+				tokensIssued(a)
+				a.refreshSession.On("CountRecent", mock.Anything, account.ID(), &ip, &userAgent, mock.Anything).
+					Return(3, 0, nil)
+				a.refreshSession.On("Create", mock.Anything, mock.Anything).Return(nil)
+				loginAudited(a, true)
+				a.accountOutbox.On("AddNewDeviceLogin", mock.Anything, account.ID(), mock.Anything,
+					&ip, "Chrome", "Windows").Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Success - Known Device",
+			input: deviceInput,
+			prepare: func(a adapter) {
+				signedIn(a)
+				tokensIssued(a)
+				a.refreshSession.On("CountRecent", mock.Anything, account.ID(), &ip, &userAgent, mock.Anything).
+					Return(3, 1, nil)
+				a.refreshSession.On("Create", mock.Anything, mock.Anything).Return(nil)
+				loginAudited(a, false)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Success - First Session Is Not Reported",
+			input: deviceInput,
+			prepare: func(a adapter) {
+				signedIn(a)
+				tokensIssued(a)
+				a.refreshSession.On("CountRecent", mock.Anything, account.ID(), &ip, &userAgent, mock.Anything).
+					Return(0, 0, nil)
+				a.refreshSession.On("Create", mock.Anything, mock.Anything).Return(nil)
+				loginAudited(a, false)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Fail - Count Sessions Error",
+			input: deviceInput,
+			prepare: func(a adapter) {
+				signedIn(a)
+				a.refreshSession.On("CountRecent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(0, 0, assert.AnError)
+			},
+			wantErr: ucerrs.ErrListRefreshSessionsDB,
+		},
+		{
+			name:  "Fail - New Device Outbox Error",
+			input: deviceInput,
+			prepare: func(a adapter) {
+				signedIn(a)
+				tokensIssued(a)
+				a.refreshSession.On("CountRecent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(1, 0, nil)
+				a.refreshSession.On("Create", mock.Anything, mock.Anything).Return(nil)
+				loginAudited(a, true)
+				a.accountOutbox.On("AddNewDeviceLogin", mock.Anything, mock.Anything, mock.Anything,
+					mock.Anything, mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrAddOutboxEventDB,
+		},
 	}
 
 	for _, tt := range tests {
@@ -112,13 +432,20 @@ func TestLoginUC_Execute(t *testing.T) {
 				refreshSession: mocks.NewRefreshSessionRepository(t),
 				passwordHasher: mocks.NewPasswordHasher(t),
 				tokenGenerator: mocks.NewTokenGenerator(t),
+				loginAttempt:   mocks.NewLoginAttemptRepository(t),
+				totpFactor:     mocks.NewTOTPFactorRepository(t),
+				authEvent:      mocks.NewAuthEventRepository(t),
+				txManager:      mocks.NewTransactionManager(t),
+				accountOutbox:  mocks.NewAccountOutbox(t),
 			}
 
+			allowTx(a.txManager)
 			tt.prepare(a)
 
 			uc := usecase.NewLoginUC(
-				a.account, a.accountRole, a.refreshSession,
-				a.passwordHasher, a.tokenGenerator, ttl,
+				a.account, a.accountRole, a.refreshSession, a.totpFactor,
+				a.passwordHasher, a.tokenGenerator, a.loginAttempt, a.authEvent,
+				a.txManager, a.accountOutbox, throttle, noSessionLimit, time.Hour*24*30, ttl,
 			)
 
 			res, err := uc.Execute(context.Background(), tt.input)
@@ -126,11 +453,186 @@ func TestLoginUC_Execute(t *testing.T) {
 			if tt.wantErr != nil {
 				assert.ErrorIs(t, err, tt.wantErr)
 				assert.Empty(t, res.AccessToken)
+
+				var retryErr *ucerrs.RetryAfterError
+				if errors.As(err, &retryErr) {
+					assert.Positive(t, retryErr.RetryAfter)
+				}
+			} else if tt.wantMFA {
+				assert.NoError(t, err)
+				assert.True(t, res.MFARequired)
+				assert.Equal(t, "mfa_token_val", res.MFAToken)
+				assert.Empty(t, res.AccessToken)
 			} else {
 				assert.NoError(t, err)
+				assert.False(t, res.MFARequired)
 				assert.Equal(t, "access_token_val", res.AccessToken)
 				assert.Equal(t, "refresh_token_val", res.RefreshToken)
 			}
 		})
 	}
 }
+
+func TestLoginUC_SessionLimit(t *testing.T) {
+	type adapter struct {
+		account        *mocks.AccountRepository
+		accountRole    *mocks.AccountRoleRepository
+		refreshSession *mocks.RefreshSessionRepository
+		passwordHasher *mocks.PasswordHasher
+		tokenGenerator *mocks.TokenGenerator
+		loginAttempt   *mocks.LoginAttemptRepository
+		totpFactor     *mocks.TOTPFactorRepository
+		authEvent      *mocks.AuthEventRepository
+		txManager      *mocks.TransactionManager
+	}
+
+	type testCase struct {
+		name    string
+		mode    string
+		prepare func(a adapter)
+		wantErr error
+	}
+
+	email := "user@test.com"
+	pass := "password123"
+	input := dto.LoginInput{Email: email, Password: pass}
+
+	account, _ := model.NewAccount(email, "hashed_db")
+	role, _ := model.NewAccountRole(account.ID())
+	throttle, _ := model.NewLoginThrottle(time.Minute*15, 3, 10, time.Second, time.Minute*15)
+
+	session := func(age time.Duration) *model.RefreshSession {
+		return model.RestoreRefreshSession(
+			uuid.New(), account.ID(), "hash", time.Now().Add(-age),
+			time.Now().Add(time.Hour), nil, nil, nil, nil, nil,
+		)
+	}
+	newest, oldest := session(time.Minute), session(time.Hour)
+
+	// The password is right and there is no second factor
+	authenticated := func(a adapter) {
+		a.loginAttempt.On("GetFailures", mock.Anything, mock.Anything, mock.Anything).
+			Return(0, time.Time{}, nil)
+		a.account.On("GetByEmail", mock.Anything, email).Return(account, nil)
+		a.passwordHasher.On("Compare", "hashed_db", pass).Return(true)
+		a.loginAttempt.On("Clear", mock.Anything, model.LoginKeyForEmail(email)).Return(nil)
+		a.passwordHasher.On("NeedsRehash", "hashed_db").Return(false)
+		a.totpFactor.On("GetByAccountID", mock.Anything, account.ID()).
+			Return(nil, pkgerrs.ErrObjectNotFound)
+		a.accountRole.On("Get", mock.Anything, account.ID()).Return(role, nil)
+		// The account row lock taken by MarkLogin orders concurrent logins,
+		// a refused one is rolled back with the transaction
+		expectTx(a.txManager)
+		a.account.On("MarkLogin", mock.Anything, mock.Anything).Return(nil)
+	}
+	issued := func(a adapter) {
+		a.tokenGenerator.On("GenerateAccessToken", mock.Anything, mock.Anything).
+			Return("access_token_val", nil)
+		a.tokenGenerator.On("GenerateRefreshToken", mock.Anything, account.ID(), mock.Anything).
+			Return("refresh_token_val", nil)
+		a.refreshSession.On("Create", mock.Anything, mock.Anything).Return(nil)
+	}
+
+	var tests = []testCase{
+		{
+			name: "Success - Under The Cap",
+			mode: "evict_oldest",
+			prepare: func(a adapter) {
+				authenticated(a)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, account.ID()).
+					Return([]*model.RefreshSession{newest}, nil)
+				issued(a)
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					_, evicted := e.Metadata()["evicted_sessions"]
+					return e.EventType() == model.AuthEventLogin && !evicted
+				})).Return(nil)
+			},
+		},
+		{
+			name: "Success - Oldest Session Is Evicted",
+			mode: "evict_oldest",
+			prepare: func(a adapter) {
+				authenticated(a)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, account.ID()).
+					Return([]*model.RefreshSession{newest, oldest}, nil)
+				issued(a)
+				a.refreshSession.On("Revoke", mock.Anything, mock.MatchedBy(func(s *model.RefreshSession) bool {
+					return s.ID() == oldest.ID() && s.IsRevoked() &&
+						*s.RevokeReason() == model.RevokeReasonSessionLimit
+				})).Return(nil).Once()
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.EventType() == model.AuthEventLogin &&
+						e.Metadata()["evicted_sessions"] == "1"
+				})).Return(nil)
+			},
+		},
+		{
+			name: "Fail - New Login Is Denied",
+			mode: "deny_new",
+			prepare: func(a adapter) {
+				authenticated(a)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, account.ID()).
+					Return([]*model.RefreshSession{session(time.Minute), session(time.Hour)}, nil)
+			},
+			wantErr: ucerrs.ErrTooManySessions,
+		},
+		{
+			name: "Fail - List Sessions DB Error",
+			mode: "evict_oldest",
+			prepare: func(a adapter) {
+				authenticated(a)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, account.ID()).
+					Return(nil, assert.AnError)
+			},
+			wantErr: ucerrs.ErrListRefreshSessionsDB,
+		},
+		{
+			name: "Fail - Revoke DB Error",
+			mode: "evict_oldest",
+			prepare: func(a adapter) {
+				authenticated(a)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, account.ID()).
+					Return([]*model.RefreshSession{session(time.Minute), session(time.Hour)}, nil)
+				issued(a)
+				a.refreshSession.On("Revoke", mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrRevokeRefreshSessionDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				account:        mocks.NewAccountRepository(t),
+				accountRole:    mocks.NewAccountRoleRepository(t),
+				refreshSession: mocks.NewRefreshSessionRepository(t),
+				passwordHasher: mocks.NewPasswordHasher(t),
+				tokenGenerator: mocks.NewTokenGenerator(t),
+				loginAttempt:   mocks.NewLoginAttemptRepository(t),
+				totpFactor:     mocks.NewTOTPFactorRepository(t),
+				authEvent:      mocks.NewAuthEventRepository(t),
+				txManager:      mocks.NewTransactionManager(t),
+			}
+			tt.prepare(a)
+
+			sessionLimit, err := model.NewSessionLimitPolicy(tt.mode, map[string]int{"user": 2})
+			require.NoError(t, err)
+
+			uc := usecase.NewLoginUC(
+				a.account, a.accountRole, a.refreshSession, a.totpFactor,
+				a.passwordHasher, a.tokenGenerator, a.loginAttempt, a.authEvent,
+				a.txManager, nil, throttle, sessionLimit, 0, time.Hour,
+			)
+
+			res, err := uc.Execute(context.Background(), input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, res.AccessToken)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "access_token_val", res.AccessToken)
+		})
+	}
+}
@@ -3,26 +3,39 @@ package usecase
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/maket12/ads-service/authservice/internal/app/dto"
 	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
 	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
 	"github.com/maket12/ads-service/authservice/internal/domain/port"
 	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
 )
 
 type LogoutUC struct {
 	refreshSession port.RefreshSessionRepository
 	tokenGenerator port.TokenGenerator
+	authEvent      port.AuthEventRepository
+	denylist       port.AccessTokenDenylist
+	accessTTL      time.Duration
 }
 
 func NewLogoutUC(
 	refreshSession port.RefreshSessionRepository,
 	tokenGenerator port.TokenGenerator,
+	authEvent port.AuthEventRepository,
+	denylist port.AccessTokenDenylist,
+	accessTTL time.Duration,
 ) *LogoutUC {
 	return &LogoutUC{
 		refreshSession: refreshSession,
 		tokenGenerator: tokenGenerator,
+		authEvent:      authEvent,
+		denylist:       denylist,
+		accessTTL:      accessTTL,
 	}
 }
 
@@ -60,10 +73,41 @@ func (uc *LogoutUC) Execute(ctx context.Context, in dto.LogoutInput) (dto.Logout
 	}
 
 	if err := uc.refreshSession.Revoke(ctx, session); err != nil {
+		if errors.Is(err, model.ErrTokenAlreadyRevoked) {
+			return dto.LogoutOutput{}, ucerrs.ErrInvalidRefreshToken
+		}
 		return dto.LogoutOutput{}, ucerrs.Wrap(
 			ucerrs.ErrRevokeRefreshSessionDB, err,
 		)
 	}
 
+	// Access tokens of the session stop working as well
+	if err := denySessions(
+		ctx, uc.denylist, []*model.RefreshSession{session}, uuid.Nil, uc.accessTTL,
+	); err != nil {
+		return dto.LogoutOutput{}, err
+	}
+
+	// The presented access token may come from an earlier rotation of
+	// the session, it is denied by its own id. An invalid one has nothing to revoke
+	if in.AccessToken != nil {
+		claims, err := uc.tokenGenerator.ValidateAccessToken(ctx, *in.AccessToken)
+		if err == nil && claims.AccountID == session.AccountID() && claims.TokenID != "" {
+			if err := uc.denylist.Add(
+				ctx, model.DenylistKeyForToken(claims.TokenID), claims.ExpiresAt,
+			); err != nil {
+				return dto.LogoutOutput{}, ucerrs.Wrap(ucerrs.ErrDenyAccessTokenDB, err)
+			}
+		}
+	}
+
+	var sessionID = session.ID()
+	if err := recordAuthEvent(
+		ctx, uc.authEvent, session.AccountID(), model.AuthEventLogout, model.AuthEventSuccess,
+		&sessionID, session.IP(), session.UserAgent(), nil,
+	); err != nil {
+		return dto.LogoutOutput{}, err
+	}
+
 	return dto.LogoutOutput{Logout: true}, nil
 }
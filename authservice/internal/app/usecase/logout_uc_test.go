@@ -21,12 +21,16 @@ func TestLogoutUC_Execute(t *testing.T) {
 	type adapter struct {
 		refreshSession *mocks.RefreshSessionRepository
 		tokenGenerator *mocks.TokenGenerator
+		authEvent      *mocks.AuthEventRepository
+		denylist       *mocks.AccessTokenDenylist
 	}
 
 	accountID := uuid.New()
 	sessionID := uuid.New()
 	token := "valid-refresh-token"
 	hashedToken := utils.HashToken(token)
+	accessToken := "valid-access-token"
+	sessionKey := model.DenylistKeyForSession(sessionID)
 
 	activeSession, _ := model.NewRefreshSession(
 		sessionID, accountID, hashedToken, nil, nil, nil, time.Hour,
@@ -55,9 +59,85 @@ func TestLogoutUC_Execute(t *testing.T) {
 				a.refreshSession.On("Revoke", mock.Anything, mock.MatchedBy(func(s *model.RefreshSession) bool {
 					return !s.IsActive()
 				})).Return(nil)
+
+				a.denylist.On("Add", mock.Anything, sessionKey, mock.Anything).Return(nil)
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.EventType() == model.AuthEventLogout && *e.SessionID() == sessionID
+				})).Return(nil)
 			},
 			wantErr: nil,
 		},
+		{
+			name: "Success - Access Token Denied By Id",
+			input: dto.LogoutInput{
+				RefreshToken: token,
+				AccessToken:  &accessToken,
+			},
+			prepare: func(a adapter) {
+				session, _ := model.NewRefreshSession(
+					sessionID, accountID, hashedToken, nil, nil, nil, time.Hour,
+				)
+				expiresAt := time.Now().Add(time.Minute)
+
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, token).
+					Return(accountID, sessionID, nil)
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).
+					Return(session, nil)
+				a.refreshSession.On("Revoke", mock.Anything, mock.Anything).Return(nil)
+				a.denylist.On("Add", mock.Anything, sessionKey, mock.Anything).Return(nil)
+				a.tokenGenerator.On("ValidateAccessToken", mock.Anything, accessToken).
+					Return(&model.AccessTokenClaims{
+						AccountID: accountID, TokenID: "jti-1", ExpiresAt: expiresAt,
+					}, nil)
+				a.denylist.On("Add", mock.Anything, model.DenylistKeyForToken("jti-1"), expiresAt).
+					Return(nil)
+				a.authEvent.On("Create", mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Success - Access Token Of Another Account Ignored",
+			input: dto.LogoutInput{
+				RefreshToken: token,
+				AccessToken:  &accessToken,
+			},
+			prepare: func(a adapter) {
+				session, _ := model.NewRefreshSession(
+					sessionID, accountID, hashedToken, nil, nil, nil, time.Hour,
+				)
+
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, token).
+					Return(accountID, sessionID, nil)
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).
+					Return(session, nil)
+				a.refreshSession.On("Revoke", mock.Anything, mock.Anything).Return(nil)
+				a.denylist.On("Add", mock.Anything, sessionKey, mock.Anything).Return(nil)
+				a.tokenGenerator.On("ValidateAccessToken", mock.Anything, accessToken).
+					Return(&model.AccessTokenClaims{
+						AccountID: uuid.New(), TokenID: "jti-2", ExpiresAt: time.Now(),
+					}, nil)
+				a.authEvent.On("Create", mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Fail - DB Error On Deny",
+			input: dto.LogoutInput{RefreshToken: token},
+			prepare: func(a adapter) {
+				session, _ := model.NewRefreshSession(
+					sessionID, accountID, hashedToken, nil, nil, nil, time.Hour,
+				)
+
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, token).
+					Return(accountID, sessionID, nil)
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).
+					Return(session, nil)
+				a.refreshSession.On("Revoke", mock.Anything, mock.Anything).Return(nil)
+				a.denylist.On("Add", mock.Anything, sessionKey, mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrDenyAccessTokenDB,
+		},
 		{
 			name:  "Fail - Invalid Token Format",
 			input: dto.LogoutInput{RefreshToken: "invalid"},
@@ -82,6 +162,23 @@ func TestLogoutUC_Execute(t *testing.T) {
 			},
 			wantErr: ucerrs.ErrInvalidRefreshToken,
 		},
+		{
+			name:  "Fail - Session Revoked Concurrently",
+			input: dto.LogoutInput{RefreshToken: token},
+			prepare: func(a adapter) {
+				session, _ := model.NewRefreshSession(
+					sessionID, accountID, hashedToken, nil, nil, nil, time.Hour,
+				)
+
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, token).
+					Return(accountID, sessionID, nil)
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).
+					Return(session, nil)
+				a.refreshSession.On("Revoke", mock.Anything, mock.Anything).
+					Return(model.ErrTokenAlreadyRevoked)
+			},
+			wantErr: ucerrs.ErrInvalidRefreshToken,
+		},
 		{
 			name:  "Fail - Token Hash Mismatch",
 			input: dto.LogoutInput{RefreshToken: "another-token"},
@@ -100,13 +197,17 @@ func TestLogoutUC_Execute(t *testing.T) {
 			a := adapter{
 				refreshSession: mocks.NewRefreshSessionRepository(t),
 				tokenGenerator: mocks.NewTokenGenerator(t),
+				authEvent:      mocks.NewAuthEventRepository(t),
+				denylist:       mocks.NewAccessTokenDenylist(t),
 			}
 
 			if tt.prepare != nil {
 				tt.prepare(a)
 			}
 
-			uc := usecase.NewLogoutUC(a.refreshSession, a.tokenGenerator)
+			uc := usecase.NewLogoutUC(
+				a.refreshSession, a.tokenGenerator, a.authEvent, a.denylist, time.Minute*15,
+			)
 
 			resp, err := uc.Execute(context.Background(), tt.input)
 
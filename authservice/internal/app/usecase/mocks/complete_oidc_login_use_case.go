@@ -0,0 +1,57 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/maket12/ads-service/authservice/internal/app/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CompleteOIDCLoginUseCase is an autogenerated mock type for the CompleteOIDCLoginUseCase type
+type CompleteOIDCLoginUseCase struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, in
+func (_m *CompleteOIDCLoginUseCase) Execute(ctx context.Context, in dto.CompleteOIDCLoginInput) (dto.CompleteOIDCLoginOutput, error) {
+	ret := _m.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 dto.CompleteOIDCLoginOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CompleteOIDCLoginInput) (dto.CompleteOIDCLoginOutput, error)); ok {
+		return rf(ctx, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CompleteOIDCLoginInput) dto.CompleteOIDCLoginOutput); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Get(0).(dto.CompleteOIDCLoginOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.CompleteOIDCLoginInput) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCompleteOIDCLoginUseCase creates a new instance of CompleteOIDCLoginUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCompleteOIDCLoginUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CompleteOIDCLoginUseCase {
+	mock := &CompleteOIDCLoginUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
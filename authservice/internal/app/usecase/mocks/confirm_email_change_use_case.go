@@ -0,0 +1,57 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/maket12/ads-service/authservice/internal/app/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ConfirmEmailChangeUseCase is an autogenerated mock type for the ConfirmEmailChangeUseCase type
+type ConfirmEmailChangeUseCase struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, in
+func (_m *ConfirmEmailChangeUseCase) Execute(ctx context.Context, in dto.ConfirmEmailChangeInput) (dto.ConfirmEmailChangeOutput, error) {
+	ret := _m.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 dto.ConfirmEmailChangeOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ConfirmEmailChangeInput) (dto.ConfirmEmailChangeOutput, error)); ok {
+		return rf(ctx, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ConfirmEmailChangeInput) dto.ConfirmEmailChangeOutput); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Get(0).(dto.ConfirmEmailChangeOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.ConfirmEmailChangeInput) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewConfirmEmailChangeUseCase creates a new instance of ConfirmEmailChangeUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewConfirmEmailChangeUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ConfirmEmailChangeUseCase {
+	mock := &ConfirmEmailChangeUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
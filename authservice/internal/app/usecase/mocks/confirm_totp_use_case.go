@@ -0,0 +1,57 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/maket12/ads-service/authservice/internal/app/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ConfirmTOTPUseCase is an autogenerated mock type for the ConfirmTOTPUseCase type
+type ConfirmTOTPUseCase struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, in
+func (_m *ConfirmTOTPUseCase) Execute(ctx context.Context, in dto.ConfirmTOTPInput) (dto.ConfirmTOTPOutput, error) {
+	ret := _m.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 dto.ConfirmTOTPOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ConfirmTOTPInput) (dto.ConfirmTOTPOutput, error)); ok {
+		return rf(ctx, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ConfirmTOTPInput) dto.ConfirmTOTPOutput); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Get(0).(dto.ConfirmTOTPOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.ConfirmTOTPInput) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewConfirmTOTPUseCase creates a new instance of ConfirmTOTPUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewConfirmTOTPUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ConfirmTOTPUseCase {
+	mock := &ConfirmTOTPUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,57 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/maket12/ads-service/authservice/internal/app/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ListAuthEventsUseCase is an autogenerated mock type for the ListAuthEventsUseCase type
+type ListAuthEventsUseCase struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, in
+func (_m *ListAuthEventsUseCase) Execute(ctx context.Context, in dto.ListAuthEventsInput) (dto.ListAuthEventsOutput, error) {
+	ret := _m.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 dto.ListAuthEventsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ListAuthEventsInput) (dto.ListAuthEventsOutput, error)); ok {
+		return rf(ctx, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ListAuthEventsInput) dto.ListAuthEventsOutput); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Get(0).(dto.ListAuthEventsOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.ListAuthEventsInput) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewListAuthEventsUseCase creates a new instance of ListAuthEventsUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewListAuthEventsUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ListAuthEventsUseCase {
+	mock := &ListAuthEventsUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
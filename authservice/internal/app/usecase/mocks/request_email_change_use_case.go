@@ -0,0 +1,57 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/maket12/ads-service/authservice/internal/app/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RequestEmailChangeUseCase is an autogenerated mock type for the RequestEmailChangeUseCase type
+type RequestEmailChangeUseCase struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, in
+func (_m *RequestEmailChangeUseCase) Execute(ctx context.Context, in dto.RequestEmailChangeInput) (dto.RequestEmailChangeOutput, error) {
+	ret := _m.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 dto.RequestEmailChangeOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.RequestEmailChangeInput) (dto.RequestEmailChangeOutput, error)); ok {
+		return rf(ctx, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.RequestEmailChangeInput) dto.RequestEmailChangeOutput); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Get(0).(dto.RequestEmailChangeOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.RequestEmailChangeInput) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewRequestEmailChangeUseCase creates a new instance of RequestEmailChangeUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRequestEmailChangeUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RequestEmailChangeUseCase {
+	mock := &RequestEmailChangeUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,57 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/maket12/ads-service/authservice/internal/app/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ResetPasswordUseCase is an autogenerated mock type for the ResetPasswordUseCase type
+type ResetPasswordUseCase struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, in
+func (_m *ResetPasswordUseCase) Execute(ctx context.Context, in dto.ResetPasswordInput) (dto.ResetPasswordOutput, error) {
+	ret := _m.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 dto.ResetPasswordOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ResetPasswordInput) (dto.ResetPasswordOutput, error)); ok {
+		return rf(ctx, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ResetPasswordInput) dto.ResetPasswordOutput); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Get(0).(dto.ResetPasswordOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.ResetPasswordInput) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewResetPasswordUseCase creates a new instance of ResetPasswordUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewResetPasswordUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ResetPasswordUseCase {
+	mock := &ResetPasswordUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
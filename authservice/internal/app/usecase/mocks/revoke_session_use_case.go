@@ -0,0 +1,57 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/maket12/ads-service/authservice/internal/app/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RevokeSessionUseCase is an autogenerated mock type for the RevokeSessionUseCase type
+type RevokeSessionUseCase struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, in
+func (_m *RevokeSessionUseCase) Execute(ctx context.Context, in dto.RevokeSessionInput) (dto.RevokeSessionOutput, error) {
+	ret := _m.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 dto.RevokeSessionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.RevokeSessionInput) (dto.RevokeSessionOutput, error)); ok {
+		return rf(ctx, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.RevokeSessionInput) dto.RevokeSessionOutput); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Get(0).(dto.RevokeSessionOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.RevokeSessionInput) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewRevokeSessionUseCase creates a new instance of RevokeSessionUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRevokeSessionUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RevokeSessionUseCase {
+	mock := &RevokeSessionUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,57 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/maket12/ads-service/authservice/internal/app/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ValidateAPIKeyUseCase is an autogenerated mock type for the ValidateAPIKeyUseCase type
+type ValidateAPIKeyUseCase struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, in
+func (_m *ValidateAPIKeyUseCase) Execute(ctx context.Context, in dto.ValidateAPIKeyInput) (dto.ValidateAPIKeyOutput, error) {
+	ret := _m.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 dto.ValidateAPIKeyOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ValidateAPIKeyInput) (dto.ValidateAPIKeyOutput, error)); ok {
+		return rf(ctx, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ValidateAPIKeyInput) dto.ValidateAPIKeyOutput); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Get(0).(dto.ValidateAPIKeyOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.ValidateAPIKeyInput) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewValidateAPIKeyUseCase creates a new instance of ValidateAPIKeyUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewValidateAPIKeyUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ValidateAPIKeyUseCase {
+	mock := &ValidateAPIKeyUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
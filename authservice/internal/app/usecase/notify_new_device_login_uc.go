@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+const newDeviceEmailSubject = "New sign-in to your account"
+
+type NotifyNewDeviceLoginUC struct {
+	account            port.AccountRepository
+	refreshSession     port.RefreshSessionRepository
+	sessionReportToken port.SessionReportTokenRepository
+	mailer             port.Mailer
+
+	reportTTL time.Duration
+	reportURL string
+}
+
+func NewNotifyNewDeviceLoginUC(
+	account port.AccountRepository,
+	refreshSession port.RefreshSessionRepository,
+	sessionReportToken port.SessionReportTokenRepository,
+	mailer port.Mailer,
+	reportTTL time.Duration,
+	reportURL string,
+) *NotifyNewDeviceLoginUC {
+	return &NotifyNewDeviceLoginUC{
+		account:            account,
+		refreshSession:     refreshSession,
+		sessionReportToken: sessionReportToken,
+		mailer:             mailer,
+		reportTTL:          reportTTL,
+		reportURL:          reportURL,
+	}
+}
+
+func (uc *NotifyNewDeviceLoginUC) Execute(ctx context.Context, in dto.NotifyNewDeviceLoginInput) (dto.NotifyNewDeviceLoginOutput, error) {
+	// Find account, a deleted or blocked one has nobody to warn
+	account, err := uc.account.GetByID(ctx, in.AccountID)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.NotifyNewDeviceLoginOutput{Sent: false}, nil
+		}
+		return dto.NotifyNewDeviceLoginOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetAccountByIDDB, err,
+		)
+	}
+	if !account.CanLogin() {
+		return dto.NotifyNewDeviceLoginOutput{Sent: false}, nil
+	}
+
+	// The session could be cleaned up before the event was handled
+	session, err := uc.refreshSession.GetByID(ctx, in.SessionID)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.NotifyNewDeviceLoginOutput{Sent: false}, nil
+		}
+		return dto.NotifyNewDeviceLoginOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetRefreshSessionByIDDB, err,
+		)
+	}
+	if session.AccountID() != account.ID() {
+		return dto.NotifyNewDeviceLoginOutput{Sent: false}, nil
+	}
+
+	// Generate one-time token, only its hash is stored
+	rawToken, err := utils.GenerateToken()
+	if err != nil {
+		return dto.NotifyNewDeviceLoginOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGenerateOneTimeToken, err,
+		)
+	}
+
+	reportToken, err := model.NewSessionReportToken(
+		account.ID(), session.ID(), utils.HashToken(rawToken), uc.reportTTL,
+	)
+	if err != nil {
+		return dto.NotifyNewDeviceLoginOutput{}, ucerrs.Wrap(
+			ucerrs.ErrInvalidInput, err,
+		)
+	}
+
+	if err := uc.sessionReportToken.Create(ctx, reportToken); err != nil {
+		return dto.NotifyNewDeviceLoginOutput{}, ucerrs.Wrap(
+			ucerrs.ErrCreateSessionReportTokenDB, err,
+		)
+	}
+
+	// Send the warning
+	var (
+		device = model.UserAgentInfo{Browser: in.Browser, OS: in.OS}.Family()
+		ip     = "unknown"
+		at     = in.LoggedInAt
+	)
+	if device == "" {
+		device = "Unknown device"
+	}
+	if in.IP != nil && *in.IP != "" {
+		ip = *in.IP
+	}
+	if at.IsZero() {
+		at = session.CreatedAt()
+	}
+
+	body := fmt.Sprintf(
+		"Your account was just signed in from a device we have not seen recently:\n\n"+
+			"Device: %s\nIP address: %s\nTime: %s\n\n"+
+			"If it was you, there is nothing to do. Otherwise sign that device out "+
+			"with the link below and change your password:\n\n%s\n\n"+
+			"The link expires in %s and works only once.",
+		device, ip, at.UTC().Format(time.RFC1123),
+		utils.BuildTokenLink(uc.reportURL, rawToken), uc.reportTTL,
+	)
+	if err := uc.mailer.Send(ctx, account.Email(), newDeviceEmailSubject, body); err != nil {
+		return dto.NotifyNewDeviceLoginOutput{}, ucerrs.Wrap(
+			ucerrs.ErrSendEmail, err,
+		)
+	}
+
+	// Output
+	return dto.NotifyNewDeviceLoginOutput{Sent: true}, nil
+}
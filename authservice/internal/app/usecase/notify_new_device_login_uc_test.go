@@ -0,0 +1,159 @@
+package usecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNotifyNewDeviceLoginUC_Execute(t *testing.T) {
+	type adapter struct {
+		account            *mocks.AccountRepository
+		refreshSession     *mocks.RefreshSessionRepository
+		sessionReportToken *mocks.SessionReportTokenRepository
+		mailer             *mocks.Mailer
+	}
+
+	type testCase struct {
+		name     string
+		prepare  func(a adapter)
+		wantErr  error
+		wantSent bool
+	}
+
+	account, _ := model.NewAccount("user@test.com", "hashed_db")
+	blockedAccount, _ := model.NewAccount("blocked@test.com", "hashed_db")
+	blockedAccount.Block()
+
+	ip := "203.0.113.7"
+	session, _ := model.NewRefreshSession(
+		uuid.New(), account.ID(), "hashed", nil, &ip, nil, time.Hour,
+	)
+	foreignSession, _ := model.NewRefreshSession(
+		uuid.New(), uuid.New(), "hashed", nil, nil, nil, time.Hour,
+	)
+
+	input := dto.NotifyNewDeviceLoginInput{
+		AccountID:  account.ID(),
+		SessionID:  session.ID(),
+		IP:         &ip,
+		Browser:    "Firefox",
+		OS:         "Linux",
+		LoggedInAt: time.Now(),
+	}
+
+	var tests = []testCase{
+		{
+			name: "Success",
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.refreshSession.On("GetByID", mock.Anything, session.ID()).Return(session, nil)
+				a.sessionReportToken.On("Create", mock.Anything, mock.MatchedBy(func(tk *model.SessionReportToken) bool {
+					return tk.AccountID() == account.ID() && tk.SessionID() == session.ID()
+				})).Return(nil)
+				a.mailer.On("Send", mock.Anything, "user@test.com", mock.Anything,
+					mock.MatchedBy(func(body string) bool {
+						return strings.Contains(body, "Firefox on Linux") &&
+							strings.Contains(body, ip) &&
+							strings.Contains(body, "https://ads.test/session/report?token=")
+					})).Return(nil)
+			},
+			wantSent: true,
+		},
+		{
+			name: "Skip - Account Not Found",
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+		},
+		{
+			name: "Skip - Account Blocked",
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(blockedAccount, nil)
+			},
+		},
+		{
+			name: "Skip - Session Cleaned Up",
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.refreshSession.On("GetByID", mock.Anything, session.ID()).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+		},
+		{
+			name: "Skip - Session Of Another Account",
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.refreshSession.On("GetByID", mock.Anything, session.ID()).Return(foreignSession, nil)
+			},
+		},
+		{
+			name: "Fail - Get Session DB Error",
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.refreshSession.On("GetByID", mock.Anything, session.ID()).Return(nil, assert.AnError)
+			},
+			wantErr: ucerrs.ErrGetRefreshSessionByIDDB,
+		},
+		{
+			name: "Fail - Create Token DB Error",
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.refreshSession.On("GetByID", mock.Anything, session.ID()).Return(session, nil)
+				a.sessionReportToken.On("Create", mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrCreateSessionReportTokenDB,
+		},
+		{
+			name: "Fail - Send Email Error",
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.refreshSession.On("GetByID", mock.Anything, session.ID()).Return(session, nil)
+				a.sessionReportToken.On("Create", mock.Anything, mock.Anything).Return(nil)
+				a.mailer.On("Send", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrSendEmail,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				account:            mocks.NewAccountRepository(t),
+				refreshSession:     mocks.NewRefreshSessionRepository(t),
+				sessionReportToken: mocks.NewSessionReportTokenRepository(t),
+				mailer:             mocks.NewMailer(t),
+			}
+			tt.prepare(a)
+
+			uc := usecase.NewNotifyNewDeviceLoginUC(
+				a.account, a.refreshSession, a.sessionReportToken, a.mailer,
+				time.Hour*24, "https://ads.test/session/report",
+			)
+
+			res, err := uc.Execute(context.Background(), input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, res.Sent)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSent, res.Sent)
+		})
+	}
+}
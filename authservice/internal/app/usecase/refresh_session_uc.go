@@ -19,21 +19,39 @@ type RefreshSessionUC struct {
 	accountRole    port.AccountRoleRepository
 	refreshSession port.RefreshSessionRepository
 	tokenGenerator port.TokenGenerator
+	authEvent      port.AuthEventRepository
+	txManager      port.TransactionManager
+	accountOutbox  port.AccountOutbox
+	denylist       port.AccessTokenDenylist
 
+	binding           *model.SessionBindingPolicy
 	refreshSessionTTL time.Duration
+	accessTTL         time.Duration
 }
 
 func NewRefreshSessionUC(
 	accountRole port.AccountRoleRepository,
 	refreshSession port.RefreshSessionRepository,
 	tokenGenerator port.TokenGenerator,
+	authEvent port.AuthEventRepository,
+	txManager port.TransactionManager,
+	accountOutbox port.AccountOutbox,
+	denylist port.AccessTokenDenylist,
+	binding *model.SessionBindingPolicy,
 	refreshSessionTTL time.Duration,
+	accessTTL time.Duration,
 ) *RefreshSessionUC {
 	return &RefreshSessionUC{
 		accountRole:       accountRole,
 		refreshSession:    refreshSession,
 		tokenGenerator:    tokenGenerator,
+		authEvent:         authEvent,
+		txManager:         txManager,
+		accountOutbox:     accountOutbox,
+		denylist:          denylist,
+		binding:           binding,
 		refreshSessionTTL: refreshSessionTTL,
+		accessTTL:         accessTTL,
 	}
 }
 
@@ -57,10 +75,14 @@ func (uc *RefreshSessionUC) Execute(ctx context.Context, in dto.RefreshSessionIn
 		)
 	}
 
+	// An already rotated token is presented again - it was stolen
+	if oldSession.IsRotated() &&
+		utils.HashToken(in.OldRefreshToken) == oldSession.RefreshTokenHash() {
+		return dto.RefreshSessionOutput{}, uc.revokeCompromisedChain(ctx, oldSession, in)
+	}
+
 	// Validate and revoke
-	if !oldSession.IsActive() ||
-		!utils.ComparePtr(oldSession.IP(), in.IP) ||
-		!utils.ComparePtr(oldSession.UserAgent(), in.UserAgent) {
+	if !oldSession.IsActive() {
 		return dto.RefreshSessionOutput{}, ucerrs.ErrInvalidRefreshToken
 	}
 
@@ -68,19 +90,13 @@ func (uc *RefreshSessionUC) Execute(ctx context.Context, in dto.RefreshSessionIn
 		return dto.RefreshSessionOutput{}, ucerrs.ErrInvalidRefreshToken
 	}
 
-	var reason = "token rotation"
+	var reason = model.RevokeReasonRotation
 	if err := oldSession.Revoke(&reason); err != nil {
 		return dto.RefreshSessionOutput{}, ucerrs.Wrap(
 			ucerrs.ErrCannotRevoke, err,
 		)
 	}
 
-	if err := uc.refreshSession.Revoke(ctx, oldSession); err != nil {
-		return dto.RefreshSessionOutput{}, ucerrs.Wrap(
-			ucerrs.ErrRevokeRefreshSessionDB, err,
-		)
-	}
-
 	// Get account role
 	accRole, err := uc.accountRole.Get(ctx, accountID)
 	if err != nil {
@@ -90,8 +106,9 @@ func (uc *RefreshSessionUC) Execute(ctx context.Context, in dto.RefreshSessionIn
 	}
 
 	// Generate new tokens
+	var sessionID = uuid.New()
 	accessToken, err := uc.tokenGenerator.GenerateAccessToken(
-		ctx, accountID, accRole.Role().String(),
+		ctx, model.NewAccessTokenClaims(accRole, sessionID),
 	)
 	if err != nil {
 		return dto.RefreshSessionOutput{}, ucerrs.Wrap(
@@ -99,7 +116,6 @@ func (uc *RefreshSessionUC) Execute(ctx context.Context, in dto.RefreshSessionIn
 		)
 	}
 
-	var sessionID = uuid.New()
 	refreshToken, err := uc.tokenGenerator.GenerateRefreshToken(
 		ctx, accountID, sessionID,
 	)
@@ -122,10 +138,40 @@ func (uc *RefreshSessionUC) Execute(ctx context.Context, in dto.RefreshSessionIn
 		)
 	}
 
-	if err := uc.refreshSession.Create(ctx, refreshSession); err != nil {
-		return dto.RefreshSessionOutput{}, ucerrs.Wrap(
-			ucerrs.ErrCreateRefreshSessionDB, err,
-		)
+	// The rotation, its audit and the anomaly event are saved together,
+	// the client gets the new tokens only if all of them are
+	if err := withinTx(ctx, uc.txManager, func(ctx context.Context) error {
+		if err := uc.refreshSession.Revoke(ctx, oldSession); err != nil {
+			if errors.Is(err, model.ErrTokenAlreadyRevoked) {
+				return ucerrs.ErrRefreshTokenReused
+			}
+			return ucerrs.Wrap(ucerrs.ErrRevokeRefreshSessionDB, err)
+		}
+
+		if err := uc.refreshSession.Create(ctx, refreshSession); err != nil {
+			return ucerrs.Wrap(ucerrs.ErrCreateRefreshSessionDB, err)
+		}
+
+		if err := recordAuthEvent(
+			ctx, uc.authEvent, accountID, model.AuthEventTokenRefresh, model.AuthEventSuccess,
+			&sessionID, in.IP, in.UserAgent,
+			map[string]string{"rotated_from": oldSessionID.String()},
+		); err != nil {
+			return err
+		}
+
+		// A client change does not end the session, the new one keeps
+		// the current IP and user agent and the change is reported
+		if !uc.binding.Matches(oldSession.IP(), oldSession.UserAgent(), in.IP, in.UserAgent) {
+			return uc.reportAnomaly(ctx, oldSession, sessionID, in)
+		}
+		return nil
+	}); err != nil {
+		// Another request has rotated the same token first
+		if errors.Is(err, ucerrs.ErrRefreshTokenReused) {
+			return dto.RefreshSessionOutput{}, uc.revokeCompromisedChain(ctx, oldSession, in)
+		}
+		return dto.RefreshSessionOutput{}, err
 	}
 
 	// Output
@@ -134,3 +180,76 @@ func (uc *RefreshSessionUC) Execute(ctx context.Context, in dto.RefreshSessionIn
 		RefreshToken: refreshToken,
 	}, nil
 }
+
+// Kills every session rotated from the reused one, so neither the thief
+// nor the victim can continue the chain, and lets the user know about it
+func (uc *RefreshSessionUC) revokeCompromisedChain(
+	ctx context.Context, session *model.RefreshSession, in dto.RefreshSessionInput,
+) error {
+	var sessionID = session.ID()
+	if err := withinTx(ctx, uc.txManager, func(ctx context.Context) error {
+		ended, err := revokeSessionChain(
+			ctx, uc.refreshSession, session.AccountID(), sessionID, "token reuse",
+		)
+		if err != nil {
+			return err
+		}
+
+		// Access tokens of the chain stop working right away
+		if err := denySessions(ctx, uc.denylist, ended, uuid.Nil, uc.accessTTL); err != nil {
+			return err
+		}
+
+		if err := recordAuthEvent(
+			ctx, uc.authEvent, session.AccountID(), model.AuthEventRefreshTokenReuse, model.AuthEventFailure,
+			&sessionID, in.IP, in.UserAgent, nil,
+		); err != nil {
+			return err
+		}
+
+		// Published by the outbox relay after commit (notify the owner)
+		if err := uc.accountOutbox.AddSessionCompromised(
+			ctx, session.AccountID(), sessionID, in.IP, in.UserAgent,
+		); err != nil {
+			return ucerrs.Wrap(ucerrs.ErrAddOutboxEventDB, err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return ucerrs.ErrRefreshTokenReused
+}
+
+// Records the client change and queues the event for the owner
+func (uc *RefreshSessionUC) reportAnomaly(
+	ctx context.Context, oldSession *model.RefreshSession,
+	sessionID uuid.UUID, in dto.RefreshSessionInput,
+) error {
+	var metadata = map[string]string{
+		"binding":      uc.binding.Mode().String(),
+		"rotated_from": oldSession.ID().String(),
+	}
+	if oldSession.IP() != nil {
+		metadata["previous_ip"] = *oldSession.IP()
+	}
+	if oldSession.UserAgent() != nil {
+		metadata["previous_user_agent"] = *oldSession.UserAgent()
+	}
+
+	if err := recordAuthEvent(
+		ctx, uc.authEvent, oldSession.AccountID(), model.AuthEventSessionAnomaly, model.AuthEventSuccess,
+		&sessionID, in.IP, in.UserAgent, metadata,
+	); err != nil {
+		return err
+	}
+
+	// Published by the outbox relay after commit (notify the owner)
+	if err := uc.accountOutbox.AddSessionAnomaly(
+		ctx, oldSession.AccountID(), sessionID,
+		oldSession.IP(), oldSession.UserAgent(), in.IP, in.UserAgent,
+	); err != nil {
+		return ucerrs.Wrap(ucerrs.ErrAddOutboxEventDB, err)
+	}
+	return nil
+}
@@ -23,6 +23,10 @@ func TestRefreshSessionUC_Execute(t *testing.T) {
 		accountRole    *mocks.AccountRoleRepository
 		refreshSession *mocks.RefreshSessionRepository
 		tokenGenerator *mocks.TokenGenerator
+		authEvent      *mocks.AuthEventRepository
+		txManager      *mocks.TransactionManager
+		accountOutbox  *mocks.AccountOutbox
+		denylist       *mocks.AccessTokenDenylist
 	}
 
 	type testCase struct {
@@ -41,13 +45,63 @@ func TestRefreshSessionUC_Execute(t *testing.T) {
 	ua := "Mozilla/5.0"
 	anotherUA := "HackerBrowser/1.0"
 	ttl := time.Hour * 24
+	accessTTL := time.Minute * 15
 
-	activeOldSession, _ := model.NewRefreshSession(
-		oldSessionID, accountID, hashedOldToken, nil, &ip, &ua, ttl,
-	)
+	activeOldSession := func() *model.RefreshSession {
+		s, _ := model.NewRefreshSession(
+			oldSessionID, accountID, hashedOldToken, nil, &ip, &ua, ttl,
+		)
+		return s
+	}
 
 	role, _ := model.NewAccountRole(accountID)
 
+	rotatedOldSession := func() *model.RefreshSession {
+		s := activeOldSession()
+		var reason = model.RevokeReasonRotation
+		_ = s.Revoke(&reason)
+		return s
+	}
+
+	// The old session is rotated into a new one
+	rotation := func(a adapter) {
+		expectTx(a.txManager)
+		a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, oldToken).
+			Return(accountID, oldSessionID, nil)
+		a.refreshSession.On("GetByID", mock.Anything, oldSessionID).
+			Return(activeOldSession(), nil)
+		a.refreshSession.On("Revoke", mock.Anything, mock.Anything).Return(nil)
+		a.accountRole.On("Get", mock.Anything, accountID).Return(role, nil)
+		a.tokenGenerator.On("GenerateAccessToken", mock.Anything, mock.Anything).
+			Return("new-access-token", nil)
+		a.tokenGenerator.On("GenerateRefreshToken", mock.Anything, accountID, mock.Anything).
+			Return("new-refresh-token", nil)
+		a.refreshSession.On("Create", mock.Anything, mock.Anything).Return(nil)
+		a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+			return e.EventType() == model.AuthEventTokenRefresh
+		})).Return(nil)
+	}
+
+	// The chain rotated from the old session is revoked, its only
+	// active session is the one the thief or the victim holds now
+	chainRevoke := func(a adapter) {
+		expectTx(a.txManager)
+		descendant, _ := model.NewRefreshSession(
+			uuid.New(), accountID, "hashed", &oldSessionID, &ip, &ua, ttl,
+		)
+		a.refreshSession.On("ListActiveForAccount", mock.Anything, accountID).
+			Return([]*model.RefreshSession{descendant}, nil).Once()
+		a.refreshSession.On("RevokeDescendants", mock.Anything, oldSessionID, mock.MatchedBy(func(r *string) bool {
+			return *r == "token reuse"
+		})).Return(nil)
+		a.refreshSession.On("ListActiveForAccount", mock.Anything, accountID).
+			Return([]*model.RefreshSession{}, nil).Once()
+		a.denylist.On("Add", mock.Anything, model.DenylistKeyForSession(descendant.ID()), mock.Anything).
+			Return(nil)
+	}
+
+	binding, _ := model.NewSessionBindingPolicy("strict")
+
 	var tests = []testCase{
 		{
 			name: "Success - Token Rotation",
@@ -57,11 +111,12 @@ func TestRefreshSessionUC_Execute(t *testing.T) {
 				UserAgent:       &ua,
 			},
 			prepare: func(a adapter) {
+				expectTx(a.txManager)
 				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, oldToken).
 					Return(accountID, oldSessionID, nil)
 
 				a.refreshSession.On("GetByID", mock.Anything, oldSessionID).
-					Return(activeOldSession, nil)
+					Return(activeOldSession(), nil)
 
 				a.refreshSession.On("Revoke", mock.Anything, mock.MatchedBy(func(s *model.RefreshSession) bool {
 					return !s.IsActive() && s.ID() == oldSessionID
@@ -69,7 +124,9 @@ func TestRefreshSessionUC_Execute(t *testing.T) {
 
 				a.accountRole.On("Get", mock.Anything, accountID).Return(role, nil)
 
-				a.tokenGenerator.On("GenerateAccessToken", mock.Anything, accountID, "user").
+				a.tokenGenerator.On("GenerateAccessToken", mock.Anything, mock.MatchedBy(func(c *model.AccessTokenClaims) bool {
+					return c.AccountID == accountID && c.Roles[0] == model.RoleUser
+				})).
 					Return("new-access-token", nil)
 				a.tokenGenerator.On("GenerateRefreshToken", mock.Anything, accountID, mock.Anything).
 					Return("new-refresh-token", nil)
@@ -77,38 +134,69 @@ func TestRefreshSessionUC_Execute(t *testing.T) {
 				a.refreshSession.On("Create", mock.Anything, mock.MatchedBy(func(s *model.RefreshSession) bool {
 					return s.RotatedFrom() != nil && *s.RotatedFrom() == oldSessionID
 				})).Return(nil)
+
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.EventType() == model.AuthEventTokenRefresh &&
+						e.Metadata()["rotated_from"] == oldSessionID.String()
+				})).Return(nil)
 			},
 			wantErr: nil,
 		},
 		{
-			name: "Fail - IP Mismatch",
+			name: "Success - IP Change Is Reported",
 			input: dto.RefreshSessionInput{
 				OldRefreshToken: oldToken,
 				IP:              &anotherIP,
 				UserAgent:       &ua,
 			},
 			prepare: func(a adapter) {
-				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, oldToken).
-					Return(accountID, oldSessionID, nil)
-				a.refreshSession.On("GetByID", mock.Anything, oldSessionID).
-					Return(activeOldSession, nil)
+				rotation(a)
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.EventType() == model.AuthEventSessionAnomaly &&
+						e.Metadata()["previous_ip"] == ip &&
+						e.Metadata()["binding"] == "strict" &&
+						*e.IP() == anotherIP
+				})).Return(nil)
+				a.accountOutbox.On("AddSessionAnomaly", mock.Anything,
+					accountID, mock.Anything, &ip, &ua, &anotherIP, &ua).Return(nil)
 			},
-			wantErr: ucerrs.ErrInvalidRefreshToken,
+			wantErr: nil,
 		},
 		{
-			name: "Fail - UserAgent Mismatch",
+			name: "Success - UserAgent Change Is Reported",
 			input: dto.RefreshSessionInput{
 				OldRefreshToken: oldToken,
 				IP:              &ip,
 				UserAgent:       &anotherUA,
 			},
 			prepare: func(a adapter) {
-				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, oldToken).
-					Return(accountID, oldSessionID, nil)
-				a.refreshSession.On("GetByID", mock.Anything, oldSessionID).
-					Return(activeOldSession, nil)
+				rotation(a)
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.EventType() == model.AuthEventSessionAnomaly &&
+						e.Metadata()["previous_user_agent"] == ua
+				})).Return(nil)
+				a.accountOutbox.On("AddSessionAnomaly", mock.Anything,
+					accountID, mock.Anything, &ip, &ua, &ip, &anotherUA).Return(nil)
 			},
-			wantErr: ucerrs.ErrInvalidRefreshToken,
+			wantErr: nil,
+		},
+		{
+			name: "Fail - Anomaly Outbox Error",
+			input: dto.RefreshSessionInput{
+				OldRefreshToken: oldToken,
+				IP:              &anotherIP,
+				UserAgent:       &ua,
+			},
+			prepare: func(a adapter) {
+				rotation(a)
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.EventType() == model.AuthEventSessionAnomaly
+				})).Return(nil)
+				a.accountOutbox.On("AddSessionAnomaly", mock.Anything,
+					mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+					mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrAddOutboxEventDB,
 		},
 		{
 			name: "Fail - Old Token Hash Mismatch",
@@ -121,7 +209,7 @@ func TestRefreshSessionUC_Execute(t *testing.T) {
 				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, "wrong-token-for-this-session").
 					Return(accountID, oldSessionID, nil)
 				a.refreshSession.On("GetByID", mock.Anything, oldSessionID).
-					Return(activeOldSession, nil)
+					Return(activeOldSession(), nil)
 			},
 			wantErr: ucerrs.ErrInvalidRefreshToken,
 		},
@@ -136,6 +224,99 @@ func TestRefreshSessionUC_Execute(t *testing.T) {
 			},
 			wantErr: ucerrs.ErrInvalidRefreshToken,
 		},
+		{
+			name:  "Fail - Reused Token Revokes The Chain",
+			input: dto.RefreshSessionInput{OldRefreshToken: oldToken, IP: &anotherIP, UserAgent: &anotherUA},
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, oldToken).
+					Return(accountID, oldSessionID, nil)
+				a.refreshSession.On("GetByID", mock.Anything, oldSessionID).
+					Return(rotatedOldSession(), nil)
+				chainRevoke(a)
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.EventType() == model.AuthEventRefreshTokenReuse &&
+						e.Outcome() == model.AuthEventFailure &&
+						e.AccountID() == accountID &&
+						*e.SessionID() == oldSessionID &&
+						*e.IP() == anotherIP
+				})).Return(nil)
+				a.accountOutbox.On("AddSessionCompromised", mock.Anything,
+					accountID, oldSessionID, &anotherIP, &anotherUA).Return(nil)
+			},
+			wantErr: ucerrs.ErrRefreshTokenReused,
+		},
+		{
+			name:  "Fail - Concurrent Rotation Is A Reuse",
+			input: dto.RefreshSessionInput{OldRefreshToken: oldToken, IP: &ip, UserAgent: &ua},
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, oldToken).
+					Return(accountID, oldSessionID, nil)
+				a.refreshSession.On("GetByID", mock.Anything, oldSessionID).
+					Return(activeOldSession(), nil)
+				a.accountRole.On("Get", mock.Anything, accountID).Return(role, nil)
+				a.tokenGenerator.On("GenerateAccessToken", mock.Anything, mock.Anything).
+					Return("new-access-token", nil)
+				a.tokenGenerator.On("GenerateRefreshToken", mock.Anything, accountID, mock.Anything).
+					Return("new-refresh-token", nil)
+				a.refreshSession.On("Revoke", mock.Anything, mock.Anything).
+					Return(model.ErrTokenAlreadyRevoked)
+				chainRevoke(a)
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.EventType() == model.AuthEventRefreshTokenReuse
+				})).Return(nil)
+				a.accountOutbox.On("AddSessionCompromised", mock.Anything,
+					accountID, oldSessionID, &ip, &ua).Return(nil)
+			},
+			wantErr: ucerrs.ErrRefreshTokenReused,
+		},
+		{
+			name:  "Fail - Reused Token, DB Error On Chain Revoke",
+			input: dto.RefreshSessionInput{OldRefreshToken: oldToken, IP: &ip, UserAgent: &ua},
+			prepare: func(a adapter) {
+				expectTx(a.txManager)
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, oldToken).
+					Return(accountID, oldSessionID, nil)
+				a.refreshSession.On("GetByID", mock.Anything, oldSessionID).
+					Return(rotatedOldSession(), nil)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, accountID).
+					Return([]*model.RefreshSession{}, nil)
+				a.refreshSession.On("RevokeDescendants", mock.Anything, oldSessionID, mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrRevokeRefreshSessionDB,
+		},
+		{
+			name:  "Fail - Reused Token, Outbox Error",
+			input: dto.RefreshSessionInput{OldRefreshToken: oldToken, IP: &ip, UserAgent: &ua},
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, oldToken).
+					Return(accountID, oldSessionID, nil)
+				a.refreshSession.On("GetByID", mock.Anything, oldSessionID).
+					Return(rotatedOldSession(), nil)
+				chainRevoke(a)
+				a.authEvent.On("Create", mock.Anything, mock.Anything).Return(nil)
+				a.accountOutbox.On("AddSessionCompromised", mock.Anything,
+					accountID, oldSessionID, mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrAddOutboxEventDB,
+		},
+		{
+			name:  "Fail - Logged Out Token Is Not A Reuse",
+			input: dto.RefreshSessionInput{OldRefreshToken: oldToken, IP: &ip, UserAgent: &ua},
+			prepare: func(a adapter) {
+				loggedOut, _ := model.NewRefreshSession(
+					oldSessionID, accountID, hashedOldToken, nil, &ip, &ua, ttl,
+				)
+				var reason = "logout"
+				_ = loggedOut.Revoke(&reason)
+
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, oldToken).
+					Return(accountID, oldSessionID, nil)
+				a.refreshSession.On("GetByID", mock.Anything, oldSessionID).
+					Return(loggedOut, nil)
+			},
+			wantErr: ucerrs.ErrInvalidRefreshToken,
+		},
 	}
 
 	for _, tt := range tests {
@@ -144,11 +325,19 @@ func TestRefreshSessionUC_Execute(t *testing.T) {
 				accountRole:    mocks.NewAccountRoleRepository(t),
 				refreshSession: mocks.NewRefreshSessionRepository(t),
 				tokenGenerator: mocks.NewTokenGenerator(t),
+				authEvent:      mocks.NewAuthEventRepository(t),
+				txManager:      mocks.NewTransactionManager(t),
+				accountOutbox:  mocks.NewAccountOutbox(t),
+				denylist:       mocks.NewAccessTokenDenylist(t),
 			}
 
 			tt.prepare(a)
 
-			uc := usecase.NewRefreshSessionUC(a.accountRole, a.refreshSession, a.tokenGenerator, ttl)
+			uc := usecase.NewRefreshSessionUC(
+				a.accountRole, a.refreshSession, a.tokenGenerator,
+				a.authEvent, a.txManager, a.accountOutbox, a.denylist,
+				binding, ttl, accessTTL,
+			)
 
 			res, err := uc.Execute(context.Background(), tt.input)
 
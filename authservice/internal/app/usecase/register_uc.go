@@ -2,33 +2,34 @@ package usecase
 
 import (
 	"context"
-	"errors"
 
 	"github.com/maket12/ads-service/authservice/internal/app/dto"
 	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
 	"github.com/maket12/ads-service/authservice/internal/domain/model"
 	"github.com/maket12/ads-service/authservice/internal/domain/port"
-	pkgerrs "github.com/maket12/ads-service/pkg/errs"
 )
 
 type RegisterUC struct {
-	account          port.AccountRepository
-	accountRole      port.AccountRoleRepository
-	passwordHasher   port.PasswordHasher
-	accountPublisher port.AccountPublisher
+	account        port.AccountRepository
+	accountRole    port.AccountRoleRepository
+	passwordHasher port.PasswordHasher
+	txManager      port.TransactionManager
+	accountOutbox  port.AccountOutbox
 }
 
 func NewRegisterUC(
 	account port.AccountRepository,
 	accountRole port.AccountRoleRepository,
 	passwordHasher port.PasswordHasher,
-	accountPublisher port.AccountPublisher,
+	txManager port.TransactionManager,
+	accountOutbox port.AccountOutbox,
 ) *RegisterUC {
 	return &RegisterUC{
-		account:          account,
-		accountRole:      accountRole,
-		passwordHasher:   passwordHasher,
-		accountPublisher: accountPublisher,
+		account:        account,
+		accountRole:    accountRole,
+		passwordHasher: passwordHasher,
+		txManager:      txManager,
+		accountOutbox:  accountOutbox,
 	}
 }
 
@@ -55,25 +56,12 @@ func (uc *RegisterUC) Execute(ctx context.Context, in dto.RegisterInput) (dto.Re
 		)
 	}
 
-	// Save all into database
-	if err := uc.account.Create(ctx, account); err != nil {
-		if errors.Is(err, pkgerrs.ErrObjectAlreadyExists) {
-			return dto.RegisterOutput{}, ucerrs.ErrAccountAlreadyExists
-		}
-		return dto.RegisterOutput{}, ucerrs.Wrap(
-			ucerrs.ErrCreateAccountDB, err,
-		)
-	}
-	if err := uc.accountRole.Create(ctx, accountRole); err != nil {
-		return dto.RegisterOutput{}, ucerrs.Wrap(
-			ucerrs.ErrCreateAccountRoleDB, err,
-		)
-	}
-
-	// Send even to rabbitmq (create profile)
-	if err := uc.accountPublisher.PublishAccountCreate(ctx, account.ID()); err != nil {
-		return dto.RegisterOutput{},
-			ucerrs.Wrap(ucerrs.ErrPublishEvent, err)
+	// Save all into database, the event goes along with the account
+	if err := createAccount(
+		ctx, uc.txManager, uc.account, uc.accountRole, uc.accountOutbox,
+		account, accountRole,
+	); err != nil {
+		return dto.RegisterOutput{}, err
 	}
 
 	// Response
@@ -17,10 +17,11 @@ import (
 
 func TestRegisterUC_Execute(t *testing.T) {
 	type adapter struct {
-		account          *mocks.AccountRepository
-		accountRole      *mocks.AccountRoleRepository
-		passwordHasher   *mocks.PasswordHasher
-		accountPublisher *mocks.AccountPublisher
+		account        *mocks.AccountRepository
+		accountRole    *mocks.AccountRoleRepository
+		passwordHasher *mocks.PasswordHasher
+		txManager      *mocks.TransactionManager
+		accountOutbox  *mocks.AccountOutbox
 	}
 
 	type testCase struct {
@@ -41,6 +42,7 @@ func TestRegisterUC_Execute(t *testing.T) {
 				a.passwordHasher.On("Hash", "securePassword123").
 					Return("hashed_password", nil)
 
+				expectTx(a.txManager)
 				a.account.On("Create", mock.Anything, mock.MatchedBy(func(acc interface{ Email() string }) bool {
 					return acc.Email() == "test@example.com"
 				})).Return(nil)
@@ -48,7 +50,7 @@ func TestRegisterUC_Execute(t *testing.T) {
 				a.accountRole.On("Create", mock.Anything, mock.Anything).
 					Return(nil)
 
-				a.accountPublisher.On("PublishAccountCreate", mock.Anything, mock.Anything).
+				a.accountOutbox.On("AddAccountCreated", mock.Anything, mock.Anything).
 					Return(nil)
 			},
 			wantErr: nil,
@@ -74,6 +76,7 @@ func TestRegisterUC_Execute(t *testing.T) {
 			prepare: func(a adapter) {
 				a.passwordHasher.On("Hash", "password").
 					Return("hashed", nil)
+				expectTx(a.txManager)
 				a.account.On("Create", mock.Anything, mock.Anything).
 					Return(errors.New("db error"))
 			},
@@ -88,6 +91,7 @@ func TestRegisterUC_Execute(t *testing.T) {
 			prepare: func(a adapter) {
 				a.passwordHasher.On("Hash", "password").
 					Return("hashed", nil)
+				expectTx(a.txManager)
 				a.account.On("Create", mock.Anything, mock.Anything).
 					Return(nil)
 				a.accountRole.On("Create", mock.Anything, mock.Anything).
@@ -96,7 +100,7 @@ func TestRegisterUC_Execute(t *testing.T) {
 			wantErr: ucerrs.ErrCreateAccountRoleDB,
 		},
 		{
-			name: "Error - create a rabbitmq event",
+			name: "Error - add outbox event",
 			input: dto.RegisterInput{
 				Email:    "test@example.com",
 				Password: "securePassword123",
@@ -105,6 +109,7 @@ func TestRegisterUC_Execute(t *testing.T) {
 				a.passwordHasher.On("Hash", "securePassword123").
 					Return("hashed_password", nil)
 
+				expectTx(a.txManager)
 				a.account.On("Create", mock.Anything, mock.MatchedBy(func(acc interface{ Email() string }) bool {
 					return acc.Email() == "test@example.com"
 				})).Return(nil)
@@ -112,27 +117,42 @@ func TestRegisterUC_Execute(t *testing.T) {
 				a.accountRole.On("Create", mock.Anything, mock.Anything).
 					Return(nil)
 
-				a.accountPublisher.On("PublishAccountCreate", mock.Anything, mock.Anything).
-					Return(errors.New("account publisher error"))
+				a.accountOutbox.On("AddAccountCreated", mock.Anything, mock.Anything).
+					Return(errors.New("outbox error"))
 			},
-			wantErr: ucerrs.ErrPublishEvent,
+			wantErr: ucerrs.ErrAddOutboxEventDB,
+		},
+		{
+			name: "Error - commit transaction",
+			input: dto.RegisterInput{
+				Email:    "test@example.com",
+				Password: "securePassword123",
+			},
+			prepare: func(a adapter) {
+				a.passwordHasher.On("Hash", "securePassword123").
+					Return("hashed_password", nil)
+				a.txManager.On("WithinTx", mock.Anything, mock.Anything).
+					Return(errors.New("commit error"))
+			},
+			wantErr: ucerrs.ErrTransactionDB,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			a := adapter{
-				account:          mocks.NewAccountRepository(t),
-				accountRole:      mocks.NewAccountRoleRepository(t),
-				passwordHasher:   mocks.NewPasswordHasher(t),
-				accountPublisher: mocks.NewAccountPublisher(t),
+				account:        mocks.NewAccountRepository(t),
+				accountRole:    mocks.NewAccountRoleRepository(t),
+				passwordHasher: mocks.NewPasswordHasher(t),
+				txManager:      mocks.NewTransactionManager(t),
+				accountOutbox:  mocks.NewAccountOutbox(t),
 			}
 
 			if tt.prepare != nil {
 				tt.prepare(a)
 			}
 
-			uc := usecase.NewRegisterUC(a.account, a.accountRole, a.passwordHasher, a.accountPublisher)
+			uc := usecase.NewRegisterUC(a.account, a.accountRole, a.passwordHasher, a.txManager, a.accountOutbox)
 
 			res, err := uc.Execute(context.Background(), tt.input)
 
@@ -147,3 +167,19 @@ func TestRegisterUC_Execute(t *testing.T) {
 		})
 	}
 }
+
+// expectTx makes the transaction manager mock run the callback in place
+func expectTx(m *mocks.TransactionManager) {
+	m.On("WithinTx", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+}
+
+// allowTx is expectTx for the cases that may stop before the transaction
+func allowTx(m *mocks.TransactionManager) {
+	m.On("WithinTx", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Maybe()
+}
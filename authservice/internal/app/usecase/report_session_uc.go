@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type ReportSessionUC struct {
+	refreshSession     port.RefreshSessionRepository
+	sessionReportToken port.SessionReportTokenRepository
+	authEvent          port.AuthEventRepository
+	denylist           port.AccessTokenDenylist
+	accessTTL          time.Duration
+}
+
+func NewReportSessionUC(
+	refreshSession port.RefreshSessionRepository,
+	sessionReportToken port.SessionReportTokenRepository,
+	authEvent port.AuthEventRepository,
+	denylist port.AccessTokenDenylist,
+	accessTTL time.Duration,
+) *ReportSessionUC {
+	return &ReportSessionUC{
+		refreshSession:     refreshSession,
+		sessionReportToken: sessionReportToken,
+		authEvent:          authEvent,
+		denylist:           denylist,
+		accessTTL:          accessTTL,
+	}
+}
+
+func (uc *ReportSessionUC) Execute(ctx context.Context, in dto.ReportSessionInput) (dto.ReportSessionOutput, error) {
+	// Find token
+	token, err := uc.sessionReportToken.GetByHash(ctx, utils.HashToken(in.Token))
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.ReportSessionOutput{}, ucerrs.ErrInvalidSessionReport
+		}
+		return dto.ReportSessionOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetSessionReportTokenDB, err,
+		)
+	}
+
+	if !token.IsActive() {
+		return dto.ReportSessionOutput{}, ucerrs.ErrInvalidSessionReport
+	}
+
+	// Consume token
+	if err := token.Use(); err != nil {
+		return dto.ReportSessionOutput{}, ucerrs.ErrInvalidSessionReport
+	}
+	if err := uc.sessionReportToken.MarkUsed(ctx, token); err != nil {
+		if errors.Is(err, model.ErrTokenAlreadyUsed) {
+			return dto.ReportSessionOutput{}, ucerrs.ErrInvalidSessionReport
+		}
+		return dto.ReportSessionOutput{}, ucerrs.Wrap(
+			ucerrs.ErrUseSessionReportTokenDB, err,
+		)
+	}
+
+	// The reported session has probably been refreshed since the letter,
+	// so the whole chain rotated from it is ended
+	ended, err := revokeSessionChain(
+		ctx, uc.refreshSession, token.AccountID(), token.SessionID(), "reported by owner",
+	)
+	if err != nil {
+		return dto.ReportSessionOutput{}, err
+	}
+
+	// Access tokens of the chain stop working right away
+	if err := denySessions(
+		ctx, uc.denylist, ended, uuid.Nil, uc.accessTTL,
+	); err != nil {
+		return dto.ReportSessionOutput{}, err
+	}
+
+	var sessionID = token.SessionID()
+	if err := recordAuthEvent(
+		ctx, uc.authEvent, token.AccountID(), model.AuthEventSessionReported, model.AuthEventSuccess,
+		&sessionID, in.IP, in.UserAgent, map[string]string{"ended_sessions": strconv.Itoa(len(ended))},
+	); err != nil {
+		return dto.ReportSessionOutput{}, err
+	}
+
+	// Output
+	return dto.ReportSessionOutput{Revoked: true}, nil
+}
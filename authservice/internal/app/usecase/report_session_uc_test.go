@@ -0,0 +1,152 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReportSessionUC_Execute(t *testing.T) {
+	type adapter struct {
+		refreshSession     *mocks.RefreshSessionRepository
+		sessionReportToken *mocks.SessionReportTokenRepository
+		authEvent          *mocks.AuthEventRepository
+		denylist           *mocks.AccessTokenDenylist
+	}
+
+	type testCase struct {
+		name    string
+		prepare func(a adapter)
+		wantErr error
+	}
+
+	rawToken := "raw-report-token"
+	hashedToken := utils.HashToken(rawToken)
+
+	accountID := uuid.New()
+	reported := uuid.New()
+	newSession := func() *model.RefreshSession {
+		s, _ := model.NewRefreshSession(uuid.New(), accountID, "hashed", nil, nil, nil, time.Hour)
+		return s
+	}
+	// The reported session was refreshed once, the owner has another device
+	rotated, other := newSession(), newSession()
+
+	newToken := func() *model.SessionReportToken {
+		tk, _ := model.NewSessionReportToken(accountID, reported, hashedToken, time.Hour)
+		return tk
+	}
+	usedToken := func() *model.SessionReportToken {
+		tk := newToken()
+		_ = tk.Use()
+		return tk
+	}
+	consumed := func(a adapter) {
+		a.sessionReportToken.On("GetByHash", mock.Anything, hashedToken).Return(newToken(), nil)
+		a.sessionReportToken.On("MarkUsed", mock.Anything, mock.MatchedBy(func(tk *model.SessionReportToken) bool {
+			return tk.IsUsed()
+		})).Return(nil)
+	}
+
+	var tests = []testCase{
+		{
+			name: "Success - Chain Is Ended",
+			prepare: func(a adapter) {
+				consumed(a)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, accountID).
+					Return([]*model.RefreshSession{rotated, other}, nil).Once()
+				a.refreshSession.On("RevokeDescendants", mock.Anything, reported, mock.MatchedBy(func(r *string) bool {
+					return r != nil && *r == "reported by owner"
+				})).Return(nil)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, accountID).
+					Return([]*model.RefreshSession{other}, nil).Once()
+				a.denylist.On("Add", mock.Anything, model.DenylistKeyForSession(rotated.ID()), mock.Anything).
+					Return(nil).Once()
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.EventType() == model.AuthEventSessionReported &&
+						e.Metadata()["ended_sessions"] == "1"
+				})).Return(nil)
+			},
+		},
+		{
+			name: "Fail - Unknown Token",
+			prepare: func(a adapter) {
+				a.sessionReportToken.On("GetByHash", mock.Anything, hashedToken).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+			wantErr: ucerrs.ErrInvalidSessionReport,
+		},
+		{
+			name: "Fail - Token Already Used",
+			prepare: func(a adapter) {
+				a.sessionReportToken.On("GetByHash", mock.Anything, hashedToken).Return(usedToken(), nil)
+			},
+			wantErr: ucerrs.ErrInvalidSessionReport,
+		},
+		{
+			name: "Fail - Concurrent Use",
+			prepare: func(a adapter) {
+				a.sessionReportToken.On("GetByHash", mock.Anything, hashedToken).Return(newToken(), nil)
+				a.sessionReportToken.On("MarkUsed", mock.Anything, mock.Anything).
+					Return(model.ErrTokenAlreadyUsed)
+			},
+			wantErr: ucerrs.ErrInvalidSessionReport,
+		},
+		{
+			name: "Fail - Get Token DB Error",
+			prepare: func(a adapter) {
+				a.sessionReportToken.On("GetByHash", mock.Anything, hashedToken).Return(nil, assert.AnError)
+			},
+			wantErr: ucerrs.ErrGetSessionReportTokenDB,
+		},
+		{
+			name: "Fail - Revoke DB Error",
+			prepare: func(a adapter) {
+				consumed(a)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, accountID).
+					Return([]*model.RefreshSession{rotated}, nil)
+				a.refreshSession.On("RevokeDescendants", mock.Anything, reported, mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrRevokeRefreshSessionDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				refreshSession:     mocks.NewRefreshSessionRepository(t),
+				sessionReportToken: mocks.NewSessionReportTokenRepository(t),
+				authEvent:          mocks.NewAuthEventRepository(t),
+				denylist:           mocks.NewAccessTokenDenylist(t),
+			}
+			tt.prepare(a)
+
+			uc := usecase.NewReportSessionUC(
+				a.refreshSession, a.sessionReportToken, a.authEvent, a.denylist, time.Minute*15,
+			)
+
+			res, err := uc.Execute(context.Background(), dto.ReportSessionInput{Token: rawToken})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, res.Revoked)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, res.Revoked)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+const emailChangeEmailSubject = "Confirm your new email"
+
+type RequestEmailChangeUC struct {
+	account          port.AccountRepository
+	emailChangeToken port.EmailChangeTokenRepository
+	passwordHasher   port.PasswordHasher
+	mailer           port.Mailer
+
+	emailChangeTokenTTL time.Duration
+	emailChangeURL      string
+}
+
+func NewRequestEmailChangeUC(
+	account port.AccountRepository,
+	emailChangeToken port.EmailChangeTokenRepository,
+	passwordHasher port.PasswordHasher,
+	mailer port.Mailer,
+	emailChangeTokenTTL time.Duration,
+	emailChangeURL string,
+) *RequestEmailChangeUC {
+	return &RequestEmailChangeUC{
+		account:             account,
+		emailChangeToken:    emailChangeToken,
+		passwordHasher:      passwordHasher,
+		mailer:              mailer,
+		emailChangeTokenTTL: emailChangeTokenTTL,
+		emailChangeURL:      emailChangeURL,
+	}
+}
+
+func (uc *RequestEmailChangeUC) Execute(ctx context.Context, in dto.RequestEmailChangeInput) (dto.RequestEmailChangeOutput, error) {
+	// Find account
+	account, err := uc.account.GetByID(ctx, in.AccountID)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.RequestEmailChangeOutput{}, ucerrs.ErrInvalidAccountID
+		}
+		return dto.RequestEmailChangeOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetAccountByIDDB, err,
+		)
+	}
+
+	if !account.CanLogin() {
+		return dto.RequestEmailChangeOutput{}, ucerrs.ErrCannotLogin
+	}
+
+	// A stolen access token alone is not enough to take over the account
+	if !uc.passwordHasher.Compare(account.PasswordHash(), in.Password) {
+		return dto.RequestEmailChangeOutput{}, ucerrs.ErrWrongPassword
+	}
+
+	newEmail := strings.TrimSpace(in.NewEmail)
+	if strings.EqualFold(newEmail, account.Email()) {
+		return dto.RequestEmailChangeOutput{}, ucerrs.ErrSameEmail
+	}
+
+	// The address is checked once more on confirmation
+	if _, err := uc.account.GetByEmail(ctx, newEmail); err == nil {
+		return dto.RequestEmailChangeOutput{}, ucerrs.ErrAccountAlreadyExists
+	} else if !errors.Is(err, pkgerrs.ErrObjectNotFound) {
+		return dto.RequestEmailChangeOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetAccountByEmailDB, err,
+		)
+	}
+
+	// Generate one-time token, only its hash is stored
+	rawToken, err := utils.GenerateToken()
+	if err != nil {
+		return dto.RequestEmailChangeOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGenerateOneTimeToken, err,
+		)
+	}
+
+	changeToken, err := model.NewEmailChangeToken(
+		account.ID(), newEmail, utils.HashToken(rawToken), uc.emailChangeTokenTTL,
+	)
+	if err != nil {
+		return dto.RequestEmailChangeOutput{}, ucerrs.Wrap(
+			ucerrs.ErrInvalidInput, err,
+		)
+	}
+
+	if err := uc.emailChangeToken.Create(ctx, changeToken); err != nil {
+		return dto.RequestEmailChangeOutput{}, ucerrs.Wrap(
+			ucerrs.ErrCreateEmailChangeTokenDB, err,
+		)
+	}
+
+	// The link goes to the new address, this proves it belongs to the user
+	body := fmt.Sprintf(
+		"Somebody asked to use this address for an account. "+
+			"If it was you, follow the link to confirm:\n\n%s\n\n"+
+			"The link expires in %s. Otherwise just ignore this letter.",
+		utils.BuildTokenLink(uc.emailChangeURL, rawToken), uc.emailChangeTokenTTL,
+	)
+	if err := uc.mailer.Send(ctx, newEmail, emailChangeEmailSubject, body); err != nil {
+		return dto.RequestEmailChangeOutput{}, ucerrs.Wrap(
+			ucerrs.ErrSendEmail, err,
+		)
+	}
+
+	// Output
+	return dto.RequestEmailChangeOutput{Sent: true}, nil
+}
@@ -0,0 +1,167 @@
+package usecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRequestEmailChangeUC_Execute(t *testing.T) {
+	type adapter struct {
+		account          *mocks.AccountRepository
+		emailChangeToken *mocks.EmailChangeTokenRepository
+		passwordHasher   *mocks.PasswordHasher
+		mailer           *mocks.Mailer
+	}
+
+	type testCase struct {
+		name    string
+		input   dto.RequestEmailChangeInput
+		prepare func(a adapter)
+		wantErr error
+	}
+
+	account, _ := model.NewAccount("user@test.com", "hashed_db")
+
+	blockedAccount, _ := model.NewAccount("blocked@test.com", "hashed_db")
+	blockedAccount.Block()
+
+	other, _ := model.NewAccount("taken@test.com", "hashed_db")
+
+	newEmail := "new@test.com"
+	input := dto.RequestEmailChangeInput{
+		AccountID: account.ID(),
+		Password:  "password123",
+		NewEmail:  newEmail,
+	}
+
+	var tests = []testCase{
+		{
+			name:  "Success",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.passwordHasher.On("Compare", "hashed_db", "password123").Return(true)
+				a.account.On("GetByEmail", mock.Anything, newEmail).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+				a.emailChangeToken.On("Create", mock.Anything, mock.MatchedBy(func(tk *model.EmailChangeToken) bool {
+					return tk.AccountID() == account.ID() && tk.NewEmail() == newEmail && tk.IsActive()
+				})).Return(nil)
+				a.mailer.On("Send", mock.Anything, newEmail, mock.Anything, mock.MatchedBy(func(body string) bool {
+					return strings.Contains(body, "https://ads.test/confirm-email-change?token=")
+				})).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Fail - Account Not Found",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+			wantErr: ucerrs.ErrInvalidAccountID,
+		},
+		{
+			name:  "Fail - Blocked Account",
+			input: dto.RequestEmailChangeInput{AccountID: blockedAccount.ID(), Password: "password123", NewEmail: newEmail},
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, blockedAccount.ID()).Return(blockedAccount, nil)
+			},
+			wantErr: ucerrs.ErrCannotLogin,
+		},
+		{
+			name:  "Fail - Wrong Password",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.passwordHasher.On("Compare", "hashed_db", "password123").Return(false)
+			},
+			wantErr: ucerrs.ErrWrongPassword,
+		},
+		{
+			name:  "Fail - Same Email",
+			input: dto.RequestEmailChangeInput{AccountID: account.ID(), Password: "password123", NewEmail: " USER@test.com "},
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.passwordHasher.On("Compare", "hashed_db", "password123").Return(true)
+			},
+			wantErr: ucerrs.ErrSameEmail,
+		},
+		{
+			name:  "Fail - Email Taken",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.passwordHasher.On("Compare", "hashed_db", "password123").Return(true)
+				a.account.On("GetByEmail", mock.Anything, newEmail).Return(other, nil)
+			},
+			wantErr: ucerrs.ErrAccountAlreadyExists,
+		},
+		{
+			name:  "Fail - DB Error On Token Create",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.passwordHasher.On("Compare", "hashed_db", "password123").Return(true)
+				a.account.On("GetByEmail", mock.Anything, newEmail).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+				a.emailChangeToken.On("Create", mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrCreateEmailChangeTokenDB,
+		},
+		{
+			name:  "Fail - Mailer Error",
+			input: input,
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.passwordHasher.On("Compare", "hashed_db", "password123").Return(true)
+				a.account.On("GetByEmail", mock.Anything, newEmail).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+				a.emailChangeToken.On("Create", mock.Anything, mock.Anything).Return(nil)
+				a.mailer.On("Send", mock.Anything, newEmail, mock.Anything, mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrSendEmail,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				account:          mocks.NewAccountRepository(t),
+				emailChangeToken: mocks.NewEmailChangeTokenRepository(t),
+				passwordHasher:   mocks.NewPasswordHasher(t),
+				mailer:           mocks.NewMailer(t),
+			}
+
+			tt.prepare(a)
+
+			uc := usecase.NewRequestEmailChangeUC(
+				a.account, a.emailChangeToken, a.passwordHasher, a.mailer,
+				time.Hour, "https://ads.test/confirm-email-change",
+			)
+
+			res, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, res.Sent)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, res.Sent)
+			}
+		})
+	}
+}
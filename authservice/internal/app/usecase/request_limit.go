@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+)
+
+// limitedKey is a limiting key with the limit it is counted against
+type limitedKey struct {
+	key   string
+	limit *model.RequestLimit
+}
+
+// Rejects the request with tooMany while any of the keys is over its limit,
+// otherwise counts it against every key. Nothing is counted for
+// a rejected request, so retries do not extend the wait
+func limitRequest(
+	ctx context.Context,
+	counter port.RequestCounter,
+	tooMany error,
+	now time.Time,
+	keys ...limitedKey,
+) error {
+	var retryAfter time.Duration
+	for _, k := range keys {
+		count, first, last, err := counter.Count(ctx, k.key, now.Add(-k.limit.Window()))
+		if err != nil {
+			return ucerrs.Wrap(ucerrs.ErrCountRequestsDB, err)
+		}
+		retryAfter = max(retryAfter, k.limit.RetryAfter(count, first, last, now))
+	}
+	if retryAfter > 0 {
+		return ucerrs.RetryAfter(tooMany, retryAfter)
+	}
+
+	for _, k := range keys {
+		if err := counter.Add(ctx, k.key, now, k.limit.Window()); err != nil {
+			return ucerrs.Wrap(ucerrs.ErrAddRequestDB, err)
+		}
+	}
+	return nil
+}
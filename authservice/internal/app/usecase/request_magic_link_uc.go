@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+const magicLinkEmailSubject = "Your sign-in link"
+
+type RequestMagicLinkUC struct {
+	account        port.AccountRepository
+	magicLinkToken port.MagicLinkTokenRepository
+	requests       port.RequestCounter
+	mailer         port.Mailer
+
+	limit        *model.RequestLimit
+	magicLinkTTL time.Duration
+	magicLinkURL string
+}
+
+func NewRequestMagicLinkUC(
+	account port.AccountRepository,
+	magicLinkToken port.MagicLinkTokenRepository,
+	requests port.RequestCounter,
+	mailer port.Mailer,
+	limit *model.RequestLimit,
+	magicLinkTTL time.Duration,
+	magicLinkURL string,
+) *RequestMagicLinkUC {
+	return &RequestMagicLinkUC{
+		account:        account,
+		magicLinkToken: magicLinkToken,
+		requests:       requests,
+		mailer:         mailer,
+		limit:          limit,
+		magicLinkTTL:   magicLinkTTL,
+		magicLinkURL:   magicLinkURL,
+	}
+}
+
+func (uc *RequestMagicLinkUC) Execute(ctx context.Context, in dto.RequestMagicLinkInput) (dto.RequestMagicLinkOutput, error) {
+	// Every request counts against the address, whether it is registered
+	// or not, so the limit can't be used to probe emails either
+	if err := limitRequest(
+		ctx, uc.requests, ucerrs.ErrTooManyMagicLinks, time.Now(),
+		limitedKey{key: model.MagicLinkKeyForEmail(in.Email), limit: uc.limit},
+	); err != nil {
+		return dto.RequestMagicLinkOutput{}, err
+	}
+
+	// The answer is the same whether the account exists or not
+	account, err := uc.account.GetByEmail(ctx, in.Email)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.RequestMagicLinkOutput{Sent: true}, nil
+		}
+		return dto.RequestMagicLinkOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetAccountByEmailDB, err,
+		)
+	}
+	if !account.CanLogin() {
+		return dto.RequestMagicLinkOutput{Sent: true}, nil
+	}
+
+	// Generate one-time token, only its hash is stored
+	rawToken, err := utils.GenerateToken()
+	if err != nil {
+		return dto.RequestMagicLinkOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGenerateOneTimeToken, err,
+		)
+	}
+
+	magicLinkToken, err := model.NewMagicLinkToken(
+		account.ID(), utils.HashToken(rawToken), uc.magicLinkTTL,
+	)
+	if err != nil {
+		return dto.RequestMagicLinkOutput{}, ucerrs.Wrap(
+			ucerrs.ErrInvalidInput, err,
+		)
+	}
+
+	if err := uc.magicLinkToken.Create(ctx, magicLinkToken); err != nil {
+		return dto.RequestMagicLinkOutput{}, ucerrs.Wrap(
+			ucerrs.ErrCreateMagicLinkTokenDB, err,
+		)
+	}
+
+	// Send the link
+	body := fmt.Sprintf(
+		"Somebody asked to sign in to your account with this address. "+
+			"If it was you, follow the link:\n\n%s\n\n"+
+			"The link expires in %s and works only once. Otherwise just ignore this letter.",
+		utils.BuildTokenLink(uc.magicLinkURL, rawToken), uc.magicLinkTTL,
+	)
+	if err := uc.mailer.Send(ctx, account.Email(), magicLinkEmailSubject, body); err != nil {
+		return dto.RequestMagicLinkOutput{}, ucerrs.Wrap(
+			ucerrs.ErrSendEmail, err,
+		)
+	}
+
+	// Output
+	return dto.RequestMagicLinkOutput{Sent: true}, nil
+}
@@ -0,0 +1,156 @@
+package usecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRequestMagicLinkUC_Execute(t *testing.T) {
+	type adapter struct {
+		account        *mocks.AccountRepository
+		magicLinkToken *mocks.MagicLinkTokenRepository
+		requests       *mocks.RequestCounter
+		mailer         *mocks.Mailer
+	}
+
+	type testCase struct {
+		name    string
+		input   dto.RequestMagicLinkInput
+		prepare func(a adapter)
+		wantErr error
+	}
+
+	email := "user@test.com"
+	emailKey := model.MagicLinkKeyForEmail(email)
+	account, _ := model.NewAccount(email, "hashed_db")
+
+	blockedAccount, _ := model.NewAccount(email, "hashed_db")
+	blockedAccount.Block()
+
+	limit, _ := model.NewRequestLimit(time.Hour, 3, 5, time.Minute)
+
+	// The address is under the limit and the request is counted
+	allowed := func(a adapter, key string) {
+		a.requests.On("Count", mock.Anything, key, mock.Anything).
+			Return(1, time.Now(), time.Now(), nil)
+		a.requests.On("Add", mock.Anything, key, mock.Anything, time.Hour).
+			Return(nil)
+	}
+
+	var tests = []testCase{
+		{
+			name:  "Success",
+			input: dto.RequestMagicLinkInput{Email: email},
+			prepare: func(a adapter) {
+				allowed(a, emailKey)
+				a.account.On("GetByEmail", mock.Anything, email).Return(account, nil)
+				a.magicLinkToken.On("Create", mock.Anything, mock.MatchedBy(func(tk *model.MagicLinkToken) bool {
+					return tk.AccountID() == account.ID() && tk.IsActive()
+				})).Return(nil)
+				a.mailer.On("Send", mock.Anything, email, mock.Anything, mock.MatchedBy(func(body string) bool {
+					return strings.Contains(body, "https://ads.test/magic-link?token=")
+				})).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Success - Unknown Email Looks The Same",
+			input: dto.RequestMagicLinkInput{Email: "ghost@test.com"},
+			prepare: func(a adapter) {
+				allowed(a, model.MagicLinkKeyForEmail("ghost@test.com"))
+				a.account.On("GetByEmail", mock.Anything, "ghost@test.com").
+					Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Success - Blocked Account Looks The Same",
+			input: dto.RequestMagicLinkInput{Email: email},
+			prepare: func(a adapter) {
+				allowed(a, emailKey)
+				a.account.On("GetByEmail", mock.Anything, email).Return(blockedAccount, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Fail - Too Many Requests",
+			input: dto.RequestMagicLinkInput{Email: email},
+			prepare: func(a adapter) {
+				a.requests.On("Count", mock.Anything, emailKey, mock.Anything).
+					Return(5, time.Now().Add(-time.Minute), time.Now(), nil)
+			},
+			wantErr: ucerrs.ErrTooManyMagicLinks,
+		},
+		{
+			name:  "Fail - Get Requests Error",
+			input: dto.RequestMagicLinkInput{Email: email},
+			prepare: func(a adapter) {
+				a.requests.On("Count", mock.Anything, emailKey, mock.Anything).
+					Return(0, time.Time{}, time.Time{}, assert.AnError)
+			},
+			wantErr: ucerrs.ErrCountRequestsDB,
+		},
+		{
+			name:  "Fail - DB Error On Token Create",
+			input: dto.RequestMagicLinkInput{Email: email},
+			prepare: func(a adapter) {
+				allowed(a, emailKey)
+				a.account.On("GetByEmail", mock.Anything, email).Return(account, nil)
+				a.magicLinkToken.On("Create", mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrCreateMagicLinkTokenDB,
+		},
+		{
+			name:  "Fail - Mailer Error",
+			input: dto.RequestMagicLinkInput{Email: email},
+			prepare: func(a adapter) {
+				allowed(a, emailKey)
+				a.account.On("GetByEmail", mock.Anything, email).Return(account, nil)
+				a.magicLinkToken.On("Create", mock.Anything, mock.Anything).Return(nil)
+				a.mailer.On("Send", mock.Anything, email, mock.Anything, mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrSendEmail,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				account:        mocks.NewAccountRepository(t),
+				magicLinkToken: mocks.NewMagicLinkTokenRepository(t),
+				requests:       mocks.NewRequestCounter(t),
+				mailer:         mocks.NewMailer(t),
+			}
+
+			tt.prepare(a)
+
+			uc := usecase.NewRequestMagicLinkUC(
+				a.account, a.magicLinkToken, a.requests, a.mailer,
+				limit, 15*time.Minute, "https://ads.test/magic-link",
+			)
+
+			res, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, res.Sent)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, res.Sent)
+			}
+		})
+	}
+}
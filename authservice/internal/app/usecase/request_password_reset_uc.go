@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+const (
+	passwordResetEmailSubject = "Reset your password"
+	passwordResetMailTimeout  = time.Second * 30
+)
+
+type RequestPasswordResetUC struct {
+	log        *slog.Logger
+	account    port.AccountRepository
+	resetToken port.PasswordResetTokenRepository
+	requests   port.RequestCounter
+	mailer     port.Mailer
+
+	emailLimit    *model.RequestLimit
+	ipLimit       *model.RequestLimit
+	resetTokenTTL time.Duration
+	resetURL      string
+}
+
+func NewRequestPasswordResetUC(
+	log *slog.Logger,
+	account port.AccountRepository,
+	resetToken port.PasswordResetTokenRepository,
+	requests port.RequestCounter,
+	mailer port.Mailer,
+	emailLimit, ipLimit *model.RequestLimit,
+	resetTokenTTL time.Duration,
+	resetURL string,
+) *RequestPasswordResetUC {
+	return &RequestPasswordResetUC{
+		log:           log,
+		account:       account,
+		resetToken:    resetToken,
+		requests:      requests,
+		mailer:        mailer,
+		emailLimit:    emailLimit,
+		ipLimit:       ipLimit,
+		resetTokenTTL: resetTokenTTL,
+		resetURL:      resetURL,
+	}
+}
+
+func (uc *RequestPasswordResetUC) Execute(ctx context.Context, in dto.RequestPasswordResetInput) (dto.RequestPasswordResetOutput, error) {
+	// Every request counts against the address and the client IP, whether
+	// the address is registered or not, so the limit can't probe emails either
+	keys := []limitedKey{{key: model.ResetKeyForEmail(in.Email), limit: uc.emailLimit}}
+	if in.IP != nil && *in.IP != "" {
+		keys = append(keys, limitedKey{key: model.ResetKeyForIP(*in.IP), limit: uc.ipLimit})
+	}
+	if err := limitRequest(
+		ctx, uc.requests, ucerrs.ErrTooManyResetRequests, time.Now(), keys...,
+	); err != nil {
+		return dto.RequestPasswordResetOutput{}, err
+	}
+
+	// The answer is the same whether the account exists or not,
+	// so nobody can probe registered emails through this method
+	account, err := uc.account.GetByEmail(ctx, in.Email)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.RequestPasswordResetOutput{Sent: true}, nil
+		}
+		return dto.RequestPasswordResetOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetAccountByEmailDB, err,
+		)
+	}
+	if !account.CanLogin() {
+		return dto.RequestPasswordResetOutput{Sent: true}, nil
+	}
+
+	// Generate one-time token, only its hash is stored
+	rawToken, err := utils.GenerateToken()
+	if err != nil {
+		return dto.RequestPasswordResetOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGenerateOneTimeToken, err,
+		)
+	}
+
+	resetToken, err := model.NewPasswordResetToken(
+		account.ID(), utils.HashToken(rawToken), uc.resetTokenTTL,
+	)
+	if err != nil {
+		return dto.RequestPasswordResetOutput{}, ucerrs.Wrap(
+			ucerrs.ErrInvalidInput, err,
+		)
+	}
+
+	if err := uc.resetToken.Create(ctx, resetToken); err != nil {
+		return dto.RequestPasswordResetOutput{}, ucerrs.Wrap(
+			ucerrs.ErrCreateResetTokenDB, err,
+		)
+	}
+
+	// The letter goes out off the request path, so an existing account
+	// does not answer slower than an unknown email
+	go uc.sendResetLink(context.WithoutCancel(ctx), account, rawToken)
+
+	// Output
+	return dto.RequestPasswordResetOutput{Sent: true}, nil
+}
+
+// A failed delivery is only logged, an error would tell the account exists
+func (uc *RequestPasswordResetUC) sendResetLink(ctx context.Context, account *model.Account, rawToken string) {
+	ctx, cancel := context.WithTimeout(ctx, passwordResetMailTimeout)
+	defer cancel()
+
+	body := fmt.Sprintf(
+		"Somebody requested a password reset for your account. "+
+			"If it was you, follow the link:\n\n%s\n\n"+
+			"The link expires in %s. Otherwise just ignore this letter.",
+		utils.BuildTokenLink(uc.resetURL, rawToken), uc.resetTokenTTL,
+	)
+	if err := uc.mailer.Send(ctx, account.Email(), passwordResetEmailSubject, body); err != nil {
+		uc.log.ErrorContext(ctx, "failed to send password reset email",
+			slog.String("account_id", account.ID().String()),
+			slog.String("public_msg", ucerrs.ErrSendEmail.Error()),
+			slog.Any("reason", err),
+		)
+	}
+}
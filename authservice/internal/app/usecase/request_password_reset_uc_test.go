@@ -0,0 +1,200 @@
+package usecase_test
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRequestPasswordResetUC_Execute(t *testing.T) {
+	type adapter struct {
+		account    *mocks.AccountRepository
+		resetToken *mocks.PasswordResetTokenRepository
+		requests   *mocks.RequestCounter
+		mailer     *mocks.Mailer
+		sent       chan struct{}
+	}
+
+	type testCase struct {
+		name     string
+		input    dto.RequestPasswordResetInput
+		prepare  func(a adapter)
+		wantErr  error
+		wantMail bool
+	}
+
+	email := "user@test.com"
+	ip := "127.0.0.1"
+	emailKey := model.ResetKeyForEmail(email)
+	ipKey := model.ResetKeyForIP(ip)
+	account, _ := model.NewAccount(email, "hashed_db")
+
+	blockedAccount, _ := model.NewAccount(email, "hashed_db")
+	blockedAccount.Block()
+
+	emailLimit, _ := model.NewRequestLimit(time.Hour, 3, 5, time.Minute)
+	ipLimit, _ := model.NewRequestLimit(time.Hour, 10, 30, time.Minute)
+
+	// The key is under its limit and the request is counted
+	allowed := func(a adapter, key string) {
+		a.requests.On("Count", mock.Anything, key, mock.Anything).
+			Return(1, time.Now(), time.Now(), nil)
+		a.requests.On("Add", mock.Anything, key, mock.Anything, time.Hour).
+			Return(nil)
+	}
+
+	var tests = []testCase{
+		{
+			name:  "Success",
+			input: dto.RequestPasswordResetInput{Email: email, IP: &ip},
+			prepare: func(a adapter) {
+				allowed(a, emailKey)
+				allowed(a, ipKey)
+				a.account.On("GetByEmail", mock.Anything, email).Return(account, nil)
+				a.resetToken.On("Create", mock.Anything, mock.MatchedBy(func(tk *model.PasswordResetToken) bool {
+					return tk.AccountID() == account.ID() && tk.IsActive()
+				})).Return(nil)
+				a.mailer.On("Send", mock.Anything, email, mock.Anything, mock.MatchedBy(func(body string) bool {
+					return strings.Contains(body, "https://ads.test/reset?token=")
+				})).Return(nil).Run(func(mock.Arguments) { close(a.sent) })
+			},
+			wantErr:  nil,
+			wantMail: true,
+		},
+		{
+			name:  "Success - Unknown Email Looks The Same",
+			input: dto.RequestPasswordResetInput{Email: "ghost@test.com", IP: &ip},
+			prepare: func(a adapter) {
+				allowed(a, model.ResetKeyForEmail("ghost@test.com"))
+				allowed(a, ipKey)
+				a.account.On("GetByEmail", mock.Anything, "ghost@test.com").
+					Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Success - Blocked Account Looks The Same",
+			input: dto.RequestPasswordResetInput{Email: email},
+			prepare: func(a adapter) {
+				allowed(a, emailKey)
+				a.account.On("GetByEmail", mock.Anything, email).Return(blockedAccount, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Success - Mailer Error Looks The Same",
+			input: dto.RequestPasswordResetInput{Email: email},
+			prepare: func(a adapter) {
+				allowed(a, emailKey)
+				a.account.On("GetByEmail", mock.Anything, email).Return(account, nil)
+				a.resetToken.On("Create", mock.Anything, mock.Anything).Return(nil)
+				a.mailer.On("Send", mock.Anything, email, mock.Anything, mock.Anything).
+					Return(assert.AnError).Run(func(mock.Arguments) { close(a.sent) })
+			},
+			wantErr:  nil,
+			wantMail: true,
+		},
+		{
+			name:  "Fail - Too Many Requests For Email",
+			input: dto.RequestPasswordResetInput{Email: email, IP: &ip},
+			prepare: func(a adapter) {
+				a.requests.On("Count", mock.Anything, emailKey, mock.Anything).
+					Return(5, time.Now().Add(-time.Minute), time.Now(), nil)
+				a.requests.On("Count", mock.Anything, ipKey, mock.Anything).
+					Return(0, time.Time{}, time.Time{}, nil)
+			},
+			wantErr: ucerrs.ErrTooManyResetRequests,
+		},
+		{
+			name:  "Fail - Too Many Requests From IP",
+			input: dto.RequestPasswordResetInput{Email: email, IP: &ip},
+			prepare: func(a adapter) {
+				a.requests.On("Count", mock.Anything, emailKey, mock.Anything).
+					Return(0, time.Time{}, time.Time{}, nil)
+				a.requests.On("Count", mock.Anything, ipKey, mock.Anything).
+					Return(30, time.Now().Add(-time.Minute), time.Now(), nil)
+			},
+			wantErr: ucerrs.ErrTooManyResetRequests,
+		},
+		{
+			name:  "Fail - DB Error On Count",
+			input: dto.RequestPasswordResetInput{Email: email},
+			prepare: func(a adapter) {
+				a.requests.On("Count", mock.Anything, emailKey, mock.Anything).
+					Return(0, time.Time{}, time.Time{}, assert.AnError)
+			},
+			wantErr: ucerrs.ErrCountRequestsDB,
+		},
+		{
+			name:  "Fail - DB Error On Add",
+			input: dto.RequestPasswordResetInput{Email: email},
+			prepare: func(a adapter) {
+				a.requests.On("Count", mock.Anything, emailKey, mock.Anything).
+					Return(0, time.Time{}, time.Time{}, nil)
+				a.requests.On("Add", mock.Anything, emailKey, mock.Anything, time.Hour).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrAddRequestDB,
+		},
+		{
+			name:  "Fail - DB Error On Token Create",
+			input: dto.RequestPasswordResetInput{Email: email},
+			prepare: func(a adapter) {
+				allowed(a, emailKey)
+				a.account.On("GetByEmail", mock.Anything, email).Return(account, nil)
+				a.resetToken.On("Create", mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrCreateResetTokenDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				account:    mocks.NewAccountRepository(t),
+				resetToken: mocks.NewPasswordResetTokenRepository(t),
+				requests:   mocks.NewRequestCounter(t),
+				mailer:     mocks.NewMailer(t),
+				sent:       make(chan struct{}),
+			}
+
+			tt.prepare(a)
+
+			uc := usecase.NewRequestPasswordResetUC(
+				slog.New(slog.DiscardHandler), a.account, a.resetToken, a.requests, a.mailer,
+				emailLimit, ipLimit, 30*time.Minute, "https://ads.test/reset",
+			)
+
+			res, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, res.Sent)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, res.Sent)
+			}
+
+			// The letter is sent in the background
+			if tt.wantMail {
+				select {
+				case <-a.sent:
+				case <-time.After(time.Second):
+					t.Fatal("reset link was not sent")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type ResetPasswordUC struct {
+	account        port.AccountRepository
+	resetToken     port.PasswordResetTokenRepository
+	refreshSession port.RefreshSessionRepository
+	passwordHasher port.PasswordHasher
+	authEvent      port.AuthEventRepository
+	denylist       port.AccessTokenDenylist
+	accessTTL      time.Duration
+}
+
+func NewResetPasswordUC(
+	account port.AccountRepository,
+	resetToken port.PasswordResetTokenRepository,
+	refreshSession port.RefreshSessionRepository,
+	passwordHasher port.PasswordHasher,
+	authEvent port.AuthEventRepository,
+	denylist port.AccessTokenDenylist,
+	accessTTL time.Duration,
+) *ResetPasswordUC {
+	return &ResetPasswordUC{
+		account:        account,
+		resetToken:     resetToken,
+		refreshSession: refreshSession,
+		passwordHasher: passwordHasher,
+		authEvent:      authEvent,
+		denylist:       denylist,
+		accessTTL:      accessTTL,
+	}
+}
+
+func (uc *ResetPasswordUC) Execute(ctx context.Context, in dto.ResetPasswordInput) (dto.ResetPasswordOutput, error) {
+	// Password policy goes first, so a weak password does not burn the token
+	if err := model.ValidatePassword(in.NewPassword); err != nil {
+		return dto.ResetPasswordOutput{}, ucerrs.ErrWeakPassword
+	}
+
+	// Find token
+	token, err := uc.resetToken.GetByHash(ctx, utils.HashToken(in.Token))
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.ResetPasswordOutput{}, ucerrs.ErrInvalidResetToken
+		}
+		return dto.ResetPasswordOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetResetTokenDB, err,
+		)
+	}
+
+	// Validate and consume
+	if !token.IsActive() {
+		return dto.ResetPasswordOutput{}, ucerrs.ErrInvalidResetToken
+	}
+
+	if err := token.Use(); err != nil {
+		return dto.ResetPasswordOutput{}, ucerrs.ErrInvalidResetToken
+	}
+
+	// Other unused links of the account are dropped along with it
+	if err := uc.resetToken.MarkUsed(ctx, token); err != nil {
+		if errors.Is(err, model.ErrTokenAlreadyUsed) {
+			return dto.ResetPasswordOutput{}, ucerrs.ErrInvalidResetToken
+		}
+		return dto.ResetPasswordOutput{}, ucerrs.Wrap(
+			ucerrs.ErrUseResetTokenDB, err,
+		)
+	}
+
+	// Find account
+	account, err := uc.account.GetByID(ctx, token.AccountID())
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.ResetPasswordOutput{}, ucerrs.ErrInvalidResetToken
+		}
+		return dto.ResetPasswordOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetAccountByIDDB, err,
+		)
+	}
+
+	if !account.CanLogin() {
+		return dto.ResetPasswordOutput{}, ucerrs.ErrCannotLogin
+	}
+
+	// Hashing the new password
+	hashedPassword, err := uc.passwordHasher.Hash(in.NewPassword)
+	if err != nil {
+		return dto.ResetPasswordOutput{}, ucerrs.Wrap(
+			ucerrs.ErrHashPassword, err,
+		)
+	}
+
+	if err := account.ChangePassword(hashedPassword); err != nil {
+		return dto.ResetPasswordOutput{}, ucerrs.Wrap(
+			ucerrs.ErrInvalidInput, err,
+		)
+	}
+
+	if err := uc.account.UpdatePassword(ctx, account); err != nil {
+		return dto.ResetPasswordOutput{}, ucerrs.Wrap(
+			ucerrs.ErrUpdateAccountDB, err,
+		)
+	}
+
+	// Kill every session, someone may have stolen them
+	if err := denyAccountSessions(
+		ctx, uc.refreshSession, uc.denylist, account.ID(), uuid.Nil, uc.accessTTL,
+	); err != nil {
+		return dto.ResetPasswordOutput{}, err
+	}
+
+	var reason = "password reset"
+	if err := uc.refreshSession.RevokeAllForAccount(ctx, account.ID(), &reason); err != nil {
+		return dto.ResetPasswordOutput{}, ucerrs.Wrap(
+			ucerrs.ErrRevokeRefreshSessionDB, err,
+		)
+	}
+
+	if err := recordAuthEvent(
+		ctx, uc.authEvent, account.ID(), model.AuthEventPasswordReset, model.AuthEventSuccess,
+		nil, nil, nil, nil,
+	); err != nil {
+		return dto.ResetPasswordOutput{}, err
+	}
+
+	// Output
+	return dto.ResetPasswordOutput{Reset: true}, nil
+}
@@ -0,0 +1,199 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResetPasswordUC_Execute(t *testing.T) {
+	type adapter struct {
+		account        *mocks.AccountRepository
+		resetToken     *mocks.PasswordResetTokenRepository
+		refreshSession *mocks.RefreshSessionRepository
+		passwordHasher *mocks.PasswordHasher
+		authEvent      *mocks.AuthEventRepository
+		denylist       *mocks.AccessTokenDenylist
+	}
+
+	type testCase struct {
+		name    string
+		input   dto.ResetPasswordInput
+		prepare func(a adapter)
+		wantErr error
+	}
+
+	rawToken := "raw-reset-token"
+	hashedToken := utils.HashToken(rawToken)
+	newPassword := "brandNewPassword42"
+
+	account, _ := model.NewAccount("user@test.com", "hashed_db")
+
+	newToken := func() *model.PasswordResetToken {
+		tk, _ := model.NewPasswordResetToken(account.ID(), hashedToken, time.Hour)
+		return tk
+	}
+
+	session, _ := model.NewRefreshSession(
+		uuid.New(), account.ID(), "session-hash", nil, nil, nil, time.Hour,
+	)
+
+	var tests = []testCase{
+		{
+			name:  "Success",
+			input: dto.ResetPasswordInput{Token: rawToken, NewPassword: newPassword},
+			prepare: func(a adapter) {
+				a.resetToken.On("GetByHash", mock.Anything, hashedToken).
+					Return(newToken(), nil)
+				a.resetToken.On("MarkUsed", mock.Anything, mock.MatchedBy(func(tk *model.PasswordResetToken) bool {
+					return tk.IsUsed()
+				})).Return(nil)
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.passwordHasher.On("Hash", newPassword).Return("new_hashed", nil)
+				a.account.On("UpdatePassword", mock.Anything, mock.MatchedBy(func(acc *model.Account) bool {
+					return acc.PasswordHash() == "new_hashed"
+				})).Return(nil)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, account.ID()).
+					Return([]*model.RefreshSession{session}, nil)
+				a.denylist.On("Add", mock.Anything,
+					model.DenylistKeyForSession(session.ID()), mock.Anything).Return(nil)
+				a.refreshSession.On("RevokeAllForAccount", mock.Anything, account.ID(), mock.MatchedBy(func(r *string) bool {
+					return r != nil && *r == "password reset"
+				})).Return(nil)
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.EventType() == model.AuthEventPasswordReset && e.AccountID() == account.ID()
+				})).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "Fail - Weak Password",
+			input:   dto.ResetPasswordInput{Token: rawToken, NewPassword: "123"},
+			prepare: func(a adapter) {},
+			wantErr: ucerrs.ErrWeakPassword,
+		},
+		{
+			name:  "Fail - Token Not Found",
+			input: dto.ResetPasswordInput{Token: "unknown", NewPassword: newPassword},
+			prepare: func(a adapter) {
+				a.resetToken.On("GetByHash", mock.Anything, utils.HashToken("unknown")).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+			wantErr: ucerrs.ErrInvalidResetToken,
+		},
+		{
+			name:  "Fail - Token Expired",
+			input: dto.ResetPasswordInput{Token: rawToken, NewPassword: newPassword},
+			prepare: func(a adapter) {
+				expired := model.RestorePasswordResetToken(
+					uuid.New(), account.ID(), hashedToken,
+					time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour), nil,
+				)
+				a.resetToken.On("GetByHash", mock.Anything, hashedToken).
+					Return(expired, nil)
+			},
+			wantErr: ucerrs.ErrInvalidResetToken,
+		},
+		{
+			name:  "Fail - Concurrent Use",
+			input: dto.ResetPasswordInput{Token: rawToken, NewPassword: newPassword},
+			prepare: func(a adapter) {
+				a.resetToken.On("GetByHash", mock.Anything, hashedToken).
+					Return(newToken(), nil)
+				a.resetToken.On("MarkUsed", mock.Anything, mock.Anything).
+					Return(model.ErrTokenAlreadyUsed)
+			},
+			wantErr: ucerrs.ErrInvalidResetToken,
+		},
+		{
+			name:  "Fail - DB Error On Password Update",
+			input: dto.ResetPasswordInput{Token: rawToken, NewPassword: newPassword},
+			prepare: func(a adapter) {
+				a.resetToken.On("GetByHash", mock.Anything, hashedToken).
+					Return(newToken(), nil)
+				a.resetToken.On("MarkUsed", mock.Anything, mock.Anything).Return(nil)
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.passwordHasher.On("Hash", newPassword).Return("new_hashed", nil)
+				a.account.On("UpdatePassword", mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrUpdateAccountDB,
+		},
+		{
+			name:  "Fail - DB Error On Deny Access Tokens",
+			input: dto.ResetPasswordInput{Token: rawToken, NewPassword: newPassword},
+			prepare: func(a adapter) {
+				a.resetToken.On("GetByHash", mock.Anything, hashedToken).
+					Return(newToken(), nil)
+				a.resetToken.On("MarkUsed", mock.Anything, mock.Anything).Return(nil)
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.passwordHasher.On("Hash", newPassword).Return("new_hashed", nil)
+				a.account.On("UpdatePassword", mock.Anything, mock.Anything).Return(nil)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, account.ID()).
+					Return([]*model.RefreshSession{session}, nil)
+				a.denylist.On("Add", mock.Anything, mock.Anything, mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrDenyAccessTokenDB,
+		},
+		{
+			name:  "Fail - DB Error On Sessions Revoke",
+			input: dto.ResetPasswordInput{Token: rawToken, NewPassword: newPassword},
+			prepare: func(a adapter) {
+				a.resetToken.On("GetByHash", mock.Anything, hashedToken).
+					Return(newToken(), nil)
+				a.resetToken.On("MarkUsed", mock.Anything, mock.Anything).Return(nil)
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.passwordHasher.On("Hash", newPassword).Return("new_hashed", nil)
+				a.account.On("UpdatePassword", mock.Anything, mock.Anything).Return(nil)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, account.ID()).
+					Return([]*model.RefreshSession{session}, nil)
+				a.denylist.On("Add", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				a.refreshSession.On("RevokeAllForAccount", mock.Anything, account.ID(), mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrRevokeRefreshSessionDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				account:        mocks.NewAccountRepository(t),
+				resetToken:     mocks.NewPasswordResetTokenRepository(t),
+				refreshSession: mocks.NewRefreshSessionRepository(t),
+				passwordHasher: mocks.NewPasswordHasher(t),
+				authEvent:      mocks.NewAuthEventRepository(t),
+				denylist:       mocks.NewAccessTokenDenylist(t),
+			}
+
+			tt.prepare(a)
+
+			uc := usecase.NewResetPasswordUC(
+				a.account, a.resetToken, a.refreshSession, a.passwordHasher,
+				a.authEvent, a.denylist, time.Minute*15,
+			)
+
+			res, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, res.Reset)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, res.Reset)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+)
+
+type RevokeAllOtherSessionsUC struct {
+	refreshSession port.RefreshSessionRepository
+	tokenGenerator port.TokenGenerator
+	authEvent      port.AuthEventRepository
+	denylist       port.AccessTokenDenylist
+	accessTTL      time.Duration
+}
+
+func NewRevokeAllOtherSessionsUC(
+	refreshSession port.RefreshSessionRepository,
+	tokenGenerator port.TokenGenerator,
+	authEvent port.AuthEventRepository,
+	denylist port.AccessTokenDenylist,
+	accessTTL time.Duration,
+) *RevokeAllOtherSessionsUC {
+	return &RevokeAllOtherSessionsUC{
+		refreshSession: refreshSession,
+		tokenGenerator: tokenGenerator,
+		authEvent:      authEvent,
+		denylist:       denylist,
+		accessTTL:      accessTTL,
+	}
+}
+
+func (uc *RevokeAllOtherSessionsUC) Execute(ctx context.Context, in dto.RevokeAllOtherSessionsInput) (dto.RevokeAllOtherSessionsOutput, error) {
+	// Find the caller's session, it survives
+	session, err := currentSession(
+		ctx, uc.tokenGenerator, uc.refreshSession,
+		in.AccountID, in.RefreshToken,
+	)
+	if err != nil {
+		return dto.RevokeAllOtherSessionsOutput{}, err
+	}
+
+	// Access tokens of the other sessions stop working, the sessions
+	// are listed before they are revoked since revoked ones are not listed
+	sessions, err := uc.refreshSession.ListActiveForAccount(ctx, in.AccountID)
+	if err != nil {
+		return dto.RevokeAllOtherSessionsOutput{}, ucerrs.Wrap(
+			ucerrs.ErrListRefreshSessionsDB, err,
+		)
+	}
+	if err := denySessions(
+		ctx, uc.denylist, sessions, session.ID(), uc.accessTTL,
+	); err != nil {
+		return dto.RevokeAllOtherSessionsOutput{}, err
+	}
+
+	// Revoke the rest
+	var reason = "revoked by user"
+	if err := uc.refreshSession.RevokeAllForAccountExcept(
+		ctx, in.AccountID, session.ID(), &reason,
+	); err != nil {
+		return dto.RevokeAllOtherSessionsOutput{}, ucerrs.Wrap(
+			ucerrs.ErrRevokeRefreshSessionDB, err,
+		)
+	}
+
+	var (
+		sessionID = session.ID()
+		revoked   int
+	)
+	for _, other := range sessions {
+		if other.ID() != sessionID {
+			revoked++
+		}
+	}
+	if err := recordAuthEvent(
+		ctx, uc.authEvent, in.AccountID, model.AuthEventSessionRevoked, model.AuthEventSuccess,
+		&sessionID, session.IP(), session.UserAgent(),
+		map[string]string{"revoked_sessions": strconv.Itoa(revoked)},
+	); err != nil {
+		return dto.RevokeAllOtherSessionsOutput{}, err
+	}
+
+	return dto.RevokeAllOtherSessionsOutput{Revoked: true}, nil
+}
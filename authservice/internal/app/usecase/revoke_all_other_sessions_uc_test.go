@@ -0,0 +1,138 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRevokeAllOtherSessionsUC_Execute(t *testing.T) {
+	type adapter struct {
+		refreshSession *mocks.RefreshSessionRepository
+		tokenGenerator *mocks.TokenGenerator
+		authEvent      *mocks.AuthEventRepository
+		denylist       *mocks.AccessTokenDenylist
+	}
+
+	type testCase struct {
+		name    string
+		input   dto.RevokeAllOtherSessionsInput
+		prepare func(a adapter)
+		wantErr error
+	}
+
+	accountID := uuid.New()
+	sessionID := uuid.New()
+	token := "current-refresh-token"
+
+	current, _ := model.NewRefreshSession(
+		sessionID, accountID, utils.HashToken(token), nil, nil, nil, time.Hour,
+	)
+
+	other, _ := model.NewRefreshSession(
+		uuid.New(), accountID, "hashed", nil, nil, nil, time.Hour,
+	)
+	otherKey := model.DenylistKeyForSession(other.ID())
+
+	input := dto.RevokeAllOtherSessionsInput{AccountID: accountID, RefreshToken: token}
+
+	var tests = []testCase{
+		{
+			name:  "Success",
+			input: input,
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, token).
+					Return(accountID, sessionID, nil)
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).Return(current, nil)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, accountID).
+					Return([]*model.RefreshSession{current, other}, nil)
+				a.denylist.On("Add", mock.Anything, otherKey, mock.Anything).Return(nil)
+				a.refreshSession.On("RevokeAllForAccountExcept", mock.Anything,
+					accountID, sessionID, mock.Anything).Return(nil)
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.EventType() == model.AuthEventSessionRevoked &&
+						*e.SessionID() == sessionID &&
+						e.Metadata()["revoked_sessions"] == "1"
+				})).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Fail - Invalid Refresh Token",
+			input: input,
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, token).
+					Return(uuid.Nil, uuid.Nil, assert.AnError)
+			},
+			wantErr: ucerrs.ErrInvalidRefreshToken,
+		},
+		{
+			name:  "Fail - DB Error On Revoke",
+			input: input,
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, token).
+					Return(accountID, sessionID, nil)
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).Return(current, nil)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, accountID).
+					Return([]*model.RefreshSession{current, other}, nil)
+				a.denylist.On("Add", mock.Anything, otherKey, mock.Anything).Return(nil)
+				a.refreshSession.On("RevokeAllForAccountExcept", mock.Anything,
+					accountID, sessionID, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrRevokeRefreshSessionDB,
+		},
+		{
+			name:  "Fail - DB Error On Deny",
+			input: input,
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateRefreshToken", mock.Anything, token).
+					Return(accountID, sessionID, nil)
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).Return(current, nil)
+				a.refreshSession.On("ListActiveForAccount", mock.Anything, accountID).
+					Return([]*model.RefreshSession{current, other}, nil)
+				a.denylist.On("Add", mock.Anything, otherKey, mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrDenyAccessTokenDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				refreshSession: mocks.NewRefreshSessionRepository(t),
+				tokenGenerator: mocks.NewTokenGenerator(t),
+				authEvent:      mocks.NewAuthEventRepository(t),
+				denylist:       mocks.NewAccessTokenDenylist(t),
+			}
+
+			tt.prepare(a)
+
+			uc := usecase.NewRevokeAllOtherSessionsUC(
+				a.refreshSession, a.tokenGenerator, a.authEvent,
+				a.denylist, time.Minute*15,
+			)
+
+			res, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, res.Revoked)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, res.Revoked)
+			}
+		})
+	}
+}
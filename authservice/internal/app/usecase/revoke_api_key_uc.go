@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+type RevokeAPIKeyUC struct {
+	apiKey port.APIKeyRepository
+}
+
+func NewRevokeAPIKeyUC(apiKey port.APIKeyRepository) *RevokeAPIKeyUC {
+	return &RevokeAPIKeyUC{apiKey: apiKey}
+}
+
+func (uc *RevokeAPIKeyUC) Execute(ctx context.Context, in dto.RevokeAPIKeyInput) (dto.RevokeAPIKeyOutput, error) {
+	// Find key
+	apiKey, err := uc.apiKey.GetByID(ctx, in.KeyID)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.RevokeAPIKeyOutput{}, ucerrs.ErrInvalidAPIKeyID
+		}
+		return dto.RevokeAPIKeyOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetAPIKeyDB, err,
+		)
+	}
+
+	// Foreign keys look the same as missing ones
+	if apiKey.AccountID() != in.AccountID {
+		return dto.RevokeAPIKeyOutput{}, ucerrs.ErrInvalidAPIKeyID
+	}
+
+	// Revoke
+	if err := apiKey.Revoke(); err != nil {
+		return dto.RevokeAPIKeyOutput{}, ucerrs.ErrAPIKeyAlreadyRevoked
+	}
+
+	if err := uc.apiKey.Revoke(ctx, apiKey); err != nil {
+		return dto.RevokeAPIKeyOutput{}, ucerrs.Wrap(
+			ucerrs.ErrRevokeAPIKeyDB, err,
+		)
+	}
+
+	return dto.RevokeAPIKeyOutput{Revoked: true}, nil
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+type RevokeRoleUC struct {
+	accountRole port.AccountRoleRepository
+	authEvent   port.AuthEventRepository
+}
+
+func NewRevokeRoleUC(
+	accountRole port.AccountRoleRepository,
+	authEvent port.AuthEventRepository,
+) *RevokeRoleUC {
+	return &RevokeRoleUC{
+		accountRole: accountRole,
+		authEvent:   authEvent,
+	}
+}
+
+func (uc *RevokeRoleUC) Execute(ctx context.Context, in dto.RevokeRoleInput) (dto.RevokeRoleOutput, error) {
+	// Nobody can take roles away from themselves, otherwise
+	// the last admin could lock everyone out
+	if in.AdminID == in.AccountID {
+		return dto.RevokeRoleOutput{Revoked: false},
+			ucerrs.ErrCannotModerateSelf
+	}
+
+	// Get roles
+	accRole, err := uc.accountRole.Get(ctx, in.AccountID)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.RevokeRoleOutput{Revoked: false},
+				ucerrs.ErrInvalidAccountID
+		}
+		return dto.RevokeRoleOutput{Revoked: false},
+			ucerrs.Wrap(ucerrs.ErrGetAccountRoleDB, err)
+	}
+
+	// Revoke
+	role, err := model.ParseRole(in.Role)
+	if err != nil || !accRole.HasRole(role) {
+		return dto.RevokeRoleOutput{Revoked: false},
+			ucerrs.ErrCannotRevokeRole
+	}
+	if _, err := accRole.Revoke(in.Role); err != nil {
+		return dto.RevokeRoleOutput{Revoked: false},
+			ucerrs.ErrCannotRevokeRole
+	}
+
+	// Update db, the last role is guarded there as well
+	if err := uc.accountRole.RemoveRole(ctx, accRole.AccountID(), role); err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.RevokeRoleOutput{Revoked: false},
+				ucerrs.ErrCannotRevokeRole
+		}
+		return dto.RevokeRoleOutput{Revoked: false},
+			ucerrs.Wrap(ucerrs.ErrUpdateAccountRoleDB, err)
+	}
+
+	if err := recordModeration(
+		ctx, uc.authEvent, model.AuthEventRoleRevoked,
+		in.AdminID, accRole.AccountID(), "revoked role "+role.String(),
+	); err != nil {
+		return dto.RevokeRoleOutput{Revoked: false}, err
+	}
+
+	// Output
+	return dto.RevokeRoleOutput{Revoked: true}, nil
+}
@@ -0,0 +1,112 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRevokeRoleUC_Execute(t *testing.T) {
+	type testCase struct {
+		name    string
+		input   dto.RevokeRoleInput
+		prepare func(m *mocks.AccountRoleRepository, e *mocks.AuthEventRepository)
+		wantErr error
+	}
+
+	adminID := uuid.New()
+	accountID := uuid.New()
+
+	moderator := func() *model.AccountRole {
+		return model.RestoreAccountRole(
+			accountID, []model.Role{model.RoleUser, model.RoleModerator}, nil,
+		)
+	}
+
+	var tests = []testCase{
+		{
+			name:  "Success",
+			input: dto.RevokeRoleInput{AdminID: adminID, AccountID: accountID, Role: "moderator"},
+			prepare: func(m *mocks.AccountRoleRepository, e *mocks.AuthEventRepository) {
+				m.On("Get", mock.Anything, accountID).Return(moderator(), nil)
+				m.On("RemoveRole", mock.Anything, accountID, model.RoleModerator).Return(nil)
+				e.On("Create", mock.Anything, mock.MatchedBy(func(ev *model.AuthEvent) bool {
+					return ev.EventType() == model.AuthEventRoleRevoked &&
+						*ev.ActorID() == adminID && ev.AccountID() == accountID
+				})).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "Fail - Own Roles",
+			input:   dto.RevokeRoleInput{AdminID: adminID, AccountID: adminID, Role: "admin"},
+			prepare: func(m *mocks.AccountRoleRepository, e *mocks.AuthEventRepository) {},
+			wantErr: ucerrs.ErrCannotModerateSelf,
+		},
+		{
+			name:  "Fail - Account Not Found",
+			input: dto.RevokeRoleInput{AdminID: adminID, AccountID: accountID, Role: "moderator"},
+			prepare: func(m *mocks.AccountRoleRepository, e *mocks.AuthEventRepository) {
+				m.On("Get", mock.Anything, accountID).Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+			wantErr: ucerrs.ErrInvalidAccountID,
+		},
+		{
+			name:  "Fail - Role Is Not Assigned",
+			input: dto.RevokeRoleInput{AdminID: adminID, AccountID: accountID, Role: "admin"},
+			prepare: func(m *mocks.AccountRoleRepository, e *mocks.AuthEventRepository) {
+				m.On("Get", mock.Anything, accountID).Return(moderator(), nil)
+			},
+			wantErr: ucerrs.ErrCannotRevokeRole,
+		},
+		{
+			name:  "Fail - Last Role",
+			input: dto.RevokeRoleInput{AdminID: adminID, AccountID: accountID, Role: "user"},
+			prepare: func(m *mocks.AccountRoleRepository, e *mocks.AuthEventRepository) {
+				m.On("Get", mock.Anything, accountID).Return(
+					model.RestoreAccountRole(accountID, []model.Role{model.RoleUser}, nil), nil,
+				)
+			},
+			wantErr: ucerrs.ErrCannotRevokeRole,
+		},
+		{
+			name:  "Fail - DB Error On Remove",
+			input: dto.RevokeRoleInput{AdminID: adminID, AccountID: accountID, Role: "moderator"},
+			prepare: func(m *mocks.AccountRoleRepository, e *mocks.AuthEventRepository) {
+				m.On("Get", mock.Anything, accountID).Return(moderator(), nil)
+				m.On("RemoveRole", mock.Anything, accountID, model.RoleModerator).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrUpdateAccountRoleDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accountRole := mocks.NewAccountRoleRepository(t)
+			authEvent := mocks.NewAuthEventRepository(t)
+			tt.prepare(accountRole, authEvent)
+
+			uc := usecase.NewRevokeRoleUC(accountRole, authEvent)
+
+			res, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, res.Revoked)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, res.Revoked)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type RevokeSessionUC struct {
+	refreshSession port.RefreshSessionRepository
+	authEvent      port.AuthEventRepository
+	denylist       port.AccessTokenDenylist
+	accessTTL      time.Duration
+}
+
+func NewRevokeSessionUC(
+	refreshSession port.RefreshSessionRepository,
+	authEvent port.AuthEventRepository,
+	denylist port.AccessTokenDenylist,
+	accessTTL time.Duration,
+) *RevokeSessionUC {
+	return &RevokeSessionUC{
+		refreshSession: refreshSession,
+		authEvent:      authEvent,
+		denylist:       denylist,
+		accessTTL:      accessTTL,
+	}
+}
+
+func (uc *RevokeSessionUC) Execute(ctx context.Context, in dto.RevokeSessionInput) (dto.RevokeSessionOutput, error) {
+	// Find session
+	session, err := uc.refreshSession.GetByID(ctx, in.SessionID)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.RevokeSessionOutput{}, ucerrs.ErrInvalidSessionID
+		}
+		return dto.RevokeSessionOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetRefreshSessionByIDDB, err,
+		)
+	}
+
+	// Foreign sessions look the same as missing ones
+	if session.AccountID() != in.AccountID {
+		return dto.RevokeSessionOutput{}, ucerrs.ErrInvalidSessionID
+	}
+
+	// Validate and revoke
+	if !session.IsActive() {
+		return dto.RevokeSessionOutput{}, ucerrs.ErrCannotRevoke
+	}
+
+	var reason = "revoked by user"
+	if err := session.Revoke(&reason); err != nil {
+		return dto.RevokeSessionOutput{}, ucerrs.ErrCannotRevoke
+	}
+
+	if err := uc.refreshSession.Revoke(ctx, session); err != nil {
+		if errors.Is(err, model.ErrTokenAlreadyRevoked) {
+			return dto.RevokeSessionOutput{}, ucerrs.ErrCannotRevoke
+		}
+		return dto.RevokeSessionOutput{}, ucerrs.Wrap(
+			ucerrs.ErrRevokeRefreshSessionDB, err,
+		)
+	}
+
+	// Access tokens of the session stop working as well
+	if err := denySessions(
+		ctx, uc.denylist, []*model.RefreshSession{session}, uuid.Nil, uc.accessTTL,
+	); err != nil {
+		return dto.RevokeSessionOutput{}, err
+	}
+
+	var sessionID = session.ID()
+	if err := recordAuthEvent(
+		ctx, uc.authEvent, session.AccountID(), model.AuthEventSessionRevoked, model.AuthEventSuccess,
+		&sessionID, session.IP(), session.UserAgent(), nil,
+	); err != nil {
+		return dto.RevokeSessionOutput{}, err
+	}
+
+	return dto.RevokeSessionOutput{Revoked: true}, nil
+}
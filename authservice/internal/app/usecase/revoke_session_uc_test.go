@@ -0,0 +1,167 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRevokeSessionUC_Execute(t *testing.T) {
+	type adapter struct {
+		refreshSession *mocks.RefreshSessionRepository
+		authEvent      *mocks.AuthEventRepository
+		denylist       *mocks.AccessTokenDenylist
+	}
+
+	type testCase struct {
+		name    string
+		input   dto.RevokeSessionInput
+		prepare func(a adapter)
+		wantErr error
+	}
+
+	accountID := uuid.New()
+	sessionID := uuid.New()
+
+	newSession := func(owner uuid.UUID) *model.RefreshSession {
+		s, _ := model.NewRefreshSession(
+			sessionID, owner, "hashed", nil, nil, nil, time.Hour,
+		)
+		return s
+	}
+
+	input := dto.RevokeSessionInput{AccountID: accountID, SessionID: sessionID}
+	sessionKey := model.DenylistKeyForSession(sessionID)
+
+	var tests = []testCase{
+		{
+			name:  "Success",
+			input: input,
+			prepare: func(a adapter) {
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).
+					Return(newSession(accountID), nil)
+				a.refreshSession.On("Revoke", mock.Anything, mock.MatchedBy(func(s *model.RefreshSession) bool {
+					return s.IsRevoked() && *s.RevokeReason() == "revoked by user"
+				})).Return(nil)
+				a.denylist.On("Add", mock.Anything, sessionKey, mock.Anything).Return(nil)
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.EventType() == model.AuthEventSessionRevoked &&
+						e.AccountID() == accountID && *e.SessionID() == sessionID
+				})).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Fail - Session Not Found",
+			input: input,
+			prepare: func(a adapter) {
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+			wantErr: ucerrs.ErrInvalidSessionID,
+		},
+		{
+			name:  "Fail - Session Of Another Account",
+			input: input,
+			prepare: func(a adapter) {
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).
+					Return(newSession(uuid.New()), nil)
+			},
+			wantErr: ucerrs.ErrInvalidSessionID,
+		},
+		{
+			name:  "Fail - Already Revoked",
+			input: input,
+			prepare: func(a adapter) {
+				s := newSession(accountID)
+				var reason = "logout"
+				_ = s.Revoke(&reason)
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).Return(s, nil)
+			},
+			wantErr: ucerrs.ErrCannotRevoke,
+		},
+		{
+			name:  "Fail - Revoked Concurrently",
+			input: input,
+			prepare: func(a adapter) {
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).
+					Return(newSession(accountID), nil)
+				a.refreshSession.On("Revoke", mock.Anything, mock.Anything).
+					Return(model.ErrTokenAlreadyRevoked)
+			},
+			wantErr: ucerrs.ErrCannotRevoke,
+		},
+		{
+			name:  "Fail - DB Error On Revoke",
+			input: input,
+			prepare: func(a adapter) {
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).
+					Return(newSession(accountID), nil)
+				a.refreshSession.On("Revoke", mock.Anything, mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrRevokeRefreshSessionDB,
+		},
+		{
+			name:  "Fail - DB Error On Deny",
+			input: input,
+			prepare: func(a adapter) {
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).
+					Return(newSession(accountID), nil)
+				a.refreshSession.On("Revoke", mock.Anything, mock.Anything).Return(nil)
+				a.denylist.On("Add", mock.Anything, sessionKey, mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrDenyAccessTokenDB,
+		},
+		{
+			name:  "Fail - DB Error On Audit",
+			input: input,
+			prepare: func(a adapter) {
+				a.refreshSession.On("GetByID", mock.Anything, sessionID).
+					Return(newSession(accountID), nil)
+				a.refreshSession.On("Revoke", mock.Anything, mock.Anything).Return(nil)
+				a.denylist.On("Add", mock.Anything, sessionKey, mock.Anything).Return(nil)
+				a.authEvent.On("Create", mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrCreateAuthEventDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				refreshSession: mocks.NewRefreshSessionRepository(t),
+				authEvent:      mocks.NewAuthEventRepository(t),
+				denylist:       mocks.NewAccessTokenDenylist(t),
+			}
+
+			tt.prepare(a)
+
+			uc := usecase.NewRevokeSessionUC(
+				a.refreshSession, a.authEvent, a.denylist, time.Minute*15,
+			)
+
+			res, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, res.Revoked)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, res.Revoked)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+const verificationEmailSubject = "Confirm your email"
+
+type SendVerificationEmailUC struct {
+	account           port.AccountRepository
+	verificationToken port.EmailVerificationTokenRepository
+	requests          port.RequestCounter
+	txManager         port.TransactionManager
+	mailer            port.Mailer
+
+	limit                *model.RequestLimit
+	verificationTokenTTL time.Duration
+	verificationURL      string
+}
+
+func NewSendVerificationEmailUC(
+	account port.AccountRepository,
+	verificationToken port.EmailVerificationTokenRepository,
+	requests port.RequestCounter,
+	txManager port.TransactionManager,
+	mailer port.Mailer,
+	limit *model.RequestLimit,
+	verificationTokenTTL time.Duration,
+	verificationURL string,
+) *SendVerificationEmailUC {
+	return &SendVerificationEmailUC{
+		account:              account,
+		verificationToken:    verificationToken,
+		requests:             requests,
+		txManager:            txManager,
+		mailer:               mailer,
+		limit:                limit,
+		verificationTokenTTL: verificationTokenTTL,
+		verificationURL:      verificationURL,
+	}
+}
+
+func (uc *SendVerificationEmailUC) Execute(ctx context.Context, in dto.SendVerificationEmailInput) (dto.SendVerificationEmailOutput, error) {
+	// Find account
+	account, err := uc.account.GetByID(ctx, in.AccountID)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.SendVerificationEmailOutput{}, ucerrs.ErrInvalidAccountID
+		}
+		return dto.SendVerificationEmailOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetAccountByIDDB, err,
+		)
+	}
+
+	// Account validation
+	if !account.CanLogin() {
+		return dto.SendVerificationEmailOutput{}, ucerrs.ErrCannotLogin
+	}
+	if account.EmailVerified() {
+		return dto.SendVerificationEmailOutput{}, ucerrs.ErrEmailAlreadyVerified
+	}
+
+	// Letters are limited per account, so the method can't flood a mailbox
+	if err := limitRequest(
+		ctx, uc.requests, ucerrs.ErrTooManyVerifications, time.Now(),
+		limitedKey{key: model.VerificationKeyForAccount(account.ID()), limit: uc.limit},
+	); err != nil {
+		return dto.SendVerificationEmailOutput{}, err
+	}
+
+	// Generate one-time token, only its hash is stored
+	rawToken, err := utils.GenerateToken()
+	if err != nil {
+		return dto.SendVerificationEmailOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGenerateOneTimeToken, err,
+		)
+	}
+
+	verificationToken, err := model.NewEmailVerificationToken(
+		account.ID(), utils.HashToken(rawToken), uc.verificationTokenTTL,
+	)
+	if err != nil {
+		return dto.SendVerificationEmailOutput{}, ucerrs.Wrap(
+			ucerrs.ErrInvalidInput, err,
+		)
+	}
+
+	// Only the link from the latest letter works, the old links are
+	// dropped together with storing the new one
+	if err := withinTx(ctx, uc.txManager, func(ctx context.Context) error {
+		if err := uc.verificationToken.DeleteUnused(ctx, account.ID()); err != nil {
+			return ucerrs.Wrap(ucerrs.ErrDeleteVerificationTokenDB, err)
+		}
+		if err := uc.verificationToken.Create(ctx, verificationToken); err != nil {
+			return ucerrs.Wrap(ucerrs.ErrCreateVerificationTokenDB, err)
+		}
+		return nil
+	}); err != nil {
+		return dto.SendVerificationEmailOutput{}, err
+	}
+
+	// Send the link
+	body := fmt.Sprintf(
+		"Please confirm your email by following the link:\n\n%s\n\nThe link expires in %s.",
+		utils.BuildTokenLink(uc.verificationURL, rawToken), uc.verificationTokenTTL,
+	)
+	if err := uc.mailer.Send(ctx, account.Email(), verificationEmailSubject, body); err != nil {
+		return dto.SendVerificationEmailOutput{}, ucerrs.Wrap(
+			ucerrs.ErrSendEmail, err,
+		)
+	}
+
+	// Output
+	return dto.SendVerificationEmailOutput{Sent: true}, nil
+}
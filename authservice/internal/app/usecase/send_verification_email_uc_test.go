@@ -0,0 +1,194 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSendVerificationEmailUC_Execute(t *testing.T) {
+	type adapter struct {
+		account           *mocks.AccountRepository
+		verificationToken *mocks.EmailVerificationTokenRepository
+		requests          *mocks.RequestCounter
+		txManager         *mocks.TransactionManager
+		mailer            *mocks.Mailer
+	}
+
+	type testCase struct {
+		name    string
+		input   dto.SendVerificationEmailInput
+		prepare func(a adapter)
+		wantErr error
+	}
+
+	const verificationURL = "http://localhost:8080/verify-email"
+
+	email := "user@test.com"
+	account, _ := model.NewAccount(email, "hashed_db")
+
+	verifiedAccount, _ := model.NewAccount(email, "hashed_db")
+	verifiedAccount.VerifyEmail()
+
+	accountKey := model.VerificationKeyForAccount(account.ID())
+	limit, _ := model.NewRequestLimit(time.Hour, 3, 5, time.Minute)
+
+	// The account is under the limit, the request is counted
+	// and the links sent before are invalidated
+	allowed := func(a adapter) {
+		a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+		a.requests.On("Count", mock.Anything, accountKey, mock.Anything).
+			Return(1, time.Now(), time.Now(), nil)
+		a.requests.On("Add", mock.Anything, accountKey, mock.Anything, time.Hour).
+			Return(nil)
+		expectTx(a.txManager)
+		a.verificationToken.On("DeleteUnused", mock.Anything, account.ID()).Return(nil)
+	}
+
+	var tests = []testCase{
+		{
+			name:  "Success",
+			input: dto.SendVerificationEmailInput{AccountID: account.ID()},
+			prepare: func(a adapter) {
+				allowed(a)
+				a.verificationToken.On("Create", mock.Anything, mock.MatchedBy(func(tk *model.EmailVerificationToken) bool {
+					return tk.AccountID() == account.ID() && tk.IsActive()
+				})).Return(nil)
+				a.mailer.On("Send", mock.Anything, email, mock.Anything, mock.MatchedBy(func(body string) bool {
+					return strings.Contains(body, verificationURL+"?token=")
+				})).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Fail - Account Not Found",
+			input: dto.SendVerificationEmailInput{AccountID: uuid.New()},
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, mock.Anything).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+			wantErr: ucerrs.ErrInvalidAccountID,
+		},
+		{
+			name:  "Fail - Already Verified",
+			input: dto.SendVerificationEmailInput{AccountID: verifiedAccount.ID()},
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, verifiedAccount.ID()).
+					Return(verifiedAccount, nil)
+			},
+			wantErr: ucerrs.ErrEmailAlreadyVerified,
+		},
+		{
+			name:  "Fail - Too Many Requests",
+			input: dto.SendVerificationEmailInput{AccountID: account.ID()},
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.requests.On("Count", mock.Anything, accountKey, mock.Anything).
+					Return(5, time.Now().Add(-time.Minute), time.Now(), nil)
+			},
+			wantErr: ucerrs.ErrTooManyVerifications,
+		},
+		{
+			name:  "Fail - DB Error On Count",
+			input: dto.SendVerificationEmailInput{AccountID: account.ID()},
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.requests.On("Count", mock.Anything, accountKey, mock.Anything).
+					Return(0, time.Time{}, time.Time{}, assert.AnError)
+			},
+			wantErr: ucerrs.ErrCountRequestsDB,
+		},
+		{
+			name:  "Fail - DB Error On Old Tokens Delete",
+			input: dto.SendVerificationEmailInput{AccountID: account.ID()},
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.requests.On("Count", mock.Anything, accountKey, mock.Anything).
+					Return(0, time.Time{}, time.Time{}, nil)
+				a.requests.On("Add", mock.Anything, accountKey, mock.Anything, time.Hour).
+					Return(nil)
+				expectTx(a.txManager)
+				a.verificationToken.On("DeleteUnused", mock.Anything, account.ID()).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrDeleteVerificationTokenDB,
+		},
+		{
+			name:  "Fail - DB Error On Token Create",
+			input: dto.SendVerificationEmailInput{AccountID: account.ID()},
+			prepare: func(a adapter) {
+				allowed(a)
+				a.verificationToken.On("Create", mock.Anything, mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrCreateVerificationTokenDB,
+		},
+		{
+			name:  "Fail - Commit Error",
+			input: dto.SendVerificationEmailInput{AccountID: account.ID()},
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.requests.On("Count", mock.Anything, accountKey, mock.Anything).
+					Return(0, time.Time{}, time.Time{}, nil)
+				a.requests.On("Add", mock.Anything, accountKey, mock.Anything, time.Hour).
+					Return(nil)
+				a.txManager.On("WithinTx", mock.Anything, mock.Anything).
+					Return(errors.New("commit error"))
+			},
+			wantErr: ucerrs.ErrTransactionDB,
+		},
+		{
+			name:  "Fail - Mailer Error",
+			input: dto.SendVerificationEmailInput{AccountID: account.ID()},
+			prepare: func(a adapter) {
+				allowed(a)
+				a.verificationToken.On("Create", mock.Anything, mock.Anything).Return(nil)
+				a.mailer.On("Send", mock.Anything, email, mock.Anything, mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrSendEmail,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				account:           mocks.NewAccountRepository(t),
+				verificationToken: mocks.NewEmailVerificationTokenRepository(t),
+				requests:          mocks.NewRequestCounter(t),
+				txManager:         mocks.NewTransactionManager(t),
+				mailer:            mocks.NewMailer(t),
+			}
+
+			tt.prepare(a)
+
+			uc := usecase.NewSendVerificationEmailUC(
+				a.account, a.verificationToken, a.requests, a.txManager, a.mailer,
+				limit, time.Hour, verificationURL,
+			)
+
+			res, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, res.Sent)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, res.Sent)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+)
+
+type StartOIDCLoginUC struct {
+	oidcState port.OIDCStateRepository
+	providers map[string]port.OIDCProvider
+
+	stateTTL time.Duration
+}
+
+func NewStartOIDCLoginUC(
+	oidcState port.OIDCStateRepository,
+	providers map[string]port.OIDCProvider,
+	stateTTL time.Duration,
+) *StartOIDCLoginUC {
+	return &StartOIDCLoginUC{
+		oidcState: oidcState,
+		providers: providers,
+		stateTTL:  stateTTL,
+	}
+}
+
+func (uc *StartOIDCLoginUC) Execute(ctx context.Context, in dto.StartOIDCLoginInput) (dto.StartOIDCLoginOutput, error) {
+	provider, ok := uc.providers[in.Provider]
+	if !ok {
+		return dto.StartOIDCLoginOutput{}, ucerrs.ErrUnknownOIDCProvider
+	}
+
+	// State protects the callback from CSRF, nonce binds the ID token
+	// to this request and the PKCE verifier binds the code to it
+	var secrets [3]string
+	for i := range secrets {
+		secret, err := utils.GenerateToken()
+		if err != nil {
+			return dto.StartOIDCLoginOutput{}, ucerrs.Wrap(
+				ucerrs.ErrGenerateOneTimeToken, err,
+			)
+		}
+		secrets[i] = secret
+	}
+	state, nonce, codeVerifier := secrets[0], secrets[1], secrets[2]
+
+	loginState, err := model.NewOIDCLoginState(
+		utils.HashToken(state), in.Provider, codeVerifier, nonce, uc.stateTTL,
+	)
+	if err != nil {
+		return dto.StartOIDCLoginOutput{}, ucerrs.Wrap(
+			ucerrs.ErrInvalidInput, err,
+		)
+	}
+
+	if err := uc.oidcState.Create(ctx, loginState); err != nil {
+		return dto.StartOIDCLoginOutput{}, ucerrs.Wrap(
+			ucerrs.ErrCreateOIDCStateDB, err,
+		)
+	}
+
+	return dto.StartOIDCLoginOutput{
+		AuthURL: provider.AuthCodeURL(state, nonce, utils.PKCEChallenge(codeVerifier)),
+		State:   state,
+	}, nil
+}
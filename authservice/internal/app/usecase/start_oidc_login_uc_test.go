@@ -0,0 +1,106 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStartOIDCLoginUC_Execute(t *testing.T) {
+	type adapter struct {
+		oidcState *mocks.OIDCStateRepository
+		provider  *mocks.OIDCProvider
+	}
+
+	type testCase struct {
+		name     string
+		provider string
+		prepare  func(a adapter)
+		wantErr  error
+	}
+
+	const stateTTL = time.Minute * 10
+
+	var (
+		saved *model.OIDCLoginState
+		url   string
+	)
+
+	var tests = []testCase{
+		{
+			name:     "Success",
+			provider: "google",
+			prepare: func(a adapter) {
+				a.oidcState.On("Create", mock.Anything, mock.MatchedBy(func(s *model.OIDCLoginState) bool {
+					saved = s
+					return s.Provider() == "google"
+				})).Return(nil)
+				a.provider.On("AuthCodeURL", mock.Anything, mock.Anything, mock.Anything).
+					Return(func(state, nonce, challenge string) string {
+						url = state + "|" + nonce + "|" + challenge
+						return "https://accounts.example.com/auth"
+					})
+			},
+			wantErr: nil,
+		},
+		{
+			name:     "Fail - Unknown Provider",
+			provider: "myspace",
+			prepare:  func(a adapter) {},
+			wantErr:  ucerrs.ErrUnknownOIDCProvider,
+		},
+		{
+			name:     "Fail - DB Error On Create",
+			provider: "google",
+			prepare: func(a adapter) {
+				a.oidcState.On("Create", mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrCreateOIDCStateDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saved, url = nil, ""
+			a := adapter{
+				oidcState: mocks.NewOIDCStateRepository(t),
+				provider:  mocks.NewOIDCProvider(t),
+			}
+
+			tt.prepare(a)
+
+			uc := usecase.NewStartOIDCLoginUC(
+				a.oidcState, map[string]port.OIDCProvider{"google": a.provider}, stateTTL,
+			)
+
+			res, err := uc.Execute(context.Background(), dto.StartOIDCLoginInput{Provider: tt.provider})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, res.State)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://accounts.example.com/auth", res.AuthURL)
+
+				// Only the hash of the state is stored, secrets stay server-side
+				assert.Equal(t, utils.HashToken(res.State), saved.StateHash())
+				assert.Equal(t,
+					res.State+"|"+saved.Nonce()+"|"+utils.PKCEChallenge(saved.CodeVerifier()),
+					url,
+				)
+				assert.WithinDuration(t, time.Now().Add(stateTTL), saved.ExpiresAt(), time.Second)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+)
+
+// Runs fn in one transaction. Errors of fn are returned as they are,
+// a failed begin or commit is reported as ErrTransactionDB
+func withinTx(ctx context.Context, txManager port.TransactionManager, fn func(ctx context.Context) error) error {
+	var fnErr error
+	err := txManager.WithinTx(ctx, func(ctx context.Context) error {
+		fnErr = fn(ctx)
+		return fnErr
+	})
+	if fnErr != nil {
+		return fnErr
+	}
+	if err != nil {
+		return ucerrs.Wrap(ucerrs.ErrTransactionDB, err)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+)
+
+type UnblockAccountUC struct {
+	account       port.AccountRepository
+	accountRole   port.AccountRoleRepository
+	authEvent     port.AuthEventRepository
+	txManager     port.TransactionManager
+	accountOutbox port.AccountOutbox
+}
+
+func NewUnblockAccountUC(
+	account port.AccountRepository,
+	accountRole port.AccountRoleRepository,
+	authEvent port.AuthEventRepository,
+	txManager port.TransactionManager,
+	accountOutbox port.AccountOutbox,
+) *UnblockAccountUC {
+	return &UnblockAccountUC{
+		account:       account,
+		accountRole:   accountRole,
+		authEvent:     authEvent,
+		txManager:     txManager,
+		accountOutbox: accountOutbox,
+	}
+}
+
+func (uc *UnblockAccountUC) Execute(ctx context.Context, in dto.UnblockAccountInput) (dto.UnblockAccountOutput, error) {
+	// Change the status
+	if err := moderateAccount(
+		ctx, uc.txManager, uc.account, uc.accountRole,
+		in.ActorID, in.AccountID, in.Reason,
+		(*model.Account).Unblock,
+		func(ctx context.Context, acc *model.Account) error {
+			// Audit
+			if err := recordModeration(
+				ctx, uc.authEvent, model.AuthEventAccountUnblocked,
+				in.ActorID, acc.ID(), in.Reason,
+			); err != nil {
+				return err
+			}
+
+			// Published by the outbox relay after commit (notify other services)
+			if err := uc.accountOutbox.AddAccountUnblocked(ctx, acc.ID()); err != nil {
+				return ucerrs.Wrap(ucerrs.ErrAddOutboxEventDB, err)
+			}
+			return nil
+		},
+	); err != nil {
+		return dto.UnblockAccountOutput{}, err
+	}
+
+	return dto.UnblockAccountOutput{Unblocked: true}, nil
+}
@@ -0,0 +1,136 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUnblockAccountUC_Execute(t *testing.T) {
+	type adapter struct {
+		account       *mocks.AccountRepository
+		authEvent     *mocks.AuthEventRepository
+		accountRole   *mocks.AccountRoleRepository
+		txManager     *mocks.TransactionManager
+		accountOutbox *mocks.AccountOutbox
+	}
+
+	type testCase struct {
+		name    string
+		prepare func(a adapter)
+		wantErr error
+	}
+
+	var (
+		actorID   = uuid.New()
+		accountID = uuid.New()
+		reason    = "appeal accepted"
+	)
+
+	newAccount := func(status model.AccountStatus) *model.Account {
+		return model.RestoreAccount(
+			accountID, "test@example.com", "hashed",
+			status, true, time.Now(), time.Now(), nil,
+		)
+	}
+
+	// The actor is an admin, the account a regular user
+	outranked := func(a adapter) {
+		a.accountRole.On("Get", mock.Anything, actorID).
+			Return(model.RestoreAccountRole(actorID, []model.Role{model.RoleAdmin}, nil), nil)
+		a.accountRole.On("Get", mock.Anything, accountID).
+			Return(model.RestoreAccountRole(accountID, []model.Role{model.RoleUser}, nil), nil)
+	}
+
+	var tests = []testCase{
+		{
+			name: "Success",
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountBlocked), nil)
+				outranked(a)
+				a.account.On("UpdateStatus", mock.Anything, mock.MatchedBy(func(acc *model.Account) bool {
+					return acc.CanLogin()
+				})).Return(nil)
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.EventType() == model.AuthEventAccountUnblocked
+				})).Return(nil)
+				a.accountOutbox.On("AddAccountUnblocked", mock.Anything, accountID).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Fail - Account Is Not Blocked",
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountActive), nil)
+				outranked(a)
+			},
+			wantErr: ucerrs.ErrInvalidStatusTransition,
+		},
+		{
+			name: "Fail - Account Is Deleted",
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountDeleted), nil)
+				outranked(a)
+			},
+			wantErr: ucerrs.ErrInvalidStatusTransition,
+		},
+		{
+			name: "Fail - Outbox Error",
+			prepare: func(a adapter) {
+				a.account.On("GetByID", mock.Anything, accountID).
+					Return(newAccount(model.AccountBlocked), nil)
+				outranked(a)
+				a.account.On("UpdateStatus", mock.Anything, mock.Anything).Return(nil)
+				a.authEvent.On("Create", mock.Anything, mock.Anything).Return(nil)
+				a.accountOutbox.On("AddAccountUnblocked", mock.Anything, accountID).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrAddOutboxEventDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				account:       mocks.NewAccountRepository(t),
+				authEvent:     mocks.NewAuthEventRepository(t),
+				accountRole:   mocks.NewAccountRoleRepository(t),
+				txManager:     mocks.NewTransactionManager(t),
+				accountOutbox: mocks.NewAccountOutbox(t),
+			}
+
+			allowTx(a.txManager)
+			tt.prepare(a)
+
+			uc := usecase.NewUnblockAccountUC(
+				a.account, a.accountRole, a.authEvent, a.txManager, a.accountOutbox,
+			)
+
+			res, err := uc.Execute(context.Background(), dto.UnblockAccountInput{
+				ActorID: actorID, AccountID: accountID, Reason: reason,
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, res.Unblocked)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, res.Unblocked)
+			}
+		})
+	}
+}
@@ -13,29 +13,45 @@ import (
 type ValidateAccessTokenUC struct {
 	account        port.AccountRepository
 	tokenGenerator port.TokenGenerator
+	denylist       port.AccessTokenDenylist
 }
 
 func NewValidateAccessTokenUC(
 	account port.AccountRepository,
 	tokenGenerator port.TokenGenerator,
+	denylist port.AccessTokenDenylist,
 ) *ValidateAccessTokenUC {
 	return &ValidateAccessTokenUC{
 		account:        account,
 		tokenGenerator: tokenGenerator,
+		denylist:       denylist,
 	}
 }
 
 func (uc *ValidateAccessTokenUC) Execute(ctx context.Context, in dto.ValidateAccessTokenInput) (dto.ValidateAccessTokenOutput, error) {
 	// Parse access token
-	accountID, role, err := uc.tokenGenerator.ValidateAccessToken(
+	claims, err := uc.tokenGenerator.ValidateAccessToken(
 		ctx, in.AccessToken,
 	)
 	if err != nil {
 		return dto.ValidateAccessTokenOutput{}, ucerrs.ErrInvalidAccessToken
 	}
 
+	// Revoked by itself or together with its session
+	for _, key := range claims.DenylistKeys() {
+		denied, err := uc.denylist.Contains(ctx, key)
+		if err != nil {
+			return dto.ValidateAccessTokenOutput{}, ucerrs.Wrap(
+				ucerrs.ErrCheckDenylistDB, err,
+			)
+		}
+		if denied {
+			return dto.ValidateAccessTokenOutput{}, ucerrs.ErrInvalidAccessToken
+		}
+	}
+
 	// Get account and check if it is not active
-	account, err := uc.account.GetByID(ctx, accountID)
+	account, err := uc.account.GetByID(ctx, claims.AccountID)
 	if err != nil {
 		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
 			return dto.ValidateAccessTokenOutput{}, ucerrs.ErrInvalidAccessToken
@@ -49,8 +65,18 @@ func (uc *ValidateAccessTokenUC) Execute(ctx context.Context, in dto.ValidateAcc
 	}
 
 	// Output
-	return dto.ValidateAccessTokenOutput{
-		AccountID: accountID,
-		Role:      role,
-	}, nil
+	out := dto.ValidateAccessTokenOutput{
+		AccountID:   claims.AccountID,
+		Roles:       make([]string, 0, len(claims.Roles)),
+		Permissions: make([]string, 0, len(claims.Permissions)),
+		ActorID:     claims.ActorID,
+	}
+	for _, role := range claims.Roles {
+		out.Roles = append(out.Roles, role.String())
+	}
+	for _, permission := range claims.Permissions {
+		out.Permissions = append(out.Permissions, permission.String())
+	}
+
+	return out, nil
 }
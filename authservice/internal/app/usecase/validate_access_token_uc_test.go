@@ -3,6 +3,7 @@ package usecase_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/maket12/ads-service/authservice/internal/app/dto"
 	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
@@ -20,6 +21,7 @@ func TestValidateAccessTokenUC_Execute(t *testing.T) {
 	type adapter struct {
 		account        *mocks.AccountRepository
 		tokenGenerator *mocks.TokenGenerator
+		denylist       *mocks.AccessTokenDenylist
 	}
 
 	type testCase struct {
@@ -30,7 +32,14 @@ func TestValidateAccessTokenUC_Execute(t *testing.T) {
 	}
 
 	accountID := uuid.New()
-	role := "user"
+	sessionID := uuid.New()
+	claims := &model.AccessTokenClaims{
+		AccountID:   accountID,
+		SessionID:   sessionID,
+		TokenID:     "token-id",
+		Roles:       []model.Role{model.RoleUser, model.RoleModerator},
+		Permissions: []model.Permission{model.PermissionAdsModerate},
+	}
 	accessToken := "valid-access-token"
 
 	activeAcc, _ := model.NewAccount("test@test.com", "hash")
@@ -45,8 +54,9 @@ func TestValidateAccessTokenUC_Execute(t *testing.T) {
 				AccessToken: accessToken,
 			},
 			prepare: func(a adapter) {
-				a.tokenGenerator.On("ValidateAccessTokenInput", mock.Anything, accessToken).
-					Return(accountID, role, nil)
+				a.tokenGenerator.On("ValidateAccessToken", mock.Anything, accessToken).
+					Return(claims, nil)
+				a.denylist.On("Contains", mock.Anything, mock.Anything).Return(false, nil)
 				a.account.On("GetByID", mock.Anything, accountID).
 					Return(activeAcc, nil)
 			},
@@ -58,19 +68,61 @@ func TestValidateAccessTokenUC_Execute(t *testing.T) {
 				AccessToken: "expired-or-fake-token",
 			},
 			prepare: func(a adapter) {
-				a.tokenGenerator.On("ValidateAccessTokenInput", mock.Anything, "expired-or-fake-token").
-					Return(uuid.Nil, "", assert.AnError)
+				a.tokenGenerator.On("ValidateAccessToken", mock.Anything, "expired-or-fake-token").
+					Return(nil, assert.AnError)
+			},
+			wantErr: ucerrs.ErrInvalidAccessToken,
+		},
+		{
+			name: "Fail - Token Revoked",
+			input: dto.ValidateAccessTokenInput{
+				AccessToken: accessToken,
+			},
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateAccessToken", mock.Anything, accessToken).
+					Return(claims, nil)
+				a.denylist.On("Contains", mock.Anything, model.DenylistKeyForToken("token-id")).
+					Return(true, nil)
+			},
+			wantErr: ucerrs.ErrInvalidAccessToken,
+		},
+		{
+			name: "Fail - Session Revoked",
+			input: dto.ValidateAccessTokenInput{
+				AccessToken: accessToken,
+			},
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateAccessToken", mock.Anything, accessToken).
+					Return(claims, nil)
+				a.denylist.On("Contains", mock.Anything, model.DenylistKeyForToken("token-id")).
+					Return(false, nil)
+				a.denylist.On("Contains", mock.Anything, model.DenylistKeyForSession(sessionID)).
+					Return(true, nil)
 			},
 			wantErr: ucerrs.ErrInvalidAccessToken,
 		},
+		{
+			name: "Fail - Denylist Error",
+			input: dto.ValidateAccessTokenInput{
+				AccessToken: accessToken,
+			},
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateAccessToken", mock.Anything, accessToken).
+					Return(claims, nil)
+				a.denylist.On("Contains", mock.Anything, mock.Anything).
+					Return(false, assert.AnError)
+			},
+			wantErr: ucerrs.ErrCheckDenylistDB,
+		},
 		{
 			name: "Fail - account Not Found In DB",
 			input: dto.ValidateAccessTokenInput{
 				AccessToken: accessToken,
 			},
 			prepare: func(a adapter) {
-				a.tokenGenerator.On("ValidateAccessTokenInput", mock.Anything, accessToken).
-					Return(accountID, role, nil)
+				a.tokenGenerator.On("ValidateAccessToken", mock.Anything, accessToken).
+					Return(claims, nil)
+				a.denylist.On("Contains", mock.Anything, mock.Anything).Return(false, nil)
 				a.account.On("GetByID", mock.Anything, accountID).
 					Return(nil, pkgerrs.ErrObjectNotFound)
 			},
@@ -82,8 +134,9 @@ func TestValidateAccessTokenUC_Execute(t *testing.T) {
 				AccessToken: accessToken,
 			},
 			prepare: func(a adapter) {
-				a.tokenGenerator.On("ValidateAccessTokenInput", mock.Anything, accessToken).
-					Return(accountID, role, nil)
+				a.tokenGenerator.On("ValidateAccessToken", mock.Anything, accessToken).
+					Return(claims, nil)
+				a.denylist.On("Contains", mock.Anything, mock.Anything).Return(false, nil)
 				a.account.On("GetByID", mock.Anything, accountID).
 					Return(bannedAcc, nil)
 			},
@@ -95,8 +148,9 @@ func TestValidateAccessTokenUC_Execute(t *testing.T) {
 				AccessToken: accessToken,
 			},
 			prepare: func(a adapter) {
-				a.tokenGenerator.On("ValidateAccessTokenInput", mock.Anything, accessToken).
-					Return(accountID, role, nil)
+				a.tokenGenerator.On("ValidateAccessToken", mock.Anything, accessToken).
+					Return(claims, nil)
+				a.denylist.On("Contains", mock.Anything, mock.Anything).Return(false, nil)
 				a.account.On("GetByID", mock.Anything, accountID).
 					Return(nil, assert.AnError)
 			},
@@ -109,22 +163,56 @@ func TestValidateAccessTokenUC_Execute(t *testing.T) {
 			a := adapter{
 				account:        mocks.NewAccountRepository(t),
 				tokenGenerator: mocks.NewTokenGenerator(t),
+				denylist:       mocks.NewAccessTokenDenylist(t),
 			}
 
 			tt.prepare(a)
 
-			uc := usecase.NewValidateAccessTokenUC(a.account, a.tokenGenerator)
+			uc := usecase.NewValidateAccessTokenUC(
+				a.account, a.tokenGenerator, a.denylist,
+			)
 
 			res, err := uc.Execute(context.Background(), tt.input)
 
 			if tt.wantErr != nil {
 				assert.ErrorIs(t, err, tt.wantErr)
-				assert.Empty(t, res.Role)
+				assert.Empty(t, res.Roles)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, role, res.Role)
+				assert.Equal(t, []string{"user", "moderator"}, res.Roles)
+				assert.Equal(t, []string{"ads:moderate"}, res.Permissions)
 				assert.Equal(t, accountID, res.AccountID)
 			}
 		})
 	}
 }
+
+func TestValidateAccessTokenUC_ReturnsActor(t *testing.T) {
+	accountID := uuid.New()
+	actorID := uuid.New()
+	accountRole := model.RestoreAccountRole(accountID, []model.Role{model.RoleUser}, nil)
+	claims := model.NewImpersonationClaims(accountRole, actorID, time.Minute)
+	claims.TokenID = "token-id"
+
+	activeAcc, _ := model.NewAccount("test@test.com", "hash")
+
+	tokenGenerator := mocks.NewTokenGenerator(t)
+	tokenGenerator.On("ValidateAccessToken", mock.Anything, "impersonation-token").
+		Return(claims, nil)
+	denylist := mocks.NewAccessTokenDenylist(t)
+	denylist.On("Contains", mock.Anything, model.DenylistKeyForToken("token-id")).
+		Return(false, nil)
+	account := mocks.NewAccountRepository(t)
+	account.On("GetByID", mock.Anything, accountID).Return(activeAcc, nil)
+
+	uc := usecase.NewValidateAccessTokenUC(account, tokenGenerator, denylist)
+
+	res, err := uc.Execute(context.Background(), dto.ValidateAccessTokenInput{
+		AccessToken: "impersonation-token",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, accountID, res.AccountID)
+	if assert.NotNil(t, res.ActorID) {
+		assert.Equal(t, actorID, *res.ActorID)
+	}
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+// How precise last_used_at of a key is
+const apiKeyUsagePeriod = time.Minute
+
+type ValidateAPIKeyUC struct {
+	account     port.AccountRepository
+	accountRole port.AccountRoleRepository
+	apiKey      port.APIKeyRepository
+}
+
+func NewValidateAPIKeyUC(
+	account port.AccountRepository,
+	accountRole port.AccountRoleRepository,
+	apiKey port.APIKeyRepository,
+) *ValidateAPIKeyUC {
+	return &ValidateAPIKeyUC{
+		account:     account,
+		accountRole: accountRole,
+		apiKey:      apiKey,
+	}
+}
+
+func (uc *ValidateAPIKeyUC) Execute(ctx context.Context, in dto.ValidateAPIKeyInput) (dto.ValidateAPIKeyOutput, error) {
+	// Find key by its visible prefix
+	prefix, ok := utils.APIKeyPrefix(in.Key)
+	if !ok {
+		return dto.ValidateAPIKeyOutput{}, ucerrs.ErrInvalidAPIKey
+	}
+
+	apiKey, err := uc.apiKey.GetByPrefix(ctx, prefix)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.ValidateAPIKeyOutput{}, ucerrs.ErrInvalidAPIKey
+		}
+		return dto.ValidateAPIKeyOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetAPIKeyDB, err,
+		)
+	}
+
+	// Check the secret and the key state
+	hash := utils.HashToken(in.Key)
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(apiKey.SecretHash())) != 1 {
+		return dto.ValidateAPIKeyOutput{}, ucerrs.ErrInvalidAPIKey
+	}
+	if !apiKey.IsActive() {
+		return dto.ValidateAPIKeyOutput{}, ucerrs.ErrInvalidAPIKey
+	}
+
+	// Get account and check if it is not active
+	account, err := uc.account.GetByID(ctx, apiKey.AccountID())
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.ValidateAPIKeyOutput{}, ucerrs.ErrInvalidAPIKey
+		}
+		return dto.ValidateAPIKeyOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetAccountByIDDB, err,
+		)
+	}
+	if !account.CanLogin() {
+		return dto.ValidateAPIKeyOutput{}, ucerrs.ErrCannotLogin
+	}
+
+	// Scopes are narrowed down to what the account still has
+	accRole, err := uc.accountRole.Get(ctx, account.ID())
+	if err != nil {
+		return dto.ValidateAPIKeyOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetAccountRoleDB, err,
+		)
+	}
+	permissions := apiKey.EffectivePermissions(accRole.Permissions())
+
+	// The last use is written once a period, not on every request
+	if !apiKey.UsedWithin(apiKeyUsagePeriod) {
+		apiKey.MarkUsed()
+		if err := uc.apiKey.MarkUsed(ctx, apiKey); err != nil {
+			return dto.ValidateAPIKeyOutput{}, ucerrs.Wrap(
+				ucerrs.ErrMarkAPIKeyUsedDB, err,
+			)
+		}
+	}
+
+	// Output
+	out := dto.ValidateAPIKeyOutput{
+		AccountID:   account.ID(),
+		KeyID:       apiKey.ID(),
+		Roles:       make([]string, 0, len(accRole.Roles())),
+		Permissions: make([]string, 0, len(permissions)),
+	}
+	for _, role := range accRole.Roles() {
+		out.Roles = append(out.Roles, role.String())
+	}
+	for _, permission := range permissions {
+		out.Permissions = append(out.Permissions, permission.String())
+	}
+
+	return out, nil
+}
@@ -0,0 +1,185 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAPIKeyUC_Execute(t *testing.T) {
+	type adapter struct {
+		account     *mocks.AccountRepository
+		accountRole *mocks.AccountRoleRepository
+		apiKey      *mocks.APIKeyRepository
+	}
+
+	type testCase struct {
+		name            string
+		key             string
+		prepare         func(a adapter)
+		wantPermissions []string
+		wantErr         error
+	}
+
+	account, _ := model.NewAccount("seller@test.com", "hashed_db")
+	blocked, _ := model.NewAccount("blocked@test.com", "hashed_db")
+	_ = blocked.Block()
+
+	// The account lost ads:write after the key was issued
+	accRole := model.RestoreAccountRole(
+		account.ID(),
+		[]model.Role{model.RoleGuest},
+		[]model.Permission{model.PermissionAdsRead},
+	)
+
+	prefix, key, err := utils.GenerateAPIKey()
+	require.NoError(t, err)
+
+	newKey := func(accountID uuid.UUID) *model.APIKey {
+		apiKey, err := model.NewAPIKey(
+			accountID, "ci", prefix, utils.HashToken(key),
+			[]model.Permission{model.PermissionAdsRead, model.PermissionAdsWrite}, nil,
+		)
+		require.NoError(t, err)
+		return apiKey
+	}
+
+	activeKey := newKey(account.ID())
+	revokedKey := newKey(account.ID())
+	_ = revokedKey.Revoke()
+	expiredAt := time.Now().Add(-time.Hour)
+	expiredKey := model.RestoreAPIKey(
+		activeKey.ID(), account.ID(), "ci", prefix, utils.HashToken(key),
+		activeKey.Scopes(), time.Now().Add(-time.Hour*2), &expiredAt, nil, nil,
+	)
+	blockedKey := newKey(blocked.ID())
+	usedAt := time.Now().Add(-time.Second * 10)
+	recentlyUsedKey := model.RestoreAPIKey(
+		activeKey.ID(), account.ID(), "ci", prefix, utils.HashToken(key),
+		activeKey.Scopes(), time.Now().Add(-time.Hour), nil, &usedAt, nil,
+	)
+
+	var tests = []testCase{
+		{
+			name: "Success",
+			key:  key,
+			prepare: func(a adapter) {
+				a.apiKey.On("GetByPrefix", mock.Anything, prefix).Return(activeKey, nil)
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.accountRole.On("Get", mock.Anything, account.ID()).Return(accRole, nil)
+				a.apiKey.On("MarkUsed", mock.Anything, mock.MatchedBy(func(k *model.APIKey) bool {
+					return k.LastUsedAt() != nil
+				})).Return(nil)
+			},
+			wantPermissions: []string{"ads:read"},
+			wantErr:         nil,
+		},
+		{
+			name: "Success - Recent Use Is Not Written Again",
+			key:  key,
+			prepare: func(a adapter) {
+				a.apiKey.On("GetByPrefix", mock.Anything, prefix).Return(recentlyUsedKey, nil)
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.accountRole.On("Get", mock.Anything, account.ID()).Return(accRole, nil)
+			},
+			wantPermissions: []string{"ads:read"},
+			wantErr:         nil,
+		},
+		{
+			name:    "Fail - Malformed Key",
+			key:     "not-a-key",
+			prepare: func(a adapter) {},
+			wantErr: ucerrs.ErrInvalidAPIKey,
+		},
+		{
+			name: "Fail - Unknown Prefix",
+			key:  key,
+			prepare: func(a adapter) {
+				a.apiKey.On("GetByPrefix", mock.Anything, prefix).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+			wantErr: ucerrs.ErrInvalidAPIKey,
+		},
+		{
+			name: "Fail - Wrong Secret",
+			key:  prefix + "_wrong-secret",
+			prepare: func(a adapter) {
+				a.apiKey.On("GetByPrefix", mock.Anything, prefix).Return(activeKey, nil)
+			},
+			wantErr: ucerrs.ErrInvalidAPIKey,
+		},
+		{
+			name: "Fail - Revoked",
+			key:  key,
+			prepare: func(a adapter) {
+				a.apiKey.On("GetByPrefix", mock.Anything, prefix).Return(revokedKey, nil)
+			},
+			wantErr: ucerrs.ErrInvalidAPIKey,
+		},
+		{
+			name: "Fail - Expired",
+			key:  key,
+			prepare: func(a adapter) {
+				a.apiKey.On("GetByPrefix", mock.Anything, prefix).Return(expiredKey, nil)
+			},
+			wantErr: ucerrs.ErrInvalidAPIKey,
+		},
+		{
+			name: "Fail - Account Blocked",
+			key:  key,
+			prepare: func(a adapter) {
+				a.apiKey.On("GetByPrefix", mock.Anything, prefix).Return(blockedKey, nil)
+				a.account.On("GetByID", mock.Anything, blocked.ID()).Return(blocked, nil)
+			},
+			wantErr: ucerrs.ErrCannotLogin,
+		},
+		{
+			name: "Fail - DB Error On Lookup",
+			key:  key,
+			prepare: func(a adapter) {
+				a.apiKey.On("GetByPrefix", mock.Anything, prefix).Return(nil, assert.AnError)
+			},
+			wantErr: ucerrs.ErrGetAPIKeyDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				account:     mocks.NewAccountRepository(t),
+				accountRole: mocks.NewAccountRoleRepository(t),
+				apiKey:      mocks.NewAPIKeyRepository(t),
+			}
+
+			tt.prepare(a)
+
+			uc := usecase.NewValidateAPIKeyUC(a.account, a.accountRole, a.apiKey)
+
+			res, err := uc.Execute(context.Background(), dto.ValidateAPIKeyInput{Key: tt.key})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, res.Permissions)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, account.ID(), res.AccountID)
+				assert.Equal(t, activeKey.ID(), res.KeyID)
+				assert.Equal(t, []string{"guest"}, res.Roles)
+				assert.Equal(t, tt.wantPermissions, res.Permissions)
+			}
+		})
+	}
+}
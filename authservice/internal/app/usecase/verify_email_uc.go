@@ -1,6 +1,87 @@
 package usecase
 
-// VerifyEmailUC TODO: Implement it
+import (
+	"context"
+	"errors"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
 type VerifyEmailUC struct {
-	// TODO: Implement UseCase
+	account           port.AccountRepository
+	verificationToken port.EmailVerificationTokenRepository
+}
+
+func NewVerifyEmailUC(
+	account port.AccountRepository,
+	verificationToken port.EmailVerificationTokenRepository,
+) *VerifyEmailUC {
+	return &VerifyEmailUC{
+		account:           account,
+		verificationToken: verificationToken,
+	}
+}
+
+func (uc *VerifyEmailUC) Execute(ctx context.Context, in dto.VerifyEmailInput) (dto.VerifyEmailOutput, error) {
+	// Find token
+	token, err := uc.verificationToken.GetByHash(ctx, utils.HashToken(in.Token))
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.VerifyEmailOutput{}, ucerrs.ErrInvalidVerificationToken
+		}
+		return dto.VerifyEmailOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetVerificationTokenDB, err,
+		)
+	}
+
+	// Validate and consume
+	if !token.IsActive() {
+		return dto.VerifyEmailOutput{}, ucerrs.ErrInvalidVerificationToken
+	}
+
+	if err := token.Use(); err != nil {
+		return dto.VerifyEmailOutput{}, ucerrs.ErrInvalidVerificationToken
+	}
+
+	if err := uc.verificationToken.MarkUsed(ctx, token); err != nil {
+		if errors.Is(err, model.ErrTokenAlreadyUsed) {
+			return dto.VerifyEmailOutput{}, ucerrs.ErrInvalidVerificationToken
+		}
+		return dto.VerifyEmailOutput{}, ucerrs.Wrap(
+			ucerrs.ErrUseVerificationTokenDB, err,
+		)
+	}
+
+	// Find account
+	account, err := uc.account.GetByID(ctx, token.AccountID())
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.VerifyEmailOutput{}, ucerrs.ErrInvalidVerificationToken
+		}
+		return dto.VerifyEmailOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetAccountByIDDB, err,
+		)
+	}
+
+	if !account.CanLogin() {
+		return dto.VerifyEmailOutput{}, ucerrs.ErrCannotLogin
+	}
+
+	// Update account
+	if !account.EmailVerified() {
+		account.VerifyEmail()
+		if err := uc.account.VerifyEmail(ctx, account); err != nil {
+			return dto.VerifyEmailOutput{}, ucerrs.Wrap(
+				ucerrs.ErrUpdateAccountDB, err,
+			)
+		}
+	}
+
+	// Output
+	return dto.VerifyEmailOutput{Verified: true}, nil
 }
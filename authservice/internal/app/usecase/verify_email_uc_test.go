@@ -0,0 +1,145 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestVerifyEmailUC_Execute(t *testing.T) {
+	type adapter struct {
+		account           *mocks.AccountRepository
+		verificationToken *mocks.EmailVerificationTokenRepository
+	}
+
+	type testCase struct {
+		name    string
+		input   dto.VerifyEmailInput
+		prepare func(a adapter)
+		wantErr error
+	}
+
+	rawToken := "raw-verification-token"
+	hashedToken := utils.HashToken(rawToken)
+
+	account, _ := model.NewAccount("user@test.com", "hashed_db")
+
+	newToken := func() *model.EmailVerificationToken {
+		tk, _ := model.NewEmailVerificationToken(account.ID(), hashedToken, time.Hour)
+		return tk
+	}
+
+	var tests = []testCase{
+		{
+			name:  "Success",
+			input: dto.VerifyEmailInput{Token: rawToken},
+			prepare: func(a adapter) {
+				a.verificationToken.On("GetByHash", mock.Anything, hashedToken).
+					Return(newToken(), nil)
+				a.verificationToken.On("MarkUsed", mock.Anything, mock.MatchedBy(func(tk *model.EmailVerificationToken) bool {
+					return tk.IsUsed()
+				})).Return(nil)
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+				a.account.On("VerifyEmail", mock.Anything, mock.MatchedBy(func(acc *model.Account) bool {
+					return acc.EmailVerified()
+				})).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Fail - Token Not Found",
+			input: dto.VerifyEmailInput{Token: "unknown"},
+			prepare: func(a adapter) {
+				a.verificationToken.On("GetByHash", mock.Anything, utils.HashToken("unknown")).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+			wantErr: ucerrs.ErrInvalidVerificationToken,
+		},
+		{
+			name:  "Fail - Token Expired",
+			input: dto.VerifyEmailInput{Token: rawToken},
+			prepare: func(a adapter) {
+				expired := model.RestoreEmailVerificationToken(
+					uuid.New(), account.ID(), hashedToken,
+					time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour), nil,
+				)
+				a.verificationToken.On("GetByHash", mock.Anything, hashedToken).
+					Return(expired, nil)
+			},
+			wantErr: ucerrs.ErrInvalidVerificationToken,
+		},
+		{
+			name:  "Fail - Token Already Used",
+			input: dto.VerifyEmailInput{Token: rawToken},
+			prepare: func(a adapter) {
+				usedAt := time.Now()
+				used := model.RestoreEmailVerificationToken(
+					uuid.New(), account.ID(), hashedToken,
+					time.Now(), time.Now().Add(time.Hour), &usedAt,
+				)
+				a.verificationToken.On("GetByHash", mock.Anything, hashedToken).
+					Return(used, nil)
+			},
+			wantErr: ucerrs.ErrInvalidVerificationToken,
+		},
+		{
+			name:  "Fail - Concurrent Use",
+			input: dto.VerifyEmailInput{Token: rawToken},
+			prepare: func(a adapter) {
+				a.verificationToken.On("GetByHash", mock.Anything, hashedToken).
+					Return(newToken(), nil)
+				a.verificationToken.On("MarkUsed", mock.Anything, mock.Anything).
+					Return(model.ErrTokenAlreadyUsed)
+			},
+			wantErr: ucerrs.ErrInvalidVerificationToken,
+		},
+		{
+			name:  "Fail - DB Error On Account Update",
+			input: dto.VerifyEmailInput{Token: rawToken},
+			prepare: func(a adapter) {
+				unverified, _ := model.NewAccount("user@test.com", "hashed_db")
+				a.verificationToken.On("GetByHash", mock.Anything, hashedToken).
+					Return(newToken(), nil)
+				a.verificationToken.On("MarkUsed", mock.Anything, mock.Anything).Return(nil)
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(unverified, nil)
+				a.account.On("VerifyEmail", mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrUpdateAccountDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				account:           mocks.NewAccountRepository(t),
+				verificationToken: mocks.NewEmailVerificationTokenRepository(t),
+			}
+
+			tt.prepare(a)
+
+			uc := usecase.NewVerifyEmailUC(a.account, a.verificationToken)
+
+			res, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, res.Verified)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, res.Verified)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type VerifyMFAUC struct {
+	account        port.AccountRepository
+	totpFactor     port.TOTPFactorRepository
+	recoveryCode   port.RecoveryCodeRepository
+	loginAttempt   port.LoginAttemptRepository
+	totpProvider   port.TOTPProvider
+	secretCipher   port.SecretCipher
+	tokenGenerator port.TokenGenerator
+	authEvent      port.AuthEventRepository
+	sessions       *sessionIssuer
+
+	throttle *model.LoginThrottle
+}
+
+func NewVerifyMFAUC(
+	account port.AccountRepository,
+	accountRole port.AccountRoleRepository,
+	refreshSession port.RefreshSessionRepository,
+	totpFactor port.TOTPFactorRepository,
+	recoveryCode port.RecoveryCodeRepository,
+	loginAttempt port.LoginAttemptRepository,
+	totpProvider port.TOTPProvider,
+	secretCipher port.SecretCipher,
+	tokenGenerator port.TokenGenerator,
+	authEvent port.AuthEventRepository,
+	txManager port.TransactionManager,
+	accountOutbox port.AccountOutbox,
+	throttle *model.LoginThrottle,
+	sessionLimit *model.SessionLimitPolicy,
+	newDeviceWindow time.Duration,
+	refreshSessionTTL time.Duration,
+) *VerifyMFAUC {
+	return &VerifyMFAUC{
+		account:        account,
+		totpFactor:     totpFactor,
+		recoveryCode:   recoveryCode,
+		loginAttempt:   loginAttempt,
+		totpProvider:   totpProvider,
+		secretCipher:   secretCipher,
+		tokenGenerator: tokenGenerator,
+		authEvent:      authEvent,
+		throttle:       throttle,
+		sessions: newSessionIssuer(
+			txManager, account, accountRole, refreshSession,
+			tokenGenerator, authEvent, accountOutbox,
+			sessionLimit, newDeviceWindow, refreshSessionTTL,
+		),
+	}
+}
+
+// Accepts a TOTP code first, otherwise tries to spend a recovery code.
+// A secret that can not be decrypted must not lock the owner out,
+// recovery codes still work then
+func (uc *VerifyMFAUC) checkCode(
+	ctx context.Context, factor *model.TOTPFactor, accountID uuid.UUID, code string,
+) (bool, error) {
+	secret, decryptErr := uc.secretCipher.Decrypt(factor.EncryptedSecret())
+	if decryptErr == nil {
+		if step, ok := uc.totpProvider.Validate(secret, code, time.Now()); ok {
+			return uc.useStep(ctx, factor, step)
+		}
+	}
+
+	codeHash := utils.HashToken(utils.NormalizeRecoveryCode(code))
+	if err := uc.recoveryCode.Use(ctx, accountID, codeHash); err != nil {
+		if !errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return false, ucerrs.Wrap(ucerrs.ErrUseRecoveryCodeDB, err)
+		}
+		if decryptErr != nil {
+			return false, ucerrs.Wrap(ucerrs.ErrDecryptSecret, decryptErr)
+		}
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// A code is accepted only once, it can not be replayed within its window
+func (uc *VerifyMFAUC) useStep(ctx context.Context, factor *model.TOTPFactor, step int64) (bool, error) {
+	if err := factor.UseStep(step); err != nil {
+		return false, nil
+	}
+	if err := uc.totpFactor.UseStep(ctx, factor); err != nil {
+		if errors.Is(err, model.ErrTOTPCodeReused) {
+			return false, nil
+		}
+		return false, ucerrs.Wrap(ucerrs.ErrUseTOTPStepDB, err)
+	}
+	return true, nil
+}
+
+func (uc *VerifyMFAUC) Execute(ctx context.Context, in dto.VerifyMFAInput) (dto.VerifyMFAOutput, error) {
+	// The challenge proves the password step has passed
+	accountID, err := uc.tokenGenerator.ValidateMFAToken(ctx, in.MFAToken)
+	if err != nil {
+		return dto.VerifyMFAOutput{}, ucerrs.ErrInvalidMFAToken
+	}
+
+	// Six digits are easy to guess, so they are throttled as well
+	var (
+		keys = []string{model.LoginKeyForMFA(accountID)}
+		now  = time.Now()
+	)
+	if err := checkLoginThrottle(
+		ctx, uc.loginAttempt, uc.throttle, keys, now,
+	); err != nil {
+		return dto.VerifyMFAOutput{}, err
+	}
+
+	// Find the factor
+	factor, err := uc.totpFactor.GetByAccountID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.VerifyMFAOutput{}, ucerrs.ErrMFANotEnrolled
+		}
+		return dto.VerifyMFAOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetTOTPFactorDB, err,
+		)
+	}
+
+	if !factor.IsConfirmed() {
+		return dto.VerifyMFAOutput{}, ucerrs.ErrMFANotEnrolled
+	}
+
+	ok, err := uc.checkCode(ctx, factor, accountID, in.Code)
+	if err != nil {
+		return dto.VerifyMFAOutput{}, err
+	}
+	if !ok {
+		if err := recordAuthEvent(
+			ctx, uc.authEvent, accountID, model.AuthEventLogin, model.AuthEventFailure,
+			nil, in.IP, in.UserAgent,
+			map[string]string{"method": loginMethodMFA, "reason": "invalid code"},
+		); err != nil {
+			return dto.VerifyMFAOutput{}, err
+		}
+		return dto.VerifyMFAOutput{}, recordLoginFailure(
+			ctx, uc.loginAttempt, uc.throttle, keys, now,
+			ucerrs.ErrInvalidMFACode,
+		)
+	}
+
+	if err := clearLoginFailures(ctx, uc.loginAttempt, keys); err != nil {
+		return dto.VerifyMFAOutput{}, err
+	}
+
+	// The account might have been blocked after the password step
+	account, err := uc.account.GetByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, pkgerrs.ErrObjectNotFound) {
+			return dto.VerifyMFAOutput{}, ucerrs.ErrInvalidAccountID
+		}
+		return dto.VerifyMFAOutput{}, ucerrs.Wrap(
+			ucerrs.ErrGetAccountByIDDB, err,
+		)
+	}
+
+	if !account.CanLogin() {
+		return dto.VerifyMFAOutput{}, ucerrs.ErrCannotLogin
+	}
+
+	tokens, err := uc.sessions.issue(
+		ctx, account, loginMethodMFA, in.IP, in.UserAgent,
+	)
+	if err != nil {
+		return dto.VerifyMFAOutput{}, err
+	}
+
+	// Output
+	return dto.VerifyMFAOutput{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
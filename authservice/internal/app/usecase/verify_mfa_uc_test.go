@@ -0,0 +1,271 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/app/dto"
+	ucerrs "github.com/maket12/ads-service/authservice/internal/app/errs"
+	"github.com/maket12/ads-service/authservice/internal/app/usecase"
+	"github.com/maket12/ads-service/authservice/internal/app/utils"
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	"github.com/maket12/ads-service/authservice/internal/domain/port/mocks"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestVerifyMFAUC_Execute(t *testing.T) {
+	type adapter struct {
+		account        *mocks.AccountRepository
+		accountRole    *mocks.AccountRoleRepository
+		refreshSession *mocks.RefreshSessionRepository
+		totpFactor     *mocks.TOTPFactorRepository
+		recoveryCode   *mocks.RecoveryCodeRepository
+		loginAttempt   *mocks.LoginAttemptRepository
+		totpProvider   *mocks.TOTPProvider
+		secretCipher   *mocks.SecretCipher
+		tokenGenerator *mocks.TokenGenerator
+		authEvent      *mocks.AuthEventRepository
+		txManager      *mocks.TransactionManager
+	}
+
+	type testCase struct {
+		name    string
+		input   dto.VerifyMFAInput
+		prepare func(a adapter)
+		wantErr error
+	}
+
+	var (
+		ttl         = time.Hour * 24
+		mfaToken    = "mfa_token_val"
+		code        = "123456"
+		recovery    = "ABCDE-FGHIJ"
+		account, _  = model.NewAccount("seller@test.com", "hashed_db")
+		role, _     = model.NewAccountRole(account.ID())
+		mfaKey      = model.LoginKeyForMFA(account.ID())
+		throttle, _ = model.NewLoginThrottle(time.Minute*15, 3, 10, time.Second, time.Minute*15)
+		step        = int64(59000000)
+
+		noSessionLimit, _ = model.NewSessionLimitPolicy("evict_oldest", nil)
+	)
+
+	loadFactor := func(a adapter) {
+		confirmed, _ := model.NewTOTPFactor(account.ID(), "sealed")
+		_ = confirmed.Confirm()
+
+		a.tokenGenerator.On("ValidateMFAToken", mock.Anything, mfaToken).
+			Return(account.ID(), nil)
+		a.loginAttempt.On("GetFailures", mock.Anything, mfaKey, mock.Anything).
+			Return(0, time.Time{}, nil)
+		a.totpFactor.On("GetByAccountID", mock.Anything, account.ID()).
+			Return(confirmed, nil)
+	}
+	passChallenge := func(a adapter) {
+		loadFactor(a)
+		a.secretCipher.On("Decrypt", "sealed").Return("SECRET", nil)
+	}
+	useStep := func(a adapter, err error) {
+		a.totpFactor.On("UseStep", mock.Anything, mock.MatchedBy(func(f *model.TOTPFactor) bool {
+			return f.LastUsedStep() != nil && *f.LastUsedStep() == step
+		})).Return(err)
+	}
+	issueTokens := func(a adapter) {
+		a.loginAttempt.On("Clear", mock.Anything, mfaKey).Return(nil)
+		a.account.On("GetByID", mock.Anything, account.ID()).Return(account, nil)
+		a.account.On("MarkLogin", mock.Anything, mock.Anything).Return(nil)
+		a.accountRole.On("Get", mock.Anything, account.ID()).Return(role, nil)
+		a.tokenGenerator.On("GenerateAccessToken", mock.Anything, mock.MatchedBy(func(c *model.AccessTokenClaims) bool {
+			return c.AccountID == account.ID() && c.Roles[0] == model.RoleUser
+		})).
+			Return("access_token_val", nil)
+		a.tokenGenerator.On("GenerateRefreshToken", mock.Anything, account.ID(), mock.Anything).
+			Return("refresh_token_val", nil)
+		a.refreshSession.On("Create", mock.Anything, mock.Anything).Return(nil)
+		a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+			return e.EventType() == model.AuthEventLogin &&
+				e.Outcome() == model.AuthEventSuccess && e.Metadata()["method"] == "mfa"
+		})).Return(nil)
+	}
+
+	var tests = []testCase{
+		{
+			name:  "Success - TOTP Code",
+			input: dto.VerifyMFAInput{MFAToken: mfaToken, Code: code},
+			prepare: func(a adapter) {
+				passChallenge(a)
+				a.totpProvider.On("Validate", "SECRET", code, mock.Anything).Return(step, true)
+				useStep(a, nil)
+				issueTokens(a)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Success - Recovery Code",
+			input: dto.VerifyMFAInput{MFAToken: mfaToken, Code: recovery},
+			prepare: func(a adapter) {
+				passChallenge(a)
+				a.totpProvider.On("Validate", "SECRET", recovery, mock.Anything).Return(int64(0), false)
+				a.recoveryCode.On("Use", mock.Anything, account.ID(), utils.HashToken("abcdefghij")).
+					Return(nil)
+				issueTokens(a)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Success - Recovery Code When Secret Can Not Be Decrypted",
+			input: dto.VerifyMFAInput{MFAToken: mfaToken, Code: recovery},
+			prepare: func(a adapter) {
+				loadFactor(a)
+				a.secretCipher.On("Decrypt", "sealed").Return("", assert.AnError)
+				a.recoveryCode.On("Use", mock.Anything, account.ID(), utils.HashToken("abcdefghij")).
+					Return(nil)
+				issueTokens(a)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Fail - Invalid Challenge",
+			input: dto.VerifyMFAInput{MFAToken: "forged", Code: code},
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateMFAToken", mock.Anything, "forged").
+					Return(account.ID(), assert.AnError)
+			},
+			wantErr: ucerrs.ErrInvalidMFAToken,
+		},
+		{
+			name:  "Fail - Too Many Attempts",
+			input: dto.VerifyMFAInput{MFAToken: mfaToken, Code: code},
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateMFAToken", mock.Anything, mfaToken).
+					Return(account.ID(), nil)
+				a.loginAttempt.On("GetFailures", mock.Anything, mfaKey, mock.Anything).
+					Return(10, time.Now(), nil)
+			},
+			wantErr: ucerrs.ErrTooManyLoginAttempts,
+		},
+		{
+			name:  "Fail - Wrong Code",
+			input: dto.VerifyMFAInput{MFAToken: mfaToken, Code: code},
+			prepare: func(a adapter) {
+				passChallenge(a)
+				a.totpProvider.On("Validate", "SECRET", code, mock.Anything).Return(int64(0), false)
+				a.recoveryCode.On("Use", mock.Anything, account.ID(), mock.Anything).
+					Return(pkgerrs.ErrObjectNotFound)
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.Outcome() == model.AuthEventFailure && e.AccountID() == account.ID()
+				})).Return(nil)
+				a.loginAttempt.On("RecordFailure", mock.Anything, mfaKey, mock.Anything, time.Minute*15).
+					Return(nil)
+			},
+			wantErr: ucerrs.ErrInvalidMFACode,
+		},
+		{
+			name:  "Fail - TOTP Code Reused",
+			input: dto.VerifyMFAInput{MFAToken: mfaToken, Code: code},
+			prepare: func(a adapter) {
+				passChallenge(a)
+				a.totpProvider.On("Validate", "SECRET", code, mock.Anything).Return(step, true)
+				useStep(a, model.ErrTOTPCodeReused)
+				a.authEvent.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuthEvent) bool {
+					return e.Outcome() == model.AuthEventFailure && e.AccountID() == account.ID()
+				})).Return(nil)
+				a.loginAttempt.On("RecordFailure", mock.Anything, mfaKey, mock.Anything, time.Minute*15).
+					Return(nil)
+			},
+			wantErr: ucerrs.ErrInvalidMFACode,
+		},
+		{
+			name:  "Fail - Secret Can Not Be Decrypted",
+			input: dto.VerifyMFAInput{MFAToken: mfaToken, Code: code},
+			prepare: func(a adapter) {
+				loadFactor(a)
+				a.secretCipher.On("Decrypt", "sealed").Return("", assert.AnError)
+				a.recoveryCode.On("Use", mock.Anything, account.ID(), mock.Anything).
+					Return(pkgerrs.ErrObjectNotFound)
+			},
+			wantErr: ucerrs.ErrDecryptSecret,
+		},
+		{
+			name:  "Fail - Not Enrolled",
+			input: dto.VerifyMFAInput{MFAToken: mfaToken, Code: code},
+			prepare: func(a adapter) {
+				a.tokenGenerator.On("ValidateMFAToken", mock.Anything, mfaToken).
+					Return(account.ID(), nil)
+				a.loginAttempt.On("GetFailures", mock.Anything, mfaKey, mock.Anything).
+					Return(0, time.Time{}, nil)
+				a.totpFactor.On("GetByAccountID", mock.Anything, account.ID()).
+					Return(nil, pkgerrs.ErrObjectNotFound)
+			},
+			wantErr: ucerrs.ErrMFANotEnrolled,
+		},
+		{
+			name:  "Fail - Account Blocked Meanwhile",
+			input: dto.VerifyMFAInput{MFAToken: mfaToken, Code: code},
+			prepare: func(a adapter) {
+				blocked, _ := model.NewAccount("seller@test.com", "hashed_db")
+				_ = blocked.Block()
+
+				passChallenge(a)
+				a.totpProvider.On("Validate", "SECRET", code, mock.Anything).Return(step, true)
+				useStep(a, nil)
+				a.loginAttempt.On("Clear", mock.Anything, mfaKey).Return(nil)
+				a.account.On("GetByID", mock.Anything, account.ID()).Return(blocked, nil)
+			},
+			wantErr: ucerrs.ErrCannotLogin,
+		},
+		{
+			name:  "Fail - DB Error On Recovery Code",
+			input: dto.VerifyMFAInput{MFAToken: mfaToken, Code: recovery},
+			prepare: func(a adapter) {
+				passChallenge(a)
+				a.totpProvider.On("Validate", "SECRET", recovery, mock.Anything).Return(int64(0), false)
+				a.recoveryCode.On("Use", mock.Anything, account.ID(), mock.Anything).
+					Return(assert.AnError)
+			},
+			wantErr: ucerrs.ErrUseRecoveryCodeDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := adapter{
+				account:        mocks.NewAccountRepository(t),
+				accountRole:    mocks.NewAccountRoleRepository(t),
+				refreshSession: mocks.NewRefreshSessionRepository(t),
+				totpFactor:     mocks.NewTOTPFactorRepository(t),
+				recoveryCode:   mocks.NewRecoveryCodeRepository(t),
+				loginAttempt:   mocks.NewLoginAttemptRepository(t),
+				totpProvider:   mocks.NewTOTPProvider(t),
+				secretCipher:   mocks.NewSecretCipher(t),
+				tokenGenerator: mocks.NewTokenGenerator(t),
+				authEvent:      mocks.NewAuthEventRepository(t),
+				txManager:      mocks.NewTransactionManager(t),
+			}
+
+			allowTx(a.txManager)
+			tt.prepare(a)
+
+			uc := usecase.NewVerifyMFAUC(
+				a.account, a.accountRole, a.refreshSession,
+				a.totpFactor, a.recoveryCode, a.loginAttempt,
+				a.totpProvider, a.secretCipher, a.tokenGenerator, a.authEvent, a.txManager,
+				nil, throttle, noSessionLimit, 0, ttl,
+			)
+
+			res, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, res.AccessToken)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "access_token_val", res.AccessToken)
+				assert.Equal(t, "refresh_token_val", res.RefreshToken)
+			}
+		})
+	}
+}
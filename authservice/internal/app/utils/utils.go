@@ -1,8 +1,13 @@
 package utils
 
 import (
+	"crypto/rand"
 	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
 	"encoding/hex"
+	"net/url"
+	"strings"
 )
 
 func HashToken(token string) string {
@@ -10,14 +15,76 @@ func HashToken(token string) string {
 	return hex.EncodeToString(hash[:])
 }
 
-func ComparePtr(s1, s2 *string) bool {
-	var v1, v2 string
-	if s1 != nil {
-		v1 = *s1
+// GenerateToken Generates url-safe random token (256 bits of entropy)
+func GenerateToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
 	}
-	if s2 != nil {
-		v2 = *s2
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// PKCEChallenge Derives the S256 code challenge from a PKCE verifier (RFC 7636)
+func PKCEChallenge(verifier string) string {
+	hash := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// GenerateRecoveryCode Generates a human friendly one-time code
+// like "k3j7q-ab2xz" (50 bits of entropy)
+func GenerateRecoveryCode() (string, error) {
+	b := make([]byte, 7)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	code := strings.ToLower(base32.StdEncoding.EncodeToString(b))[:10]
+	return code[:5] + "-" + code[5:], nil
+}
+
+// APIKeyPrefixLen is the length of the visible part of an API key, "ak_" and 8 chars
+const APIKeyPrefixLen = 11
+
+// GenerateAPIKey Generates an API key like "ak_k3j7qab2_<secret>",
+// the prefix is unique per key and is stored in clear to look the key up
+func GenerateAPIKey() (prefix, key string, err error) {
+	b := make([]byte, 5)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
 	}
+	secret, err := GenerateToken()
+	if err != nil {
+		return "", "", err
+	}
+
+	prefix = "ak_" + strings.ToLower(base32.StdEncoding.EncodeToString(b))
+	return prefix, prefix + "_" + secret, nil
+}
+
+// APIKeyPrefix Extracts the visible prefix of a presented API key
+func APIKeyPrefix(key string) (string, bool) {
+	if len(key) <= APIKeyPrefixLen+1 || !strings.HasPrefix(key, "ak_") || key[APIKeyPrefixLen] != '_' {
+		return "", false
+	}
+	return key[:APIKeyPrefixLen], true
+}
+
+// NormalizeRecoveryCode Makes a typed recovery code comparable,
+// case and separators are ignored
+func NormalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+// BuildTokenLink Appends token as a query parameter to the base url
+func BuildTokenLink(baseURL, token string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return baseURL + "?token=" + url.QueryEscape(token)
+	}
+
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
 
-	return v1 == v2
+	return u.String()
 }
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccessTokenClaims is what an access token asserts about its bearer,
+// permissions are resolved at issue time so services do not need the db
+type AccessTokenClaims struct {
+	AccountID   uuid.UUID
+	SessionID   uuid.UUID // refresh session the token was issued for
+	Roles       []Role
+	Permissions []Permission
+	ActorID     *uuid.UUID // admin acting on behalf of the account, nil for own tokens
+
+	// Set by the token generator when a token is validated, an ExpiresAt
+	// set before issuing may only shorten the regular lifetime
+	TokenID   string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+func NewAccessTokenClaims(accountRole *AccountRole, sessionID uuid.UUID) *AccessTokenClaims {
+	return &AccessTokenClaims{
+		AccountID:   accountRole.AccountID(),
+		SessionID:   sessionID,
+		Roles:       accountRole.Roles(),
+		Permissions: accountRole.Permissions(),
+	}
+}
+
+// NewImpersonationClaims describes a token the actor uses on behalf of the
+// account, it is not bound to a session since it can not be refreshed
+func NewImpersonationClaims(accountRole *AccountRole, actorID uuid.UUID, ttl time.Duration) *AccessTokenClaims {
+	claims := NewAccessTokenClaims(accountRole, uuid.Nil)
+	claims.ActorID = &actorID
+	claims.ExpiresAt = time.Now().Add(ttl)
+	return claims
+}
+
+func (c *AccessTokenClaims) IsImpersonated() bool {
+	return c.ActorID != nil
+}
+
+func (c *AccessTokenClaims) HasPermission(permission Permission) bool {
+	return slices.Contains(c.Permissions, permission)
+}
+
+// DenylistKeys returns the keys a revocation of this token may be stored
+// under, tokens issued before jti and sid were added have none
+func (c *AccessTokenClaims) DenylistKeys() []string {
+	var keys []string
+	if c.TokenID != "" {
+		keys = append(keys, DenylistKeyForToken(c.TokenID))
+	}
+	if c.SessionID != uuid.Nil {
+		keys = append(keys, DenylistKeyForSession(c.SessionID))
+	}
+	return keys
+}
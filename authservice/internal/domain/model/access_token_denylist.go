@@ -0,0 +1,18 @@
+package model
+
+import "github.com/google/uuid"
+
+// Denylist keys, a single token is revoked by its jti and every
+// token of a refresh session by the session id
+const (
+	denylistKeyTokenPrefix   = "jti:"
+	denylistKeySessionPrefix = "sid:"
+)
+
+func DenylistKeyForToken(tokenID string) string {
+	return denylistKeyTokenPrefix + tokenID
+}
+
+func DenylistKeyForSession(sessionID uuid.UUID) string {
+	return denylistKeySessionPrefix + sessionID.String()
+}
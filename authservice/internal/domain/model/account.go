@@ -1,6 +1,8 @@
 package model
 
 import (
+	"errors"
+	"strings"
 	"time"
 
 	pkgerrs "github.com/maket12/ads-service/pkg/errs"
@@ -8,14 +10,30 @@ import (
 	"github.com/google/uuid"
 )
 
+var (
+	ErrStatusTransition = errors.New("account status can not be changed this way")
+)
+
 type AccountStatus string
 
+func (s AccountStatus) String() string { return string(s) }
+
 const (
 	AccountActive  AccountStatus = "active"
 	AccountBlocked AccountStatus = "blocked"
 	AccountDeleted AccountStatus = "deleted"
 )
 
+func ParseAccountStatus(rawStatus string) (AccountStatus, error) {
+	status := AccountStatus(strings.ToLower(strings.TrimSpace(rawStatus)))
+	switch status {
+	case AccountActive, AccountBlocked, AccountDeleted:
+		return status, nil
+	default:
+		return "", pkgerrs.NewValueInvalidError("status")
+	}
+}
+
 // ================ Rich model for account ================
 
 type Account struct {
@@ -83,14 +101,34 @@ func (a *Account) IsDeleted() bool { return a.status == AccountDeleted }
 
 // ================ Mutation ================
 
-func (a *Account) Block() {
+// Block is allowed only for active accounts
+func (a *Account) Block() error {
+	if a.status != AccountActive {
+		return ErrStatusTransition
+	}
 	a.status = AccountBlocked
 	a.updatedAt = time.Now()
+	return nil
 }
 
-func (a *Account) Delete() {
+// Unblock is allowed only for blocked accounts
+func (a *Account) Unblock() error {
+	if a.status != AccountBlocked {
+		return ErrStatusTransition
+	}
+	a.status = AccountActive
+	a.updatedAt = time.Now()
+	return nil
+}
+
+// Delete is a soft delete, a deleted account can not be restored
+func (a *Account) Delete() error {
+	if a.status == AccountDeleted {
+		return ErrStatusTransition
+	}
 	a.status = AccountDeleted
 	a.updatedAt = time.Now()
+	return nil
 }
 
 func (a *Account) MarkLogin() {
@@ -99,7 +137,31 @@ func (a *Account) MarkLogin() {
 	a.updatedAt = now
 }
 
+func (a *Account) ChangePassword(passwordHash string) error {
+	if passwordHash == "" {
+		return pkgerrs.NewValueRequiredError("password_hash")
+	}
+
+	a.passwordHash = passwordHash
+	a.updatedAt = time.Now()
+	return nil
+}
+
 func (a *Account) VerifyEmail() {
 	a.emailVerified = true
 	a.updatedAt = time.Now()
 }
+
+// ChangeEmail swaps the address confirmed through an EmailChangeToken,
+// following the link proves the new address, so it counts as verified
+func (a *Account) ChangeEmail(email string) error {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return pkgerrs.NewValueRequiredError("email")
+	}
+
+	a.email = email
+	a.emailVerified = true
+	a.updatedAt = time.Now()
+	return nil
+}
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"errors"
+	"slices"
 	"strings"
 
 	pkgerrs "github.com/maket12/ads-service/pkg/errs"
@@ -8,20 +10,64 @@ import (
 	"github.com/google/uuid"
 )
 
+var ErrLastRole = errors.New("account must keep at least one role")
+
 type Role string
 
 func (r Role) String() string { return string(r) }
 
 const (
-	RoleUser  Role = "user"
-	RoleAdmin Role = "admin"
+	RoleGuest     Role = "guest"
+	RoleUser      Role = "user"
+	RoleModerator Role = "moderator"
+	RoleSupport   Role = "support"
+	RoleAdmin     Role = "admin"
+)
+
+func ParseRole(rawRole string) (Role, error) {
+	role := Role(strings.ToLower(strings.TrimSpace(rawRole)))
+	switch role {
+	case RoleGuest, RoleUser, RoleModerator, RoleSupport, RoleAdmin:
+		return role, nil
+	default:
+		return "", pkgerrs.NewValueInvalidError("role")
+	}
+}
+
+// Ranks of the roles, staff may only moderate accounts ranked below them.
+// Moderators and support share a rank, neither may block the other
+var roleRanks = map[Role]int{
+	RoleGuest:     0,
+	RoleUser:      1,
+	RoleModerator: 2,
+	RoleSupport:   2,
+	RoleAdmin:     3,
+}
+
+// Permission is granted through roles, the mapping itself lives in the db
+type Permission string
+
+func (p Permission) String() string { return string(p) }
+
+const (
+	PermissionAdsRead     Permission = "ads:read"
+	PermissionAdsWrite    Permission = "ads:write"
+	PermissionAdsModerate Permission = "ads:moderate"
+	PermissionUsersRead   Permission = "users:read"
+	PermissionUsersBlock  Permission = "users:block"
+	PermissionUsersDelete Permission = "users:delete"
+	PermissionRolesAssign Permission = "roles:assign"
+	PermissionAuditRead   Permission = "audit:read"
+
+	PermissionAccountsImpersonate Permission = "accounts:impersonate"
 )
 
-// ================ Rich model for account's Role ================
+// ================ Rich model for account's Roles ================
 
 type AccountRole struct {
-	accountID uuid.UUID
-	role      Role
+	accountID   uuid.UUID
+	roles       []Role
+	permissions []Permission
 }
 
 func NewAccountRole(accountID uuid.UUID) (*AccountRole, error) {
@@ -30,29 +76,74 @@ func NewAccountRole(accountID uuid.UUID) (*AccountRole, error) {
 	}
 	return &AccountRole{
 		accountID: accountID,
-		role:      RoleUser,
+		roles:     []Role{RoleUser},
 	}, nil
 }
 
-func RestoreAccountRole(accountID uuid.UUID, role Role) *AccountRole {
+func RestoreAccountRole(accountID uuid.UUID, roles []Role, permissions []Permission) *AccountRole {
 	return &AccountRole{
-		accountID: accountID,
-		role:      role,
+		accountID:   accountID,
+		roles:       roles,
+		permissions: permissions,
 	}
 }
 
 // ================ Read-Only ================
 
-func (a *AccountRole) AccountID() uuid.UUID { return a.accountID }
-func (a *AccountRole) Role() Role           { return a.role }
+func (a *AccountRole) AccountID() uuid.UUID      { return a.accountID }
+func (a *AccountRole) Roles() []Role             { return slices.Clone(a.roles) }
+func (a *AccountRole) Permissions() []Permission { return slices.Clone(a.permissions) }
+
+func (a *AccountRole) HasRole(role Role) bool {
+	return slices.Contains(a.roles, role)
+}
+
+// HasPermission checks permissions loaded from the db,
+// roles assigned after loading are not taken into account
+func (a *AccountRole) HasPermission(permission Permission) bool {
+	return slices.Contains(a.permissions, permission)
+}
+
+// Rank is the highest rank among the roles of the account
+func (a *AccountRole) Rank() int {
+	var rank int
+	for _, role := range a.roles {
+		rank = max(rank, roleRanks[role])
+	}
+	return rank
+}
+
+// Outranks reports whether the account is ranked strictly above the other one
+func (a *AccountRole) Outranks(other *AccountRole) bool {
+	return a.Rank() > other.Rank()
+}
 
 // ================ Mutation ================
 
-func (a *AccountRole) Assign(rawRole string) error {
-	lowerRawRole := strings.ToLower(rawRole)
-	if lowerRawRole != "user" && lowerRawRole != "admin" {
-		return pkgerrs.NewValueInvalidError("role")
+// Assign adds a role, assigning a role the account already has is a no-op
+func (a *AccountRole) Assign(rawRole string) (Role, error) {
+	role, err := ParseRole(rawRole)
+	if err != nil {
+		return "", err
+	}
+	if !a.HasRole(role) {
+		a.roles = append(a.roles, role)
+	}
+	return role, nil
+}
+
+// Revoke removes a role, the last one can not be revoked
+func (a *AccountRole) Revoke(rawRole string) (Role, error) {
+	role, err := ParseRole(rawRole)
+	if err != nil {
+		return "", err
+	}
+	if !a.HasRole(role) {
+		return role, nil
+	}
+	if len(a.roles) == 1 {
+		return "", ErrLastRole
 	}
-	a.role = Role(lowerRawRole)
-	return nil
+	a.roles = slices.DeleteFunc(a.roles, func(r Role) bool { return r == role })
+	return role, nil
 }
@@ -1,7 +1,6 @@
 package model_test
 
 import (
-	"strings"
 	"testing"
 
 	"github.com/maket12/ads-service/authservice/internal/domain/model"
@@ -41,7 +40,7 @@ func TestNewAccountRole(t *testing.T) {
 				require.NoError(t, err)
 				require.NotNil(t, accRole)
 				assert.Equal(t, tt.accountID, accRole.AccountID())
-				assert.Equal(t, model.RoleUser, accRole.Role())
+				assert.Equal(t, []model.Role{model.RoleUser}, accRole.Roles())
 			} else {
 				require.Error(t, err)
 				assert.ErrorIs(t, err, pkgerrs.ErrValueIsInvalid)
@@ -55,31 +54,42 @@ func TestAccountRole_Assign(t *testing.T) {
 	t.Parallel()
 
 	type testCase struct {
-		name   string
-		role   string
-		expect error
+		name       string
+		role       string
+		expect     error
+		expectRole []model.Role
 	}
 
 	var tests = []testCase{
 		{
-			name:   "success - admin",
-			role:   "admin",
-			expect: nil,
+			name:       "success - admin",
+			role:       "admin",
+			expect:     nil,
+			expectRole: []model.Role{model.RoleUser, model.RoleAdmin},
+		},
+		{
+			name:       "success - moderator",
+			role:       "moderator",
+			expect:     nil,
+			expectRole: []model.Role{model.RoleUser, model.RoleModerator},
 		},
 		{
-			name:   "success - user",
-			role:   "user",
-			expect: nil,
+			name:       "success - in upper case",
+			role:       "SUPPORT",
+			expect:     nil,
+			expectRole: []model.Role{model.RoleUser, model.RoleSupport},
 		},
 		{
-			name:   "success - in upper case",
-			role:   "ADMIN",
-			expect: nil,
+			name:       "already assigned",
+			role:       "user",
+			expect:     nil,
+			expectRole: []model.Role{model.RoleUser},
 		},
 		{
-			name:   "invalid role value",
-			role:   "unknown",
-			expect: pkgerrs.ErrValueIsInvalid,
+			name:       "invalid role value",
+			role:       "unknown",
+			expect:     pkgerrs.ErrValueIsInvalid,
+			expectRole: []model.Role{model.RoleUser},
 		},
 	}
 
@@ -87,16 +97,155 @@ func TestAccountRole_Assign(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			accRole, _ := model.NewAccountRole(uuid.New())
 
-			err := accRole.Assign(tt.role)
+			_, err := accRole.Assign(tt.role)
 
 			if tt.expect == nil {
 				require.NoError(t, err)
-				assert.Equal(t, model.Role(strings.ToLower(tt.role)), accRole.Role())
 			} else {
 				require.Error(t, err)
-				assert.ErrorIs(t, err, pkgerrs.ErrValueIsInvalid)
-				assert.NotEqual(t, model.Role(tt.role), accRole.Role())
+				assert.ErrorIs(t, err, tt.expect)
+			}
+			assert.Equal(t, tt.expectRole, accRole.Roles())
+		})
+	}
+}
+
+func TestAccountRole_Revoke(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name       string
+		roles      []model.Role
+		role       string
+		expect     error
+		expectRole []model.Role
+	}
+
+	var tests = []testCase{
+		{
+			name:       "success",
+			roles:      []model.Role{model.RoleUser, model.RoleAdmin},
+			role:       "admin",
+			expect:     nil,
+			expectRole: []model.Role{model.RoleUser},
+		},
+		{
+			name:       "not assigned",
+			roles:      []model.Role{model.RoleUser},
+			role:       "moderator",
+			expect:     nil,
+			expectRole: []model.Role{model.RoleUser},
+		},
+		{
+			name:       "last role",
+			roles:      []model.Role{model.RoleUser},
+			role:       "user",
+			expect:     model.ErrLastRole,
+			expectRole: []model.Role{model.RoleUser},
+		},
+		{
+			name:       "invalid role value",
+			roles:      []model.Role{model.RoleUser},
+			role:       "root",
+			expect:     pkgerrs.ErrValueIsInvalid,
+			expectRole: []model.Role{model.RoleUser},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accRole := model.RestoreAccountRole(uuid.New(), tt.roles, nil)
+
+			_, err := accRole.Revoke(tt.role)
+
+			if tt.expect == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expect)
 			}
+			assert.Equal(t, tt.expectRole, accRole.Roles())
+		})
+	}
+}
+
+func TestAccessTokenClaims_HasPermission(t *testing.T) {
+	t.Parallel()
+
+	accRole := model.RestoreAccountRole(
+		uuid.New(),
+		[]model.Role{model.RoleModerator},
+		[]model.Permission{model.PermissionAdsRead, model.PermissionAdsModerate},
+	)
+	claims := model.NewAccessTokenClaims(accRole, uuid.New())
+
+	assert.Equal(t, accRole.AccountID(), claims.AccountID)
+	assert.True(t, claims.HasPermission(model.PermissionAdsModerate))
+	assert.False(t, claims.HasPermission(model.PermissionUsersBlock))
+}
+
+func TestAccessTokenClaims_DenylistKeys(t *testing.T) {
+	t.Parallel()
+
+	sessionID := uuid.New()
+	claims := &model.AccessTokenClaims{TokenID: "token-id", SessionID: sessionID}
+	assert.Equal(t,
+		[]string{"jti:token-id", "sid:" + sessionID.String()},
+		claims.DenylistKeys(),
+	)
+
+	// Tokens issued before revocation support have nothing to check
+	assert.Empty(t, (&model.AccessTokenClaims{}).DenylistKeys())
+}
+
+func TestAccountRole_Outranks(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name   string
+		actor  []model.Role
+		target []model.Role
+		expect bool
+	}
+
+	var tests = []testCase{
+		{
+			name:   "admin outranks user",
+			actor:  []model.Role{model.RoleAdmin},
+			target: []model.Role{model.RoleUser},
+			expect: true,
+		},
+		{
+			name:   "support outranks user",
+			actor:  []model.Role{model.RoleSupport, model.RoleUser},
+			target: []model.Role{model.RoleUser},
+			expect: true,
+		},
+		{
+			name:   "support does not outrank moderator",
+			actor:  []model.Role{model.RoleSupport},
+			target: []model.Role{model.RoleModerator, model.RoleUser},
+			expect: false,
+		},
+		{
+			name:   "support does not outrank admin",
+			actor:  []model.Role{model.RoleSupport},
+			target: []model.Role{model.RoleUser, model.RoleAdmin},
+			expect: false,
+		},
+		{
+			name:   "admin does not outrank admin",
+			actor:  []model.Role{model.RoleAdmin},
+			target: []model.Role{model.RoleAdmin},
+			expect: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actor := model.RestoreAccountRole(uuid.New(), tt.actor, nil)
+			target := model.RestoreAccountRole(uuid.New(), tt.target, nil)
+			assert.Equal(t, tt.expect, actor.Outranks(target))
 		})
 	}
 }
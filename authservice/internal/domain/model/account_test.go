@@ -195,14 +195,115 @@ func TestAccount_StatedChanges(t *testing.T) {
 		assert.True(t, acc.LastLoginAt().After(initialUpdatedAt))
 		assert.True(t, acc.UpdatedAt().After(initialUpdatedAt))
 	})
+	t.Run("change password", func(t *testing.T) {
+		require.NoError(t, acc.ChangePassword("new-password-hash"))
+		assert.Equal(t, "new-password-hash", acc.PasswordHash())
+		assert.True(t, acc.UpdatedAt().After(initialUpdatedAt))
+		assert.ErrorIs(t, acc.ChangePassword(""), pkgerrs.ErrValueIsRequired)
+	})
+	t.Run("change email", func(t *testing.T) {
+		require.NoError(t, acc.ChangeEmail(" new@email.go "))
+		assert.Equal(t, "new@email.go", acc.Email())
+		assert.True(t, acc.EmailVerified())
+		assert.ErrorIs(t, acc.ChangeEmail(" "), pkgerrs.ErrValueIsRequired)
+	})
 	t.Run("block account", func(t *testing.T) {
-		acc.Block()
+		require.NoError(t, acc.Block())
 		assert.Equal(t, model.AccountBlocked, acc.Status())
 		assert.True(t, acc.UpdatedAt().After(initialUpdatedAt))
 	})
 	t.Run("delete account", func(t *testing.T) {
-		acc.Delete()
+		require.NoError(t, acc.Delete())
 		assert.Equal(t, model.AccountDeleted, acc.Status())
 		assert.True(t, acc.UpdatedAt().After(initialUpdatedAt))
 	})
 }
+
+func TestAccount_StatusTransitions(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name       string
+		status     model.AccountStatus
+		transition func(acc *model.Account) error
+		expected   model.AccountStatus
+		expect     error
+	}
+
+	var tests = []testCase{
+		{
+			name:       "block active",
+			status:     model.AccountActive,
+			transition: (*model.Account).Block,
+			expected:   model.AccountBlocked,
+		},
+		{
+			name:       "block blocked",
+			status:     model.AccountBlocked,
+			transition: (*model.Account).Block,
+			expected:   model.AccountBlocked,
+			expect:     model.ErrStatusTransition,
+		},
+		{
+			name:       "block deleted",
+			status:     model.AccountDeleted,
+			transition: (*model.Account).Block,
+			expected:   model.AccountDeleted,
+			expect:     model.ErrStatusTransition,
+		},
+		{
+			name:       "unblock blocked",
+			status:     model.AccountBlocked,
+			transition: (*model.Account).Unblock,
+			expected:   model.AccountActive,
+		},
+		{
+			name:       "unblock active",
+			status:     model.AccountActive,
+			transition: (*model.Account).Unblock,
+			expected:   model.AccountActive,
+			expect:     model.ErrStatusTransition,
+		},
+		{
+			name:       "unblock deleted",
+			status:     model.AccountDeleted,
+			transition: (*model.Account).Unblock,
+			expected:   model.AccountDeleted,
+			expect:     model.ErrStatusTransition,
+		},
+		{
+			name:       "delete blocked",
+			status:     model.AccountBlocked,
+			transition: (*model.Account).Delete,
+			expected:   model.AccountDeleted,
+		},
+		{
+			name:       "delete deleted",
+			status:     model.AccountDeleted,
+			transition: (*model.Account).Delete,
+			expected:   model.AccountDeleted,
+			expect:     model.ErrStatusTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			acc := model.RestoreAccount(
+				uuid.New(), "email.com", "password",
+				tt.status, false, time.Now(),
+				time.Now(), nil)
+			err := tt.transition(acc)
+			assert.ErrorIs(t, err, tt.expect)
+			assert.Equal(t, tt.expected, acc.Status())
+		})
+	}
+}
+
+func TestParseAccountStatus(t *testing.T) {
+	status, err := model.ParseAccountStatus(" Blocked ")
+	assert.NoError(t, err)
+	assert.Equal(t, model.AccountBlocked, status)
+
+	_, err = model.ParseAccountStatus("frozen")
+	assert.ErrorIs(t, err, pkgerrs.ErrValueIsInvalid)
+}
@@ -0,0 +1,148 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+const apiKeyNameMaxLen = 100
+
+var ErrAPIKeyAlreadyRevoked = errors.New("api key has been already revoked")
+
+// ================ Rich model for API Key ================
+
+// APIKey authenticates machine clients on behalf of an account. Only a hash
+// of the secret is kept, the prefix is stored in clear to find the key and
+// to let the owner tell keys apart. Scopes narrow the account permissions
+type APIKey struct {
+	id         uuid.UUID
+	accountID  uuid.UUID
+	name       string
+	prefix     string
+	secretHash string
+	scopes     []Permission
+	createdAt  time.Time
+	expiresAt  *time.Time
+	lastUsedAt *time.Time
+	revokedAt  *time.Time
+}
+
+func NewAPIKey(
+	accountID uuid.UUID, name, prefix, secretHash string,
+	scopes []Permission, expiresAt *time.Time,
+) (*APIKey, error) {
+	name = strings.TrimSpace(name)
+
+	if accountID == uuid.Nil {
+		return nil, pkgerrs.NewValueInvalidError("account_id")
+	}
+	if name == "" {
+		return nil, pkgerrs.NewValueRequiredError("name")
+	}
+	if len(name) > apiKeyNameMaxLen {
+		return nil, pkgerrs.NewValueInvalidError("name")
+	}
+	if prefix == "" {
+		return nil, pkgerrs.NewValueRequiredError("prefix")
+	}
+	if secretHash == "" {
+		return nil, pkgerrs.NewValueRequiredError("secret_hash")
+	}
+	if len(scopes) == 0 {
+		return nil, pkgerrs.NewValueRequiredError("scopes")
+	}
+
+	now := time.Now()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, pkgerrs.NewValueInvalidError("expires_at")
+	}
+
+	return &APIKey{
+		id:         uuid.New(),
+		accountID:  accountID,
+		name:       name,
+		prefix:     prefix,
+		secretHash: secretHash,
+		scopes:     slices.Compact(slices.Sorted(slices.Values(scopes))),
+		createdAt:  now,
+		expiresAt:  expiresAt,
+	}, nil
+}
+
+func RestoreAPIKey(
+	id, accountID uuid.UUID, name, prefix, secretHash string,
+	scopes []Permission, createdAt time.Time,
+	expiresAt, lastUsedAt, revokedAt *time.Time,
+) *APIKey {
+	return &APIKey{
+		id:         id,
+		accountID:  accountID,
+		name:       name,
+		prefix:     prefix,
+		secretHash: secretHash,
+		scopes:     scopes,
+		createdAt:  createdAt,
+		expiresAt:  expiresAt,
+		lastUsedAt: lastUsedAt,
+		revokedAt:  revokedAt,
+	}
+}
+
+// ================ Read-Only ================
+
+func (k *APIKey) ID() uuid.UUID          { return k.id }
+func (k *APIKey) AccountID() uuid.UUID   { return k.accountID }
+func (k *APIKey) Name() string           { return k.name }
+func (k *APIKey) Prefix() string         { return k.prefix }
+func (k *APIKey) SecretHash() string     { return k.secretHash }
+func (k *APIKey) Scopes() []Permission   { return slices.Clone(k.scopes) }
+func (k *APIKey) CreatedAt() time.Time   { return k.createdAt }
+func (k *APIKey) ExpiresAt() *time.Time  { return k.expiresAt }
+func (k *APIKey) LastUsedAt() *time.Time { return k.lastUsedAt }
+func (k *APIKey) RevokedAt() *time.Time  { return k.revokedAt }
+
+func (k *APIKey) IsActive() bool  { return !k.IsExpired() && !k.IsRevoked() }
+func (k *APIKey) IsRevoked() bool { return k.revokedAt != nil }
+func (k *APIKey) IsExpired() bool {
+	return k.expiresAt != nil && time.Now().After(*k.expiresAt)
+}
+
+// UsedWithin tells whether the key was used during the last period
+func (k *APIKey) UsedWithin(period time.Duration) bool {
+	return k.lastUsedAt != nil && time.Since(*k.lastUsedAt) < period
+}
+
+// EffectivePermissions returns the scopes the account still has, so a key
+// never outlives a revoked role
+func (k *APIKey) EffectivePermissions(granted []Permission) []Permission {
+	permissions := make([]Permission, 0, len(k.scopes))
+	for _, scope := range k.scopes {
+		if slices.Contains(granted, scope) {
+			permissions = append(permissions, scope)
+		}
+	}
+	return permissions
+}
+
+// ================ Mutation ================
+
+func (k *APIKey) Revoke() error {
+	if k.IsRevoked() {
+		return ErrAPIKeyAlreadyRevoked
+	}
+
+	now := time.Now()
+	k.revokedAt = &now
+	return nil
+}
+
+func (k *APIKey) MarkUsed() {
+	now := time.Now()
+	k.lastUsedAt = &now
+}
@@ -0,0 +1,140 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAPIKey(t *testing.T) {
+	t.Parallel()
+
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+	scopes := []model.Permission{model.PermissionAdsWrite}
+
+	type testCase struct {
+		name      string
+		accountID uuid.UUID
+		keyName   string
+		scopes    []model.Permission
+		expiresAt *time.Time
+		expect    error
+	}
+
+	var tests = []testCase{
+		{
+			name:      "success",
+			accountID: uuid.New(),
+			keyName:   "feed importer",
+			scopes:    scopes,
+			expiresAt: &future,
+			expect:    nil,
+		},
+		{
+			name:      "success - without expiry",
+			accountID: uuid.New(),
+			keyName:   "feed importer",
+			scopes:    scopes,
+			expect:    nil,
+		},
+		{
+			name:      "nullable account id",
+			accountID: uuid.Nil,
+			keyName:   "feed importer",
+			scopes:    scopes,
+			expect:    pkgerrs.ErrValueIsInvalid,
+		},
+		{
+			name:      "empty name",
+			accountID: uuid.New(),
+			keyName:   "   ",
+			scopes:    scopes,
+			expect:    pkgerrs.ErrValueIsRequired,
+		},
+		{
+			name:      "too long name",
+			accountID: uuid.New(),
+			keyName:   strings.Repeat("k", 101),
+			scopes:    scopes,
+			expect:    pkgerrs.ErrValueIsInvalid,
+		},
+		{
+			name:      "no scopes",
+			accountID: uuid.New(),
+			keyName:   "feed importer",
+			scopes:    nil,
+			expect:    pkgerrs.ErrValueIsRequired,
+		},
+		{
+			name:      "expiry in the past",
+			accountID: uuid.New(),
+			keyName:   "feed importer",
+			scopes:    scopes,
+			expiresAt: &past,
+			expect:    pkgerrs.ErrValueIsInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := model.NewAPIKey(
+				tt.accountID, tt.keyName, "ak_abcdefgh", "hash", tt.scopes, tt.expiresAt,
+			)
+
+			if tt.expect != nil {
+				assert.ErrorIs(t, err, tt.expect)
+				assert.Nil(t, key)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.accountID, key.AccountID())
+			assert.True(t, key.IsActive())
+			assert.Nil(t, key.LastUsedAt())
+		})
+	}
+}
+
+func TestAPIKey_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	key, err := model.NewAPIKey(
+		uuid.New(), "feed importer", "ak_abcdefgh", "hash",
+		[]model.Permission{model.PermissionAdsWrite, model.PermissionAdsRead, model.PermissionAdsWrite},
+		nil,
+	)
+	require.NoError(t, err)
+
+	// Duplicated scopes are collapsed
+	assert.Equal(t, []model.Permission{model.PermissionAdsRead, model.PermissionAdsWrite}, key.Scopes())
+
+	// Only the scopes the account still has are effective
+	assert.Equal(t,
+		[]model.Permission{model.PermissionAdsWrite},
+		key.EffectivePermissions([]model.Permission{model.PermissionAdsWrite, model.PermissionUsersRead}),
+	)
+
+	assert.False(t, key.UsedWithin(time.Minute))
+	key.MarkUsed()
+	assert.NotNil(t, key.LastUsedAt())
+	assert.True(t, key.UsedWithin(time.Minute))
+
+	require.NoError(t, key.Revoke())
+	assert.False(t, key.IsActive())
+	assert.ErrorIs(t, key.Revoke(), model.ErrAPIKeyAlreadyRevoked)
+
+	expired := time.Now().Add(-time.Minute)
+	restored := model.RestoreAPIKey(
+		key.ID(), key.AccountID(), key.Name(), key.Prefix(), key.SecretHash(),
+		key.Scopes(), key.CreatedAt(), &expired, nil, nil,
+	)
+	assert.True(t, restored.IsExpired())
+	assert.False(t, restored.IsActive())
+}
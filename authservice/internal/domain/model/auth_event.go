@@ -0,0 +1,183 @@
+package model
+
+import (
+	"maps"
+	"strings"
+	"time"
+
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type AuthEventType string
+
+func (t AuthEventType) String() string { return string(t) }
+
+const (
+	AuthEventLogin             AuthEventType = "login"
+	AuthEventLogout            AuthEventType = "logout"
+	AuthEventTokenRefresh      AuthEventType = "token_refresh"
+	AuthEventRefreshTokenReuse AuthEventType = "refresh_token_reuse"
+	AuthEventSessionAnomaly    AuthEventType = "session_anomaly"
+	AuthEventRoleAssigned      AuthEventType = "role_assigned"
+	AuthEventRoleRevoked       AuthEventType = "role_revoked"
+	AuthEventPasswordChanged   AuthEventType = "password_changed"
+	AuthEventPasswordReset     AuthEventType = "password_reset"
+	AuthEventEmailChanged      AuthEventType = "email_changed"
+	AuthEventAccountBlocked    AuthEventType = "account_blocked"
+	AuthEventAccountUnblocked  AuthEventType = "account_unblocked"
+	AuthEventAccountDeleted    AuthEventType = "account_deleted"
+	AuthEventImpersonation     AuthEventType = "impersonation_started"
+	AuthEventSessionReported   AuthEventType = "session_reported"
+	AuthEventSessionRevoked    AuthEventType = "session_revoked"
+)
+
+func ParseAuthEventType(rawType string) (AuthEventType, error) {
+	eventType := AuthEventType(strings.ToLower(strings.TrimSpace(rawType)))
+	switch eventType {
+	case AuthEventLogin, AuthEventLogout, AuthEventTokenRefresh,
+		AuthEventRefreshTokenReuse, AuthEventSessionAnomaly, AuthEventRoleAssigned, AuthEventRoleRevoked,
+		AuthEventPasswordChanged, AuthEventPasswordReset, AuthEventEmailChanged,
+		AuthEventAccountBlocked, AuthEventAccountUnblocked, AuthEventAccountDeleted,
+		AuthEventImpersonation, AuthEventSessionReported, AuthEventSessionRevoked:
+		return eventType, nil
+	default:
+		return "", pkgerrs.NewValueInvalidError("event_type")
+	}
+}
+
+type AuthEventOutcome string
+
+func (o AuthEventOutcome) String() string { return string(o) }
+
+const (
+	AuthEventSuccess AuthEventOutcome = "success"
+	AuthEventFailure AuthEventOutcome = "failure"
+)
+
+func ParseAuthEventOutcome(rawOutcome string) (AuthEventOutcome, error) {
+	outcome := AuthEventOutcome(strings.ToLower(strings.TrimSpace(rawOutcome)))
+	switch outcome {
+	case AuthEventSuccess, AuthEventFailure:
+		return outcome, nil
+	default:
+		return "", pkgerrs.NewValueInvalidError("outcome")
+	}
+}
+
+// ================ Rich model for Auth Event ================
+
+type AuthEvent struct {
+	id        uuid.UUID
+	accountID uuid.UUID
+	eventType AuthEventType
+	sessionID *uuid.UUID
+	ip        *string
+	userAgent *string
+	actorID   *uuid.UUID
+	reason    *string
+	outcome   AuthEventOutcome
+	metadata  map[string]string
+	createdAt time.Time
+}
+
+func NewAuthEvent(
+	accountID uuid.UUID, eventType AuthEventType,
+	sessionID *uuid.UUID, ip *string, userAgent *string,
+) (*AuthEvent, error) {
+	if accountID == uuid.Nil {
+		return nil, pkgerrs.NewValueInvalidError("account_id")
+	}
+	if eventType == "" {
+		return nil, pkgerrs.NewValueRequiredError("event_type")
+	}
+	if sessionID != nil && *sessionID == uuid.Nil {
+		return nil, pkgerrs.NewValueInvalidError("session_id")
+	}
+
+	return &AuthEvent{
+		id:        uuid.New(),
+		accountID: accountID,
+		eventType: eventType,
+		sessionID: sessionID,
+		ip:        ip,
+		userAgent: userAgent,
+		outcome:   AuthEventSuccess,
+		metadata:  make(map[string]string),
+		createdAt: time.Now(),
+	}, nil
+}
+
+func RestoreAuthEvent(
+	id, accountID uuid.UUID, eventType AuthEventType,
+	sessionID *uuid.UUID, ip *string, userAgent *string,
+	actorID *uuid.UUID, reason *string,
+	outcome AuthEventOutcome, metadata map[string]string, createdAt time.Time,
+) *AuthEvent {
+	return &AuthEvent{
+		id:        id,
+		accountID: accountID,
+		eventType: eventType,
+		sessionID: sessionID,
+		ip:        ip,
+		userAgent: userAgent,
+		actorID:   actorID,
+		reason:    reason,
+		outcome:   outcome,
+		metadata:  metadata,
+		createdAt: createdAt,
+	}
+}
+
+// ================ Read-Only ================
+
+func (e *AuthEvent) ID() uuid.UUID             { return e.id }
+func (e *AuthEvent) AccountID() uuid.UUID      { return e.accountID }
+func (e *AuthEvent) EventType() AuthEventType  { return e.eventType }
+func (e *AuthEvent) SessionID() *uuid.UUID     { return e.sessionID }
+func (e *AuthEvent) IP() *string               { return e.ip }
+func (e *AuthEvent) UserAgent() *string        { return e.userAgent }
+func (e *AuthEvent) ActorID() *uuid.UUID       { return e.actorID }
+func (e *AuthEvent) Reason() *string           { return e.reason }
+func (e *AuthEvent) Outcome() AuthEventOutcome { return e.outcome }
+func (e *AuthEvent) CreatedAt() time.Time      { return e.createdAt }
+
+func (e *AuthEvent) Metadata() map[string]string {
+	return maps.Clone(e.metadata)
+}
+
+// ================ Mutation ================
+
+// SetActor records who performed the action on the account and why
+func (e *AuthEvent) SetActor(actorID uuid.UUID, reason string) error {
+	if actorID == uuid.Nil {
+		return pkgerrs.NewValueInvalidError("actor_id")
+	}
+	if reason == "" {
+		return pkgerrs.NewValueRequiredError("reason")
+	}
+	e.actorID = &actorID
+	e.reason = &reason
+	return nil
+}
+
+// Fail marks the attempt as unsuccessful, events are successful by default
+func (e *AuthEvent) Fail() {
+	e.outcome = AuthEventFailure
+}
+
+// SetMetadata attaches a detail, empty values are not stored
+func (e *AuthEvent) SetMetadata(key, value string) error {
+	if key == "" {
+		return pkgerrs.NewValueRequiredError("metadata_key")
+	}
+	if value == "" {
+		return nil
+	}
+	if e.metadata == nil {
+		e.metadata = make(map[string]string)
+	}
+	e.metadata[key] = value
+	return nil
+}
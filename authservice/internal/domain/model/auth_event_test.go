@@ -0,0 +1,170 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuthEvent(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name      string
+		accountID uuid.UUID
+		eventType model.AuthEventType
+		sessionID *uuid.UUID
+		expect    error
+	}
+
+	var tests = []testCase{
+		{
+			name:      "success",
+			accountID: uuid.New(),
+			eventType: model.AuthEventRefreshTokenReuse,
+			sessionID: vPtr(uuid.New()),
+			expect:    nil,
+		},
+		{
+			name:      "success without session",
+			accountID: uuid.New(),
+			eventType: model.AuthEventRefreshTokenReuse,
+			expect:    nil,
+		},
+		{
+			name:      "nullable account id",
+			accountID: uuid.Nil,
+			eventType: model.AuthEventRefreshTokenReuse,
+			expect:    pkgerrs.ErrValueIsInvalid,
+		},
+		{
+			name:      "empty event type",
+			accountID: uuid.New(),
+			eventType: "",
+			expect:    pkgerrs.ErrValueIsRequired,
+		},
+		{
+			name:      "nullable session id",
+			accountID: uuid.New(),
+			eventType: model.AuthEventRefreshTokenReuse,
+			sessionID: vPtr(uuid.Nil),
+			expect:    pkgerrs.ErrValueIsInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := model.NewAuthEvent(
+				tt.accountID, tt.eventType, tt.sessionID, nil, nil,
+			)
+			if tt.expect == nil {
+				require.NoError(t, err)
+				require.NotNil(t, event)
+				assert.NotEqual(t, uuid.Nil, event.ID())
+				assert.Equal(t, tt.accountID, event.AccountID())
+				assert.Equal(t, tt.eventType, event.EventType())
+				assert.Equal(t, tt.sessionID, event.SessionID())
+				assert.Equal(t, model.AuthEventSuccess, event.Outcome())
+				assert.Empty(t, event.Metadata())
+				assert.False(t, event.CreatedAt().IsZero())
+			} else {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expect)
+				assert.Nil(t, event)
+			}
+		})
+	}
+}
+
+func TestAuthEvent_SetActor(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name    string
+		actorID uuid.UUID
+		reason  string
+		expect  error
+	}
+
+	var tests = []testCase{
+		{
+			name:    "success",
+			actorID: uuid.New(),
+			reason:  "spam",
+			expect:  nil,
+		},
+		{
+			name:    "nullable actor id",
+			actorID: uuid.Nil,
+			reason:  "spam",
+			expect:  pkgerrs.ErrValueIsInvalid,
+		},
+		{
+			name:    "empty reason",
+			actorID: uuid.New(),
+			reason:  "",
+			expect:  pkgerrs.ErrValueIsRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, _ := model.NewAuthEvent(
+				uuid.New(), model.AuthEventAccountBlocked, nil, nil, nil,
+			)
+			err := event.SetActor(tt.actorID, tt.reason)
+			if tt.expect == nil {
+				require.NoError(t, err)
+				assert.Equal(t, tt.actorID, *event.ActorID())
+				assert.Equal(t, tt.reason, *event.Reason())
+			} else {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expect)
+				assert.Nil(t, event.ActorID())
+				assert.Nil(t, event.Reason())
+			}
+		})
+	}
+}
+
+func TestAuthEvent_OutcomeAndMetadata(t *testing.T) {
+	t.Parallel()
+
+	event, err := model.NewAuthEvent(uuid.New(), model.AuthEventLogin, nil, nil, nil)
+	require.NoError(t, err)
+
+	event.Fail()
+	assert.Equal(t, model.AuthEventFailure, event.Outcome())
+
+	require.NoError(t, event.SetMetadata("method", "password"))
+	require.NoError(t, event.SetMetadata("email", ""))
+	assert.ErrorIs(t, event.SetMetadata("", "value"), pkgerrs.ErrValueIsRequired)
+	assert.Equal(t, map[string]string{"method": "password"}, event.Metadata())
+
+	// The returned map is a copy
+	event.Metadata()["method"] = "oidc"
+	assert.Equal(t, "password", event.Metadata()["method"])
+}
+
+func TestParseAuthEventFilters(t *testing.T) {
+	t.Parallel()
+
+	eventType, err := model.ParseAuthEventType(" Login ")
+	require.NoError(t, err)
+	assert.Equal(t, model.AuthEventLogin, eventType)
+
+	_, err = model.ParseAuthEventType("unknown")
+	assert.ErrorIs(t, err, pkgerrs.ErrValueIsInvalid)
+
+	outcome, err := model.ParseAuthEventOutcome("FAILURE")
+	require.NoError(t, err)
+	assert.Equal(t, model.AuthEventFailure, outcome)
+
+	_, err = model.ParseAuthEventOutcome("maybe")
+	assert.ErrorIs(t, err, pkgerrs.ErrValueIsInvalid)
+}
@@ -0,0 +1,95 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// ================ Rich model for Email Change Token ================
+
+// EmailChangeToken is sent to the new address, the email is swapped
+// only after the owner of that address follows the link
+type EmailChangeToken struct {
+	id        uuid.UUID
+	accountID uuid.UUID
+	newEmail  string
+	tokenHash string
+	createdAt time.Time
+	expiresAt time.Time
+	usedAt    *time.Time
+}
+
+func NewEmailChangeToken(
+	accountID uuid.UUID, newEmail, tokenHash string, ttl time.Duration,
+) (*EmailChangeToken, error) {
+	newEmail = strings.TrimSpace(newEmail)
+
+	if accountID == uuid.Nil {
+		return nil, pkgerrs.NewValueInvalidError("account_id")
+	}
+	if newEmail == "" {
+		return nil, pkgerrs.NewValueRequiredError("new_email")
+	}
+	if tokenHash == "" {
+		return nil, pkgerrs.NewValueRequiredError("token_hash")
+	}
+	if ttl <= 0 {
+		return nil, pkgerrs.NewValueInvalidError("ttl")
+	}
+
+	now := time.Now()
+
+	return &EmailChangeToken{
+		id:        uuid.New(),
+		accountID: accountID,
+		newEmail:  newEmail,
+		tokenHash: tokenHash,
+		createdAt: now,
+		expiresAt: now.Add(ttl),
+	}, nil
+}
+
+func RestoreEmailChangeToken(
+	id, accountID uuid.UUID, newEmail, tokenHash string,
+	createdAt, expiresAt time.Time, usedAt *time.Time,
+) *EmailChangeToken {
+	return &EmailChangeToken{
+		id:        id,
+		accountID: accountID,
+		newEmail:  newEmail,
+		tokenHash: tokenHash,
+		createdAt: createdAt,
+		expiresAt: expiresAt,
+		usedAt:    usedAt,
+	}
+}
+
+// ================ Read-Only ================
+
+func (t *EmailChangeToken) ID() uuid.UUID        { return t.id }
+func (t *EmailChangeToken) AccountID() uuid.UUID { return t.accountID }
+func (t *EmailChangeToken) NewEmail() string     { return t.newEmail }
+func (t *EmailChangeToken) TokenHash() string    { return t.tokenHash }
+func (t *EmailChangeToken) CreatedAt() time.Time { return t.createdAt }
+func (t *EmailChangeToken) ExpiresAt() time.Time { return t.expiresAt }
+func (t *EmailChangeToken) UsedAt() *time.Time   { return t.usedAt }
+
+func (t *EmailChangeToken) IsActive() bool  { return !t.IsExpired() && !t.IsUsed() }
+func (t *EmailChangeToken) IsExpired() bool { return time.Now().After(t.expiresAt) }
+func (t *EmailChangeToken) IsUsed() bool    { return t.usedAt != nil }
+
+// ================ Mutation ================
+
+func (t *EmailChangeToken) Use() error {
+	if t.IsUsed() {
+		return ErrTokenAlreadyUsed
+	}
+
+	now := time.Now()
+	t.usedAt = &now
+	return nil
+}
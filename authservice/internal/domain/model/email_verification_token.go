@@ -0,0 +1,88 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTokenAlreadyUsed = errors.New("token has been used earlier")
+)
+
+// ================ Rich model for Email Verification Token ================
+
+type EmailVerificationToken struct {
+	id        uuid.UUID
+	accountID uuid.UUID
+	tokenHash string
+	createdAt time.Time
+	expiresAt time.Time
+	usedAt    *time.Time
+}
+
+func NewEmailVerificationToken(
+	accountID uuid.UUID, tokenHash string, ttl time.Duration,
+) (*EmailVerificationToken, error) {
+	if accountID == uuid.Nil {
+		return nil, pkgerrs.NewValueInvalidError("account_id")
+	}
+	if tokenHash == "" {
+		return nil, pkgerrs.NewValueRequiredError("token_hash")
+	}
+	if ttl <= 0 {
+		return nil, pkgerrs.NewValueInvalidError("ttl")
+	}
+
+	now := time.Now()
+
+	return &EmailVerificationToken{
+		id:        uuid.New(),
+		accountID: accountID,
+		tokenHash: tokenHash,
+		createdAt: now,
+		expiresAt: now.Add(ttl),
+	}, nil
+}
+
+func RestoreEmailVerificationToken(
+	id, accountID uuid.UUID, tokenHash string,
+	createdAt, expiresAt time.Time, usedAt *time.Time,
+) *EmailVerificationToken {
+	return &EmailVerificationToken{
+		id:        id,
+		accountID: accountID,
+		tokenHash: tokenHash,
+		createdAt: createdAt,
+		expiresAt: expiresAt,
+		usedAt:    usedAt,
+	}
+}
+
+// ================ Read-Only ================
+
+func (t *EmailVerificationToken) ID() uuid.UUID        { return t.id }
+func (t *EmailVerificationToken) AccountID() uuid.UUID { return t.accountID }
+func (t *EmailVerificationToken) TokenHash() string    { return t.tokenHash }
+func (t *EmailVerificationToken) CreatedAt() time.Time { return t.createdAt }
+func (t *EmailVerificationToken) ExpiresAt() time.Time { return t.expiresAt }
+func (t *EmailVerificationToken) UsedAt() *time.Time   { return t.usedAt }
+
+func (t *EmailVerificationToken) IsActive() bool  { return !t.IsExpired() && !t.IsUsed() }
+func (t *EmailVerificationToken) IsExpired() bool { return time.Now().After(t.expiresAt) }
+func (t *EmailVerificationToken) IsUsed() bool    { return t.usedAt != nil }
+
+// ================ Mutation ================
+
+func (t *EmailVerificationToken) Use() error {
+	if t.IsUsed() {
+		return ErrTokenAlreadyUsed
+	}
+
+	now := time.Now()
+	t.usedAt = &now
+	return nil
+}
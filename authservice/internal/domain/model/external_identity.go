@@ -0,0 +1,77 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// ================ Rich model for External Identity ================
+
+// ExternalIdentity links an account to a subject of an OIDC provider,
+// a subject is unique within its provider only
+type ExternalIdentity struct {
+	id        uuid.UUID
+	accountID uuid.UUID
+	provider  string
+	subject   string
+	email     string
+	createdAt time.Time
+}
+
+func NewExternalIdentity(
+	accountID uuid.UUID, provider, subject, email string,
+) (*ExternalIdentity, error) {
+	if accountID == uuid.Nil {
+		return nil, pkgerrs.NewValueInvalidError("account_id")
+	}
+	if provider == "" {
+		return nil, pkgerrs.NewValueRequiredError("provider")
+	}
+	if subject == "" {
+		return nil, pkgerrs.NewValueRequiredError("subject")
+	}
+
+	return &ExternalIdentity{
+		id:        uuid.New(),
+		accountID: accountID,
+		provider:  provider,
+		subject:   subject,
+		email:     strings.ToLower(strings.TrimSpace(email)),
+		createdAt: time.Now(),
+	}, nil
+}
+
+func RestoreExternalIdentity(
+	id, accountID uuid.UUID, provider, subject, email string,
+	createdAt time.Time,
+) *ExternalIdentity {
+	return &ExternalIdentity{
+		id:        id,
+		accountID: accountID,
+		provider:  provider,
+		subject:   subject,
+		email:     email,
+		createdAt: createdAt,
+	}
+}
+
+// ================ Read-Only ================
+
+func (i *ExternalIdentity) ID() uuid.UUID        { return i.id }
+func (i *ExternalIdentity) AccountID() uuid.UUID { return i.accountID }
+func (i *ExternalIdentity) Provider() string     { return i.provider }
+func (i *ExternalIdentity) Subject() string      { return i.subject }
+func (i *ExternalIdentity) Email() string        { return i.email }
+func (i *ExternalIdentity) CreatedAt() time.Time { return i.createdAt }
+
+// ================ Claims of a verified ID token ================
+
+type OIDCClaims struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+}
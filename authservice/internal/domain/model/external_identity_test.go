@@ -0,0 +1,155 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExternalIdentity(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name      string
+		accountID uuid.UUID
+		provider  string
+		subject   string
+		expect    error
+	}
+
+	var tests = []testCase{
+		{
+			name:      "success",
+			accountID: uuid.New(),
+			provider:  "google",
+			subject:   "1234567890",
+			expect:    nil,
+		},
+		{
+			name:      "nullable account id",
+			accountID: uuid.Nil,
+			provider:  "google",
+			subject:   "1234567890",
+			expect:    pkgerrs.ErrValueIsInvalid,
+		},
+		{
+			name:      "empty provider",
+			accountID: uuid.New(),
+			provider:  "",
+			subject:   "1234567890",
+			expect:    pkgerrs.ErrValueIsRequired,
+		},
+		{
+			name:      "empty subject",
+			accountID: uuid.New(),
+			provider:  "google",
+			subject:   "",
+			expect:    pkgerrs.ErrValueIsRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity, err := model.NewExternalIdentity(
+				tt.accountID, tt.provider, tt.subject, " User@Email.com ",
+			)
+			if tt.expect == nil {
+				require.NoError(t, err)
+				assert.NotEqual(t, uuid.Nil, identity.ID())
+				assert.Equal(t, tt.accountID, identity.AccountID())
+				assert.Equal(t, tt.provider, identity.Provider())
+				assert.Equal(t, tt.subject, identity.Subject())
+				assert.Equal(t, "user@email.com", identity.Email())
+			} else {
+				assert.ErrorIs(t, err, tt.expect)
+				assert.Nil(t, identity)
+			}
+		})
+	}
+}
+
+func TestNewOIDCLoginState(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name         string
+		stateHash    string
+		provider     string
+		codeVerifier string
+		nonce        string
+		ttl          time.Duration
+		expect       error
+	}
+
+	var tests = []testCase{
+		{
+			name:         "success",
+			stateHash:    "hashed",
+			provider:     "google",
+			codeVerifier: "verifier",
+			nonce:        "nonce",
+			ttl:          time.Minute,
+			expect:       nil,
+		},
+		{
+			name:         "empty state hash",
+			stateHash:    "",
+			provider:     "google",
+			codeVerifier: "verifier",
+			nonce:        "nonce",
+			ttl:          time.Minute,
+			expect:       pkgerrs.ErrValueIsRequired,
+		},
+		{
+			name:         "empty code verifier",
+			stateHash:    "hashed",
+			provider:     "google",
+			codeVerifier: "",
+			nonce:        "nonce",
+			ttl:          time.Minute,
+			expect:       pkgerrs.ErrValueIsRequired,
+		},
+		{
+			name:         "invalid ttl (not positive)",
+			stateHash:    "hashed",
+			provider:     "google",
+			codeVerifier: "verifier",
+			nonce:        "nonce",
+			ttl:          0,
+			expect:       pkgerrs.ErrValueIsInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, err := model.NewOIDCLoginState(
+				tt.stateHash, tt.provider, tt.codeVerifier, tt.nonce, tt.ttl,
+			)
+			if tt.expect == nil {
+				require.NoError(t, err)
+				assert.Equal(t, tt.provider, state.Provider())
+				assert.True(t, state.ExpiresAt().After(state.CreatedAt()))
+				assert.False(t, state.IsExpired())
+			} else {
+				assert.ErrorIs(t, err, tt.expect)
+				assert.Nil(t, state)
+			}
+		})
+	}
+}
+
+func TestOIDCLoginState_IsExpired(t *testing.T) {
+	t.Parallel()
+
+	state := model.RestoreOIDCLoginState(
+		"hashed", "google", "verifier", "nonce",
+		time.Now().Add(-time.Hour), time.Now().Add(-time.Minute),
+	)
+	assert.True(t, state.IsExpired())
+}
@@ -0,0 +1,104 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// Throttling keys, failures are counted separately per account and per client IP
+const (
+	loginKeyAccountPrefix = "account:"
+	loginKeyIPPrefix      = "ip:"
+	loginKeyMFAPrefix     = "mfa:"
+)
+
+// LoginKeyForEmail is used for unknown emails as well, so the throttle
+// does not reveal whether an account exists
+func LoginKeyForEmail(email string) string {
+	return loginKeyAccountPrefix + strings.ToLower(strings.TrimSpace(email))
+}
+
+func LoginKeyForIP(ip string) string {
+	return loginKeyIPPrefix + ip
+}
+
+// LoginKeyForMFA limits second factor guesses of an account
+func LoginKeyForMFA(accountID uuid.UUID) string {
+	return loginKeyMFAPrefix + accountID.String()
+}
+
+// ================ Brute-force policy for password logins ================
+
+// LoginThrottle counts failures over a sliding window. The first freeAttempts
+// failures are not delayed, then every next attempt has to wait
+// baseDelay * 2^n, and after maxFailures the key is locked out entirely
+type LoginThrottle struct {
+	window       time.Duration
+	freeAttempts int
+	maxFailures  int
+	baseDelay    time.Duration
+	lockout      time.Duration
+}
+
+func NewLoginThrottle(
+	window time.Duration,
+	freeAttempts, maxFailures int,
+	baseDelay, lockout time.Duration,
+) (*LoginThrottle, error) {
+	if window <= 0 {
+		return nil, pkgerrs.NewValueInvalidError("window")
+	}
+	if freeAttempts < 0 {
+		return nil, pkgerrs.NewValueInvalidError("free_attempts")
+	}
+	if maxFailures <= freeAttempts {
+		return nil, pkgerrs.NewValueInvalidError("max_failures")
+	}
+	if baseDelay <= 0 {
+		return nil, pkgerrs.NewValueInvalidError("base_delay")
+	}
+	if lockout < baseDelay {
+		return nil, pkgerrs.NewValueInvalidError("lockout")
+	}
+
+	return &LoginThrottle{
+		window:       window,
+		freeAttempts: freeAttempts,
+		maxFailures:  maxFailures,
+		baseDelay:    baseDelay,
+		lockout:      lockout,
+	}, nil
+}
+
+// ================ Read-Only ================
+
+func (t *LoginThrottle) Window() time.Duration { return t.window }
+
+// RetryAfter returns how long the caller has to wait before the next attempt,
+// failures is the number of failures within the window and lastFailure
+// the time of the most recent one. Zero means the attempt is allowed
+func (t *LoginThrottle) RetryAfter(failures int, lastFailure, now time.Time) time.Duration {
+	if failures <= t.freeAttempts {
+		return 0
+	}
+
+	var wait time.Duration
+	if failures >= t.maxFailures {
+		wait = t.lockout
+	} else {
+		wait = t.baseDelay
+		for i := t.freeAttempts + 1; i < failures && wait < t.lockout; i++ {
+			wait *= 2
+		}
+		wait = min(wait, t.lockout)
+	}
+
+	if remaining := lastFailure.Add(wait).Sub(now); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
@@ -0,0 +1,170 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLoginThrottle(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name         string
+		window       time.Duration
+		freeAttempts int
+		maxFailures  int
+		baseDelay    time.Duration
+		lockout      time.Duration
+		expect       error
+	}
+
+	var tests = []testCase{
+		{
+			name:         "valid policy",
+			window:       time.Minute * 15,
+			freeAttempts: 3,
+			maxFailures:  10,
+			baseDelay:    time.Second,
+			lockout:      time.Minute * 15,
+			expect:       nil,
+		},
+		{
+			name:         "empty window",
+			window:       0,
+			freeAttempts: 3,
+			maxFailures:  10,
+			baseDelay:    time.Second,
+			lockout:      time.Minute,
+			expect:       pkgerrs.ErrValueIsInvalid,
+		},
+		{
+			name:         "max failures not above free attempts",
+			window:       time.Minute,
+			freeAttempts: 3,
+			maxFailures:  3,
+			baseDelay:    time.Second,
+			lockout:      time.Minute,
+			expect:       pkgerrs.ErrValueIsInvalid,
+		},
+		{
+			name:         "lockout shorter than base delay",
+			window:       time.Minute,
+			freeAttempts: 3,
+			maxFailures:  10,
+			baseDelay:    time.Minute,
+			lockout:      time.Second,
+			expect:       pkgerrs.ErrValueIsInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			throttle, err := model.NewLoginThrottle(
+				tt.window, tt.freeAttempts, tt.maxFailures, tt.baseDelay, tt.lockout,
+			)
+
+			if tt.expect != nil {
+				assert.ErrorIs(t, err, tt.expect)
+				assert.Nil(t, throttle)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.window, throttle.Window())
+			}
+		})
+	}
+}
+
+func TestLoginThrottle_RetryAfter(t *testing.T) {
+	t.Parallel()
+
+	throttle, err := model.NewLoginThrottle(
+		time.Minute*15, 3, 6, time.Second, time.Minute*10,
+	)
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	type testCase struct {
+		name        string
+		failures    int
+		lastFailure time.Time
+		expect      time.Duration
+	}
+
+	var tests = []testCase{
+		{
+			name:        "no failures",
+			failures:    0,
+			lastFailure: time.Time{},
+			expect:      0,
+		},
+		{
+			name:        "free attempts are not delayed",
+			failures:    3,
+			lastFailure: now,
+			expect:      0,
+		},
+		{
+			name:        "first delayed attempt",
+			failures:    4,
+			lastFailure: now,
+			expect:      time.Second,
+		},
+		{
+			name:        "delay doubles",
+			failures:    5,
+			lastFailure: now,
+			expect:      time.Second * 2,
+		},
+		{
+			name:        "delay is counted from the last failure",
+			failures:    5,
+			lastFailure: now.Add(-time.Second),
+			expect:      time.Second,
+		},
+		{
+			name:        "delay has passed",
+			failures:    5,
+			lastFailure: now.Add(-time.Minute),
+			expect:      0,
+		},
+		{
+			name:        "locked out",
+			failures:    6,
+			lastFailure: now,
+			expect:      time.Minute * 10,
+		},
+		{
+			name:        "lockout has passed",
+			failures:    6,
+			lastFailure: now.Add(-time.Minute * 11),
+			expect:      0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, throttle.RetryAfter(tt.failures, tt.lastFailure, now))
+		})
+	}
+}
+
+func TestLoginKeys(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "account:user@email.com", model.LoginKeyForEmail(" User@Email.com "))
+	assert.Equal(t, "ip:127.0.0.1", model.LoginKeyForIP("127.0.0.1"))
+
+	accountID := uuid.New()
+	assert.Equal(t, "mfa:"+accountID.String(), model.LoginKeyForMFA(accountID))
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"time"
+
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// ================ Rich model for Magic Link Token ================
+
+type MagicLinkToken struct {
+	id        uuid.UUID
+	accountID uuid.UUID
+	tokenHash string
+	createdAt time.Time
+	expiresAt time.Time
+	usedAt    *time.Time
+}
+
+func NewMagicLinkToken(
+	accountID uuid.UUID, tokenHash string, ttl time.Duration,
+) (*MagicLinkToken, error) {
+	if accountID == uuid.Nil {
+		return nil, pkgerrs.NewValueInvalidError("account_id")
+	}
+	if tokenHash == "" {
+		return nil, pkgerrs.NewValueRequiredError("token_hash")
+	}
+	if ttl <= 0 {
+		return nil, pkgerrs.NewValueInvalidError("ttl")
+	}
+
+	now := time.Now()
+
+	return &MagicLinkToken{
+		id:        uuid.New(),
+		accountID: accountID,
+		tokenHash: tokenHash,
+		createdAt: now,
+		expiresAt: now.Add(ttl),
+	}, nil
+}
+
+func RestoreMagicLinkToken(
+	id, accountID uuid.UUID, tokenHash string,
+	createdAt, expiresAt time.Time, usedAt *time.Time,
+) *MagicLinkToken {
+	return &MagicLinkToken{
+		id:        id,
+		accountID: accountID,
+		tokenHash: tokenHash,
+		createdAt: createdAt,
+		expiresAt: expiresAt,
+		usedAt:    usedAt,
+	}
+}
+
+// ================ Read-Only ================
+
+func (t *MagicLinkToken) ID() uuid.UUID        { return t.id }
+func (t *MagicLinkToken) AccountID() uuid.UUID { return t.accountID }
+func (t *MagicLinkToken) TokenHash() string    { return t.tokenHash }
+func (t *MagicLinkToken) CreatedAt() time.Time { return t.createdAt }
+func (t *MagicLinkToken) ExpiresAt() time.Time { return t.expiresAt }
+func (t *MagicLinkToken) UsedAt() *time.Time   { return t.usedAt }
+
+func (t *MagicLinkToken) IsActive() bool  { return !t.IsExpired() && !t.IsUsed() }
+func (t *MagicLinkToken) IsExpired() bool { return time.Now().After(t.expiresAt) }
+func (t *MagicLinkToken) IsUsed() bool    { return t.usedAt != nil }
+
+// ================ Mutation ================
+
+func (t *MagicLinkToken) Use() error {
+	if t.IsUsed() {
+		return ErrTokenAlreadyUsed
+	}
+
+	now := time.Now()
+	t.usedAt = &now
+	return nil
+}
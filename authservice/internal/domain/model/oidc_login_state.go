@@ -0,0 +1,77 @@
+package model
+
+import (
+	"time"
+
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+// ================ Rich model for OIDC Login State ================
+
+// OIDCLoginState keeps the secrets of an authorization request between
+// the redirect to a provider and the callback. Only a hash of the state
+// is stored, the PKCE verifier and the nonce never leave the service
+type OIDCLoginState struct {
+	stateHash    string
+	provider     string
+	codeVerifier string
+	nonce        string
+	createdAt    time.Time
+	expiresAt    time.Time
+}
+
+func NewOIDCLoginState(
+	stateHash, provider, codeVerifier, nonce string, ttl time.Duration,
+) (*OIDCLoginState, error) {
+	if stateHash == "" {
+		return nil, pkgerrs.NewValueRequiredError("state_hash")
+	}
+	if provider == "" {
+		return nil, pkgerrs.NewValueRequiredError("provider")
+	}
+	if codeVerifier == "" {
+		return nil, pkgerrs.NewValueRequiredError("code_verifier")
+	}
+	if nonce == "" {
+		return nil, pkgerrs.NewValueRequiredError("nonce")
+	}
+	if ttl <= 0 {
+		return nil, pkgerrs.NewValueInvalidError("ttl")
+	}
+
+	now := time.Now()
+
+	return &OIDCLoginState{
+		stateHash:    stateHash,
+		provider:     provider,
+		codeVerifier: codeVerifier,
+		nonce:        nonce,
+		createdAt:    now,
+		expiresAt:    now.Add(ttl),
+	}, nil
+}
+
+func RestoreOIDCLoginState(
+	stateHash, provider, codeVerifier, nonce string,
+	createdAt, expiresAt time.Time,
+) *OIDCLoginState {
+	return &OIDCLoginState{
+		stateHash:    stateHash,
+		provider:     provider,
+		codeVerifier: codeVerifier,
+		nonce:        nonce,
+		createdAt:    createdAt,
+		expiresAt:    expiresAt,
+	}
+}
+
+// ================ Read-Only ================
+
+func (s *OIDCLoginState) StateHash() string    { return s.stateHash }
+func (s *OIDCLoginState) Provider() string     { return s.provider }
+func (s *OIDCLoginState) CodeVerifier() string { return s.codeVerifier }
+func (s *OIDCLoginState) Nonce() string        { return s.nonce }
+func (s *OIDCLoginState) CreatedAt() time.Time { return s.createdAt }
+func (s *OIDCLoginState) ExpiresAt() time.Time { return s.expiresAt }
+
+func (s *OIDCLoginState) IsExpired() bool { return time.Now().After(s.expiresAt) }
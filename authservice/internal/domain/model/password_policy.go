@@ -0,0 +1,40 @@
+package model
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+const (
+	PasswordMinLength = 8
+	PasswordMaxLength = 72 // bcrypt ignores everything after 72 bytes
+)
+
+// ValidatePassword checks a plain password against the password policy:
+// proper length, at least one letter and at least one digit
+func ValidatePassword(password string) error {
+	if password == "" {
+		return pkgerrs.NewValueRequiredError("password")
+	}
+	if utf8.RuneCountInString(password) < PasswordMinLength ||
+		len(password) > PasswordMaxLength {
+		return pkgerrs.NewValueInvalidError("password")
+	}
+
+	var hasLetter, hasDigit bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		}
+	}
+	if !hasLetter || !hasDigit {
+		return pkgerrs.NewValueInvalidError("password")
+	}
+
+	return nil
+}
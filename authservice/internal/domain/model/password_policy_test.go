@@ -0,0 +1,65 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePassword(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		password string
+		expect   error
+	}
+
+	var tests = []testCase{
+		{
+			name:     "valid password",
+			password: "securePassword123",
+			expect:   nil,
+		},
+		{
+			name:     "empty password",
+			password: "",
+			expect:   pkgerrs.ErrValueIsRequired,
+		},
+		{
+			name:     "too short",
+			password: "abc12",
+			expect:   pkgerrs.ErrValueIsInvalid,
+		},
+		{
+			name:     "too long",
+			password: strings.Repeat("a1", 40),
+			expect:   pkgerrs.ErrValueIsInvalid,
+		},
+		{
+			name:     "no digits",
+			password: "onlyletters",
+			expect:   pkgerrs.ErrValueIsInvalid,
+		},
+		{
+			name:     "no letters",
+			password: "1234567890",
+			expect:   pkgerrs.ErrValueIsInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := model.ValidatePassword(tt.password)
+			if tt.expect == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.expect)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"time"
+
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// ================ Rich model for Password Reset Token ================
+
+type PasswordResetToken struct {
+	id        uuid.UUID
+	accountID uuid.UUID
+	tokenHash string
+	createdAt time.Time
+	expiresAt time.Time
+	usedAt    *time.Time
+}
+
+func NewPasswordResetToken(
+	accountID uuid.UUID, tokenHash string, ttl time.Duration,
+) (*PasswordResetToken, error) {
+	if accountID == uuid.Nil {
+		return nil, pkgerrs.NewValueInvalidError("account_id")
+	}
+	if tokenHash == "" {
+		return nil, pkgerrs.NewValueRequiredError("token_hash")
+	}
+	if ttl <= 0 {
+		return nil, pkgerrs.NewValueInvalidError("ttl")
+	}
+
+	now := time.Now()
+
+	return &PasswordResetToken{
+		id:        uuid.New(),
+		accountID: accountID,
+		tokenHash: tokenHash,
+		createdAt: now,
+		expiresAt: now.Add(ttl),
+	}, nil
+}
+
+func RestorePasswordResetToken(
+	id, accountID uuid.UUID, tokenHash string,
+	createdAt, expiresAt time.Time, usedAt *time.Time,
+) *PasswordResetToken {
+	return &PasswordResetToken{
+		id:        id,
+		accountID: accountID,
+		tokenHash: tokenHash,
+		createdAt: createdAt,
+		expiresAt: expiresAt,
+		usedAt:    usedAt,
+	}
+}
+
+// ================ Read-Only ================
+
+func (t *PasswordResetToken) ID() uuid.UUID        { return t.id }
+func (t *PasswordResetToken) AccountID() uuid.UUID { return t.accountID }
+func (t *PasswordResetToken) TokenHash() string    { return t.tokenHash }
+func (t *PasswordResetToken) CreatedAt() time.Time { return t.createdAt }
+func (t *PasswordResetToken) ExpiresAt() time.Time { return t.expiresAt }
+func (t *PasswordResetToken) UsedAt() *time.Time   { return t.usedAt }
+
+func (t *PasswordResetToken) IsActive() bool  { return !t.IsExpired() && !t.IsUsed() }
+func (t *PasswordResetToken) IsExpired() bool { return time.Now().After(t.expiresAt) }
+func (t *PasswordResetToken) IsUsed() bool    { return t.usedAt != nil }
+
+// ================ Mutation ================
+
+func (t *PasswordResetToken) Use() error {
+	if t.IsUsed() {
+		return ErrTokenAlreadyUsed
+	}
+
+	now := time.Now()
+	t.usedAt = &now
+	return nil
+}
@@ -0,0 +1,135 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPasswordResetToken(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name      string
+		accountID uuid.UUID
+		tokenHash string
+		ttl       time.Duration
+		expect    error
+	}
+
+	var tests = []testCase{
+		{
+			name:      "success",
+			accountID: uuid.New(),
+			tokenHash: "hashed",
+			ttl:       time.Hour,
+			expect:    nil,
+		},
+		{
+			name:      "nullable account id",
+			accountID: uuid.Nil,
+			tokenHash: "hashed",
+			ttl:       time.Hour,
+			expect:    pkgerrs.ErrValueIsInvalid,
+		},
+		{
+			name:      "empty token hash",
+			accountID: uuid.New(),
+			tokenHash: "",
+			ttl:       time.Hour,
+			expect:    pkgerrs.ErrValueIsRequired,
+		},
+		{
+			name:      "invalid ttl (not positive)",
+			accountID: uuid.New(),
+			tokenHash: "hashed",
+			ttl:       0,
+			expect:    pkgerrs.ErrValueIsInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := model.NewPasswordResetToken(
+				tt.accountID, tt.tokenHash, tt.ttl,
+			)
+			if tt.expect == nil {
+				require.NoError(t, err)
+				require.NotNil(t, token)
+				assert.NotEqual(t, uuid.Nil, token.ID())
+				assert.Equal(t, tt.accountID, token.AccountID())
+				assert.Equal(t, tt.tokenHash, token.TokenHash())
+				assert.True(t, token.ExpiresAt().After(token.CreatedAt()))
+				assert.True(t, token.IsActive())
+			} else {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expect)
+				assert.Nil(t, token)
+			}
+		})
+	}
+}
+
+func TestPasswordResetToken_IsActive(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name      string
+		expiresAt time.Time
+		usedAt    *time.Time
+		expect    bool
+	}
+
+	var tests = []testCase{
+		{
+			name:      "active",
+			expiresAt: time.Now().Add(time.Hour),
+			expect:    true,
+		},
+		{
+			name:      "expired",
+			expiresAt: time.Now().Add(-time.Hour),
+			expect:    false,
+		},
+		{
+			name:      "used",
+			expiresAt: time.Now().Add(time.Hour),
+			usedAt:    vPtr(time.Now()),
+			expect:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := model.RestorePasswordResetToken(
+				uuid.New(), uuid.New(), "hashed",
+				time.Now().Add(-time.Minute), tt.expiresAt, tt.usedAt,
+			)
+			assert.Equal(t, tt.expect, token.IsActive())
+		})
+	}
+}
+
+func TestPasswordResetToken_Use(t *testing.T) {
+	t.Parallel()
+
+	token, err := model.NewPasswordResetToken(uuid.New(), "hashed", time.Hour)
+	require.NoError(t, err)
+
+	t.Run("first use", func(t *testing.T) {
+		require.NoError(t, token.Use())
+		assert.True(t, token.IsUsed())
+		assert.NotNil(t, token.UsedAt())
+		assert.False(t, token.IsActive())
+	})
+
+	t.Run("second use", func(t *testing.T) {
+		assert.ErrorIs(t, token.Use(), model.ErrTokenAlreadyUsed)
+	})
+}
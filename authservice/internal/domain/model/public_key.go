@@ -0,0 +1,60 @@
+package model
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+type SigningAlgorithm string
+
+func (a SigningAlgorithm) String() string { return string(a) }
+
+const (
+	SigningAlgRS256 SigningAlgorithm = "RS256"
+	SigningAlgEdDSA SigningAlgorithm = "EdDSA"
+)
+
+// ================ Public part of a token signing key ================
+
+type PublicKey struct {
+	keyID     string
+	algorithm SigningAlgorithm
+	key       crypto.PublicKey
+	active    bool
+}
+
+func NewPublicKey(keyID string, algorithm SigningAlgorithm, key crypto.PublicKey, active bool) (*PublicKey, error) {
+	if keyID == "" {
+		return nil, pkgerrs.NewValueRequiredError("key_id")
+	}
+
+	switch algorithm {
+	case SigningAlgRS256:
+		if _, ok := key.(*rsa.PublicKey); !ok {
+			return nil, pkgerrs.NewValueInvalidError("key")
+		}
+	case SigningAlgEdDSA:
+		if _, ok := key.(ed25519.PublicKey); !ok {
+			return nil, pkgerrs.NewValueInvalidError("key")
+		}
+	default:
+		return nil, pkgerrs.NewValueInvalidError("algorithm")
+	}
+
+	return &PublicKey{
+		keyID:     keyID,
+		algorithm: algorithm,
+		key:       key,
+		active:    active,
+	}, nil
+}
+
+// ================ Read-Only ================
+
+func (k *PublicKey) KeyID() string               { return k.keyID }
+func (k *PublicKey) Algorithm() SigningAlgorithm { return k.algorithm }
+func (k *PublicKey) Key() crypto.PublicKey       { return k.key }
+func (k *PublicKey) IsActive() bool              { return k.active }
@@ -0,0 +1,86 @@
+package model_test
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPublicKey(t *testing.T) {
+	t.Parallel()
+
+	edPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	type testCase struct {
+		name      string
+		keyID     string
+		algorithm model.SigningAlgorithm
+		key       crypto.PublicKey
+		expect    error
+	}
+
+	var tests = []testCase{
+		{
+			name:      "success - eddsa",
+			keyID:     "key-1",
+			algorithm: model.SigningAlgEdDSA,
+			key:       edPub,
+			expect:    nil,
+		},
+		{
+			name:      "success - rs256",
+			keyID:     "key-2",
+			algorithm: model.SigningAlgRS256,
+			key:       &rsaKey.PublicKey,
+			expect:    nil,
+		},
+		{
+			name:      "empty key id",
+			keyID:     "",
+			algorithm: model.SigningAlgEdDSA,
+			key:       edPub,
+			expect:    pkgerrs.ErrValueIsRequired,
+		},
+		{
+			name:      "key does not match algorithm",
+			keyID:     "key-1",
+			algorithm: model.SigningAlgRS256,
+			key:       edPub,
+			expect:    pkgerrs.ErrValueIsInvalid,
+		},
+		{
+			name:      "unsupported algorithm",
+			keyID:     "key-1",
+			algorithm: model.SigningAlgorithm("HS256"),
+			key:       edPub,
+			expect:    pkgerrs.ErrValueIsInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := model.NewPublicKey(tt.keyID, tt.algorithm, tt.key, true)
+			if tt.expect == nil {
+				require.NoError(t, err)
+				assert.Equal(t, tt.keyID, key.KeyID())
+				assert.Equal(t, tt.algorithm, key.Algorithm())
+				assert.True(t, key.IsActive())
+			} else {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expect)
+				assert.Nil(t, key)
+			}
+		})
+	}
+}
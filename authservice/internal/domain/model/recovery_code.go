@@ -0,0 +1,61 @@
+package model
+
+import (
+	"time"
+
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// RecoveryCodesCount is how many one-time codes are issued on 2FA enrollment
+const RecoveryCodesCount = 10
+
+// ================ Rich model for 2FA Recovery Code ================
+
+type RecoveryCode struct {
+	id        uuid.UUID
+	accountID uuid.UUID
+	codeHash  string
+	createdAt time.Time
+	usedAt    *time.Time
+}
+
+func NewRecoveryCode(accountID uuid.UUID, codeHash string) (*RecoveryCode, error) {
+	if accountID == uuid.Nil {
+		return nil, pkgerrs.NewValueInvalidError("account_id")
+	}
+	if codeHash == "" {
+		return nil, pkgerrs.NewValueRequiredError("code_hash")
+	}
+
+	return &RecoveryCode{
+		id:        uuid.New(),
+		accountID: accountID,
+		codeHash:  codeHash,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func RestoreRecoveryCode(
+	id, accountID uuid.UUID, codeHash string,
+	createdAt time.Time, usedAt *time.Time,
+) *RecoveryCode {
+	return &RecoveryCode{
+		id:        id,
+		accountID: accountID,
+		codeHash:  codeHash,
+		createdAt: createdAt,
+		usedAt:    usedAt,
+	}
+}
+
+// ================ Read-Only ================
+
+func (c *RecoveryCode) ID() uuid.UUID        { return c.id }
+func (c *RecoveryCode) AccountID() uuid.UUID { return c.accountID }
+func (c *RecoveryCode) CodeHash() string     { return c.codeHash }
+func (c *RecoveryCode) CreatedAt() time.Time { return c.createdAt }
+func (c *RecoveryCode) UsedAt() *time.Time   { return c.usedAt }
+
+func (c *RecoveryCode) IsUsed() bool { return c.usedAt != nil }
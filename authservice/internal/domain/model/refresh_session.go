@@ -13,6 +13,9 @@ var (
 	ErrTokenAlreadyRevoked = errors.New("token has been revoked earlier")
 )
 
+// RevokeReasonRotation marks a session replaced by its descendant on refresh
+const RevokeReasonRotation = "token rotation"
+
 // ================ Rich model for Refresh Session ================
 
 type RefreshSession struct {
@@ -101,6 +104,12 @@ func (r *RefreshSession) IsActive() bool  { return !r.IsExpired() && !r.IsRevoke
 func (r *RefreshSession) IsExpired() bool { return time.Now().After(r.ExpiresAt()) }
 func (r *RefreshSession) IsRevoked() bool { return r.RevokedAt() != nil }
 
+// IsRotated reports whether the session was revoked by a regular rotation,
+// so presenting its token again means that the token has been reused
+func (r *RefreshSession) IsRotated() bool {
+	return r.IsRevoked() && r.revokeReason != nil && *r.revokeReason == RevokeReasonRotation
+}
+
 // ================ Mutation ================
 
 func (r *RefreshSession) Revoke(reason *string) error {
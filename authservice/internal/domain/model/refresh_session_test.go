@@ -174,6 +174,52 @@ func TestRefreshSession_IsRevoked(t *testing.T) {
 	}
 }
 
+func TestRefreshSession_IsRotated(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name         string
+		revokedAt    *time.Time
+		revokeReason *string
+		expect       bool
+	}
+
+	var tests = []testCase{
+		{
+			name:         "revoked by rotation - true",
+			revokedAt:    vPtr(time.Now()),
+			revokeReason: vPtr(model.RevokeReasonRotation),
+			expect:       true,
+		},
+		{
+			name:         "revoked by logout - false",
+			revokedAt:    vPtr(time.Now()),
+			revokeReason: vPtr("logout"),
+			expect:       false,
+		},
+		{
+			name:      "revoked without reason - false",
+			revokedAt: vPtr(time.Now()),
+			expect:    false,
+		},
+		{
+			name:   "session is not revoked yet - false",
+			expect: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := model.RestoreRefreshSession(
+				uuid.New(), uuid.New(), "hashed",
+				time.Now(), time.Now().Add(time.Hour),
+				tt.revokedAt, tt.revokeReason,
+				nil, nil, nil)
+			assert.Equal(t, tt.expect, session.IsRotated())
+		})
+	}
+}
+
 func TestRefreshSession_IsActive(t *testing.T) {
 	t.Parallel()
 
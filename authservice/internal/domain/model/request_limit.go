@@ -0,0 +1,109 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// Limiting keys, requests are counted separately per purpose and subject
+const (
+	requestKeyMagicLinkPrefix  = "magic_link:"
+	requestKeyVerifyPrefix     = "verify_email:"
+	requestKeyResetEmailPrefix = "reset_email:"
+	requestKeyResetIPPrefix    = "reset_ip:"
+)
+
+// MagicLinkKeyForEmail counts sign-in links sent to an address,
+// known or not, so the limit does not reveal whether an account exists
+func MagicLinkKeyForEmail(email string) string {
+	return requestKeyMagicLinkPrefix + strings.ToLower(strings.TrimSpace(email))
+}
+
+// VerificationKeyForAccount counts verification letters sent to an account
+func VerificationKeyForAccount(accountID uuid.UUID) string {
+	return requestKeyVerifyPrefix + accountID.String()
+}
+
+// ResetKeyForEmail counts reset links sent to an address, known or not,
+// so the limit does not reveal whether an account exists
+func ResetKeyForEmail(email string) string {
+	return requestKeyResetEmailPrefix + strings.ToLower(strings.TrimSpace(email))
+}
+
+// ResetKeyForIP counts reset links requested from a client IP,
+// whatever addresses it asks for
+func ResetKeyForIP(ip string) string {
+	return requestKeyResetIPPrefix + ip
+}
+
+// ================ Rate limit for requests that send letters ================
+
+// RequestLimit spaces out requests over a sliding window. The first
+// freeRequests are not delayed, then every next request has to wait
+// baseDelay * 2^n after the previous one, and after maxRequests the key
+// waits until its first request leaves the window
+type RequestLimit struct {
+	window       time.Duration
+	freeRequests int
+	maxRequests  int
+	baseDelay    time.Duration
+}
+
+func NewRequestLimit(
+	window time.Duration,
+	freeRequests, maxRequests int,
+	baseDelay time.Duration,
+) (*RequestLimit, error) {
+	if window <= 0 {
+		return nil, pkgerrs.NewValueInvalidError("window")
+	}
+	if freeRequests < 0 {
+		return nil, pkgerrs.NewValueInvalidError("free_requests")
+	}
+	if maxRequests <= freeRequests {
+		return nil, pkgerrs.NewValueInvalidError("max_requests")
+	}
+	if baseDelay <= 0 || baseDelay > window {
+		return nil, pkgerrs.NewValueInvalidError("base_delay")
+	}
+
+	return &RequestLimit{
+		window:       window,
+		freeRequests: freeRequests,
+		maxRequests:  maxRequests,
+		baseDelay:    baseDelay,
+	}, nil
+}
+
+// ================ Read-Only ================
+
+func (l *RequestLimit) Window() time.Duration { return l.window }
+
+// RetryAfter returns how long the caller has to wait before the next request,
+// requests is the number of requests within the window, first and last
+// the times of the oldest and the most recent one. Zero means it is allowed
+func (l *RequestLimit) RetryAfter(requests int, first, last, now time.Time) time.Duration {
+	if requests < l.freeRequests {
+		return 0
+	}
+
+	var until time.Time
+	if requests >= l.maxRequests {
+		until = first.Add(l.window)
+	} else {
+		wait := l.baseDelay
+		for i := l.freeRequests; i < requests && wait < l.window; i++ {
+			wait *= 2
+		}
+		until = last.Add(min(wait, l.window))
+	}
+
+	if remaining := until.Sub(now); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
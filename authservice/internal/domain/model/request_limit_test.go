@@ -0,0 +1,163 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRequestLimit(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name         string
+		window       time.Duration
+		freeRequests int
+		maxRequests  int
+		baseDelay    time.Duration
+		expect       error
+	}
+
+	var tests = []testCase{
+		{
+			name:         "valid limit",
+			window:       time.Hour,
+			freeRequests: 3,
+			maxRequests:  5,
+			baseDelay:    time.Minute,
+			expect:       nil,
+		},
+		{
+			name:         "empty window",
+			window:       0,
+			freeRequests: 3,
+			maxRequests:  5,
+			baseDelay:    time.Minute,
+			expect:       pkgerrs.ErrValueIsInvalid,
+		},
+		{
+			name:         "max requests not above free requests",
+			window:       time.Hour,
+			freeRequests: 3,
+			maxRequests:  3,
+			baseDelay:    time.Minute,
+			expect:       pkgerrs.ErrValueIsInvalid,
+		},
+		{
+			name:         "base delay longer than window",
+			window:       time.Minute,
+			freeRequests: 3,
+			maxRequests:  5,
+			baseDelay:    time.Hour,
+			expect:       pkgerrs.ErrValueIsInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			limit, err := model.NewRequestLimit(
+				tt.window, tt.freeRequests, tt.maxRequests, tt.baseDelay,
+			)
+
+			if tt.expect != nil {
+				assert.ErrorIs(t, err, tt.expect)
+				assert.Nil(t, limit)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.window, limit.Window())
+			}
+		})
+	}
+}
+
+func TestRequestLimit_RetryAfter(t *testing.T) {
+	t.Parallel()
+
+	limit, err := model.NewRequestLimit(time.Hour, 2, 4, time.Minute)
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	type testCase struct {
+		name     string
+		requests int
+		first    time.Time
+		last     time.Time
+		expect   time.Duration
+	}
+
+	var tests = []testCase{
+		{
+			name:     "no requests",
+			requests: 0,
+			expect:   0,
+		},
+		{
+			name:     "free requests are not delayed",
+			requests: 1,
+			first:    now,
+			last:     now,
+			expect:   0,
+		},
+		{
+			name:     "first delayed request",
+			requests: 2,
+			first:    now,
+			last:     now,
+			expect:   time.Minute,
+		},
+		{
+			name:     "delay doubles",
+			requests: 3,
+			first:    now,
+			last:     now,
+			expect:   time.Minute * 2,
+		},
+		{
+			name:     "delay is counted from the last request",
+			requests: 3,
+			first:    now.Add(-time.Minute * 10),
+			last:     now.Add(-time.Minute),
+			expect:   time.Minute,
+		},
+		{
+			name:     "limit waits for the first request to leave the window",
+			requests: 4,
+			first:    now.Add(-time.Minute * 45),
+			last:     now,
+			expect:   time.Minute * 15,
+		},
+		{
+			name:     "window has moved on",
+			requests: 4,
+			first:    now.Add(-time.Hour),
+			last:     now.Add(-time.Minute * 30),
+			expect:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, limit.RetryAfter(tt.requests, tt.first, tt.last, now))
+		})
+	}
+}
+
+func TestRequestKeys(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+	assert.Equal(t, "magic_link:user@email.com", model.MagicLinkKeyForEmail(" User@Email.com "))
+	assert.Equal(t, "verify_email:"+accountID.String(), model.VerificationKeyForAccount(accountID))
+	assert.Equal(t, "reset_email:user@email.com", model.ResetKeyForEmail(" User@Email.com "))
+	assert.Equal(t, "reset_ip:127.0.0.1", model.ResetKeyForIP("127.0.0.1"))
+}
@@ -0,0 +1,121 @@
+package model
+
+import (
+	"net/netip"
+	"strings"
+
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+type SessionBindingMode string
+
+func (m SessionBindingMode) String() string { return string(m) }
+
+// From the strictest to the most relaxed one
+const (
+	// SessionBindingStrict requires exactly the same IP and user agent
+	SessionBindingStrict SessionBindingMode = "strict"
+	// SessionBindingSubnet lets the IP move within its /24 (IPv4) or /64 (IPv6)
+	// network and the browser update, but not change its family
+	SessionBindingSubnet SessionBindingMode = "subnet"
+	// SessionBindingUserAgent ignores the IP and checks the user agent family only
+	SessionBindingUserAgent SessionBindingMode = "user_agent"
+	// SessionBindingNone does not bind sessions at all
+	SessionBindingNone SessionBindingMode = "none"
+)
+
+const (
+	ipv4BindingPrefix = 24
+	ipv6BindingPrefix = 64
+)
+
+func ParseSessionBindingMode(rawMode string) (SessionBindingMode, error) {
+	mode := SessionBindingMode(strings.ToLower(strings.TrimSpace(rawMode)))
+	switch mode {
+	case SessionBindingStrict, SessionBindingSubnet,
+		SessionBindingUserAgent, SessionBindingNone:
+		return mode, nil
+	default:
+		return "", pkgerrs.NewValueInvalidError("session_binding_mode")
+	}
+}
+
+// ================ Binding policy for refresh sessions ================
+
+// SessionBindingPolicy decides whether the client refreshing a session
+// still looks like the one the session was issued to
+type SessionBindingPolicy struct {
+	mode SessionBindingMode
+}
+
+func NewSessionBindingPolicy(rawMode string) (*SessionBindingPolicy, error) {
+	mode, err := ParseSessionBindingMode(rawMode)
+	if err != nil {
+		return nil, err
+	}
+	return &SessionBindingPolicy{mode: mode}, nil
+}
+
+// ================ Read-Only ================
+
+func (p *SessionBindingPolicy) Mode() SessionBindingMode { return p.mode }
+
+// Matches compares the session client with the current one, a missing
+// value is treated as an empty string
+func (p *SessionBindingPolicy) Matches(sessionIP, sessionUserAgent, ip, userAgent *string) bool {
+	var (
+		oldIP, newIP = valueOrEmpty(sessionIP), valueOrEmpty(ip)
+		oldUA, newUA = valueOrEmpty(sessionUserAgent), valueOrEmpty(userAgent)
+	)
+
+	switch p.mode {
+	case SessionBindingStrict:
+		return oldIP == newIP && oldUA == newUA
+	case SessionBindingSubnet:
+		return sameSubnet(oldIP, newIP) && sameUserAgentFamily(oldUA, newUA)
+	case SessionBindingUserAgent:
+		return sameUserAgentFamily(oldUA, newUA)
+	default:
+		return true
+	}
+}
+
+func sameSubnet(ip1, ip2 string) bool {
+	if ip1 == ip2 {
+		return true
+	}
+
+	addr1, err1 := netip.ParseAddr(ip1)
+	addr2, err2 := netip.ParseAddr(ip2)
+	if err1 != nil || err2 != nil {
+		return false
+	}
+	addr1, addr2 = addr1.Unmap(), addr2.Unmap()
+	if addr1.Is4() != addr2.Is4() {
+		return false
+	}
+
+	var bits = ipv6BindingPrefix
+	if addr1.Is4() {
+		bits = ipv4BindingPrefix
+	}
+	prefix, err := addr1.Prefix(bits)
+	if err != nil {
+		return false
+	}
+	return prefix.Contains(addr2)
+}
+
+func sameUserAgentFamily(ua1, ua2 string) bool {
+	if ua1 == ua2 {
+		return true
+	}
+	return ParseUserAgent(ua1).Family() == ParseUserAgent(ua2).Family()
+}
+
+func valueOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,170 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSessionBindingPolicy(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name   string
+		mode   string
+		want   model.SessionBindingMode
+		expect error
+	}
+
+	var tests = []testCase{
+		{name: "strict", mode: "strict", want: model.SessionBindingStrict},
+		{name: "subnet", mode: "subnet", want: model.SessionBindingSubnet},
+		{name: "user agent, case and spaces", mode: " USER_AGENT ", want: model.SessionBindingUserAgent},
+		{name: "none", mode: "none", want: model.SessionBindingNone},
+		{name: "unknown mode", mode: "paranoid", expect: pkgerrs.ErrValueIsInvalid},
+		{name: "empty mode", mode: "", expect: pkgerrs.ErrValueIsInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := model.NewSessionBindingPolicy(tt.mode)
+			if tt.expect != nil {
+				assert.ErrorIs(t, err, tt.expect)
+				assert.Nil(t, policy)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, policy.Mode())
+		})
+	}
+}
+
+func TestSessionBindingPolicy_Matches(t *testing.T) {
+	t.Parallel()
+
+	const (
+		chromeWindows   = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36"
+		chromeWindowsV2 = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/121.0.0.0 Safari/537.36"
+		firefoxWindows  = "Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:121.0) Gecko/20100101 Firefox/121.0"
+		chromeAndroid   = "Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36"
+	)
+
+	type testCase struct {
+		name   string
+		mode   string
+		oldIP  *string
+		oldUA  *string
+		newIP  *string
+		newUA  *string
+		expect bool
+	}
+
+	var tests = []testCase{
+		// strict
+		{
+			name: "strict - same client", mode: "strict",
+			oldIP: vPtr("10.0.0.1"), oldUA: vPtr(chromeWindows),
+			newIP: vPtr("10.0.0.1"), newUA: vPtr(chromeWindows),
+			expect: true,
+		},
+		{
+			name: "strict - neighbour ip", mode: "strict",
+			oldIP: vPtr("10.0.0.1"), oldUA: vPtr(chromeWindows),
+			newIP: vPtr("10.0.0.2"), newUA: vPtr(chromeWindows),
+			expect: false,
+		},
+		{
+			name: "strict - browser update", mode: "strict",
+			oldIP: vPtr("10.0.0.1"), oldUA: vPtr(chromeWindows),
+			newIP: vPtr("10.0.0.1"), newUA: vPtr(chromeWindowsV2),
+			expect: false,
+		},
+		{
+			name: "strict - missing equals empty", mode: "strict",
+			oldIP: nil, oldUA: vPtr(""),
+			newIP: vPtr(""), newUA: nil,
+			expect: true,
+		},
+		// subnet
+		{
+			name: "subnet - same ipv4 /24 and browser update", mode: "subnet",
+			oldIP: vPtr("192.168.1.10"), oldUA: vPtr(chromeWindows),
+			newIP: vPtr("192.168.1.200"), newUA: vPtr(chromeWindowsV2),
+			expect: true,
+		},
+		{
+			name: "subnet - another ipv4 network", mode: "subnet",
+			oldIP: vPtr("192.168.1.10"), oldUA: vPtr(chromeWindows),
+			newIP: vPtr("192.168.2.10"), newUA: vPtr(chromeWindows),
+			expect: false,
+		},
+		{
+			name: "subnet - same ipv6 /64", mode: "subnet",
+			oldIP: vPtr("2001:db8:1:2::1"), oldUA: vPtr(chromeWindows),
+			newIP: vPtr("2001:db8:1:2:ffff::9"), newUA: vPtr(chromeWindows),
+			expect: true,
+		},
+		{
+			name: "subnet - another ipv6 /64", mode: "subnet",
+			oldIP: vPtr("2001:db8:1:2::1"), oldUA: vPtr(chromeWindows),
+			newIP: vPtr("2001:db8:1:3::1"), newUA: vPtr(chromeWindows),
+			expect: false,
+		},
+		{
+			name: "subnet - ipv4 mapped into ipv6", mode: "subnet",
+			oldIP: vPtr("192.168.1.10"), oldUA: vPtr(chromeWindows),
+			newIP: vPtr("::ffff:192.168.1.20"), newUA: vPtr(chromeWindows),
+			expect: true,
+		},
+		{
+			name: "subnet - ipv4 to ipv6", mode: "subnet",
+			oldIP: vPtr("192.168.1.10"), oldUA: vPtr(chromeWindows),
+			newIP: vPtr("2001:db8::1"), newUA: vPtr(chromeWindows),
+			expect: false,
+		},
+		{
+			name: "subnet - unparsable ip", mode: "subnet",
+			oldIP: vPtr("192.168.1.10"), oldUA: vPtr(chromeWindows),
+			newIP: vPtr("not-an-ip"), newUA: vPtr(chromeWindows),
+			expect: false,
+		},
+		{
+			name: "subnet - another browser", mode: "subnet",
+			oldIP: vPtr("192.168.1.10"), oldUA: vPtr(chromeWindows),
+			newIP: vPtr("192.168.1.10"), newUA: vPtr(firefoxWindows),
+			expect: false,
+		},
+		// user agent family
+		{
+			name: "user agent - another network", mode: "user_agent",
+			oldIP: vPtr("192.168.1.10"), oldUA: vPtr(chromeWindows),
+			newIP: vPtr("8.8.8.8"), newUA: vPtr(chromeWindowsV2),
+			expect: true,
+		},
+		{
+			name: "user agent - another system", mode: "user_agent",
+			oldIP: vPtr("192.168.1.10"), oldUA: vPtr(chromeWindows),
+			newIP: vPtr("192.168.1.10"), newUA: vPtr(chromeAndroid),
+			expect: false,
+		},
+		// none
+		{
+			name: "none - everything changed", mode: "none",
+			oldIP: vPtr("192.168.1.10"), oldUA: vPtr(chromeWindows),
+			newIP: vPtr("8.8.8.8"), newUA: vPtr("curl/8.0"),
+			expect: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := model.NewSessionBindingPolicy(tt.mode)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, policy.Matches(tt.oldIP, tt.oldUA, tt.newIP, tt.newUA))
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package model
+
+import (
+	"slices"
+	"strings"
+
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+)
+
+// RevokeReasonSessionLimit marks a session evicted by a newer login
+const RevokeReasonSessionLimit = "session limit"
+
+type SessionLimitMode string
+
+func (m SessionLimitMode) String() string { return string(m) }
+
+const (
+	// SessionLimitEvictOldest revokes the oldest sessions to make room
+	SessionLimitEvictOldest SessionLimitMode = "evict_oldest"
+	// SessionLimitDenyNew keeps the existing sessions and refuses the login
+	SessionLimitDenyNew SessionLimitMode = "deny_new"
+)
+
+func ParseSessionLimitMode(rawMode string) (SessionLimitMode, error) {
+	mode := SessionLimitMode(strings.ToLower(strings.TrimSpace(rawMode)))
+	switch mode {
+	case SessionLimitEvictOldest, SessionLimitDenyNew:
+		return mode, nil
+	default:
+		return "", pkgerrs.NewValueInvalidError("session_limit_mode")
+	}
+}
+
+// ================ Active sessions cap ================
+
+// SessionLimitPolicy caps the number of active refresh sessions
+// of an account depending on its roles
+type SessionLimitPolicy struct {
+	mode   SessionLimitMode
+	limits map[Role]int
+}
+
+func NewSessionLimitPolicy(rawMode string, rawLimits map[string]int) (*SessionLimitPolicy, error) {
+	mode, err := ParseSessionLimitMode(rawMode)
+	if err != nil {
+		return nil, err
+	}
+
+	var limits = make(map[Role]int, len(rawLimits))
+	for rawRole, limit := range rawLimits {
+		role, err := ParseRole(rawRole)
+		if err != nil {
+			return nil, err
+		}
+		if limit <= 0 {
+			return nil, pkgerrs.NewValueInvalidError("session_limit")
+		}
+		limits[role] = limit
+	}
+
+	return &SessionLimitPolicy{mode: mode, limits: limits}, nil
+}
+
+// ================ Read-Only ================
+
+func (p *SessionLimitPolicy) Mode() SessionLimitMode { return p.mode }
+
+// Limit is the largest cap among the roles, so that an extra role never
+// takes sessions away. A role without a cap lifts it, 0 means no cap
+func (p *SessionLimitPolicy) Limit(roles []Role) int {
+	var limit int
+	for _, role := range roles {
+		roleLimit, ok := p.limits[role]
+		if !ok {
+			return 0
+		}
+		limit = max(limit, roleLimit)
+	}
+	return limit
+}
+
+// Excess returns the oldest active sessions that have to go
+// to leave room for one more, nothing when it fits under the cap
+func (p *SessionLimitPolicy) Excess(active []*RefreshSession, roles []Role) []*RefreshSession {
+	limit := p.Limit(roles)
+	if limit == 0 || len(active) < limit {
+		return nil
+	}
+
+	var sessions = slices.Clone(active)
+	slices.SortFunc(sessions, func(a, b *RefreshSession) int {
+		return a.CreatedAt().Compare(b.CreatedAt())
+	})
+	return sessions[:len(sessions)-limit+1]
+}
@@ -0,0 +1,87 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSessionLimitPolicy(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name   string
+		mode   string
+		limits map[string]int
+		want   model.SessionLimitMode
+		expect error
+	}
+
+	var tests = []testCase{
+		{name: "evict oldest", mode: "evict_oldest", limits: map[string]int{"user": 3}, want: model.SessionLimitEvictOldest},
+		{name: "deny new, case and spaces", mode: " DENY_NEW ", want: model.SessionLimitDenyNew},
+		{name: "unknown mode", mode: "random", expect: pkgerrs.ErrValueIsInvalid},
+		{name: "unknown role", mode: "deny_new", limits: map[string]int{"reseller": 3}, expect: pkgerrs.ErrValueIsInvalid},
+		{name: "zero limit", mode: "deny_new", limits: map[string]int{"user": 0}, expect: pkgerrs.ErrValueIsInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := model.NewSessionLimitPolicy(tt.mode, tt.limits)
+			if tt.expect != nil {
+				assert.ErrorIs(t, err, tt.expect)
+				assert.Nil(t, policy)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, policy.Mode())
+		})
+	}
+}
+
+func TestSessionLimitPolicy_Limit(t *testing.T) {
+	t.Parallel()
+
+	policy, err := model.NewSessionLimitPolicy("evict_oldest", map[string]int{
+		"guest": 1, "user": 3, "support": 10,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, policy.Limit([]model.Role{model.RoleUser}))
+	assert.Equal(t, 10, policy.Limit([]model.Role{model.RoleUser, model.RoleSupport}))
+	assert.Equal(t, 0, policy.Limit([]model.Role{model.RoleUser, model.RoleAdmin}))
+	assert.Equal(t, 0, policy.Limit(nil))
+}
+
+func TestSessionLimitPolicy_Excess(t *testing.T) {
+	t.Parallel()
+
+	policy, err := model.NewSessionLimitPolicy("evict_oldest", map[string]int{"user": 2})
+	require.NoError(t, err)
+
+	session := func(age time.Duration) *model.RefreshSession {
+		return model.RestoreRefreshSession(
+			uuid.New(), uuid.New(), "hash", time.Now().Add(-age),
+			time.Now().Add(time.Hour), nil, nil, nil, nil, nil,
+		)
+	}
+	var (
+		newest = session(time.Minute)
+		middle = session(time.Hour)
+		oldest = session(time.Hour * 2)
+		user   = []model.Role{model.RoleUser}
+	)
+
+	assert.Empty(t, policy.Excess([]*model.RefreshSession{newest}, user))
+	assert.Equal(t, []*model.RefreshSession{middle},
+		policy.Excess([]*model.RefreshSession{newest, middle}, user))
+	assert.Equal(t, []*model.RefreshSession{oldest, middle},
+		policy.Excess([]*model.RefreshSession{newest, oldest, middle}, user))
+	assert.Empty(t, policy.Excess([]*model.RefreshSession{newest, oldest, middle}, []model.Role{model.RoleAdmin}))
+}
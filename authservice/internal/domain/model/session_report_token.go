@@ -0,0 +1,92 @@
+package model
+
+import (
+	"time"
+
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// ================ Rich model for Session Report Token ================
+
+// SessionReportToken backs the "this wasn't me" link of a new device
+// notification, it ends exactly the session the letter was about
+type SessionReportToken struct {
+	id        uuid.UUID
+	accountID uuid.UUID
+	sessionID uuid.UUID
+	tokenHash string
+	createdAt time.Time
+	expiresAt time.Time
+	usedAt    *time.Time
+}
+
+func NewSessionReportToken(
+	accountID, sessionID uuid.UUID, tokenHash string, ttl time.Duration,
+) (*SessionReportToken, error) {
+	if accountID == uuid.Nil {
+		return nil, pkgerrs.NewValueInvalidError("account_id")
+	}
+	if sessionID == uuid.Nil {
+		return nil, pkgerrs.NewValueInvalidError("session_id")
+	}
+	if tokenHash == "" {
+		return nil, pkgerrs.NewValueRequiredError("token_hash")
+	}
+	if ttl <= 0 {
+		return nil, pkgerrs.NewValueInvalidError("ttl")
+	}
+
+	now := time.Now()
+
+	return &SessionReportToken{
+		id:        uuid.New(),
+		accountID: accountID,
+		sessionID: sessionID,
+		tokenHash: tokenHash,
+		createdAt: now,
+		expiresAt: now.Add(ttl),
+	}, nil
+}
+
+func RestoreSessionReportToken(
+	id, accountID, sessionID uuid.UUID, tokenHash string,
+	createdAt, expiresAt time.Time, usedAt *time.Time,
+) *SessionReportToken {
+	return &SessionReportToken{
+		id:        id,
+		accountID: accountID,
+		sessionID: sessionID,
+		tokenHash: tokenHash,
+		createdAt: createdAt,
+		expiresAt: expiresAt,
+		usedAt:    usedAt,
+	}
+}
+
+// ================ Read-Only ================
+
+func (t *SessionReportToken) ID() uuid.UUID        { return t.id }
+func (t *SessionReportToken) AccountID() uuid.UUID { return t.accountID }
+func (t *SessionReportToken) SessionID() uuid.UUID { return t.sessionID }
+func (t *SessionReportToken) TokenHash() string    { return t.tokenHash }
+func (t *SessionReportToken) CreatedAt() time.Time { return t.createdAt }
+func (t *SessionReportToken) ExpiresAt() time.Time { return t.expiresAt }
+func (t *SessionReportToken) UsedAt() *time.Time   { return t.usedAt }
+
+func (t *SessionReportToken) IsActive() bool  { return !t.IsExpired() && !t.IsUsed() }
+func (t *SessionReportToken) IsExpired() bool { return time.Now().After(t.expiresAt) }
+func (t *SessionReportToken) IsUsed() bool    { return t.usedAt != nil }
+
+// ================ Mutation ================
+
+func (t *SessionReportToken) Use() error {
+	if t.IsUsed() {
+		return ErrTokenAlreadyUsed
+	}
+
+	now := time.Now()
+	t.usedAt = &now
+	return nil
+}
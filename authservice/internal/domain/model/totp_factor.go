@@ -0,0 +1,89 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTOTPAlreadyConfirmed = errors.New("totp factor has been already confirmed")
+	ErrTOTPCodeReused       = errors.New("totp code has been already used")
+)
+
+// ================ Rich model for TOTP second factor ================
+
+// TOTPFactor keeps the shared secret encrypted, the plain one never leaves
+// the enrollment response. The factor is enabled once it is confirmed
+type TOTPFactor struct {
+	accountID       uuid.UUID
+	encryptedSecret string
+	createdAt       time.Time
+	confirmedAt     *time.Time
+	lastUsedStep    *int64
+}
+
+func NewTOTPFactor(accountID uuid.UUID, encryptedSecret string) (*TOTPFactor, error) {
+	if accountID == uuid.Nil {
+		return nil, pkgerrs.NewValueInvalidError("account_id")
+	}
+	if encryptedSecret == "" {
+		return nil, pkgerrs.NewValueRequiredError("encrypted_secret")
+	}
+
+	return &TOTPFactor{
+		accountID:       accountID,
+		encryptedSecret: encryptedSecret,
+		createdAt:       time.Now(),
+	}, nil
+}
+
+func RestoreTOTPFactor(
+	accountID uuid.UUID, encryptedSecret string,
+	createdAt time.Time, confirmedAt *time.Time,
+	lastUsedStep *int64,
+) *TOTPFactor {
+	return &TOTPFactor{
+		accountID:       accountID,
+		encryptedSecret: encryptedSecret,
+		createdAt:       createdAt,
+		confirmedAt:     confirmedAt,
+		lastUsedStep:    lastUsedStep,
+	}
+}
+
+// ================ Read-Only ================
+
+func (f *TOTPFactor) AccountID() uuid.UUID    { return f.accountID }
+func (f *TOTPFactor) EncryptedSecret() string { return f.encryptedSecret }
+func (f *TOTPFactor) CreatedAt() time.Time    { return f.createdAt }
+func (f *TOTPFactor) ConfirmedAt() *time.Time { return f.confirmedAt }
+func (f *TOTPFactor) LastUsedStep() *int64    { return f.lastUsedStep }
+
+func (f *TOTPFactor) IsConfirmed() bool { return f.confirmedAt != nil }
+
+// ================ Mutation ================
+
+func (f *TOTPFactor) Confirm() error {
+	if f.IsConfirmed() {
+		return ErrTOTPAlreadyConfirmed
+	}
+
+	now := time.Now()
+	f.confirmedAt = &now
+	return nil
+}
+
+// UseStep accepts a code of the given time step, a step can not be
+// used twice and neither can the ones before the last accepted step
+func (f *TOTPFactor) UseStep(step int64) error {
+	if f.lastUsedStep != nil && step <= *f.lastUsedStep {
+		return ErrTOTPCodeReused
+	}
+
+	f.lastUsedStep = &step
+	return nil
+}
@@ -0,0 +1,103 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+	pkgerrs "github.com/maket12/ads-service/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTOTPFactor(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name            string
+		accountID       uuid.UUID
+		encryptedSecret string
+		expect          error
+	}
+
+	var tests = []testCase{
+		{
+			name:            "success",
+			accountID:       uuid.New(),
+			encryptedSecret: "sealed",
+			expect:          nil,
+		},
+		{
+			name:            "nullable account id",
+			accountID:       uuid.Nil,
+			encryptedSecret: "sealed",
+			expect:          pkgerrs.ErrValueIsInvalid,
+		},
+		{
+			name:            "empty secret",
+			accountID:       uuid.New(),
+			encryptedSecret: "",
+			expect:          pkgerrs.ErrValueIsRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factor, err := model.NewTOTPFactor(tt.accountID, tt.encryptedSecret)
+			if tt.expect == nil {
+				require.NoError(t, err)
+				require.NotNil(t, factor)
+				assert.Equal(t, tt.accountID, factor.AccountID())
+				assert.Equal(t, tt.encryptedSecret, factor.EncryptedSecret())
+				assert.False(t, factor.IsConfirmed())
+			} else {
+				assert.ErrorIs(t, err, tt.expect)
+				assert.Nil(t, factor)
+			}
+		})
+	}
+}
+
+func TestTOTPFactor_Confirm(t *testing.T) {
+	t.Parallel()
+
+	factor, err := model.NewTOTPFactor(uuid.New(), "sealed")
+	require.NoError(t, err)
+
+	require.NoError(t, factor.Confirm())
+	assert.True(t, factor.IsConfirmed())
+	assert.NotNil(t, factor.ConfirmedAt())
+
+	assert.ErrorIs(t, factor.Confirm(), model.ErrTOTPAlreadyConfirmed)
+}
+
+func TestTOTPFactor_UseStep(t *testing.T) {
+	t.Parallel()
+
+	factor, err := model.NewTOTPFactor(uuid.New(), "sealed")
+	require.NoError(t, err)
+	assert.Nil(t, factor.LastUsedStep())
+
+	require.NoError(t, factor.UseStep(100))
+	assert.Equal(t, int64(100), *factor.LastUsedStep())
+
+	assert.ErrorIs(t, factor.UseStep(100), model.ErrTOTPCodeReused)
+	assert.ErrorIs(t, factor.UseStep(99), model.ErrTOTPCodeReused)
+	require.NoError(t, factor.UseStep(101))
+}
+
+func TestNewRecoveryCode(t *testing.T) {
+	t.Parallel()
+
+	code, err := model.NewRecoveryCode(uuid.New(), "hashed")
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, code.ID())
+	assert.False(t, code.IsUsed())
+
+	_, err = model.NewRecoveryCode(uuid.Nil, "hashed")
+	assert.ErrorIs(t, err, pkgerrs.ErrValueIsInvalid)
+
+	_, err = model.NewRecoveryCode(uuid.New(), "")
+	assert.ErrorIs(t, err, pkgerrs.ErrValueIsRequired)
+}
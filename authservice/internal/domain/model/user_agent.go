@@ -0,0 +1,74 @@
+package model
+
+import "strings"
+
+// UserAgentInfo is a coarse description of the client, enough to tell
+// devices apart without keeping a full user agent database
+type UserAgentInfo struct {
+	Browser string
+	OS      string
+}
+
+// Known browsers, the order matters: most of them mimic Chrome and Safari
+var userAgentBrowsers = []struct {
+	marker string
+	name   string
+}{
+	{"Edg/", "Edge"},
+	{"EdgA/", "Edge"},
+	{"OPR/", "Opera"},
+	{"YaBrowser/", "Yandex"},
+	{"SamsungBrowser/", "Samsung Internet"},
+	{"FxiOS/", "Firefox"},
+	{"Firefox/", "Firefox"},
+	{"CriOS/", "Chrome"},
+	{"Chrome/", "Chrome"},
+	{"Safari/", "Safari"},
+}
+
+// Mobile systems go first, their user agents mention desktop ones as well
+var userAgentSystems = []struct {
+	marker string
+	name   string
+}{
+	{"iPhone", "iOS"},
+	{"iPad", "iOS"},
+	{"Android", "Android"},
+	{"Windows", "Windows"},
+	{"CrOS", "ChromeOS"},
+	{"Mac OS X", "macOS"},
+	{"Macintosh", "macOS"},
+	{"Linux", "Linux"},
+}
+
+// ParseUserAgent recognises popular browsers and systems, other clients
+// (mobile apps, scripts) are named after the first product token
+func ParseUserAgent(userAgent string) UserAgentInfo {
+	var info UserAgentInfo
+	for _, b := range userAgentBrowsers {
+		if strings.Contains(userAgent, b.marker) {
+			info.Browser = b.name
+			break
+		}
+	}
+	if info.Browser == "" {
+		product, _, _ := strings.Cut(strings.TrimSpace(userAgent), " ")
+		info.Browser, _, _ = strings.Cut(product, "/")
+	}
+
+	for _, s := range userAgentSystems {
+		if strings.Contains(userAgent, s.marker) {
+			info.OS = s.name
+			break
+		}
+	}
+	return info
+}
+
+// Family stays the same across browser updates, e.g. "Chrome on Android"
+func (i UserAgentInfo) Family() string {
+	if i.OS == "" {
+		return i.Browser
+	}
+	return i.Browser + " on " + i.OS
+}
@@ -0,0 +1,79 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUserAgent(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name      string
+		userAgent string
+		expect    model.UserAgentInfo
+		family    string
+	}
+
+	var tests = []testCase{
+		{
+			name:      "chrome on windows",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			expect:    model.UserAgentInfo{Browser: "Chrome", OS: "Windows"},
+			family:    "Chrome on Windows",
+		},
+		{
+			name:      "edge pretends to be chrome",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.2210.91",
+			expect:    model.UserAgentInfo{Browser: "Edge", OS: "Windows"},
+			family:    "Edge on Windows",
+		},
+		{
+			name:      "safari on iphone",
+			userAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Mobile/15E148 Safari/604.1",
+			expect:    model.UserAgentInfo{Browser: "Safari", OS: "iOS"},
+			family:    "Safari on iOS",
+		},
+		{
+			name:      "firefox on android",
+			userAgent: "Mozilla/5.0 (Android 14; Mobile; rv:121.0) Gecko/121.0 Firefox/121.0",
+			expect:    model.UserAgentInfo{Browser: "Firefox", OS: "Android"},
+			family:    "Firefox on Android",
+		},
+		{
+			name:      "firefox on linux",
+			userAgent: "Mozilla/5.0 (X11; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0",
+			expect:    model.UserAgentInfo{Browser: "Firefox", OS: "Linux"},
+			family:    "Firefox on Linux",
+		},
+		{
+			name:      "mobile app",
+			userAgent: "AdsApp/2.3.1 (Android 14)",
+			expect:    model.UserAgentInfo{Browser: "AdsApp", OS: "Android"},
+			family:    "AdsApp on Android",
+		},
+		{
+			name:      "script",
+			userAgent: "curl/8.4.0",
+			expect:    model.UserAgentInfo{Browser: "curl"},
+			family:    "curl",
+		},
+		{
+			name:      "empty",
+			userAgent: "",
+			expect:    model.UserAgentInfo{},
+			family:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := model.ParseUserAgent(tt.userAgent)
+			assert.Equal(t, tt.expect, info)
+			assert.Equal(t, tt.family, info.Family())
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// AccessTokenDenylist keeps revoked access tokens until they expire on
+// their own (see model.DenylistKeyForToken and model.DenylistKeyForSession)
+type AccessTokenDenylist interface {
+	// Add denies the key until expiresAt, a later expiry of an existing key wins
+	Add(ctx context.Context, key string, expiresAt time.Time) error
+	Contains(ctx context.Context, key string) (bool, error)
+	// DeleteExpired removes up to limit entries expired before the given time
+	DeleteExpired(ctx context.Context, expiredBefore time.Time, limit int) (int64, error)
+}
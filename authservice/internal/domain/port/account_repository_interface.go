@@ -2,16 +2,65 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"github.com/maket12/ads-service/authservice/internal/domain/model"
 
 	"github.com/google/uuid"
 )
 
+// AccountFilter narrows the admin listing, nil fields are not filtered on
+type AccountFilter struct {
+	Status        *model.AccountStatus
+	Role          *model.Role
+	EmailVerified *bool
+	EmailPrefix   *string    // case-insensitive
+	CreatedFrom   *time.Time // inclusive
+	CreatedTo     *time.Time // exclusive
+	LastLoginFrom *time.Time // inclusive, accounts that never logged in are left out
+	LastLoginTo   *time.Time // exclusive, accounts that never logged in are left out
+}
+
+type AccountSortField string
+
+func (f AccountSortField) String() string { return string(f) }
+
+const (
+	AccountSortCreatedAt   AccountSortField = "created_at"
+	AccountSortLastLoginAt AccountSortField = "last_login_at"
+	AccountSortEmail       AccountSortField = "email"
+)
+
+type AccountSort struct {
+	Field AccountSortField
+	Desc  bool
+}
+
+// AccountCursor points at the last account of the previous page, only the
+// value of the field the list is sorted by is used. Accounts that never
+// logged in have the zero LastLoginAt
+type AccountCursor struct {
+	CreatedAt   time.Time
+	LastLoginAt time.Time
+	Email       string
+	ID          uuid.UUID
+}
+
+// AccountSummary is a row of the admin listing
+type AccountSummary struct {
+	Account        *model.Account
+	Roles          []model.Role
+	ActiveSessions int
+}
+
 type AccountRepository interface {
 	Create(ctx context.Context, account *model.Account) error
 	GetByEmail(ctx context.Context, email string) (*model.Account, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*model.Account, error)
 	MarkLogin(ctx context.Context, account *model.Account) error
 	VerifyEmail(ctx context.Context, account *model.Account) error
+	UpdatePassword(ctx context.Context, account *model.Account) error
+	UpdateStatus(ctx context.Context, account *model.Account) error
+	// List returns accounts in the given order, starting right after the cursor
+	List(ctx context.Context, filter AccountFilter, sort AccountSort, after *AccountCursor, limit int) ([]*AccountSummary, error)
 }
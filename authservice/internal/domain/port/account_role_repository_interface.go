@@ -10,7 +10,10 @@ import (
 
 type AccountRoleRepository interface {
 	Create(ctx context.Context, accountRole *model.AccountRole) error
+	// Get returns roles of the account together with the permissions they grant
 	Get(ctx context.Context, accountID uuid.UUID) (*model.AccountRole, error)
-	Update(ctx context.Context, accountRole *model.AccountRole) error
+	AddRole(ctx context.Context, accountID uuid.UUID, role model.Role) error
+	// RemoveRole never removes the last role of an account
+	RemoveRole(ctx context.Context, accountID uuid.UUID, role model.Role) error
 	Delete(ctx context.Context, accountID uuid.UUID) error
 }
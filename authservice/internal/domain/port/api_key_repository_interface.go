@@ -0,0 +1,19 @@
+package port
+
+import (
+	"context"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *model.APIKey) error
+	GetByID(ctx context.Context, id uuid.UUID) (*model.APIKey, error)
+	GetByPrefix(ctx context.Context, prefix string) (*model.APIKey, error)
+	// ListByAccount returns revoked and expired keys as well, newest first
+	ListByAccount(ctx context.Context, accountID uuid.UUID) ([]*model.APIKey, error)
+	Revoke(ctx context.Context, key *model.APIKey) error
+	MarkUsed(ctx context.Context, key *model.APIKey) error
+}
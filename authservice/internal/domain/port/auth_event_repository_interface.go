@@ -0,0 +1,31 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+// AuthEventFilter narrows the audit log, nil fields are not filtered on
+type AuthEventFilter struct {
+	AccountID *uuid.UUID
+	EventType *model.AuthEventType
+	Outcome   *model.AuthEventOutcome
+	From      *time.Time // inclusive
+	To        *time.Time // exclusive
+}
+
+// AuthEventCursor points at the last event of the previous page
+type AuthEventCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+type AuthEventRepository interface {
+	Create(ctx context.Context, event *model.AuthEvent) error
+	// List returns events newest first, starting right after the cursor
+	List(ctx context.Context, filter AuthEventFilter, after *AuthEventCursor, limit int) ([]*model.AuthEvent, error)
+}
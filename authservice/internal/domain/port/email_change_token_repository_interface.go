@@ -0,0 +1,16 @@
+package port
+
+import (
+	"context"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+)
+
+type EmailChangeTokenRepository interface {
+	Create(ctx context.Context, token *model.EmailChangeToken) error
+	GetByHash(ctx context.Context, tokenHash string) (*model.EmailChangeToken, error)
+	// Confirm marks the used token and swaps the account email atomically,
+	// model.ErrTokenAlreadyUsed is returned if the token was consumed meanwhile
+	// and pkgerrs.ErrObjectAlreadyExists if the address has been taken
+	Confirm(ctx context.Context, token *model.EmailChangeToken) error
+}
@@ -0,0 +1,16 @@
+package port
+
+import (
+	"context"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+type EmailVerificationTokenRepository interface {
+	Create(ctx context.Context, token *model.EmailVerificationToken) error
+	GetByHash(ctx context.Context, tokenHash string) (*model.EmailVerificationToken, error)
+	MarkUsed(ctx context.Context, token *model.EmailVerificationToken) error
+	DeleteUnused(ctx context.Context, accountID uuid.UUID) error
+}
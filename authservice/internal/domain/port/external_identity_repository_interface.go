@@ -0,0 +1,12 @@
+package port
+
+import (
+	"context"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+)
+
+type ExternalIdentityRepository interface {
+	Create(ctx context.Context, identity *model.ExternalIdentity) error
+	GetByProviderSubject(ctx context.Context, provider, subject string) (*model.ExternalIdentity, error)
+}
@@ -0,0 +1,12 @@
+package port
+
+import (
+	"context"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+)
+
+// KeySet exposes the public keys used to verify access tokens
+type KeySet interface {
+	PublicKeys(ctx context.Context) ([]*model.PublicKey, error)
+}
@@ -0,0 +1,9 @@
+package port
+
+import "context"
+
+// Locker is a cross-replica mutex, acquired is false when
+// another process holds the lock. unlock must be called when done
+type Locker interface {
+	TryLock(ctx context.Context, name string) (unlock func(ctx context.Context) error, acquired bool, err error)
+}
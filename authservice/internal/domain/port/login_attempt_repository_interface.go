@@ -0,0 +1,18 @@
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// LoginAttemptRepository keeps failed login attempts per throttling key
+// (see model.LoginKeyForEmail and model.LoginKeyForIP)
+type LoginAttemptRepository interface {
+	// RecordFailure stores a failure and forgets the ones older than the window
+	RecordFailure(ctx context.Context, key string, at time.Time, window time.Duration) error
+	// GetFailures returns the number of failures since the given time and the latest one
+	GetFailures(ctx context.Context, key string, since time.Time) (count int, last time.Time, err error)
+	Clear(ctx context.Context, key string) error
+	// DeleteExpired removes up to limit failures of any key older than the given time
+	DeleteExpired(ctx context.Context, expiredBefore time.Time, limit int) (int64, error)
+}
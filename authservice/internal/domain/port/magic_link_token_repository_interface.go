@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+)
+
+type MagicLinkTokenRepository interface {
+	Create(ctx context.Context, token *model.MagicLinkToken) error
+	GetByHash(ctx context.Context, tokenHash string) (*model.MagicLinkToken, error)
+	MarkUsed(ctx context.Context, token *model.MagicLinkToken) error
+}
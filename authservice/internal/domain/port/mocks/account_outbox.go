@@ -0,0 +1,156 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// AccountOutbox is an autogenerated mock type for the AccountOutbox type
+type AccountOutbox struct {
+	mock.Mock
+}
+
+// AddAccountBlocked provides a mock function with given fields: ctx, accountID, reason
+func (_m *AccountOutbox) AddAccountBlocked(ctx context.Context, accountID uuid.UUID, reason string) error {
+	ret := _m.Called(ctx, accountID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAccountBlocked")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, accountID, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddAccountCreated provides a mock function with given fields: ctx, accountID
+func (_m *AccountOutbox) AddAccountCreated(ctx context.Context, accountID uuid.UUID) error {
+	ret := _m.Called(ctx, accountID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAccountCreated")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, accountID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddAccountDeleted provides a mock function with given fields: ctx, accountID, reason
+func (_m *AccountOutbox) AddAccountDeleted(ctx context.Context, accountID uuid.UUID, reason string) error {
+	ret := _m.Called(ctx, accountID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAccountDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, accountID, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddAccountUnblocked provides a mock function with given fields: ctx, accountID
+func (_m *AccountOutbox) AddAccountUnblocked(ctx context.Context, accountID uuid.UUID) error {
+	ret := _m.Called(ctx, accountID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAccountUnblocked")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, accountID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddNewDeviceLogin provides a mock function with given fields: ctx, accountID, sessionID, ip, browser, os
+func (_m *AccountOutbox) AddNewDeviceLogin(ctx context.Context, accountID uuid.UUID, sessionID uuid.UUID, ip *string, browser string, os string) error {
+	ret := _m.Called(ctx, accountID, sessionID, ip, browser, os)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddNewDeviceLogin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *string, string, string) error); ok {
+		r0 = rf(ctx, accountID, sessionID, ip, browser, os)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddSessionAnomaly provides a mock function with given fields: ctx, accountID, sessionID, previousIP, previousUserAgent, ip, userAgent
+func (_m *AccountOutbox) AddSessionAnomaly(ctx context.Context, accountID uuid.UUID, sessionID uuid.UUID, previousIP *string, previousUserAgent *string, ip *string, userAgent *string) error {
+	ret := _m.Called(ctx, accountID, sessionID, previousIP, previousUserAgent, ip, userAgent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSessionAnomaly")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *string, *string, *string, *string) error); ok {
+		r0 = rf(ctx, accountID, sessionID, previousIP, previousUserAgent, ip, userAgent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddSessionCompromised provides a mock function with given fields: ctx, accountID, sessionID, ip, userAgent
+func (_m *AccountOutbox) AddSessionCompromised(ctx context.Context, accountID uuid.UUID, sessionID uuid.UUID, ip *string, userAgent *string) error {
+	ret := _m.Called(ctx, accountID, sessionID, ip, userAgent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSessionCompromised")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *string, *string) error); ok {
+		r0 = rf(ctx, accountID, sessionID, ip, userAgent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewAccountOutbox creates a new instance of AccountOutbox. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAccountOutbox(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AccountOutbox {
+	mock := &AccountOutbox{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -15,17 +15,17 @@ type AccountPublisher struct {
 	mock.Mock
 }
 
-// PublishAccountCreate provides a mock function with given fields: ctx, accountID
-func (_m *AccountPublisher) PublishAccountCreate(ctx context.Context, accountID uuid.UUID) error {
-	ret := _m.Called(ctx, accountID)
+// PublishAccountEmailChanged provides a mock function with given fields: ctx, accountID, oldEmail, newEmail
+func (_m *AccountPublisher) PublishAccountEmailChanged(ctx context.Context, accountID uuid.UUID, oldEmail string, newEmail string) error {
+	ret := _m.Called(ctx, accountID, oldEmail, newEmail)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PublishAccountCreate")
+		panic("no return value specified for PublishAccountEmailChanged")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, accountID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r0 = rf(ctx, accountID, oldEmail, newEmail)
 	} else {
 		r0 = ret.Error(0)
 	}
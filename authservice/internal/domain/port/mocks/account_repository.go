@@ -3,12 +3,12 @@
 package mocks
 
 import (
-	model "github.com/maket12/ads-service/authservice/internal/domain/model"
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
-
 	uuid "github.com/google/uuid"
+	model "github.com/maket12/ads-service/authservice/internal/domain/model"
+	port "github.com/maket12/ads-service/authservice/internal/domain/port"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // AccountRepository is an autogenerated mock type for the AccountRepository type
@@ -94,6 +94,36 @@ func (_m *AccountRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.
 	return r0, r1
 }
 
+// List provides a mock function with given fields: ctx, filter, sort, after, limit
+func (_m *AccountRepository) List(ctx context.Context, filter port.AccountFilter, sort port.AccountSort, after *port.AccountCursor, limit int) ([]*port.AccountSummary, error) {
+	ret := _m.Called(ctx, filter, sort, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*port.AccountSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, port.AccountFilter, port.AccountSort, *port.AccountCursor, int) ([]*port.AccountSummary, error)); ok {
+		return rf(ctx, filter, sort, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, port.AccountFilter, port.AccountSort, *port.AccountCursor, int) []*port.AccountSummary); ok {
+		r0 = rf(ctx, filter, sort, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*port.AccountSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, port.AccountFilter, port.AccountSort, *port.AccountCursor, int) error); ok {
+		r1 = rf(ctx, filter, sort, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MarkLogin provides a mock function with given fields: ctx, account
 func (_m *AccountRepository) MarkLogin(ctx context.Context, account *model.Account) error {
 	ret := _m.Called(ctx, account)
@@ -112,6 +142,42 @@ func (_m *AccountRepository) MarkLogin(ctx context.Context, account *model.Accou
 	return r0
 }
 
+// UpdatePassword provides a mock function with given fields: ctx, account
+func (_m *AccountRepository) UpdatePassword(ctx context.Context, account *model.Account) error {
+	ret := _m.Called(ctx, account)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Account) error); ok {
+		r0 = rf(ctx, account)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateStatus provides a mock function with given fields: ctx, account
+func (_m *AccountRepository) UpdateStatus(ctx context.Context, account *model.Account) error {
+	ret := _m.Called(ctx, account)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Account) error); ok {
+		r0 = rf(ctx, account)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // VerifyEmail provides a mock function with given fields: ctx, account
 func (_m *AccountRepository) VerifyEmail(ctx context.Context, account *model.Account) error {
 	ret := _m.Called(ctx, account)
@@ -3,9 +3,9 @@
 package mocks
 
 import (
-	model "github.com/maket12/ads-service/authservice/internal/domain/model"
 	context "context"
 
+	model "github.com/maket12/ads-service/authservice/internal/domain/model"
 	mock "github.com/stretchr/testify/mock"
 
 	uuid "github.com/google/uuid"
@@ -16,6 +16,24 @@ type AccountRoleRepository struct {
 	mock.Mock
 }
 
+// AddRole provides a mock function with given fields: ctx, accountID, role
+func (_m *AccountRoleRepository) AddRole(ctx context.Context, accountID uuid.UUID, role model.Role) error {
+	ret := _m.Called(ctx, accountID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.Role) error); ok {
+		r0 = rf(ctx, accountID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: ctx, accountRole
 func (_m *AccountRoleRepository) Create(ctx context.Context, accountRole *model.AccountRole) error {
 	ret := _m.Called(ctx, accountRole)
@@ -82,17 +100,17 @@ func (_m *AccountRoleRepository) Get(ctx context.Context, accountID uuid.UUID) (
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: ctx, accountRole
-func (_m *AccountRoleRepository) Update(ctx context.Context, accountRole *model.AccountRole) error {
-	ret := _m.Called(ctx, accountRole)
+// RemoveRole provides a mock function with given fields: ctx, accountID, role
+func (_m *AccountRoleRepository) RemoveRole(ctx context.Context, accountID uuid.UUID, role model.Role) error {
+	ret := _m.Called(ctx, accountID, role)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for RemoveRole")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.AccountRole) error); ok {
-		r0 = rf(ctx, accountRole)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.Role) error); ok {
+		r0 = rf(ctx, accountID, role)
 	} else {
 		r0 = ret.Error(0)
 	}
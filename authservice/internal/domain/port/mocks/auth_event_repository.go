@@ -0,0 +1,78 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/maket12/ads-service/authservice/internal/domain/model"
+	port "github.com/maket12/ads-service/authservice/internal/domain/port"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuthEventRepository is an autogenerated mock type for the AuthEventRepository type
+type AuthEventRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, event
+func (_m *AuthEventRepository) Create(ctx context.Context, event *model.AuthEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AuthEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: ctx, filter, after, limit
+func (_m *AuthEventRepository) List(ctx context.Context, filter port.AuthEventFilter, after *port.AuthEventCursor, limit int) ([]*model.AuthEvent, error) {
+	ret := _m.Called(ctx, filter, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*model.AuthEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, port.AuthEventFilter, *port.AuthEventCursor, int) ([]*model.AuthEvent, error)); ok {
+		return rf(ctx, filter, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, port.AuthEventFilter, *port.AuthEventCursor, int) []*model.AuthEvent); ok {
+		r0 = rf(ctx, filter, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AuthEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, port.AuthEventFilter, *port.AuthEventCursor, int) error); ok {
+		r1 = rf(ctx, filter, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAuthEventRepository creates a new instance of AuthEventRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuthEventRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuthEventRepository {
+	mock := &AuthEventRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/maket12/ads-service/authservice/internal/domain/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EmailChangeTokenRepository is an autogenerated mock type for the EmailChangeTokenRepository type
+type EmailChangeTokenRepository struct {
+	mock.Mock
+}
+
+// Confirm provides a mock function with given fields: ctx, token
+func (_m *EmailChangeTokenRepository) Confirm(ctx context.Context, token *model.EmailChangeToken) error {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Confirm")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.EmailChangeToken) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Create provides a mock function with given fields: ctx, token
+func (_m *EmailChangeTokenRepository) Create(ctx context.Context, token *model.EmailChangeToken) error {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.EmailChangeToken) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByHash provides a mock function with given fields: ctx, tokenHash
+func (_m *EmailChangeTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*model.EmailChangeToken, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByHash")
+	}
+
+	var r0 *model.EmailChangeToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.EmailChangeToken, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.EmailChangeToken); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.EmailChangeToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewEmailChangeTokenRepository creates a new instance of EmailChangeTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEmailChangeTokenRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EmailChangeTokenRepository {
+	mock := &EmailChangeTokenRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/maket12/ads-service/authservice/internal/domain/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// KeySet is an autogenerated mock type for the KeySet type
+type KeySet struct {
+	mock.Mock
+}
+
+// PublicKeys provides a mock function with given fields: ctx
+func (_m *KeySet) PublicKeys(ctx context.Context) ([]*model.PublicKey, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublicKeys")
+	}
+
+	var r0 []*model.PublicKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.PublicKey, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.PublicKey); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PublicKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewKeySet creates a new instance of KeySet. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewKeySet(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *KeySet {
+	mock := &KeySet{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
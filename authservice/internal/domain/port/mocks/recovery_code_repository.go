@@ -0,0 +1,67 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/maket12/ads-service/authservice/internal/domain/model"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// RecoveryCodeRepository is an autogenerated mock type for the RecoveryCodeRepository type
+type RecoveryCodeRepository struct {
+	mock.Mock
+}
+
+// Replace provides a mock function with given fields: ctx, accountID, codes
+func (_m *RecoveryCodeRepository) Replace(ctx context.Context, accountID uuid.UUID, codes []*model.RecoveryCode) error {
+	ret := _m.Called(ctx, accountID, codes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Replace")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []*model.RecoveryCode) error); ok {
+		r0 = rf(ctx, accountID, codes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Use provides a mock function with given fields: ctx, accountID, codeHash
+func (_m *RecoveryCodeRepository) Use(ctx context.Context, accountID uuid.UUID, codeHash string) error {
+	ret := _m.Called(ctx, accountID, codeHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Use")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, accountID, codeHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewRecoveryCodeRepository creates a new instance of RecoveryCodeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRecoveryCodeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RecoveryCodeRepository {
+	mock := &RecoveryCodeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
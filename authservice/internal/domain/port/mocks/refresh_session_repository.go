@@ -3,9 +3,9 @@
 package mocks
 
 import (
-	model "github.com/maket12/ads-service/authservice/internal/domain/model"
 	context "context"
 
+	model "github.com/maket12/ads-service/authservice/internal/domain/model"
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
@@ -18,6 +18,41 @@ type RefreshSessionRepository struct {
 	mock.Mock
 }
 
+// CountRecent provides a mock function with given fields: ctx, accountID, ip, userAgent, createdAfter
+func (_m *RefreshSessionRepository) CountRecent(ctx context.Context, accountID uuid.UUID, ip *string, userAgent *string, createdAfter time.Time) (int, int, error) {
+	ret := _m.Called(ctx, accountID, ip, userAgent, createdAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountRecent")
+	}
+
+	var r0 int
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string, *string, time.Time) (int, int, error)); ok {
+		return rf(ctx, accountID, ip, userAgent, createdAfter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string, *string, time.Time) int); ok {
+		r0 = rf(ctx, accountID, ip, userAgent, createdAfter)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *string, *string, time.Time) int); ok {
+		r1 = rf(ctx, accountID, ip, userAgent, createdAfter)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, *string, *string, time.Time) error); ok {
+		r2 = rf(ctx, accountID, ip, userAgent, createdAfter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Create provides a mock function with given fields: ctx, session
 func (_m *RefreshSessionRepository) Create(ctx context.Context, session *model.RefreshSession) error {
 	ret := _m.Called(ctx, session)
@@ -54,6 +89,34 @@ func (_m *RefreshSessionRepository) DeleteExpired(ctx context.Context, expiresAt
 	return r0
 }
 
+// DeleteStale provides a mock function with given fields: ctx, expiredBefore, revokedBefore, limit
+func (_m *RefreshSessionRepository) DeleteStale(ctx context.Context, expiredBefore time.Time, revokedBefore time.Time, limit int) (int64, error) {
+	ret := _m.Called(ctx, expiredBefore, revokedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStale")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) (int64, error)); ok {
+		return rf(ctx, expiredBefore, revokedBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) int64); ok {
+		r0 = rf(ctx, expiredBefore, revokedBefore, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int) error); ok {
+		r1 = rf(ctx, expiredBefore, revokedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByHash provides a mock function with given fields: ctx, tokenHash
 func (_m *RefreshSessionRepository) GetByHash(ctx context.Context, tokenHash string) (*model.RefreshSession, error) {
 	ret := _m.Called(ctx, tokenHash)
@@ -180,6 +243,24 @@ func (_m *RefreshSessionRepository) RevokeAllForAccount(ctx context.Context, acc
 	return r0
 }
 
+// RevokeAllForAccountExcept provides a mock function with given fields: ctx, accountID, exceptSessionID, reason
+func (_m *RefreshSessionRepository) RevokeAllForAccountExcept(ctx context.Context, accountID uuid.UUID, exceptSessionID uuid.UUID, reason *string) error {
+	ret := _m.Called(ctx, accountID, exceptSessionID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAllForAccountExcept")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *string) error); ok {
+		r0 = rf(ctx, accountID, exceptSessionID, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RevokeDescendants provides a mock function with given fields: ctx, sessionID, reason
 func (_m *RefreshSessionRepository) RevokeDescendants(ctx context.Context, sessionID uuid.UUID, reason *string) error {
 	ret := _m.Called(ctx, sessionID, reason)
@@ -0,0 +1,118 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// RequestCounter is an autogenerated mock type for the RequestCounter type
+type RequestCounter struct {
+	mock.Mock
+}
+
+// Add provides a mock function with given fields: ctx, key, at, window
+func (_m *RequestCounter) Add(ctx context.Context, key string, at time.Time, window time.Duration) error {
+	ret := _m.Called(ctx, key, at, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Duration) error); ok {
+		r0 = rf(ctx, key, at, window)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Count provides a mock function with given fields: ctx, key, since
+func (_m *RequestCounter) Count(ctx context.Context, key string, since time.Time) (int, time.Time, time.Time, error) {
+	ret := _m.Called(ctx, key, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int
+	var r1 time.Time
+	var r2 time.Time
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (int, time.Time, time.Time, error)); ok {
+		return rf(ctx, key, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) int); ok {
+		r0 = rf(ctx, key, since)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) time.Time); ok {
+		r1 = rf(ctx, key, since)
+	} else {
+		r1 = ret.Get(1).(time.Time)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, time.Time) time.Time); ok {
+		r2 = rf(ctx, key, since)
+	} else {
+		r2 = ret.Get(2).(time.Time)
+	}
+
+	if rf, ok := ret.Get(3).(func(context.Context, string, time.Time) error); ok {
+		r3 = rf(ctx, key, since)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// DeleteExpired provides a mock function with given fields: ctx, expiredBefore, limit
+func (_m *RequestCounter) DeleteExpired(ctx context.Context, expiredBefore time.Time, limit int) (int64, error) {
+	ret := _m.Called(ctx, expiredBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpired")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) (int64, error)); ok {
+		return rf(ctx, expiredBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) int64); ok {
+		r0 = rf(ctx, expiredBefore, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, expiredBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewRequestCounter creates a new instance of RequestCounter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRequestCounter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RequestCounter {
+	mock := &RequestCounter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,80 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// SecretCipher is an autogenerated mock type for the SecretCipher type
+type SecretCipher struct {
+	mock.Mock
+}
+
+// Decrypt provides a mock function with given fields: sealed
+func (_m *SecretCipher) Decrypt(sealed string) (string, error) {
+	ret := _m.Called(sealed)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Decrypt")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(sealed)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(sealed)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(sealed)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Encrypt provides a mock function with given fields: plain
+func (_m *SecretCipher) Encrypt(plain string) (string, error) {
+	ret := _m.Called(plain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Encrypt")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(plain)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(plain)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(plain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewSecretCipher creates a new instance of SecretCipher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSecretCipher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SecretCipher {
+	mock := &SecretCipher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
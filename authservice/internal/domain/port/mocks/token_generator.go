@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	model "github.com/maket12/ads-service/authservice/internal/domain/model"
 	mock "github.com/stretchr/testify/mock"
 
 	uuid "github.com/google/uuid"
@@ -15,9 +16,9 @@ type TokenGenerator struct {
 	mock.Mock
 }
 
-// GenerateAccessToken provides a mock function with given fields: ctx, accountID, role
-func (_m *TokenGenerator) GenerateAccessToken(ctx context.Context, accountID uuid.UUID, role string) (string, error) {
-	ret := _m.Called(ctx, accountID, role)
+// GenerateAccessToken provides a mock function with given fields: ctx, claims
+func (_m *TokenGenerator) GenerateAccessToken(ctx context.Context, claims *model.AccessTokenClaims) (string, error) {
+	ret := _m.Called(ctx, claims)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GenerateAccessToken")
@@ -25,17 +26,45 @@ func (_m *TokenGenerator) GenerateAccessToken(ctx context.Context, accountID uui
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (string, error)); ok {
-		return rf(ctx, accountID, role)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AccessTokenClaims) (string, error)); ok {
+		return rf(ctx, claims)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) string); ok {
-		r0 = rf(ctx, accountID, role)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AccessTokenClaims) string); ok {
+		r0 = rf(ctx, claims)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
-		r1 = rf(ctx, accountID, role)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.AccessTokenClaims) error); ok {
+		r1 = rf(ctx, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GenerateMFAToken provides a mock function with given fields: ctx, accountID
+func (_m *TokenGenerator) GenerateMFAToken(ctx context.Context, accountID uuid.UUID) (string, error) {
+	ret := _m.Called(ctx, accountID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateMFAToken")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (string, error)); ok {
+		return rf(ctx, accountID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) string); ok {
+		r0 = rf(ctx, accountID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, accountID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -72,40 +101,63 @@ func (_m *TokenGenerator) GenerateRefreshToken(ctx context.Context, accountID uu
 }
 
 // ValidateAccessToken provides a mock function with given fields: ctx, token
-func (_m *TokenGenerator) ValidateAccessToken(ctx context.Context, token string) (uuid.UUID, string, error) {
+func (_m *TokenGenerator) ValidateAccessToken(ctx context.Context, token string) (*model.AccessTokenClaims, error) {
 	ret := _m.Called(ctx, token)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ValidateAccessToken")
 	}
 
-	var r0 uuid.UUID
-	var r1 string
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (uuid.UUID, string, error)); ok {
+	var r0 *model.AccessTokenClaims
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.AccessTokenClaims, error)); ok {
 		return rf(ctx, token)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) uuid.UUID); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.AccessTokenClaims); ok {
 		r0 = rf(ctx, token)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(uuid.UUID)
+			r0 = ret.Get(0).(*model.AccessTokenClaims)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) string); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
 		r1 = rf(ctx, token)
 	} else {
-		r1 = ret.Get(1).(string)
+		r1 = ret.Error(1)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
-		r2 = rf(ctx, token)
+	return r0, r1
+}
+
+// ValidateMFAToken provides a mock function with given fields: ctx, token
+func (_m *TokenGenerator) ValidateMFAToken(ctx context.Context, token string) (uuid.UUID, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateMFAToken")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (uuid.UUID, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) uuid.UUID); ok {
+		r0 = rf(ctx, token)
 	} else {
-		r2 = ret.Error(2)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
 	}
 
-	return r0, r1, r2
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // ValidateRefreshToken provides a mock function with given fields: ctx, token
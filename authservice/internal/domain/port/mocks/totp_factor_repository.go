@@ -0,0 +1,115 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/maket12/ads-service/authservice/internal/domain/model"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// TOTPFactorRepository is an autogenerated mock type for the TOTPFactorRepository type
+type TOTPFactorRepository struct {
+	mock.Mock
+}
+
+// Confirm provides a mock function with given fields: ctx, factor
+func (_m *TOTPFactorRepository) Confirm(ctx context.Context, factor *model.TOTPFactor) error {
+	ret := _m.Called(ctx, factor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Confirm")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.TOTPFactor) error); ok {
+		r0 = rf(ctx, factor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByAccountID provides a mock function with given fields: ctx, accountID
+func (_m *TOTPFactorRepository) GetByAccountID(ctx context.Context, accountID uuid.UUID) (*model.TOTPFactor, error) {
+	ret := _m.Called(ctx, accountID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByAccountID")
+	}
+
+	var r0 *model.TOTPFactor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.TOTPFactor, error)); ok {
+		return rf(ctx, accountID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.TOTPFactor); ok {
+		r0 = rf(ctx, accountID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TOTPFactor)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, accountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Save provides a mock function with given fields: ctx, factor
+func (_m *TOTPFactorRepository) Save(ctx context.Context, factor *model.TOTPFactor) error {
+	ret := _m.Called(ctx, factor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.TOTPFactor) error); ok {
+		r0 = rf(ctx, factor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UseStep provides a mock function with given fields: ctx, factor
+func (_m *TOTPFactorRepository) UseStep(ctx context.Context, factor *model.TOTPFactor) error {
+	ret := _m.Called(ctx, factor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseStep")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.TOTPFactor) error); ok {
+		r0 = rf(ctx, factor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewTOTPFactorRepository creates a new instance of TOTPFactorRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTOTPFactorRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TOTPFactorRepository {
+	mock := &TOTPFactorRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,16 @@
+package port
+
+import (
+	"context"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+)
+
+// OIDCProvider is one configured identity provider (authorization code flow with PKCE)
+type OIDCProvider interface {
+	// AuthCodeURL builds the url the user is redirected to,
+	// codeChallenge is the S256 challenge of the PKCE verifier
+	AuthCodeURL(state, nonce, codeChallenge string) string
+	// Exchange redeems the code and returns the claims of the verified ID token
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*model.OIDCClaims, error)
+}
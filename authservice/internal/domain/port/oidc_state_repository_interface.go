@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+)
+
+type OIDCStateRepository interface {
+	Create(ctx context.Context, state *model.OIDCLoginState) error
+	// Consume returns the state and deletes it, so a state is used only once
+	Consume(ctx context.Context, stateHash string) (*model.OIDCLoginState, error)
+}
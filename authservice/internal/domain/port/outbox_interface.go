@@ -0,0 +1,19 @@
+package port
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// AccountOutbox saves account events together with the data they describe,
+// they are published only after the transaction is committed
+type AccountOutbox interface {
+	AddAccountCreated(ctx context.Context, accountID uuid.UUID) error
+	AddSessionCompromised(ctx context.Context, accountID, sessionID uuid.UUID, ip, userAgent *string) error
+	AddSessionAnomaly(ctx context.Context, accountID, sessionID uuid.UUID, previousIP, previousUserAgent, ip, userAgent *string) error
+	AddNewDeviceLogin(ctx context.Context, accountID, sessionID uuid.UUID, ip *string, browser, os string) error
+	AddAccountBlocked(ctx context.Context, accountID uuid.UUID, reason string) error
+	AddAccountUnblocked(ctx context.Context, accountID uuid.UUID) error
+	AddAccountDeleted(ctx context.Context, accountID uuid.UUID, reason string) error
+}
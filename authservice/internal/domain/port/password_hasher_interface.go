@@ -3,4 +3,6 @@ package port
 type PasswordHasher interface {
 	Hash(password string) (string, error)
 	Compare(hash, password string) bool
+	// NeedsRehash reports hashes made by an outdated algorithm or cost
+	NeedsRehash(hash string) bool
 }
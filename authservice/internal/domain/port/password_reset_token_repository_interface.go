@@ -0,0 +1,15 @@
+package port
+
+import (
+	"context"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+)
+
+type PasswordResetTokenRepository interface {
+	Create(ctx context.Context, token *model.PasswordResetToken) error
+	GetByHash(ctx context.Context, tokenHash string) (*model.PasswordResetToken, error)
+	// MarkUsed consumes the token and drops the other unused tokens
+	// of the account in the same statement, so older links die with it
+	MarkUsed(ctx context.Context, token *model.PasswordResetToken) error
+}
@@ -7,5 +7,5 @@ import (
 )
 
 type AccountPublisher interface {
-	PublishAccountCreate(ctx context.Context, accountID uuid.UUID) error
+	PublishAccountEmailChanged(ctx context.Context, accountID uuid.UUID, oldEmail, newEmail string) error
 }
@@ -0,0 +1,16 @@
+package port
+
+import (
+	"context"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+type RecoveryCodeRepository interface {
+	// Replace drops every code of the account and stores the new set
+	Replace(ctx context.Context, accountID uuid.UUID, codes []*model.RecoveryCode) error
+	// Use marks an unused code as used, ErrObjectNotFound otherwise
+	Use(ctx context.Context, accountID uuid.UUID, codeHash string) error
+}
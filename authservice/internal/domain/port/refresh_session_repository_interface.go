@@ -13,9 +13,16 @@ type RefreshSessionRepository interface {
 	Create(ctx context.Context, session *model.RefreshSession) error
 	GetByHash(ctx context.Context, tokenHash string) (*model.RefreshSession, error)
 	GetByID(ctx context.Context, tokenID uuid.UUID) (*model.RefreshSession, error)
+	// Revoke fails with model.ErrTokenAlreadyRevoked if the session
+	// has been revoked or rotated since it was read
 	Revoke(ctx context.Context, session *model.RefreshSession) error
 	RevokeAllForAccount(ctx context.Context, accountID uuid.UUID, reason *string) error
+	RevokeAllForAccountExcept(ctx context.Context, accountID, exceptSessionID uuid.UUID, reason *string) error
 	RevokeDescendants(ctx context.Context, sessionID uuid.UUID, reason *string) error
 	DeleteExpired(ctx context.Context, expiresAt time.Time) error
+	DeleteStale(ctx context.Context, expiredBefore, revokedBefore time.Time, limit int) (int64, error)
 	ListActiveForAccount(ctx context.Context, accountID uuid.UUID) ([]*model.RefreshSession, error)
+	// CountRecent counts the sessions of the account created after the given time,
+	// sameDevice are the ones with exactly the same IP and user agent
+	CountRecent(ctx context.Context, accountID uuid.UUID, ip, userAgent *string, createdAfter time.Time) (total, sameDevice int, err error)
 }
@@ -0,0 +1,18 @@
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// RequestCounter keeps requests made against a limit per limiting key
+// (see model.RequestLimit and model.ResetKeyForEmail)
+type RequestCounter interface {
+	// Add counts a request and forgets the ones older than the window
+	Add(ctx context.Context, key string, at time.Time, window time.Duration) error
+	// Count returns the number of requests since the given time,
+	// the first and the latest of them
+	Count(ctx context.Context, key string, since time.Time) (count int, first, last time.Time, err error)
+	// DeleteExpired removes up to limit requests of any key older than the given time
+	DeleteExpired(ctx context.Context, expiredBefore time.Time, limit int) (int64, error)
+}
@@ -0,0 +1,7 @@
+package port
+
+// SecretCipher encrypts secrets which have to be stored reversibly
+type SecretCipher interface {
+	Encrypt(plain string) (string, error)
+	Decrypt(sealed string) (string, error)
+}
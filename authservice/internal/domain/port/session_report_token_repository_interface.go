@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+)
+
+type SessionReportTokenRepository interface {
+	Create(ctx context.Context, token *model.SessionReportToken) error
+	GetByHash(ctx context.Context, tokenHash string) (*model.SessionReportToken, error)
+	MarkUsed(ctx context.Context, token *model.SessionReportToken) error
+}
@@ -3,12 +3,16 @@ package port
 import (
 	"context"
 
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+
 	"github.com/google/uuid"
 )
 
 type TokenGenerator interface {
-	GenerateAccessToken(ctx context.Context, accountID uuid.UUID, role string) (string, error)
+	GenerateAccessToken(ctx context.Context, claims *model.AccessTokenClaims) (string, error)
 	GenerateRefreshToken(ctx context.Context, accountID, sessionID uuid.UUID) (string, error)
-	ValidateAccessToken(ctx context.Context, token string) (accountID uuid.UUID, role string, err error)
+	ValidateAccessToken(ctx context.Context, token string) (*model.AccessTokenClaims, error)
 	ValidateRefreshToken(ctx context.Context, token string) (accountID uuid.UUID, sessionID uuid.UUID, err error)
+	GenerateMFAToken(ctx context.Context, accountID uuid.UUID) (string, error)
+	ValidateMFAToken(ctx context.Context, token string) (accountID uuid.UUID, err error)
 }
@@ -0,0 +1,19 @@
+package port
+
+import (
+	"context"
+
+	"github.com/maket12/ads-service/authservice/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+type TOTPFactorRepository interface {
+	// Save creates a factor or replaces a not yet confirmed one
+	Save(ctx context.Context, factor *model.TOTPFactor) error
+	GetByAccountID(ctx context.Context, accountID uuid.UUID) (*model.TOTPFactor, error)
+	Confirm(ctx context.Context, factor *model.TOTPFactor) error
+	// UseStep stores the last accepted time step, it fails with
+	// model.ErrTOTPCodeReused when the same or a later one is stored
+	UseStep(ctx context.Context, factor *model.TOTPFactor) error
+}
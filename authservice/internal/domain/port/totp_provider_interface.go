@@ -0,0 +1,12 @@
+package port
+
+import "time"
+
+// TOTPProvider implements RFC 6238 one-time passwords
+type TOTPProvider interface {
+	GenerateSecret() (string, error)
+	// URI builds the otpauth:// link rendered as a QR code by authenticator apps
+	URI(secret, accountName string) string
+	// Validate returns the time step the code belongs to
+	Validate(secret, code string, at time.Time) (step int64, ok bool)
+}
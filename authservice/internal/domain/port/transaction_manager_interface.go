@@ -0,0 +1,9 @@
+package port
+
+import "context"
+
+// TransactionManager runs fn in one transaction, repositories called
+// with the ctx passed to fn take part in it
+type TransactionManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS email_verification_tokens;
+
+DROP INDEX IF EXISTS idx_email_verification_tokens_account;
+DROP INDEX IF EXISTS idx_email_verification_tokens_expires;
\ No newline at end of file
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS password_reset_tokens;
+
+DROP INDEX IF EXISTS idx_password_reset_tokens_account;
+DROP INDEX IF EXISTS idx_password_reset_tokens_expires;
\ No newline at end of file
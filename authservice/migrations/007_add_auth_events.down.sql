@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS auth_events;
+
+DROP INDEX IF EXISTS idx_auth_events_account_created;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS auth_events (
+    id uuid PRIMARY KEY,
+    account_id uuid NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    event_type text NOT NULL,
+    session_id uuid,
+    ip text,
+    user_agent text,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_auth_events_account_created ON auth_events(account_id, created_at DESC);
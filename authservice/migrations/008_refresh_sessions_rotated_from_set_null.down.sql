@@ -0,0 +1,4 @@
+ALTER TABLE refresh_sessions DROP CONSTRAINT IF EXISTS refresh_sessions_rotated_from_fkey;
+ALTER TABLE refresh_sessions
+    ADD CONSTRAINT refresh_sessions_rotated_from_fkey
+        FOREIGN KEY (rotated_from) REFERENCES refresh_sessions(id);
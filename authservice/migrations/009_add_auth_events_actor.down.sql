@@ -0,0 +1,2 @@
+ALTER TABLE auth_events DROP COLUMN IF EXISTS reason;
+ALTER TABLE auth_events DROP COLUMN IF EXISTS actor_id;
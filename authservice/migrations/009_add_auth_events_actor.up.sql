@@ -0,0 +1,3 @@
+-- Who performed the action (e.g. an admin) and why
+ALTER TABLE auth_events ADD COLUMN IF NOT EXISTS actor_id uuid;
+ALTER TABLE auth_events ADD COLUMN IF NOT EXISTS reason text;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_login_failures_key_failed;
+
+DROP TABLE IF EXISTS login_failures;
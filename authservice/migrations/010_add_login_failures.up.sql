@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS login_failures (
+    id bigserial PRIMARY KEY,
+    throttle_key text NOT NULL,
+    failed_at timestamptz NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_login_failures_key_failed ON login_failures(throttle_key, failed_at DESC);
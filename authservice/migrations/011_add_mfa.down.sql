@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_recovery_codes_account_hash;
+
+DROP TABLE IF EXISTS recovery_codes;
+
+DROP TABLE IF EXISTS totp_factors;
@@ -0,0 +1,17 @@
+-- Secrets are encrypted by the service before they get here
+CREATE TABLE IF NOT EXISTS totp_factors (
+    account_id uuid PRIMARY KEY REFERENCES accounts(id) ON DELETE CASCADE,
+    encrypted_secret text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    confirmed_at timestamptz
+);
+
+CREATE TABLE IF NOT EXISTS recovery_codes (
+    id uuid PRIMARY KEY,
+    account_id uuid NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    code_hash text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    used_at timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_recovery_codes_account_hash ON recovery_codes(account_id, code_hash);
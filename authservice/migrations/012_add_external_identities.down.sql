@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_oidc_login_states_expires_at;
+
+DROP TABLE IF EXISTS oidc_login_states;
+
+DROP INDEX IF EXISTS idx_external_identities_account_id;
+DROP INDEX IF EXISTS idx_external_identities_provider_subject;
+
+DROP TABLE IF EXISTS external_identities;
@@ -0,0 +1,24 @@
+-- Links accounts to subjects of OIDC providers
+CREATE TABLE IF NOT EXISTS external_identities (
+    id uuid PRIMARY KEY,
+    account_id uuid NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    provider text NOT NULL,
+    subject text NOT NULL,
+    email text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_external_identities_provider_subject ON external_identities(provider, subject);
+CREATE INDEX IF NOT EXISTS idx_external_identities_account_id ON external_identities(account_id);
+
+-- Pending authorization requests, a row lives until the callback or expiration
+CREATE TABLE IF NOT EXISTS oidc_login_states (
+    state_hash text PRIMARY KEY,
+    provider text NOT NULL,
+    code_verifier text NOT NULL,
+    nonce text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    expires_at timestamptz NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_oidc_login_states_expires_at ON oidc_login_states(expires_at);
@@ -0,0 +1,31 @@
+DO $$
+    BEGIN
+        IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'role_type') THEN
+            CREATE TYPE role_type AS ENUM ('user', 'admin');
+        END IF;
+    END
+$$;
+
+-- Collapse roles back to a single one per account, admins stay admins
+CREATE TABLE account_roles_single AS
+SELECT
+    account_id,
+    (CASE WHEN bool_or(role = 'admin') THEN 'admin' ELSE 'user' END)::role_type AS role
+FROM account_roles
+GROUP BY account_id;
+
+DROP TABLE IF EXISTS account_roles;
+
+ALTER TABLE account_roles_single RENAME TO account_roles;
+ALTER TABLE account_roles ALTER COLUMN role SET NOT NULL;
+ALTER TABLE account_roles ADD PRIMARY KEY (account_id);
+ALTER TABLE account_roles ADD CONSTRAINT account_roles_account_id_fkey
+    FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_account_roles_role ON account_roles(role);
+
+DROP TABLE IF EXISTS role_permissions;
+
+DROP TABLE IF EXISTS permissions;
+
+DROP TABLE IF EXISTS roles;
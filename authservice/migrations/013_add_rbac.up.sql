@@ -0,0 +1,60 @@
+-- Dictionary of roles and of the permissions they grant
+CREATE TABLE IF NOT EXISTS roles (
+    name text PRIMARY KEY,
+    description text NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS permissions (
+    name text PRIMARY KEY,
+    description text NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role text NOT NULL REFERENCES roles(name) ON DELETE CASCADE,
+    permission text NOT NULL REFERENCES permissions(name) ON DELETE CASCADE,
+    PRIMARY KEY (role, permission)
+);
+
+INSERT INTO roles (name, description) VALUES
+    ('guest', 'Read-only access to public ads'),
+    ('user', 'Regular account'),
+    ('moderator', 'Reviews ads before publication'),
+    ('support', 'Helps users with their accounts'),
+    ('admin', 'Full access')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO permissions (name, description) VALUES
+    ('ads:read', 'View ads'),
+    ('ads:write', 'Create and edit own ads'),
+    ('ads:moderate', 'Approve or reject ads'),
+    ('users:read', 'View accounts and their roles'),
+    ('users:block', 'Block and unblock accounts'),
+    ('users:delete', 'Delete accounts'),
+    ('roles:assign', 'Assign and revoke roles')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES
+    ('guest', 'ads:read'),
+    ('user', 'ads:read'),
+    ('user', 'ads:write'),
+    ('moderator', 'ads:read'),
+    ('moderator', 'ads:moderate'),
+    ('support', 'users:read'),
+    ('support', 'users:block'),
+    ('admin', 'ads:read'),
+    ('admin', 'ads:write'),
+    ('admin', 'ads:moderate'),
+    ('admin', 'users:read'),
+    ('admin', 'users:block'),
+    ('admin', 'users:delete'),
+    ('admin', 'roles:assign')
+ON CONFLICT (role, permission) DO NOTHING;
+
+-- An account may now hold several roles, existing single-role rows are kept as they are
+ALTER TABLE account_roles DROP CONSTRAINT IF EXISTS account_roles_pkey;
+ALTER TABLE account_roles ALTER COLUMN role TYPE text USING role::text;
+ALTER TABLE account_roles ADD PRIMARY KEY (account_id, role);
+ALTER TABLE account_roles ADD CONSTRAINT account_roles_role_fkey
+    FOREIGN KEY (role) REFERENCES roles(name);
+
+DROP TYPE IF EXISTS role_type;
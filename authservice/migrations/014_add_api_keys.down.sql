@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_api_keys_account_id;
+DROP INDEX IF EXISTS idx_api_keys_prefix;
+
+DROP TABLE IF EXISTS api_keys;
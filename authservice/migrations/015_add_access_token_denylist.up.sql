@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS access_token_denylist (
+    denylist_key text PRIMARY KEY,
+    expires_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_access_token_denylist_expires_at ON access_token_denylist(expires_at);
@@ -0,0 +1,11 @@
+DELETE FROM role_permissions WHERE permission = 'audit:read';
+DELETE FROM permissions WHERE name = 'audit:read';
+
+DROP TRIGGER IF EXISTS trg_auth_events_append_only ON auth_events;
+DROP FUNCTION IF EXISTS auth_events_forbid_update();
+
+DROP INDEX IF EXISTS idx_auth_events_type_created;
+DROP INDEX IF EXISTS idx_auth_events_created;
+
+ALTER TABLE auth_events DROP COLUMN IF EXISTS metadata;
+ALTER TABLE auth_events DROP COLUMN IF EXISTS outcome;
@@ -0,0 +1,27 @@
+-- Outcome and free-form details of every security-relevant action
+ALTER TABLE auth_events ADD COLUMN IF NOT EXISTS outcome text NOT NULL DEFAULT 'success';
+ALTER TABLE auth_events ADD COLUMN IF NOT EXISTS metadata jsonb NOT NULL DEFAULT '{}'::jsonb;
+
+-- Pages are read newest first, with and without an account
+CREATE INDEX IF NOT EXISTS idx_auth_events_created ON auth_events(created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_auth_events_type_created ON auth_events(event_type, created_at DESC);
+
+-- The audit log is append-only, rows go away only together with the account
+CREATE OR REPLACE FUNCTION auth_events_forbid_update() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'auth_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_auth_events_append_only ON auth_events;
+CREATE TRIGGER trg_auth_events_append_only
+    BEFORE UPDATE ON auth_events
+    FOR EACH ROW EXECUTE FUNCTION auth_events_forbid_update();
+
+INSERT INTO permissions (name, description) VALUES
+    ('audit:read', 'View the security audit log')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES
+    ('admin', 'audit:read')
+ON CONFLICT (role, permission) DO NOTHING;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS email_change_tokens;
+
+DROP INDEX IF EXISTS idx_email_change_tokens_account;
+DROP INDEX IF EXISTS idx_email_change_tokens_expires;
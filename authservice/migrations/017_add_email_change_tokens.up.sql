@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS email_change_tokens (
+    id uuid PRIMARY KEY,
+    account_id uuid NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    new_email citext NOT NULL,
+    token_hash text NOT NULL UNIQUE,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    expires_at timestamptz NOT NULL,
+    used_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_email_change_tokens_account ON email_change_tokens(account_id);
+CREATE INDEX IF NOT EXISTS idx_email_change_tokens_expires ON email_change_tokens(expires_at);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS magic_link_tokens;
+
+DROP INDEX IF EXISTS idx_magic_link_tokens_account;
+DROP INDEX IF EXISTS idx_magic_link_tokens_expires;
\ No newline at end of file
@@ -0,0 +1,8 @@
+-- Admins may act on behalf of another account through short-lived tokens
+INSERT INTO permissions (name, description) VALUES
+    ('accounts:impersonate', 'Act on behalf of another account')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES
+    ('admin', 'accounts:impersonate')
+ON CONFLICT (role, permission) DO NOTHING;
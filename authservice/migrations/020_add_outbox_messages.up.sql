@@ -0,0 +1,17 @@
+-- Events written in the same transaction as the data they describe,
+-- the relay publishes them and marks as sent (see pkg/outbox)
+CREATE TABLE IF NOT EXISTS outbox_messages (
+    id uuid PRIMARY KEY,
+    routing_key text NOT NULL,
+    payload jsonb NOT NULL,
+    attempts int NOT NULL DEFAULT 0,
+    last_error text,
+    next_attempt_at timestamptz NOT NULL DEFAULT now(),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    sent_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_messages_pending
+    ON outbox_messages(created_at) WHERE sent_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_outbox_messages_sent
+    ON outbox_messages(sent_at) WHERE sent_at IS NOT NULL;
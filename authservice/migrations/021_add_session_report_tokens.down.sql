@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_refresh_sessions_account_created;
+
+DROP TABLE IF EXISTS session_report_tokens;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS session_report_tokens (
+    id uuid PRIMARY KEY,
+    account_id uuid NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    session_id uuid NOT NULL REFERENCES refresh_sessions(id) ON DELETE CASCADE,
+    token_hash text NOT NULL UNIQUE,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    expires_at timestamptz NOT NULL,
+    used_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_session_report_tokens_session ON session_report_tokens(session_id);
+CREATE INDEX IF NOT EXISTS idx_session_report_tokens_expires ON session_report_tokens(expires_at);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_sessions_account_created ON refresh_sessions(account_id, created_at DESC);
@@ -0,0 +1 @@
+ALTER TABLE totp_factors DROP COLUMN IF EXISTS last_used_step;
@@ -0,0 +1,2 @@
+-- Time step of the last accepted code, a code is never accepted twice
+ALTER TABLE totp_factors ADD COLUMN IF NOT EXISTS last_used_step bigint;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_request_counts_requested;
+DROP INDEX IF EXISTS idx_request_counts_key_requested;
+
+DROP TABLE IF EXISTS request_counts;
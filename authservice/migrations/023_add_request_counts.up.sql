@@ -0,0 +1,10 @@
+-- Requests counted against a limit (password resets, sign-in links, ...),
+-- unlike login_failures every request counts, successful or not
+CREATE TABLE IF NOT EXISTS request_counts (
+    id bigserial PRIMARY KEY,
+    limit_key text NOT NULL,
+    requested_at timestamptz NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_request_counts_key_requested ON request_counts(limit_key, requested_at DESC);
+CREATE INDEX IF NOT EXISTS idx_request_counts_requested ON request_counts(requested_at);
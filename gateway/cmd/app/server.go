@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
@@ -16,7 +17,9 @@ import (
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 func AuthMiddleware(authClient auth_v1.AuthServiceClient) func(http.Handler) http.Handler {
@@ -30,30 +33,186 @@ func AuthMiddleware(authClient auth_v1.AuthServiceClient) func(http.Handler) htt
 			}
 
 			parts := strings.Split(authHeader, " ")
-			if len(parts) != 2 || parts[0] != "Bearer" {
+			if len(parts) != 2 {
 				next.ServeHTTP(w, r)
 				return
 			}
 
-			token := parts[1]
-
-			resp, err := authClient.ValidateAccessToken(r.Context(), &auth_v1.ValidateAccessTokenRequest{
-				AccessToken: token,
-			})
-
-			if err != nil {
+			// Machine clients authenticate with an API key instead of a JWT,
+			// both end up with the same account context, but the key is
+			// forwarded so that credentials can not be managed with it
+			var accountID, actorID, apiKeyID string
+			var roles, permissions []string
+			switch parts[0] {
+			case "Bearer":
+				resp, err := authClient.ValidateAccessToken(r.Context(), &auth_v1.ValidateAccessTokenRequest{
+					AccessToken: parts[1],
+				})
+				if err != nil {
+					next.ServeHTTP(w, r)
+					return
+				}
+				accountID, roles, permissions = resp.GetAccountId(), resp.GetRoles(), resp.GetPermissions()
+				actorID = resp.GetActorId()
+			case "ApiKey":
+				resp, err := authClient.ValidateAPIKey(r.Context(), &auth_v1.ValidateAPIKeyRequest{
+					Key: parts[1],
+				})
+				if err != nil {
+					next.ServeHTTP(w, r)
+					return
+				}
+				accountID, roles, permissions = resp.GetAccountId(), resp.GetRoles(), resp.GetPermissions()
+				apiKeyID = resp.GetKeyId()
+			default:
 				next.ServeHTTP(w, r)
 				return
 			}
 
-			ctx := utils.SetAccountIDInCtx(r.Context(), resp.GetAccountId())
-			ctx = utils.SetAccountRoleInCtx(ctx, resp.GetRole())
+			ctx := utils.SetAccountIDInCtx(r.Context(), accountID)
+			ctx = utils.SetAccountRolesInCtx(ctx, roles)
+			ctx = utils.SetAccountPermissionsInCtx(ctx, permissions)
+			// An admin acting on behalf of the account is forwarded to every service
+			if actorID != "" {
+				ctx = utils.SetActorIDInCtx(ctx, actorID)
+			}
+			if apiKeyID != "" {
+				ctx = utils.SetAPIKeyIDInCtx(ctx, apiKeyID)
+			}
 
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
 
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKSHandler serves the auth service public keys, so access tokens
+// can be verified without calling the auth service
+func JWKSHandler(authClient auth_v1.AuthServiceClient) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		resp, err := authClient.GetJWKS(r.Context(), &auth_v1.GetJWKSRequest{})
+		if err != nil {
+			log.Printf("Gateway: ERROR - could not get jwks: %v", err)
+			http.Error(w, "could not get keys", http.StatusBadGateway)
+			return
+		}
+
+		var keys = make([]jwk, 0, len(resp.GetKeys()))
+		for _, k := range resp.GetKeys() {
+			keys = append(keys, jwk{
+				Kid: k.GetKid(),
+				Kty: k.GetKty(),
+				Alg: k.GetAlg(),
+				Use: k.GetUse(),
+				N:   k.GetN(),
+				E:   k.GetE(),
+				Crv: k.GetCrv(),
+				X:   k.GetX(),
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		if err := json.NewEncoder(w).Encode(map[string][]jwk{"keys": keys}); err != nil {
+			log.Printf("Gateway: ERROR - could not write jwks: %v", err)
+		}
+	})
+}
+
+type introspection struct {
+	Active    bool     `json:"active"`
+	TokenType string   `json:"token_type,omitempty"`
+	Subject   string   `json:"sub,omitempty"`
+	Roles     []string `json:"roles,omitempty"`
+	SessionID string   `json:"sid,omitempty"`
+	Actor     *actor   `json:"act,omitempty"`
+	IssuedAt  int64    `json:"iat,omitempty"`
+	ExpiresAt int64    `json:"exp,omitempty"`
+}
+
+type actor struct {
+	Subject string `json:"sub"`
+}
+
+// IntrospectHandler implements the RFC 7662 endpoint, the client credential
+// comes with HTTP Basic auth or as client_id and client_secret form fields
+func IntrospectHandler(authClient auth_v1.AuthServiceClient) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("token") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_request"})
+			return
+		}
+
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok {
+			clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+		}
+
+		req := &auth_v1.IntrospectTokenRequest{
+			ClientId:     clientID,
+			ClientSecret: clientSecret,
+			Token:        r.PostForm.Get("token"),
+		}
+		if hint := r.PostForm.Get("token_type_hint"); hint != "" {
+			req.TokenTypeHint = &hint
+		}
+
+		resp, err := authClient.IntrospectToken(r.Context(), req)
+		if err != nil {
+			if status.Code(err) == codes.Unauthenticated {
+				w.Header().Set("WWW-Authenticate", `Basic realm="introspect"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client"})
+				return
+			}
+			log.Printf("Gateway: ERROR - could not introspect token: %v", err)
+			http.Error(w, "could not introspect token", http.StatusBadGateway)
+			return
+		}
+
+		var out = introspection{Active: resp.GetActive()}
+		if out.Active {
+			out.TokenType = resp.GetTokenType()
+			out.Subject = resp.GetAccountId()
+			out.Roles = resp.GetRoles()
+			out.SessionID = resp.GetSessionId()
+			out.IssuedAt = resp.GetIssuedAt().AsTime().Unix()
+			out.ExpiresAt = resp.GetExpiresAt().AsTime().Unix()
+			if resp.ActorId != nil {
+				out.Actor = &actor{Subject: resp.GetActorId()}
+			}
+		}
+
+		if err := json.NewEncoder(w).Encode(out); err != nil {
+			log.Printf("Gateway: ERROR - could not write introspection: %v", err)
+		}
+	})
+}
+
 func closeAuthConnection(authConn *grpc.ClientConn) {
 	log.Printf("Gateway: Closing Auth Service Connection...")
 	if err := authConn.Close(); err != nil {
@@ -136,6 +295,8 @@ func main() {
 
 	http.Handle("/", playground.Handler("GraphQL playground", "/query"))
 	http.Handle("/query", router)
+	http.Handle("/.well-known/jwks.json", JWKSHandler(resolver.AuthClient))
+	http.Handle("/oauth/introspect", IntrospectHandler(resolver.AuthClient))
 
 	log.Printf("Gateway: Server is running on port %d", cfg.GatewayPort)
 	log.Fatal(http.ListenAndServe(fmt.Sprintf(":%d", cfg.GatewayPort), nil))
@@ -9,12 +9,85 @@ import (
 	"strconv"
 )
 
+// API key of a machine client, the secret itself is never returned again
+type APIKey struct {
+	KeyID      string   `json:"keyId"`
+	Name       string   `json:"name"`
+	Prefix     string   `json:"prefix"`
+	Scopes     []string `json:"scopes"`
+	CreatedAt  string   `json:"createdAt"`
+	ExpiresAt  *string  `json:"expiresAt,omitempty"`
+	LastUsedAt *string  `json:"lastUsedAt,omitempty"`
+	RevokedAt  *string  `json:"revokedAt,omitempty"`
+}
+
+// Page of accounts, nextCursor is null on the last page
+type AccountPage struct {
+	Accounts   []*AccountSummary `json:"accounts"`
+	NextCursor *string           `json:"nextCursor,omitempty"`
+}
+
+// Account row of the admin listing
+type AccountSummary struct {
+	AccountID      string   `json:"accountId"`
+	Email          string   `json:"email"`
+	Status         string   `json:"status"`
+	EmailVerified  bool     `json:"emailVerified"`
+	Roles          []string `json:"roles"`
+	ActiveSessions int      `json:"activeSessions"`
+	CreatedAt      string   `json:"createdAt"`
+	LastLoginAt    *string  `json:"lastLoginAt,omitempty"`
+}
+
+// Security event from the audit log
+type AuthEvent struct {
+	EventID   string               `json:"eventId"`
+	AccountID string               `json:"accountId"`
+	EventType string               `json:"eventType"`
+	Outcome   string               `json:"outcome"`
+	SessionID *string              `json:"sessionId,omitempty"`
+	IP        *string              `json:"ip,omitempty"`
+	UserAgent *string              `json:"userAgent,omitempty"`
+	ActorID   *string              `json:"actorId,omitempty"`
+	Reason    *string              `json:"reason,omitempty"`
+	Metadata  []*AuthEventMetadata `json:"metadata"`
+	CreatedAt string               `json:"createdAt"`
+}
+
+// Key-value detail of a security event
+type AuthEventMetadata struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Page of security events, nextCursor is null on the last page
+type AuthEventPage struct {
+	Events     []*AuthEvent `json:"events"`
+	NextCursor *string      `json:"nextCursor,omitempty"`
+}
+
+// Short-lived access token an admin uses on behalf of an account, there is no refresh token
+type ImpersonateResponse struct {
+	AccessToken string `json:"accessToken"`
+	ExpiresAt   string `json:"expiresAt"`
+}
+
 type Mutation struct {
 }
 
 type Query struct {
 }
 
+// Active login session (refresh session)
+type Session struct {
+	SessionID string  `json:"sessionId"`
+	IP        *string `json:"ip,omitempty"`
+	UserAgent *string `json:"userAgent,omitempty"`
+	CreatedAt string  `json:"createdAt"`
+	ExpiresAt string  `json:"expiresAt"`
+	Current   bool    `json:"current"`
+}
+
 // Ad Status
 type AdStatus string
 
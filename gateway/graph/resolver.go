@@ -1,9 +1,17 @@
 package graph
 
 import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/maket12/ads-service/gateway/graph/model"
 	"github.com/maket12/ads-service/pkg/generated/ad_v1"
 	"github.com/maket12/ads-service/pkg/generated/auth_v1"
 	"github.com/maket12/ads-service/pkg/generated/user_v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // This file will not be regenerated automatically.
@@ -16,3 +24,83 @@ type Resolver struct {
 	UserClient user_v1.UserServiceClient
 	AdClient   ad_v1.AdServiceClient
 }
+
+// formatOptionalTime renders an optional proto timestamp as RFC 3339
+func formatOptionalTime(ts *timestamppb.Timestamp) *string {
+	if ts == nil {
+		return nil
+	}
+	formatted := ts.AsTime().Format(time.RFC3339)
+	return &formatted
+}
+
+// parseOptionalTime reads an optional RFC 3339 argument
+func parseOptionalTime(name string, value *string) (*timestamppb.Timestamp, error) {
+	if value == nil {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be in RFC 3339 format", name)
+	}
+	return timestamppb.New(t), nil
+}
+
+// accountSortFields maps GraphQL sort names to the ones of the auth service,
+// unknown names are passed as they are and rejected there
+var accountSortFields = map[string]string{
+	"createdAt":   "created_at",
+	"lastLoginAt": "last_login_at",
+	"email":       "email",
+}
+
+func mapAccountPage(resp *auth_v1.ListAccountsResponse) *model.AccountPage {
+	accounts := make([]*model.AccountSummary, 0, len(resp.GetAccounts()))
+	for _, a := range resp.GetAccounts() {
+		accounts = append(accounts, &model.AccountSummary{
+			AccountID:      a.GetAccountId(),
+			Email:          a.GetEmail(),
+			Status:         a.GetStatus(),
+			EmailVerified:  a.GetEmailVerified(),
+			Roles:          a.GetRoles(),
+			ActiveSessions: int(a.GetActiveSessions()),
+			CreatedAt:      a.GetCreatedAt().AsTime().Format(time.RFC3339),
+			LastLoginAt:    formatOptionalTime(a.GetLastLoginAt()),
+		})
+	}
+	return &model.AccountPage{
+		Accounts:   accounts,
+		NextCursor: resp.NextCursor,
+	}
+}
+
+// mapAuthEventPage flattens event metadata into sorted key-value pairs,
+// GraphQL has no map type
+func mapAuthEventPage(resp *auth_v1.ListAuthEventsResponse) *model.AuthEventPage {
+	events := make([]*model.AuthEvent, 0, len(resp.GetEvents()))
+	for _, e := range resp.GetEvents() {
+		metadata := make([]*model.AuthEventMetadata, 0, len(e.GetMetadata()))
+		for _, key := range slices.Sorted(maps.Keys(e.GetMetadata())) {
+			metadata = append(metadata, &model.AuthEventMetadata{
+				Key: key, Value: e.GetMetadata()[key],
+			})
+		}
+		events = append(events, &model.AuthEvent{
+			EventID:   e.GetEventId(),
+			AccountID: e.GetAccountId(),
+			EventType: e.GetEventType(),
+			Outcome:   e.GetOutcome(),
+			SessionID: e.SessionId,
+			IP:        e.Ip,
+			UserAgent: e.UserAgent,
+			ActorID:   e.ActorId,
+			Reason:    e.Reason,
+			Metadata:  metadata,
+			CreatedAt: e.GetCreatedAt().AsTime().Format(time.RFC3339),
+		})
+	}
+	return &model.AuthEventPage{
+		Events:     events,
+		NextCursor: resp.NextCursor,
+	}
+}
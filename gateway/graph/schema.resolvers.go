@@ -15,6 +15,7 @@ import (
 	"github.com/maket12/ads-service/pkg/generated/auth_v1"
 	"github.com/maket12/ads-service/pkg/generated/user_v1"
 	"github.com/maket12/ads-service/pkg/utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Price is the resolver for the price field.
@@ -71,6 +72,8 @@ func (r *mutationResolver) Login(ctx context.Context, email string, password str
 	return &auth_v1.LoginResponse{
 		AccessToken:  resp.GetAccessToken(),
 		RefreshToken: resp.GetRefreshToken(),
+		MfaRequired:  resp.GetMfaRequired(),
+		MfaToken:     resp.GetMfaToken(),
 	}, nil
 }
 
@@ -103,7 +106,20 @@ func (r *mutationResolver) RefreshSession(ctx context.Context, oldRefreshToken s
 
 // AssignRole is the resolver for the assignRole field.
 func (r *mutationResolver) AssignRole(ctx context.Context, accountID string, role string) (bool, error) {
-	resp, err := r.AuthClient.AssignRole(ctx, &auth_v1.AssignRoleRequest{
+	idVal := ctx.Value(utils.AccountIDKey)
+	if idVal == nil {
+		return false, fmt.Errorf("unauthorized")
+	}
+
+	permissionsVal := ctx.Value(utils.AccountPermissionsKey)
+	if permissionsVal == nil {
+		return false, fmt.Errorf("unauthorized")
+	}
+
+	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
+	outCtx = utils.PackAccountPermissionsForGRPC(outCtx, permissionsVal.([]string))
+
+	resp, err := r.AuthClient.AssignRole(outCtx, &auth_v1.AssignRoleRequest{
 		AccountId: accountID,
 		Role:      role,
 	})
@@ -113,6 +129,449 @@ func (r *mutationResolver) AssignRole(ctx context.Context, accountID string, rol
 	return resp.GetAssign(), nil
 }
 
+// RevokeRole is the resolver for the revokeRole field.
+func (r *mutationResolver) RevokeRole(ctx context.Context, accountID string, role string) (bool, error) {
+	idVal := ctx.Value(utils.AccountIDKey)
+	if idVal == nil {
+		return false, fmt.Errorf("unauthorized")
+	}
+
+	permissionsVal := ctx.Value(utils.AccountPermissionsKey)
+	if permissionsVal == nil {
+		return false, fmt.Errorf("unauthorized")
+	}
+
+	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
+	outCtx = utils.PackAccountPermissionsForGRPC(outCtx, permissionsVal.([]string))
+
+	resp, err := r.AuthClient.RevokeRole(outCtx, &auth_v1.RevokeRoleRequest{
+		AccountId: accountID,
+		Role:      role,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.GetRevoked(), nil
+}
+
+// SendVerificationEmail is the resolver for the sendVerificationEmail field.
+func (r *mutationResolver) SendVerificationEmail(ctx context.Context) (bool, error) {
+	idVal := ctx.Value(utils.AccountIDKey)
+	if idVal == nil {
+		return false, fmt.Errorf("unauthorized")
+	}
+
+	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
+
+	resp, err := r.AuthClient.SendVerificationEmail(
+		outCtx, &auth_v1.SendVerificationEmailRequest{},
+	)
+	if err != nil {
+		return false, err
+	}
+	return resp.GetSent(), nil
+}
+
+// VerifyEmail is the resolver for the verifyEmail field.
+func (r *mutationResolver) VerifyEmail(ctx context.Context, token string) (bool, error) {
+	resp, err := r.AuthClient.VerifyEmail(
+		ctx, &auth_v1.VerifyEmailRequest{Token: token},
+	)
+	if err != nil {
+		return false, err
+	}
+	return resp.GetVerified(), nil
+}
+
+// RequestPasswordReset is the resolver for the requestPasswordReset field.
+func (r *mutationResolver) RequestPasswordReset(ctx context.Context, email string, ip *string) (bool, error) {
+	resp, err := r.AuthClient.RequestPasswordReset(ctx, &auth_v1.RequestPasswordResetRequest{
+		Email: email,
+		Ip:    ip,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.GetSent(), nil
+}
+
+// ResetPassword is the resolver for the resetPassword field.
+func (r *mutationResolver) ResetPassword(ctx context.Context, token string, newPassword string) (bool, error) {
+	resp, err := r.AuthClient.ResetPassword(ctx, &auth_v1.ResetPasswordRequest{
+		Token:       token,
+		NewPassword: newPassword,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.GetSuccess(), nil
+}
+
+// ChangePassword is the resolver for the changePassword field.
+func (r *mutationResolver) ChangePassword(ctx context.Context, oldPassword string, newPassword string, refreshToken string) (bool, error) {
+	idVal := ctx.Value(utils.AccountIDKey)
+	if idVal == nil {
+		return false, fmt.Errorf("unauthorized")
+	}
+
+	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
+
+	resp, err := r.AuthClient.ChangePassword(outCtx, &auth_v1.ChangePasswordRequest{
+		OldPassword:  oldPassword,
+		NewPassword:  newPassword,
+		RefreshToken: refreshToken,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.GetChanged(), nil
+}
+
+// RequestEmailChange is the resolver for the requestEmailChange field.
+func (r *mutationResolver) RequestEmailChange(ctx context.Context, password string, newEmail string) (bool, error) {
+	idVal := ctx.Value(utils.AccountIDKey)
+	if idVal == nil {
+		return false, fmt.Errorf("unauthorized")
+	}
+
+	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
+
+	resp, err := r.AuthClient.RequestEmailChange(outCtx, &auth_v1.RequestEmailChangeRequest{
+		Password: password,
+		NewEmail: newEmail,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.GetSent(), nil
+}
+
+// ConfirmEmailChange is the resolver for the confirmEmailChange field.
+func (r *mutationResolver) ConfirmEmailChange(ctx context.Context, token string) (bool, error) {
+	resp, err := r.AuthClient.ConfirmEmailChange(
+		ctx, &auth_v1.ConfirmEmailChangeRequest{Token: token},
+	)
+	if err != nil {
+		return false, err
+	}
+	return resp.GetChanged(), nil
+}
+
+// RevokeSession is the resolver for the revokeSession field.
+func (r *mutationResolver) RevokeSession(ctx context.Context, sessionID string) (bool, error) {
+	idVal := ctx.Value(utils.AccountIDKey)
+	if idVal == nil {
+		return false, fmt.Errorf("unauthorized")
+	}
+
+	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
+
+	resp, err := r.AuthClient.RevokeSession(
+		outCtx, &auth_v1.RevokeSessionRequest{SessionId: sessionID},
+	)
+	if err != nil {
+		return false, err
+	}
+	return resp.GetRevoked(), nil
+}
+
+// RevokeAllOtherSessions is the resolver for the revokeAllOtherSessions field.
+func (r *mutationResolver) RevokeAllOtherSessions(ctx context.Context, refreshToken string) (bool, error) {
+	idVal := ctx.Value(utils.AccountIDKey)
+	if idVal == nil {
+		return false, fmt.Errorf("unauthorized")
+	}
+
+	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
+
+	resp, err := r.AuthClient.RevokeAllOtherSessions(
+		outCtx, &auth_v1.RevokeAllOtherSessionsRequest{RefreshToken: refreshToken},
+	)
+	if err != nil {
+		return false, err
+	}
+	return resp.GetRevoked(), nil
+}
+
+// EnrollTotp is the resolver for the enrollTOTP field.
+func (r *mutationResolver) EnrollTotp(ctx context.Context) (*auth_v1.EnrollTOTPResponse, error) {
+	idVal := ctx.Value(utils.AccountIDKey)
+	if idVal == nil {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
+
+	resp, err := r.AuthClient.EnrollTOTP(outCtx, &auth_v1.EnrollTOTPRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &auth_v1.EnrollTOTPResponse{
+		Secret:     resp.GetSecret(),
+		OtpauthUri: resp.GetOtpauthUri(),
+	}, nil
+}
+
+// ConfirmTotp is the resolver for the confirmTOTP field.
+func (r *mutationResolver) ConfirmTotp(ctx context.Context, code string) ([]string, error) {
+	idVal := ctx.Value(utils.AccountIDKey)
+	if idVal == nil {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
+
+	resp, err := r.AuthClient.ConfirmTOTP(
+		outCtx, &auth_v1.ConfirmTOTPRequest{Code: code},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetRecoveryCodes(), nil
+}
+
+// VerifyMfa is the resolver for the verifyMFA field.
+func (r *mutationResolver) VerifyMfa(ctx context.Context, mfaToken string, code string, ip *string, userAgent *string) (*auth_v1.VerifyMFAResponse, error) {
+	resp, err := r.AuthClient.VerifyMFA(ctx, &auth_v1.VerifyMFARequest{
+		MfaToken:  mfaToken,
+		Code:      code,
+		Ip:        ip,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &auth_v1.VerifyMFAResponse{
+		AccessToken:  resp.GetAccessToken(),
+		RefreshToken: resp.GetRefreshToken(),
+	}, nil
+}
+
+// StartOidcLogin is the resolver for the startOidcLogin field.
+func (r *mutationResolver) StartOidcLogin(ctx context.Context, provider string) (*auth_v1.StartOIDCLoginResponse, error) {
+	resp, err := r.AuthClient.StartOIDCLogin(
+		ctx, &auth_v1.StartOIDCLoginRequest{Provider: provider},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_v1.StartOIDCLoginResponse{
+		AuthorizationUrl: resp.GetAuthorizationUrl(),
+		State:            resp.GetState(),
+	}, nil
+}
+
+// CompleteOidcLogin is the resolver for the completeOidcLogin field.
+func (r *mutationResolver) CompleteOidcLogin(ctx context.Context, provider string, state string, code string, ip *string, userAgent *string) (*auth_v1.CompleteOIDCLoginResponse, error) {
+	resp, err := r.AuthClient.CompleteOIDCLogin(ctx, &auth_v1.CompleteOIDCLoginRequest{
+		Provider:  provider,
+		State:     state,
+		Code:      code,
+		Ip:        ip,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &auth_v1.CompleteOIDCLoginResponse{
+		AccessToken:    resp.GetAccessToken(),
+		RefreshToken:   resp.GetRefreshToken(),
+		MfaRequired:    resp.GetMfaRequired(),
+		MfaToken:       resp.GetMfaToken(),
+		AccountCreated: resp.GetAccountCreated(),
+	}, nil
+}
+
+// RequestMagicLink is the resolver for the requestMagicLink field.
+func (r *mutationResolver) RequestMagicLink(ctx context.Context, email string) (bool, error) {
+	resp, err := r.AuthClient.RequestMagicLink(
+		ctx, &auth_v1.RequestMagicLinkRequest{Email: email},
+	)
+	if err != nil {
+		return false, err
+	}
+	return resp.GetSent(), nil
+}
+
+// ConsumeMagicLink is the resolver for the consumeMagicLink field.
+func (r *mutationResolver) ConsumeMagicLink(ctx context.Context, token string, ip *string, userAgent *string) (*auth_v1.LoginResponse, error) {
+	resp, err := r.AuthClient.ConsumeMagicLink(ctx, &auth_v1.ConsumeMagicLinkRequest{
+		Token:     token,
+		Ip:        ip,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &auth_v1.LoginResponse{
+		AccessToken:  resp.GetAccessToken(),
+		RefreshToken: resp.GetRefreshToken(),
+		MfaRequired:  resp.GetMfaRequired(),
+		MfaToken:     resp.GetMfaToken(),
+	}, nil
+}
+
+// ReportSession is the resolver for the reportSession field.
+func (r *mutationResolver) ReportSession(ctx context.Context, token string, ip *string, userAgent *string) (bool, error) {
+	resp, err := r.AuthClient.ReportSession(ctx, &auth_v1.ReportSessionRequest{
+		Token:     token,
+		Ip:        ip,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.GetRevoked(), nil
+}
+
+// BlockAccount is the resolver for the blockAccount field.
+func (r *mutationResolver) BlockAccount(ctx context.Context, accountID string, reason string) (bool, error) {
+	idVal := ctx.Value(utils.AccountIDKey)
+	if idVal == nil {
+		return false, fmt.Errorf("unauthorized")
+	}
+
+	permissionsVal := ctx.Value(utils.AccountPermissionsKey)
+	if permissionsVal == nil {
+		return false, fmt.Errorf("unauthorized")
+	}
+
+	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
+	outCtx = utils.PackAccountPermissionsForGRPC(outCtx, permissionsVal.([]string))
+
+	resp, err := r.AuthClient.BlockAccount(outCtx, &auth_v1.BlockAccountRequest{
+		AccountId: accountID,
+		Reason:    reason,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.GetBlocked(), nil
+}
+
+// UnblockAccount is the resolver for the unblockAccount field.
+func (r *mutationResolver) UnblockAccount(ctx context.Context, accountID string, reason string) (bool, error) {
+	idVal := ctx.Value(utils.AccountIDKey)
+	if idVal == nil {
+		return false, fmt.Errorf("unauthorized")
+	}
+
+	permissionsVal := ctx.Value(utils.AccountPermissionsKey)
+	if permissionsVal == nil {
+		return false, fmt.Errorf("unauthorized")
+	}
+
+	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
+	outCtx = utils.PackAccountPermissionsForGRPC(outCtx, permissionsVal.([]string))
+
+	resp, err := r.AuthClient.UnblockAccount(outCtx, &auth_v1.UnblockAccountRequest{
+		AccountId: accountID,
+		Reason:    reason,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.GetUnblocked(), nil
+}
+
+// DeleteAccount is the resolver for the deleteAccount field.
+func (r *mutationResolver) DeleteAccount(ctx context.Context, accountID string, reason string) (bool, error) {
+	idVal := ctx.Value(utils.AccountIDKey)
+	if idVal == nil {
+		return false, fmt.Errorf("unauthorized")
+	}
+
+	permissionsVal := ctx.Value(utils.AccountPermissionsKey)
+	if permissionsVal == nil {
+		return false, fmt.Errorf("unauthorized")
+	}
+
+	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
+	outCtx = utils.PackAccountPermissionsForGRPC(outCtx, permissionsVal.([]string))
+
+	resp, err := r.AuthClient.DeleteAccount(outCtx, &auth_v1.DeleteAccountRequest{
+		AccountId: accountID,
+		Reason:    reason,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.GetDeleted(), nil
+}
+
+// Impersonate is the resolver for the impersonate field.
+func (r *mutationResolver) Impersonate(ctx context.Context, accountID string, reason string) (*model.ImpersonateResponse, error) {
+	idVal := ctx.Value(utils.AccountIDKey)
+	if idVal == nil {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	permissionsVal := ctx.Value(utils.AccountPermissionsKey)
+	if permissionsVal == nil {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
+	outCtx = utils.PackAccountPermissionsForGRPC(outCtx, permissionsVal.([]string))
+
+	resp, err := r.AuthClient.Impersonate(outCtx, &auth_v1.ImpersonateRequest{
+		AccountId: accountID,
+		Reason:    reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &model.ImpersonateResponse{
+		AccessToken: resp.GetAccessToken(),
+		ExpiresAt:   resp.GetExpiresAt().AsTime().Format(time.RFC3339),
+	}, nil
+}
+
+// CreateAPIKey is the resolver for the createApiKey field.
+func (r *mutationResolver) CreateAPIKey(ctx context.Context, name string, scopes []string, expiresAt *string) (*auth_v1.CreateAPIKeyResponse, error) {
+	idVal := ctx.Value(utils.AccountIDKey)
+	if idVal == nil {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	permissionsVal := ctx.Value(utils.AccountPermissionsKey)
+	if permissionsVal == nil {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	req := &auth_v1.CreateAPIKeyRequest{Name: name, Scopes: scopes}
+	if expiresAt != nil {
+		t, err := time.Parse(time.RFC3339, *expiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("expiresAt must be in RFC 3339 format")
+		}
+		req.ExpiresAt = timestamppb.New(t)
+	}
+
+	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
+	outCtx = utils.PackAccountPermissionsForGRPC(outCtx, permissionsVal.([]string))
+
+	return r.AuthClient.CreateAPIKey(outCtx, req)
+}
+
+// RevokeAPIKey is the resolver for the revokeApiKey field.
+func (r *mutationResolver) RevokeAPIKey(ctx context.Context, keyID string) (bool, error) {
+	idVal := ctx.Value(utils.AccountIDKey)
+	if idVal == nil {
+		return false, fmt.Errorf("unauthorized")
+	}
+
+	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
+
+	resp, err := r.AuthClient.RevokeAPIKey(
+		outCtx, &auth_v1.RevokeAPIKeyRequest{KeyId: keyID},
+	)
+	if err != nil {
+		return false, err
+	}
+	return resp.GetRevoked(), nil
+}
+
 // UpdateProfile is the resolver for the updateProfile field.
 func (r *mutationResolver) UpdateProfile(ctx context.Context, firstName *string, lastName *string, phone *string, avatarURL *string, bio *string) (bool, error) {
 	idVal := ctx.Value(utils.AccountIDKey)
@@ -246,13 +705,13 @@ func (r *queryResolver) Me(ctx context.Context) (*user_v1.GetProfileResponse, er
 		return nil, fmt.Errorf("unauthorized")
 	}
 
-	roleVal := ctx.Value(utils.AccountRoleKey)
-	if roleVal == nil {
+	permissionsVal := ctx.Value(utils.AccountPermissionsKey)
+	if permissionsVal == nil {
 		return nil, fmt.Errorf("unauthorized")
 	}
 
 	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
-	outCtx = utils.PackAccountRoleForGRPC(outCtx, roleVal.(string))
+	outCtx = utils.PackAccountPermissionsForGRPC(outCtx, permissionsVal.([]string))
 
 	return r.UserClient.GetProfile(outCtx, &user_v1.GetProfileRequest{})
 }
@@ -269,19 +728,234 @@ func (r *queryResolver) Ad(ctx context.Context, adID string) (*ad_v1.GetAdRespon
 	return r.AdClient.GetAd(outCtx, &ad_v1.GetAdRequest{AdId: adID})
 }
 
+// Sessions is the resolver for the sessions field.
+func (r *queryResolver) Sessions(ctx context.Context, refreshToken *string) ([]*model.Session, error) {
+	idVal := ctx.Value(utils.AccountIDKey)
+	if idVal == nil {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
+
+	resp, err := r.AuthClient.ListSessions(
+		outCtx, &auth_v1.ListSessionsRequest{RefreshToken: refreshToken},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*model.Session, 0, len(resp.GetSessions()))
+	for _, s := range resp.GetSessions() {
+		sessions = append(sessions, &model.Session{
+			SessionID: s.GetSessionId(),
+			IP:        s.Ip,
+			UserAgent: s.UserAgent,
+			CreatedAt: s.GetCreatedAt().AsTime().Format(time.RFC3339),
+			ExpiresAt: s.GetExpiresAt().AsTime().Format(time.RFC3339),
+			Current:   s.GetCurrent(),
+		})
+	}
+	return sessions, nil
+}
+
+// UserRoles is the resolver for the userRoles field.
+func (r *queryResolver) UserRoles(ctx context.Context, accountID *string) (*auth_v1.GetUserRolesResponse, error) {
+	idVal := ctx.Value(utils.AccountIDKey)
+	if idVal == nil {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	permissionsVal := ctx.Value(utils.AccountPermissionsKey)
+	if permissionsVal == nil {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
+	outCtx = utils.PackAccountPermissionsForGRPC(outCtx, permissionsVal.([]string))
+
+	req := &auth_v1.GetUserRolesRequest{}
+	if accountID != nil {
+		req.AccountId = *accountID
+	}
+
+	return r.AuthClient.GetUserRoles(outCtx, req)
+}
+
+// APIKeys is the resolver for the apiKeys field.
+func (r *queryResolver) APIKeys(ctx context.Context) ([]*model.APIKey, error) {
+	idVal := ctx.Value(utils.AccountIDKey)
+	if idVal == nil {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
+
+	resp, err := r.AuthClient.ListAPIKeys(outCtx, &auth_v1.ListAPIKeysRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*model.APIKey, 0, len(resp.GetKeys()))
+	for _, k := range resp.GetKeys() {
+		keys = append(keys, &model.APIKey{
+			KeyID:      k.GetKeyId(),
+			Name:       k.GetName(),
+			Prefix:     k.GetPrefix(),
+			Scopes:     k.GetScopes(),
+			CreatedAt:  k.GetCreatedAt().AsTime().Format(time.RFC3339),
+			ExpiresAt:  formatOptionalTime(k.GetExpiresAt()),
+			LastUsedAt: formatOptionalTime(k.GetLastUsedAt()),
+			RevokedAt:  formatOptionalTime(k.GetRevokedAt()),
+		})
+	}
+	return keys, nil
+}
+
+// AuthEvents is the resolver for the authEvents field.
+func (r *queryResolver) AuthEvents(ctx context.Context, accountID *string, eventType *string, outcome *string, from *string, to *string, cursor *string, limit *int) (*model.AuthEventPage, error) {
+	idVal := ctx.Value(utils.AccountIDKey)
+	if idVal == nil {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	permissionsVal := ctx.Value(utils.AccountPermissionsKey)
+	if permissionsVal == nil {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	req := &auth_v1.ListAuthEventsRequest{
+		AccountId: accountID,
+		EventType: eventType,
+		Outcome:   outcome,
+		Cursor:    cursor,
+	}
+	if limit != nil {
+		req.Limit = int32(*limit)
+	}
+	if from != nil {
+		t, err := time.Parse(time.RFC3339, *from)
+		if err != nil {
+			return nil, fmt.Errorf("from must be in RFC 3339 format")
+		}
+		req.From = timestamppb.New(t)
+	}
+	if to != nil {
+		t, err := time.Parse(time.RFC3339, *to)
+		if err != nil {
+			return nil, fmt.Errorf("to must be in RFC 3339 format")
+		}
+		req.To = timestamppb.New(t)
+	}
+
+	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
+	outCtx = utils.PackAccountPermissionsForGRPC(outCtx, permissionsVal.([]string))
+
+	resp, err := r.AuthClient.ListAuthEvents(outCtx, req)
+	if err != nil {
+		return nil, err
+	}
+	return mapAuthEventPage(resp), nil
+}
+
+// MyAuthEvents is the resolver for the myAuthEvents field.
+func (r *queryResolver) MyAuthEvents(ctx context.Context, cursor *string, limit *int) (*model.AuthEventPage, error) {
+	idVal := ctx.Value(utils.AccountIDKey)
+	if idVal == nil {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	req := &auth_v1.ListMyAuthEventsRequest{Cursor: cursor}
+	if limit != nil {
+		req.Limit = int32(*limit)
+	}
+
+	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
+
+	resp, err := r.AuthClient.ListMyAuthEvents(outCtx, req)
+	if err != nil {
+		return nil, err
+	}
+	return mapAuthEventPage(resp), nil
+}
+
+// Accounts is the resolver for the accounts field.
+func (r *queryResolver) Accounts(ctx context.Context, status *string, role *string, emailVerified *bool, emailPrefix *string, createdFrom *string, createdTo *string, lastLoginFrom *string, lastLoginTo *string, sortBy *string, sortDesc *bool, cursor *string, limit *int) (*model.AccountPage, error) {
+	idVal := ctx.Value(utils.AccountIDKey)
+	if idVal == nil {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	permissionsVal := ctx.Value(utils.AccountPermissionsKey)
+	if permissionsVal == nil {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	req := &auth_v1.ListAccountsRequest{
+		Status:        status,
+		Role:          role,
+		EmailVerified: emailVerified,
+		EmailPrefix:   emailPrefix,
+		Cursor:        cursor,
+	}
+	if sortBy != nil {
+		field, ok := accountSortFields[*sortBy]
+		if !ok {
+			field = *sortBy
+		}
+		req.SortBy = &field
+	}
+	if sortDesc != nil {
+		req.SortDesc = *sortDesc
+	}
+	if limit != nil {
+		req.Limit = int32(*limit)
+	}
+
+	var err error
+	if req.CreatedFrom, err = parseOptionalTime("createdFrom", createdFrom); err != nil {
+		return nil, err
+	}
+	if req.CreatedTo, err = parseOptionalTime("createdTo", createdTo); err != nil {
+		return nil, err
+	}
+	if req.LastLoginFrom, err = parseOptionalTime("lastLoginFrom", lastLoginFrom); err != nil {
+		return nil, err
+	}
+	if req.LastLoginTo, err = parseOptionalTime("lastLoginTo", lastLoginTo); err != nil {
+		return nil, err
+	}
+
+	outCtx := utils.PackAccountIDForGRPC(ctx, idVal.(string))
+	outCtx = utils.PackAccountPermissionsForGRPC(outCtx, permissionsVal.([]string))
+
+	resp, err := r.AuthClient.ListAccounts(outCtx, req)
+	if err != nil {
+		return nil, err
+	}
+	return mapAccountPage(resp), nil
+}
+
 // ID is the resolver for the id field.
 func (r *userResolver) ID(ctx context.Context, obj *user_v1.GetProfileResponse) (string, error) {
 	return obj.GetAccountId(), nil
 }
 
-// Role is the resolver for the role field.
-func (r *userResolver) Role(ctx context.Context, obj *user_v1.GetProfileResponse) (*string, error) {
-	roleVal := ctx.Value(utils.AccountRoleKey)
-	if roleVal == nil {
-		return nil, nil
+// Roles is the resolver for the roles field.
+func (r *userResolver) Roles(ctx context.Context, obj *user_v1.GetProfileResponse) ([]string, error) {
+	rolesVal := ctx.Value(utils.AccountRolesKey)
+	if rolesVal == nil {
+		return []string{}, nil
+	}
+	return rolesVal.([]string), nil
+}
+
+// Permissions is the resolver for the permissions field.
+func (r *userResolver) Permissions(ctx context.Context, obj *user_v1.GetProfileResponse) ([]string, error) {
+	permissionsVal := ctx.Value(utils.AccountPermissionsKey)
+	if permissionsVal == nil {
+		return []string{}, nil
 	}
-	role := roleVal.(string)
-	return &role, nil
+	return permissionsVal.([]string), nil
 }
 
 // UpdatedAt is the resolver for the updatedAt field.
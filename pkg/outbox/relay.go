@@ -0,0 +1,134 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Sent messages are deleted at most this often
+const purgeInterval = time.Hour
+
+// Store is where the relay takes messages from, see PostgresStore
+type Store interface {
+	ProcessDue(ctx context.Context, limit int, backoff Backoff, send func(ctx context.Context, msg Message) error) (sent, failed int, err error)
+	PurgeSent(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Publisher sends a message to the broker and returns once it is confirmed
+type Publisher interface {
+	Publish(ctx context.Context, routingKey string, payload []byte) error
+}
+
+// Backoff doubles the delay after every failed attempt up to Max
+type Backoff struct {
+	Base time.Duration
+	Max  time.Duration
+}
+
+// Delay returns how long to wait after the given number of failed attempts
+func (b Backoff) Delay(attempts int) time.Duration {
+	delay := b.Base
+	for i := 1; i < attempts && delay < b.Max; i++ {
+		delay *= 2
+	}
+	return min(delay, b.Max)
+}
+
+type RelayConfig struct {
+	Interval  time.Duration // pause between polls when the outbox is drained
+	BatchSize int
+	Backoff   Backoff
+	Retention time.Duration // how long sent messages are kept
+}
+
+// Relay moves messages from the outbox to the broker. A message is sent
+// at least once, consumers are expected to handle duplicates
+type Relay struct {
+	log       *slog.Logger
+	store     Store
+	publisher Publisher
+	cfg       RelayConfig
+}
+
+func NewRelay(log *slog.Logger, store Store, publisher Publisher, cfg RelayConfig) *Relay {
+	return &Relay{
+		log:       log,
+		store:     store,
+		publisher: publisher,
+		cfg:       cfg,
+	}
+}
+
+// Start runs the relay in background until ctx is cancelled
+func (r *Relay) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(r.cfg.Interval)
+		defer ticker.Stop()
+
+		var purgedAt time.Time
+		for {
+			r.Flush(ctx)
+
+			// Sent messages are only kept for troubleshooting
+			if r.cfg.Retention > 0 && time.Since(purgedAt) >= purgeInterval {
+				r.purge(ctx)
+				purgedAt = time.Now()
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Flush sends batches of due messages until the outbox has none left,
+// it returns the number of sent messages
+func (r *Relay) Flush(ctx context.Context) int {
+	var total int
+	for ctx.Err() == nil {
+		sent, failed, err := r.store.ProcessDue(ctx, r.cfg.BatchSize, r.cfg.Backoff, r.send)
+		if err != nil {
+			r.log.ErrorContext(ctx, "failed to relay outbox messages",
+				slog.Any("reason", err),
+			)
+			return total
+		}
+		total += sent
+		if sent+failed < r.cfg.BatchSize {
+			return total
+		}
+	}
+	return total
+}
+
+func (r *Relay) send(ctx context.Context, msg Message) error {
+	if err := r.publisher.Publish(ctx, msg.RoutingKey, msg.Payload); err != nil {
+		r.log.WarnContext(ctx, "failed to publish outbox message",
+			slog.String("message_id", msg.ID.String()),
+			slog.String("routing_key", msg.RoutingKey),
+			slog.Int("attempt", msg.Attempts+1),
+			slog.Any("reason", err),
+		)
+		return err
+	}
+	return nil
+}
+
+func (r *Relay) purge(ctx context.Context) {
+	deleted, err := r.store.PurgeSent(ctx, time.Now().Add(-r.cfg.Retention))
+	if err != nil {
+		r.log.ErrorContext(ctx, "failed to purge sent outbox messages",
+			slog.Any("reason", err),
+		)
+		return
+	}
+	if deleted > 0 {
+		r.log.InfoContext(ctx, "sent outbox messages purged",
+			slog.Int64("deleted", deleted),
+		)
+	}
+}
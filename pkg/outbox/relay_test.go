@@ -0,0 +1,97 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/maket12/ads-service/pkg/outbox"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff_Delay(t *testing.T) {
+	backoff := outbox.Backoff{Base: time.Second, Max: time.Minute}
+
+	assert.Equal(t, time.Second, backoff.Delay(1))
+	assert.Equal(t, time.Second*2, backoff.Delay(2))
+	assert.Equal(t, time.Second*8, backoff.Delay(4))
+	assert.Equal(t, time.Minute, backoff.Delay(7))
+	assert.Equal(t, time.Minute, backoff.Delay(1000))
+}
+
+// memoryStore keeps messages in a slice and applies the backoff like the
+// postgres store does
+type memoryStore struct {
+	messages []outbox.Message
+	sent     []uuid.UUID
+	retryAt  map[uuid.UUID]time.Duration
+}
+
+func (s *memoryStore) ProcessDue(
+	ctx context.Context, limit int, backoff outbox.Backoff,
+	send func(ctx context.Context, msg outbox.Message) error,
+) (int, int, error) {
+	var sent, failed int
+	var rest []outbox.Message
+	for i, msg := range s.messages {
+		if i >= limit {
+			rest = append(rest, msg)
+			continue
+		}
+		if err := send(ctx, msg); err != nil {
+			s.retryAt[msg.ID] = backoff.Delay(msg.Attempts + 1)
+			failed++
+			continue
+		}
+		s.sent = append(s.sent, msg.ID)
+		sent++
+	}
+	s.messages = rest
+	return sent, failed, nil
+}
+
+func (s *memoryStore) PurgeSent(context.Context, time.Time) (int64, error) {
+	return 0, nil
+}
+
+type fakePublisher struct {
+	fail map[string]bool
+}
+
+func (p *fakePublisher) Publish(_ context.Context, routingKey string, _ []byte) error {
+	if p.fail[routingKey] {
+		return errors.New("nacked")
+	}
+	return nil
+}
+
+func TestRelay_Flush(t *testing.T) {
+	var messages []outbox.Message
+	for range 5 {
+		messages = append(messages, outbox.Message{ID: uuid.New(), RoutingKey: "account.created"})
+	}
+	broken := outbox.Message{ID: uuid.New(), RoutingKey: "account.broken", Attempts: 2}
+	messages = append(messages, broken)
+
+	store := &memoryStore{messages: messages, retryAt: map[uuid.UUID]time.Duration{}}
+	publisher := &fakePublisher{fail: map[string]bool{"account.broken": true}}
+
+	relay := outbox.NewRelay(slog.Default(), store, publisher, outbox.RelayConfig{
+		Interval:  time.Second,
+		BatchSize: 2,
+		Backoff:   outbox.Backoff{Base: time.Second, Max: time.Minute},
+	})
+
+	// Batches are taken until the outbox is drained
+	sent := relay.Flush(context.Background())
+	assert.Equal(t, 5, sent)
+	assert.Len(t, store.sent, 5)
+	assert.Empty(t, store.messages)
+
+	// The failed message is put off by the third attempt
+	assert.Equal(t, time.Second*4, store.retryAt[broken.ID])
+}
@@ -0,0 +1,141 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	pkgpostgres "github.com/maket12/ads-service/pkg/postgres"
+
+	"github.com/google/uuid"
+)
+
+// Message is an event waiting in the outbox until the broker confirms it
+type Message struct {
+	ID         uuid.UUID
+	RoutingKey string
+	Payload    []byte
+	Attempts   int
+	CreatedAt  time.Time
+}
+
+// PostgresStore keeps messages in the outbox_messages table of the service:
+//
+//	CREATE TABLE outbox_messages (
+//	    id uuid PRIMARY KEY,
+//	    routing_key text NOT NULL,
+//	    payload jsonb NOT NULL,
+//	    attempts int NOT NULL DEFAULT 0,
+//	    last_error text,
+//	    next_attempt_at timestamptz NOT NULL DEFAULT now(),
+//	    created_at timestamptz NOT NULL DEFAULT now(),
+//	    sent_at timestamptz
+//	);
+type PostgresStore struct {
+	db *sql.DB
+}
+
+func NewPostgresStore(client *pkgpostgres.Client) *PostgresStore {
+	return &PostgresStore{db: client.DB}
+}
+
+// Add marshals the event and saves it within the transaction of ctx,
+// so it is sent only if the rest of the transaction is committed
+func (s *PostgresStore) Add(ctx context.Context, routingKey string, event any) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	const query = `INSERT INTO outbox_messages (id, routing_key, payload) VALUES ($1, $2, $3)`
+	if tx, ok := pkgpostgres.TxFromContext(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, uuid.New(), routingKey, payload)
+	} else {
+		_, err = s.db.ExecContext(ctx, query, uuid.New(), routingKey, payload)
+	}
+	return err
+}
+
+// ProcessDue locks up to limit messages whose attempt is due and calls send
+// for each of them. A sent message is marked, a failed one is put off by
+// the backoff. Replicas skip the locked rows, so a message is sent by one of them
+func (s *PostgresStore) ProcessDue(
+	ctx context.Context, limit int, backoff Backoff,
+	send func(ctx context.Context, msg Message) error,
+) (sent, failed int, err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id, routing_key, payload, attempts, created_at
+		FROM outbox_messages
+		WHERE sent_at IS NULL AND next_attempt_at <= now()
+		ORDER BY created_at
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED`, limit,
+	)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to select due messages: %w", err)
+	}
+
+	var messages []Message
+	for rows.Next() {
+		var msg Message
+		if err := rows.Scan(&msg.ID, &msg.RoutingKey, &msg.Payload, &msg.Attempts, &msg.CreatedAt); err != nil {
+			_ = rows.Close()
+			return 0, 0, fmt.Errorf("failed to scan message: %w", err)
+		}
+		messages = append(messages, msg)
+	}
+	if err := rows.Close(); err != nil {
+		return 0, 0, err
+	}
+	if err := rows.Err(); err != nil {
+		return 0, 0, err
+	}
+
+	for _, msg := range messages {
+		if sendErr := send(ctx, msg); sendErr != nil {
+			retryAt := time.Now().Add(backoff.Delay(msg.Attempts + 1))
+			if _, err := tx.ExecContext(ctx, `
+				UPDATE outbox_messages
+				SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3
+				WHERE id = $1`, msg.ID, sendErr.Error(), retryAt,
+			); err != nil {
+				return 0, 0, fmt.Errorf("failed to postpone message: %w", err)
+			}
+			failed++
+			continue
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE outbox_messages
+			SET attempts = attempts + 1, last_error = NULL, sent_at = now()
+			WHERE id = $1`, msg.ID,
+		); err != nil {
+			return 0, 0, fmt.Errorf("failed to mark message as sent: %w", err)
+		}
+		sent++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return sent, failed, nil
+}
+
+// PurgeSent deletes messages sent before the given time
+func (s *PostgresStore) PurgeSent(ctx context.Context, before time.Time) (int64, error) {
+	res, err := s.db.ExecContext(ctx,
+		`DELETE FROM outbox_messages WHERE sent_at IS NOT NULL AND sent_at < $1`, before,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
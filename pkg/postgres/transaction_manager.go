@@ -1,4 +1,60 @@
 package postgres
 
-// TransactionManager TODO: Maybe complete it later
-type TransactionManager struct{}
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+type txKey struct{}
+
+// TransactionManager runs a function in a transaction which is passed
+// through the context, repositories pick it up with TxFromContext so
+// their writes are committed or rolled back together
+type TransactionManager struct {
+	db *sql.DB
+}
+
+func NewTransactionManager(client *Client) *TransactionManager {
+	return &TransactionManager{db: client.DB}
+}
+
+// WithinTx commits when fn succeeds and rolls back otherwise, the error of fn
+// is returned as it is. A call inside another one joins the outer transaction
+func (tm *TransactionManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := TxFromContext(ctx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := tm.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Do not keep the connection in a broken transaction after a panic
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			return errors.Join(err, fmt.Errorf("failed to rollback transaction: %w", rbErr))
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// TxFromContext returns the transaction started by WithinTx, if any
+func TxFromContext(ctx context.Context) (*sql.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(*sql.Tx)
+	return tx, ok
+}
@@ -0,0 +1,86 @@
+package rabbitmq
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// ConfirmPublisher sends messages on a channel in confirm mode and waits
+// until the broker takes responsibility for each of them
+type ConfirmPublisher struct {
+	exchange string
+	channel  *amqp.Channel
+	mu       sync.Mutex // a channel must not be shared by concurrent publishers
+}
+
+func NewConfirmPublisher(client *RabbitClient, exchange string) (*ConfirmPublisher, error) {
+	ch, err := client.Conn.Channel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open channel: %w", err)
+	}
+
+	if err := ch.ExchangeDeclare(
+		exchange,
+		"topic",
+		true,
+		false,
+		false,
+		false,
+		nil,
+	); err != nil {
+		_ = ch.Close()
+		return nil, fmt.Errorf("failed to declare exchange: %w", err)
+	}
+
+	if err := ch.Confirm(false); err != nil {
+		_ = ch.Close()
+		return nil, fmt.Errorf("failed to put channel into confirm mode: %w", err)
+	}
+
+	return &ConfirmPublisher{
+		exchange: exchange,
+		channel:  ch,
+	}, nil
+}
+
+// Publish returns an error when the message is rejected by the broker
+// or is not confirmed before ctx is done
+func (p *ConfirmPublisher) Publish(ctx context.Context, routingKey string, payload []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	confirmation, err := p.channel.PublishWithDeferredConfirmWithContext(
+		ctx,
+		p.exchange,
+		routingKey,
+		false,
+		false,
+		amqp.Publishing{
+			ContentType:  "application/json",
+			DeliveryMode: amqp.Persistent,
+			Body:         payload,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to publish: %w", err)
+	}
+
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to wait for confirmation: %w", err)
+	}
+	if !acked {
+		return fmt.Errorf("message was not acknowledged by the broker")
+	}
+	return nil
+}
+
+func (p *ConfirmPublisher) Close() error {
+	if err := p.channel.Close(); err != nil {
+		return fmt.Errorf("failed to close rabbitmq channel: %w", err)
+	}
+	return nil
+}
@@ -1,7 +1,87 @@
 package rabbitmq
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Routing keys of the account_topic exchange. Account creation
+// still goes through the configurable ACCOUNT_ROUTING_KEY
+const (
+	SessionCompromisedRoutingKey  = "account.session.compromised"
+	SessionAnomalyRoutingKey      = "account.session.anomaly"
+	NewDeviceLoginRoutingKey      = "account.session.new_device"
+	AccountBlockedRoutingKey      = "account.blocked"
+	AccountUnblockedRoutingKey    = "account.unblocked"
+	AccountDeletedRoutingKey      = "account.deleted"
+	AccountEmailChangedRoutingKey = "account.email.changed"
+)
 
 type AccountCreatedEvent struct {
 	AccountID uuid.UUID `json:"account_id"`
 }
+
+// SessionCompromisedEvent is published when an already rotated refresh
+// token is presented again, i.e. it was most likely stolen
+type SessionCompromisedEvent struct {
+	AccountID  uuid.UUID `json:"account_id"`
+	SessionID  uuid.UUID `json:"session_id"`
+	IP         *string   `json:"ip,omitempty"`
+	UserAgent  *string   `json:"user_agent,omitempty"`
+	DetectedAt time.Time `json:"detected_at"`
+}
+
+// SessionAnomalyEvent is published when a session is refreshed from a client
+// that does not match the binding policy, e.g. another network or browser.
+// The session goes on, the owner should be able to review it
+type SessionAnomalyEvent struct {
+	AccountID         uuid.UUID `json:"account_id"`
+	SessionID         uuid.UUID `json:"session_id"`
+	PreviousIP        *string   `json:"previous_ip,omitempty"`
+	PreviousUserAgent *string   `json:"previous_user_agent,omitempty"`
+	IP                *string   `json:"ip,omitempty"`
+	UserAgent         *string   `json:"user_agent,omitempty"`
+	DetectedAt        time.Time `json:"detected_at"`
+}
+
+// NewDeviceLoginEvent is published when the account signs in from an IP
+// and user agent pair none of its recent sessions had. The device is
+// described coarsely, e.g. "Chrome" on "Windows", so it can be shown as is
+type NewDeviceLoginEvent struct {
+	AccountID  uuid.UUID `json:"account_id"`
+	SessionID  uuid.UUID `json:"session_id"`
+	IP         *string   `json:"ip,omitempty"`
+	Browser    string    `json:"browser,omitempty"`
+	OS         string    `json:"os,omitempty"`
+	LoggedInAt time.Time `json:"logged_in_at"`
+}
+
+// AccountBlockedEvent is published when an admin blocks the account,
+// consumers should hide everything the account owns
+type AccountBlockedEvent struct {
+	AccountID uuid.UUID `json:"account_id"`
+	Reason    string    `json:"reason"`
+	BlockedAt time.Time `json:"blocked_at"`
+}
+
+// AccountUnblockedEvent reverts the effects of AccountBlockedEvent
+type AccountUnblockedEvent struct {
+	AccountID   uuid.UUID `json:"account_id"`
+	UnblockedAt time.Time `json:"unblocked_at"`
+}
+
+// AccountDeletedEvent is published when the account is soft-deleted
+type AccountDeletedEvent struct {
+	AccountID uuid.UUID `json:"account_id"`
+	Reason    string    `json:"reason"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+// AccountEmailChangedEvent is published after the new address is confirmed
+type AccountEmailChangedEvent struct {
+	AccountID uuid.UUID `json:"account_id"`
+	OldEmail  string    `json:"old_email"`
+	NewEmail  string    `json:"new_email"`
+	ChangedAt time.Time `json:"changed_at"`
+}
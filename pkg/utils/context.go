@@ -14,16 +14,20 @@ import (
 type contextKey string
 
 const (
-	AccountIDKey   contextKey = "account_id"
-	AccountRoleKey contextKey = "account_role"
+	AccountIDKey          contextKey = "account_id"
+	AccountRolesKey       contextKey = "account_roles"
+	AccountPermissionsKey contextKey = "account_permissions"
+	ActorIDKey            contextKey = "actor_id"
+	APIKeyIDKey           contextKey = "api_key_id"
 )
 
 // Custom errors
 var (
-	ErrMetadataIsMissing       = errors.New("metadata is missing")
-	ErrAccountIDNotSpecified   = errors.New("account id not found in metadata")
-	ErrInvalidAccountID        = errors.New("metadata contains invalid account id")
-	ErrAccountRoleNotSpecified = errors.New("account role not found in metadata")
+	ErrMetadataIsMissing     = errors.New("metadata is missing")
+	ErrAccountIDNotSpecified = errors.New("account id not found in metadata")
+	ErrInvalidAccountID      = errors.New("metadata contains invalid account id")
+	ErrInvalidActorID        = errors.New("metadata contains invalid actor id")
+	ErrInvalidAPIKeyID       = errors.New("metadata contains invalid api key id")
 )
 
 // ExtractAccountID Extracts account id from incoming context (GRPC)
@@ -46,29 +50,80 @@ func ExtractAccountID(ctx context.Context) (uuid.UUID, error) {
 	return id, nil
 }
 
-// ExtractAccountRole Extracts account role from incoming context (GRPC)
-func ExtractAccountRole(ctx context.Context) (string, error) {
+// ExtractAccountPermissions Extracts account permissions from incoming context (GRPC),
+// an account without permissions has no values at all
+func ExtractAccountPermissions(ctx context.Context) ([]string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return "", pkgerrs.NewNotAuthenticatedErrorWithReason(ErrMetadataIsMissing)
+		return nil, pkgerrs.NewNotAuthenticatedErrorWithReason(ErrMetadataIsMissing)
 	}
 
-	vals := md.Get("x-account-role")
+	return md.Get("x-account-permissions"), nil
+}
+
+// ExtractActorID Extracts id of the admin acting on behalf of the account
+// from incoming context (GRPC), nil when the account acts by itself
+func ExtractActorID(ctx context.Context) (*uuid.UUID, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, pkgerrs.NewNotAuthenticatedErrorWithReason(ErrMetadataIsMissing)
+	}
+
+	vals := md.Get("x-actor-id")
+	if len(vals) == 0 {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(vals[0])
+	if err != nil {
+		return nil, pkgerrs.NewNotAuthenticatedErrorWithReason(ErrInvalidActorID)
+	}
+
+	return &id, nil
+}
+
+// ExtractAPIKeyID Extracts id of the api key the request is authenticated with
+// from incoming context (GRPC), nil when the account uses an access token
+func ExtractAPIKeyID(ctx context.Context) (*uuid.UUID, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, pkgerrs.NewNotAuthenticatedErrorWithReason(ErrMetadataIsMissing)
+	}
+
+	vals := md.Get("x-api-key-id")
 	if len(vals) == 0 {
-		return "", pkgerrs.NewNotAuthenticatedErrorWithReason(ErrAccountRoleNotSpecified)
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(vals[0])
+	if err != nil {
+		return nil, pkgerrs.NewNotAuthenticatedErrorWithReason(ErrInvalidAPIKeyID)
 	}
 
-	return vals[0], nil
+	return &id, nil
 }
 
-// PackAccountIDForGRPC Packs account id into outgoing context (metadata | GRPC)
+// PackAccountIDForGRPC Packs account id into outgoing context (metadata | GRPC),
+// the actor of an impersonated request and the api key of a machine client
+// always travel together with it
 func PackAccountIDForGRPC(ctx context.Context, accountID string) context.Context {
-	return metadata.AppendToOutgoingContext(ctx, "x-account-id", accountID)
+	kv := []string{"x-account-id", accountID}
+	if actorID, ok := ctx.Value(ActorIDKey).(string); ok && actorID != "" {
+		kv = append(kv, "x-actor-id", actorID)
+	}
+	if keyID, ok := ctx.Value(APIKeyIDKey).(string); ok && keyID != "" {
+		kv = append(kv, "x-api-key-id", keyID)
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
 }
 
-// PackAccountRoleForGRPC Packs account role into outgoing context (metadata | GRPC)
-func PackAccountRoleForGRPC(ctx context.Context, accountRole string) context.Context {
-	return metadata.AppendToOutgoingContext(ctx, "x-account-role", accountRole)
+// PackAccountPermissionsForGRPC Packs account permissions into outgoing context (metadata | GRPC)
+func PackAccountPermissionsForGRPC(ctx context.Context, permissions []string) context.Context {
+	kv := make([]string, 0, len(permissions)*2)
+	for _, permission := range permissions {
+		kv = append(kv, "x-account-permissions", permission)
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
 }
 
 // SetAccountIDInCtx Sets account id in context (gateway)
@@ -76,7 +131,22 @@ func SetAccountIDInCtx(ctx context.Context, accountID string) context.Context {
 	return context.WithValue(ctx, AccountIDKey, accountID)
 }
 
-// SetAccountRoleInCtx Sets account role in context (gateway)
-func SetAccountRoleInCtx(ctx context.Context, role string) context.Context {
-	return context.WithValue(ctx, AccountRoleKey, role)
+// SetAccountRolesInCtx Sets account roles in context (gateway)
+func SetAccountRolesInCtx(ctx context.Context, roles []string) context.Context {
+	return context.WithValue(ctx, AccountRolesKey, roles)
+}
+
+// SetAccountPermissionsInCtx Sets account permissions in context (gateway)
+func SetAccountPermissionsInCtx(ctx context.Context, permissions []string) context.Context {
+	return context.WithValue(ctx, AccountPermissionsKey, permissions)
+}
+
+// SetActorIDInCtx Sets id of the impersonating admin in context (gateway)
+func SetActorIDInCtx(ctx context.Context, actorID string) context.Context {
+	return context.WithValue(ctx, ActorIDKey, actorID)
+}
+
+// SetAPIKeyIDInCtx Sets id of the api key the request is authenticated with in context (gateway)
+func SetAPIKeyIDInCtx(ctx context.Context, keyID string) context.Context {
+	return context.WithValue(ctx, APIKeyIDKey, keyID)
 }
@@ -70,37 +70,113 @@ func TestExtractAccountID(t *testing.T) {
 	}
 }
 
-func TestExtractAccountRole(t *testing.T) {
+func TestExtractAccountPermissions(t *testing.T) {
 	type testCase struct {
 		name    string
 		ctx     context.Context
-		expect  string
+		expect  []string
 		wantErr bool
 	}
 
 	var (
-		targetRole = "admin"
-		tests      = []testCase{
+		tests = []testCase{
 			{
 				name: "success",
 				ctx: metadata.NewIncomingContext(context.Background(),
-					metadata.Pairs("x-account-role", targetRole),
+					metadata.Pairs(
+						"x-account-permissions", "users:block",
+						"x-account-permissions", "users:delete",
+					),
 				),
-				expect:  targetRole,
+				expect:  []string{"users:block", "users:delete"},
+				wantErr: false,
+			},
+			{
+				name: "success - packed by the caller",
+				ctx: func() context.Context {
+					out := utils.PackAccountPermissionsForGRPC(
+						context.Background(), []string{"ads:moderate"},
+					)
+					md, _ := metadata.FromOutgoingContext(out)
+					return metadata.NewIncomingContext(context.Background(), md)
+				}(),
+				expect:  []string{"ads:moderate"},
 				wantErr: false,
 			},
 			{
 				name:    "failure - missing metadata",
 				ctx:     context.Background(),
-				expect:  "",
+				expect:  nil,
 				wantErr: true,
 			},
 			{
-				name: "failure - account role is not specified",
+				name: "no permissions",
 				ctx: metadata.NewIncomingContext(context.Background(),
 					metadata.Pairs("wants to be", "a digital nomad"),
 				),
-				expect:  "",
+				expect:  nil,
+				wantErr: false,
+			},
+		}
+	)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			permissions, err := utils.ExtractAccountPermissions(tt.ctx)
+
+			if !tt.wantErr {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+
+			assert.Equal(t, tt.expect, permissions)
+		})
+	}
+}
+
+func TestExtractActorID(t *testing.T) {
+	type testCase struct {
+		name    string
+		ctx     context.Context
+		expect  *uuid.UUID
+		wantErr bool
+	}
+
+	var (
+		actorID = uuid.New()
+		tests   = []testCase{
+			{
+				name: "success - packed by the gateway",
+				ctx: func() context.Context {
+					ctx := utils.SetActorIDInCtx(context.Background(), actorID.String())
+					out := utils.PackAccountIDForGRPC(ctx, uuid.NewString())
+					md, _ := metadata.FromOutgoingContext(out)
+					return metadata.NewIncomingContext(context.Background(), md)
+				}(),
+				expect:  &actorID,
+				wantErr: false,
+			},
+			{
+				name: "not impersonated",
+				ctx: metadata.NewIncomingContext(context.Background(),
+					metadata.Pairs("x-account-id", uuid.NewString()),
+				),
+				expect:  nil,
+				wantErr: false,
+			},
+			{
+				name:    "failure - missing metadata",
+				ctx:     context.Background(),
+				expect:  nil,
+				wantErr: true,
+			},
+			{
+				name: "failure - invalid actor id",
+				ctx: metadata.NewIncomingContext(context.Background(),
+					metadata.Pairs("x-actor-id", "not-valid-uuid"),
+				),
+				expect:  nil,
 				wantErr: true,
 			},
 		}
@@ -108,7 +184,7 @@ func TestExtractAccountRole(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			uid, err := utils.ExtractAccountRole(tt.ctx)
+			actor, err := utils.ExtractActorID(tt.ctx)
 
 			if !tt.wantErr {
 				assert.NoError(t, err)
@@ -116,7 +192,69 @@ func TestExtractAccountRole(t *testing.T) {
 				assert.Error(t, err)
 			}
 
-			assert.Equal(t, tt.expect, uid)
+			assert.Equal(t, tt.expect, actor)
+		})
+	}
+}
+
+func TestExtractAPIKeyID(t *testing.T) {
+	type testCase struct {
+		name    string
+		ctx     context.Context
+		expect  *uuid.UUID
+		wantErr bool
+	}
+
+	var (
+		keyID = uuid.New()
+		tests = []testCase{
+			{
+				name: "success - packed by the gateway",
+				ctx: func() context.Context {
+					ctx := utils.SetAPIKeyIDInCtx(context.Background(), keyID.String())
+					out := utils.PackAccountIDForGRPC(ctx, uuid.NewString())
+					md, _ := metadata.FromOutgoingContext(out)
+					return metadata.NewIncomingContext(context.Background(), md)
+				}(),
+				expect:  &keyID,
+				wantErr: false,
+			},
+			{
+				name: "access token",
+				ctx: metadata.NewIncomingContext(context.Background(),
+					metadata.Pairs("x-account-id", uuid.NewString()),
+				),
+				expect:  nil,
+				wantErr: false,
+			},
+			{
+				name:    "failure - missing metadata",
+				ctx:     context.Background(),
+				expect:  nil,
+				wantErr: true,
+			},
+			{
+				name: "failure - invalid api key id",
+				ctx: metadata.NewIncomingContext(context.Background(),
+					metadata.Pairs("x-api-key-id", "not-valid-uuid"),
+				),
+				expect:  nil,
+				wantErr: true,
+			},
+		}
+	)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := utils.ExtractAPIKeyID(tt.ctx)
+
+			if !tt.wantErr {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+
+			assert.Equal(t, tt.expect, key)
 		})
 	}
 }